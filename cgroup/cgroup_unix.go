@@ -0,0 +1,957 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup gets the resource limits and usage of a control group
+// (cgroup) from the cgroup v2 unified hierarchy or, on hosts whose
+// controllers are in cgroup v1 hierarchies, from the v1 controllers. The
+// cgroup is the one that the current process, or a given pid, belongs to, as
+// listed in /proc/[pid]/cgroup. Inside of a container, this is the
+// container's cgroup: unlike /proc/meminfo and /proc/stat, which report the
+// host's totals, the cgroup's files report the container's limits and usage.
+//
+// The cgroup, Get, holds the cgroup's cpu, memory, io, and pids information
+// from cpu.max, cpu.stat, cpuset.cpus.effective, memory.current, memory.max,
+// memory.stat, io.stat, pids.current, and pids.max. A file that doesn't
+// exist, e.g. because its controller isn't enabled for the cgroup, is
+// skipped; its values are zero.
+// A limit of max is represented by Unlimited. With cgroup v1, the same
+// information is read from the cpu, cpuacct, cpuset, memory, blkio, and pids
+// controllers' files and converted to the cgroup v2 units; the memory.stat
+// fields that v1 doesn't have are zero.
+//
+// The usage, GetUsage, is the cgroup's cpu usage, throttling, and io during
+// the interval between two snapshots; it is calculated from the change in
+// the cgroup's counters.
+package cgroup
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strconv"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const (
+	// Mount is where the cgroup v2 hierarchy is mounted.
+	Mount = "/sys/fs/cgroup"
+	// HybridMount is where the cgroup v2 hierarchy is mounted on systems
+	// that also mount cgroup v1 hierarchies at Mount.
+	HybridMount = "/sys/fs/cgroup/unified"
+	// SelfFile lists the cgroups of the current process.
+	SelfFile = "/proc/self/cgroup"
+	// ControllersFile exists in every cgroup v2 directory.
+	ControllersFile = "cgroup.controllers"
+)
+
+// The cgroup files that are processed.
+const (
+	CPUMaxFile        = "cpu.max"
+	CPUStatFile       = "cpu.stat"
+	CpusetCPUsFile    = "cpuset.cpus.effective"
+	MemoryCurrentFile = "memory.current"
+	MemoryMaxFile     = "memory.max"
+	MemoryStatFile    = "memory.stat"
+	IOStatFile        = "io.stat"
+	PidsCurrentFile   = "pids.current"
+	PidsMaxFile       = "pids.max"
+)
+
+// Unlimited is the value of a limit that is set to max.
+const Unlimited = -1
+
+// ErrNotSupported is returned when neither the cgroup v2 hierarchy nor any of
+// the cgroup v1 controllers are mounted or the process doesn't belong to a
+// cgroup in them.
+var ErrNotSupported = errors.New("cgroups are not supported")
+
+// CPU holds the cgroup's cpu bandwidth limit and usage. The times are in
+// microseconds.
+type CPU struct {
+	// The cpu time the cgroup may use in each period; Unlimited if there
+	// is no limit.
+	Quota  int64 `json:"quota"`
+	Period int64 `json:"period"`
+	// The cpu time used by the cgroup.
+	UsageUsec  int64 `json:"usage_usec"`
+	UserUsec   int64 `json:"user_usec"`
+	SystemUsec int64 `json:"system_usec"`
+	// The number of periods that have elapsed and the number of them in
+	// which the cgroup was throttled for exhausting its quota.
+	NrPeriods   int64 `json:"nr_periods"`
+	NrThrottled int64 `json:"nr_throttled"`
+	// The total time that the cgroup was throttled.
+	ThrottledUsec int64 `json:"throttled_usec"`
+	// The number of cpus in the cgroup's effective cpuset; 0 if the cpuset
+	// controller isn't available.
+	EffectiveCPUs int32 `json:"effective_cpus"`
+}
+
+// Memory holds the cgroup's memory limit and usage, in bytes, and a subset
+// of memory.stat. PgFault and PgMajFault are counts.
+type Memory struct {
+	Current int64 `json:"current"`
+	// The memory limit; Unlimited if there is no limit.
+	Max           int64 `json:"max"`
+	Anon          int64 `json:"anon"`
+	File          int64 `json:"file"`
+	KernelStack   int64 `json:"kernel_stack"`
+	Slab          int64 `json:"slab"`
+	Sock          int64 `json:"sock"`
+	Shmem         int64 `json:"shmem"`
+	FileMapped    int64 `json:"file_mapped"`
+	FileDirty     int64 `json:"file_dirty"`
+	FileWriteback int64 `json:"file_writeback"`
+	ActiveAnon    int64 `json:"active_anon"`
+	InactiveAnon  int64 `json:"inactive_anon"`
+	ActiveFile    int64 `json:"active_file"`
+	InactiveFile  int64 `json:"inactive_file"`
+	Unevictable   int64 `json:"unevictable"`
+	PgFault       int64 `json:"pgfault"`
+	PgMajFault    int64 `json:"pgmajfault"`
+}
+
+// IODevice holds the cgroup's io on a device, identified by its major and
+// minor numbers: the bytes and operations read, written, and discarded.
+type IODevice struct {
+	Major  uint32 `json:"major"`
+	Minor  uint32 `json:"minor"`
+	RBytes int64  `json:"rbytes"`
+	WBytes int64  `json:"wbytes"`
+	RIOs   int64  `json:"rios"`
+	WIOs   int64  `json:"wios"`
+	DBytes int64  `json:"dbytes"`
+	DIOs   int64  `json:"dios"`
+}
+
+// Pids holds the number of processes in the cgroup and the limit; Max is
+// Unlimited if there is no limit.
+type Pids struct {
+	Current int64 `json:"current"`
+	Max     int64 `json:"max"`
+}
+
+// Cgroup holds the information of a cgroup. Path is the cgroup's path within
+// the cgroup hierarchy, e.g. /kubepods/burstable/pod1234/abcd.
+type Cgroup struct {
+	Timestamp int64      `json:"timestamp"`
+	Path      string     `json:"path"`
+	CPU       CPU        `json:"cpu"`
+	Memory    Memory     `json:"memory"`
+	IO        []IODevice `json:"io"`
+	Pids      Pids       `json:"pids"`
+}
+
+// CPUUsage holds the cgroup's cpu usage and throttling during the interval
+// between two snapshots. Usage, User, and System are percentages of a single
+// cpu; a cgroup using more than one cpu has values over 100. Quota is the
+// percentage of the cpu quota used; it is 0 if there is no limit.
+// ThrottledPercent is the percentage of the elapsed periods in which the
+// cgroup was throttled and ThrottledUsec is the time that it was throttled,
+// in microseconds.
+type CPUUsage struct {
+	Usage            float32 `json:"usage"`
+	User             float32 `json:"user"`
+	System           float32 `json:"system"`
+	Quota            float32 `json:"quota"`
+	NrPeriods        int64   `json:"nr_periods"`
+	NrThrottled      int64   `json:"nr_throttled"`
+	ThrottledPercent float32 `json:"throttled_percent"`
+	ThrottledUsec    int64   `json:"throttled_usec"`
+}
+
+// MemoryUsage holds the cgroup's current memory usage, in bytes, and limit.
+// Percent is the percentage of the limit used; it is 0 if there is no limit.
+// PgFault and PgMajFault are the number of faults during the interval
+// between two snapshots.
+type MemoryUsage struct {
+	Current    int64   `json:"current"`
+	Max        int64   `json:"max"`
+	Percent    float32 `json:"percent"`
+	PgFault    int64   `json:"pgfault"`
+	PgMajFault int64   `json:"pgmajfault"`
+}
+
+// IODeviceUsage holds the cgroup's io on a device during the interval between
+// two snapshots.
+type IODeviceUsage struct {
+	Major  uint32 `json:"major"`
+	Minor  uint32 `json:"minor"`
+	RBytes int64  `json:"rbytes"`
+	WBytes int64  `json:"wbytes"`
+	RIOs   int64  `json:"rios"`
+	WIOs   int64  `json:"wios"`
+	DBytes int64  `json:"dbytes"`
+	DIOs   int64  `json:"dios"`
+}
+
+// Usage holds the cgroup's usage during the interval between two snapshots;
+// the TimeDelta field holds the time elapsed, in nanoseconds, between the
+// two snapshots. Pids holds the current values.
+type Usage struct {
+	Timestamp int64           `json:"timestamp"`
+	TimeDelta int64           `json:"time_delta"`
+	CPU       CPUUsage        `json:"cpu"`
+	Memory    MemoryUsage     `json:"memory"`
+	IO        []IODeviceUsage `json:"io"`
+	Pids      Pids            `json:"pids"`
+}
+
+// Profiler processes the information of a cgroup.
+type Profiler struct {
+	*joe.Buffer
+	// The cgroup version: 1 or 2.
+	Version int
+	// The cgroup's path within the cgroup hierarchy. With cgroup v1, it's
+	// the path within the cpu controller's hierarchy or, if it isn't
+	// mounted, the first mounted one of the cpuacct, memory, blkio, and pids
+	// controllers' hierarchies.
+	Path string
+	// The cgroup's directory. With cgroup v1, it's the directory of the
+	// controller that Path is from.
+	Dir string
+	// The cgroup files. A nil file is skipped. With cgroup v1, MemoryCurrent
+	// and MemoryMax are memory.usage_in_bytes and memory.limit_in_bytes.
+	CPUMax        joe.Procer
+	CPUStat       joe.Procer
+	Cpuset        joe.Procer
+	MemoryCurrent joe.Procer
+	MemoryMax     joe.Procer
+	MemoryStat    joe.Procer
+	IOStat        joe.Procer
+	PidsCurrent   joe.Procer
+	PidsMax       joe.Procer
+	// The cgroup v1 files that don't have a cgroup v2 equivalent. A nil file
+	// is skipped.
+	CPUQuota     joe.Procer
+	CPUPeriod    joe.Procer
+	CPUAcctUsage joe.Procer
+	CPUAcctStat  joe.Procer
+	BlkioBytes   joe.Procer
+	BlkioIOs     joe.Procer
+	// the ticks per second, for cpuacct.stat.
+	clkTck int64
+	// the cgroup v1 controllers' directories.
+	dirs  map[string]string
+	prior Cgroup
+}
+
+// Returns an initialized Profiler for the cgroup of the current process;
+// ready to use. The cgroup v2 hierarchy is used if it's mounted and has any
+// of the cpu, memory, io, and pids controllers; otherwise, the cgroup v1
+// controllers are used, if any are mounted. If neither are, or the process
+// doesn't belong to a cgroup in them, ErrNotSupported is returned. Upon
+// creation, a snapshot of the cgroup is taken so that any GetUsage() will
+// return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	return NewPIDProfiler(0, opts...)
+}
+
+// NewPIDProfiler returns an initialized Profiler for the cgroup of the
+// process with the pid; if pid is 0, the cgroup of the current process is
+// used.
+func NewPIDProfiler(pid int, opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	mnt, controllers, err := mount(o)
+	if err != nil && err != ErrNotSupported {
+		return nil, err
+	}
+	// On a hybrid system, the controllers are in the cgroup v1 hierarchies
+	// and the cgroup v2 hierarchy has none of them.
+	if err == ErrNotSupported || !controllers {
+		prof, err = newV1Profiler(o, pid)
+		if err == nil || err != ErrNotSupported || mnt == "" {
+			return prof, err
+		}
+	}
+	prof = &Profiler{Buffer: joe.NewBuffer(), Version: 2}
+	prof.Path, err = findPath(o, pid)
+	if err != nil {
+		return nil, err
+	}
+	prof.Dir = path.Join(mnt, prof.Path)
+	// Inside of a container without its own cgroup namespace, the path is
+	// the one in the host's hierarchy while the container's cgroup is
+	// mounted as the root of the hierarchy.
+	_, err = o.Stat(path.Join(prof.Dir, ControllersFile))
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return nil, err
+		}
+		prof.Dir = mnt
+	}
+	for _, f := range []struct {
+		proc *joe.Procer
+		name string
+	}{
+		{&prof.CPUMax, CPUMaxFile},
+		{&prof.CPUStat, CPUStatFile},
+		{&prof.Cpuset, CpusetCPUsFile},
+		{&prof.MemoryCurrent, MemoryCurrentFile},
+		{&prof.MemoryMax, MemoryMaxFile},
+		{&prof.MemoryStat, MemoryStatFile},
+		{&prof.IOStat, IOStatFile},
+		{&prof.PidsCurrent, PidsCurrentFile},
+		{&prof.PidsMax, PidsMaxFile},
+	} {
+		*f.proc, err = newProc(o, path.Join(prof.Dir, f.name))
+		if err != nil {
+			return nil, err
+		}
+	}
+	prof.prior, err = prof.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// mount returns the location of the cgroup v2 hierarchy, using the Options,
+// and whether any of the cpu, memory, io, and pids controllers are available
+// in it.
+func mount(o joe.Options) (string, bool, error) {
+	for _, mnt := range []string{Mount, HybridMount} {
+		mnt = o.Path(mnt)
+		b, err := o.ReadFile(path.Join(mnt, ControllersFile))
+		if err == nil {
+			for _, name := range bytes.Fields(b) {
+				switch string(name) {
+				case "cpu", "memory", "io", "pids":
+					return mnt, true, nil
+				}
+			}
+			return mnt, false, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", false, err
+		}
+	}
+	return "", false, ErrNotSupported
+}
+
+// FindPath returns the path, within the cgroup v2 hierarchy, of the cgroup
+// that the process with the pid belongs to; if pid is 0, the cgroup of the
+// current process is returned.
+func FindPath(pid int, opts ...joe.Option) (string, error) {
+	return findPath(joe.NewOptions(opts...), pid)
+}
+
+// findPath processes /proc/[pid]/cgroup for the cgroup v2 entry, e.g.:
+//
+//	0::/kubepods/burstable/pod1234/abcd
+func findPath(o joe.Options, pid int) (string, error) {
+	fname := SelfFile
+	if pid != 0 {
+		fname = fmt.Sprintf("/proc/%d/cgroup", pid)
+	}
+	b, err := o.ReadFile(o.Path(fname))
+	if err != nil {
+		return "", &joe.ReadError{Info: fname, Err: err}
+	}
+	for _, line := range bytes.Split(b, []byte{'\n'}) {
+		if bytes.HasPrefix(line, []byte("0::")) {
+			return string(line[3:]), nil
+		}
+	}
+	return "", ErrNotSupported
+}
+
+// newProc returns a Procer for the file, using the Options; if the file
+// doesn't exist, nil is returned.
+func newProc(o joe.Options, fname string) (joe.Procer, error) {
+	proc, err := o.NewProc(fname)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return proc, nil
+}
+
+// procs returns the cgroup files.
+func (prof *Profiler) procs() []joe.Procer {
+	return []joe.Procer{
+		prof.CPUMax, prof.CPUStat, prof.Cpuset, prof.MemoryCurrent,
+		prof.MemoryMax, prof.MemoryStat, prof.IOStat, prof.PidsCurrent,
+		prof.PidsMax,
+		prof.CPUQuota, prof.CPUPeriod, prof.CPUAcctUsage, prof.CPUAcctStat,
+		prof.BlkioBytes, prof.BlkioIOs,
+	}
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	for _, p := range prof.procs() {
+		if p == nil {
+			continue
+		}
+		err := p.Reset()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the current information of the cgroup.
+func (prof *Profiler) Get() (cg Cgroup, err error) {
+	err = prof.Reset()
+	if err != nil {
+		return cg, err
+	}
+	cg.Timestamp = time.Now().UTC().UnixNano()
+	cg.Path = prof.Path
+	if prof.PidsCurrent != nil {
+		cg.Pids.Current, err = prof.parseValue(PidsCurrentFile, prof.PidsCurrent)
+		if err != nil {
+			return cg, err
+		}
+	}
+	if prof.PidsMax != nil {
+		cg.Pids.Max, err = prof.parseValue(PidsMaxFile, prof.PidsMax)
+		if err != nil {
+			return cg, err
+		}
+	}
+	if prof.CPUStat != nil {
+		err = prof.parseStat(CPUStatFile, prof.CPUStat, func(k []byte, v int64) {
+			switch string(k) {
+			case "usage_usec":
+				cg.CPU.UsageUsec = v
+			case "user_usec":
+				cg.CPU.UserUsec = v
+			case "system_usec":
+				cg.CPU.SystemUsec = v
+			case "nr_periods":
+				cg.CPU.NrPeriods = v
+			case "nr_throttled":
+				cg.CPU.NrThrottled = v
+			case "throttled_usec":
+				cg.CPU.ThrottledUsec = v
+			case "throttled_time": // cgroup v1: nanoseconds
+				cg.CPU.ThrottledUsec = v / 1000
+			}
+		})
+		if err != nil {
+			return cg, err
+		}
+	}
+	if prof.Cpuset != nil {
+		cg.CPU.EffectiveCPUs, err = prof.parseCPUList()
+		if err != nil {
+			return cg, err
+		}
+	}
+	if prof.Version == 1 {
+		err = prof.getV1(&cg)
+	} else {
+		err = prof.getV2(&cg)
+	}
+	if err != nil {
+		return cg, err
+	}
+	return cg, nil
+}
+
+// getV2 gets the information from the cgroup v2 files that differ from the
+// cgroup v1 ones.
+func (prof *Profiler) getV2(c *Cgroup) (err error) {
+	if prof.CPUMax != nil {
+		c.CPU.Quota, c.CPU.Period, err = prof.parseCPUMax()
+		if err != nil {
+			return err
+		}
+	}
+	if prof.MemoryCurrent != nil {
+		c.Memory.Current, err = prof.parseValue(MemoryCurrentFile, prof.MemoryCurrent)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.MemoryMax != nil {
+		c.Memory.Max, err = prof.parseValue(MemoryMaxFile, prof.MemoryMax)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.MemoryStat != nil {
+		err = prof.parseStat(MemoryStatFile, prof.MemoryStat, func(k []byte, v int64) {
+			switch string(k) {
+			case "anon":
+				c.Memory.Anon = v
+			case "file":
+				c.Memory.File = v
+			case "kernel_stack":
+				c.Memory.KernelStack = v
+			case "slab":
+				c.Memory.Slab = v
+			case "sock":
+				c.Memory.Sock = v
+			case "shmem":
+				c.Memory.Shmem = v
+			case "file_mapped":
+				c.Memory.FileMapped = v
+			case "file_dirty":
+				c.Memory.FileDirty = v
+			case "file_writeback":
+				c.Memory.FileWriteback = v
+			case "active_anon":
+				c.Memory.ActiveAnon = v
+			case "inactive_anon":
+				c.Memory.InactiveAnon = v
+			case "active_file":
+				c.Memory.ActiveFile = v
+			case "inactive_file":
+				c.Memory.InactiveFile = v
+			case "unevictable":
+				c.Memory.Unevictable = v
+			case "pgfault":
+				c.Memory.PgFault = v
+			case "pgmajfault":
+				c.Memory.PgMajFault = v
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if prof.IOStat != nil {
+		c.IO, err = prof.parseIOStat()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetUsage returns the cgroup's usage since the prior snapshot. The current
+// snapshot is stored for use as the prior snapshot on the next GetUsage call.
+// If ongoing usage information is desired, the UsageTicker should be used;
+// it's better suited for ongoing usage information.
+func (prof *Profiler) GetUsage() (u Usage, err error) {
+	cur, err := prof.Get()
+	if err != nil {
+		return u, err
+	}
+	u = CalculateUsage(&cur, &prof.prior)
+	prof.prior = cur
+	return u, nil
+}
+
+// parseValue processes a file holding a single value, or max, e.g.:
+//
+//	536870912
+func (prof *Profiler) parseValue(name string, proc joe.Procer) (n int64, err error) {
+	prof.Line, err = proc.ReadSlice('\n')
+	if err != nil && err != io.EOF {
+		return 0, &joe.ReadError{Info: name, Err: err}
+	}
+	n, err = parseLimit(bytes.TrimSpace(prof.Line))
+	if err != nil {
+		return 0, &joe.ParseError{Info: name, Err: err}
+	}
+	return n, nil
+}
+
+// parseLimit parses a value that may be max or, with cgroup v1, -1; either is
+// returned as Unlimited.
+func parseLimit(p []byte) (int64, error) {
+	if string(p) == "max" || string(p) == "-1" {
+		return Unlimited, nil
+	}
+	n, err := tools.ParseUint(p)
+	return int64(n), err
+}
+
+// parseCPUMax processes cpu.max, which holds the quota, or max, and the
+// period, e.g.:
+//
+//	max 100000
+func (prof *Profiler) parseCPUMax() (quota, period int64, err error) {
+	prof.Line, err = prof.CPUMax.ReadSlice('\n')
+	if err != nil && err != io.EOF {
+		return 0, 0, &joe.ReadError{Info: CPUMaxFile, Err: err}
+	}
+	fields := bytes.Fields(prof.Line)
+	if len(fields) != 2 {
+		return 0, 0, &joe.ParseError{Info: CPUMaxFile, Err: fmt.Errorf("%q: expected a quota and a period", bytes.TrimSpace(prof.Line))}
+	}
+	quota, err = parseLimit(fields[0])
+	if err != nil {
+		return 0, 0, &joe.ParseError{Info: CPUMaxFile + ": quota", Err: err}
+	}
+	n, err := tools.ParseUint(fields[1])
+	if err != nil {
+		return 0, 0, &joe.ParseError{Info: CPUMaxFile + ": period", Err: err}
+	}
+	return quota, int64(n), nil
+}
+
+// parseCPUList processes the cpuset's cpu list and returns the number of cpus
+// in it, e.g. 4 for:
+//
+//	0-2,7
+func (prof *Profiler) parseCPUList() (n int32, err error) {
+	name := CpusetCPUsFile
+	if prof.Version == 1 {
+		name = CpusetV1CPUsFile
+	}
+	prof.Line, err = prof.Cpuset.ReadSlice('\n')
+	if err != nil && err != io.EOF {
+		return 0, &joe.ReadError{Info: name, Err: err}
+	}
+	prof.Line = bytes.TrimSpace(prof.Line)
+	if len(prof.Line) == 0 {
+		return 0, nil
+	}
+	var lo, hi uint64
+	for _, r := range bytes.Split(prof.Line, []byte{','}) {
+		i := bytes.IndexByte(r, '-')
+		if i < 0 {
+			_, err = tools.ParseUint(r)
+			if err != nil {
+				return 0, &joe.ParseError{Info: name, Err: err}
+			}
+			n++
+			continue
+		}
+		lo, err = tools.ParseUint(r[:i])
+		if err != nil {
+			return 0, &joe.ParseError{Info: name, Err: err}
+		}
+		hi, err = tools.ParseUint(r[i+1:])
+		if err != nil {
+			return 0, &joe.ParseError{Info: name, Err: err}
+		}
+		if hi < lo {
+			return 0, &joe.ParseError{Info: name, Err: fmt.Errorf("%q: invalid range", r)}
+		}
+		n += int32(hi - lo + 1)
+	}
+	return n, nil
+}
+
+// parseStat processes a file of key value lines, e.g. cpu.stat:
+//
+//	usage_usec 1234567
+//	user_usec 1000000
+//
+// set is called with each key and value.
+func (prof *Profiler) parseStat(name string, proc joe.Procer, set func(k []byte, v int64)) error {
+	var (
+		i, line int
+		n       uint64
+		err     error
+	)
+	for {
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return &joe.ReadError{Info: name, Err: err}
+		}
+		line++
+		i = bytes.IndexByte(prof.Line, 0x20)
+		if i < 0 {
+			return &joe.ParseError{Info: fmt.Sprintf("%s: line %d", name, line), Err: fmt.Errorf("%q: not a key value pair", bytes.TrimSpace(prof.Line))}
+		}
+		prof.Val = bytes.TrimSpace(prof.Line[i+1:])
+		n, err = tools.ParseUint(prof.Val)
+		if err != nil {
+			return &joe.ParseError{Info: fmt.Sprintf("%s: line %d: %s", name, line, prof.Line[:i]), Err: err}
+		}
+		set(prof.Line[:i], int64(n))
+	}
+}
+
+// parseIOStat processes io.stat, which has a line per device, e.g.:
+//
+//	8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0
+func (prof *Profiler) parseIOStat() (devs []IODevice, err error) {
+	var (
+		i, pos, eq, line int
+		n                uint64
+	)
+	for {
+		prof.Line, err = prof.IOStat.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return devs, nil
+			}
+			return nil, &joe.ReadError{Info: IOStatFile, Err: err}
+		}
+		line++
+		var dev IODevice
+		i = bytes.IndexByte(prof.Line, 0x20)
+		if i < 0 {
+			i = len(bytes.TrimRight(prof.Line, "\n"))
+		}
+		dev.Major, dev.Minor, err = parseDevice(prof.Line[:i])
+		if err != nil {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", IOStatFile, line), Err: err}
+		}
+		// the key=value pairs
+		for pos = i + 1; pos < len(prof.Line); pos = i + 1 {
+			eq = 0
+			for i = pos; i < len(prof.Line); i++ {
+				if prof.Line[i] == '=' {
+					eq = i
+				}
+				if prof.Line[i] == 0x20 || prof.Line[i] == '\n' {
+					break
+				}
+			}
+			if eq == 0 {
+				return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", IOStatFile, line), Err: fmt.Errorf("%q: not a key=value pair", prof.Line[pos:i])}
+			}
+			prof.Val = prof.Line[eq+1 : i]
+			n, err = tools.ParseUint(prof.Val)
+			if err != nil {
+				return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d: %s", IOStatFile, line, prof.Line[pos:eq]), Err: err}
+			}
+			switch string(prof.Line[pos:eq]) {
+			case "rbytes":
+				dev.RBytes = int64(n)
+			case "wbytes":
+				dev.WBytes = int64(n)
+			case "rios":
+				dev.RIOs = int64(n)
+			case "wios":
+				dev.WIOs = int64(n)
+			case "dbytes":
+				dev.DBytes = int64(n)
+			case "dios":
+				dev.DIOs = int64(n)
+			}
+		}
+		devs = append(devs, dev)
+	}
+}
+
+// parseDevice parses a device's major:minor numbers.
+func parseDevice(p []byte) (major, minor uint32, err error) {
+	i := bytes.IndexByte(p, ':')
+	if i < 0 {
+		return 0, 0, fmt.Errorf("%q: not a major:minor device number", p)
+	}
+	n, err := strconv.ParseUint(string(p[:i]), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	major = uint32(n)
+	n, err = strconv.ParseUint(string(p[i+1:]), 10, 32)
+	if err != nil {
+		return 0, 0, err
+	}
+	return major, uint32(n), nil
+}
+
+// CalculateUsage returns the cgroup's usage between the prior and current
+// snapshots. The percentages are calculated from the change in the cpu
+// times, which are in microseconds, relative to the time elapsed between the
+// snapshots. A counter that decreased, e.g. because the cgroup was
+// recreated, is treated as having been reset.
+func CalculateUsage(cur, prior *Cgroup) Usage {
+	u := Usage{Timestamp: cur.Timestamp, TimeDelta: cur.Timestamp - prior.Timestamp, Pids: cur.Pids}
+	u.CPU.Usage = cpuPercent(tools.CounterDelta(cur.CPU.UsageUsec, prior.CPU.UsageUsec), u.TimeDelta)
+	u.CPU.User = cpuPercent(tools.CounterDelta(cur.CPU.UserUsec, prior.CPU.UserUsec), u.TimeDelta)
+	u.CPU.System = cpuPercent(tools.CounterDelta(cur.CPU.SystemUsec, prior.CPU.SystemUsec), u.TimeDelta)
+	if cur.CPU.Quota > 0 && cur.CPU.Period > 0 {
+		// the quota is per period: Quota/Period cpus may be used.
+		u.CPU.Quota = float32(float64(u.CPU.Usage) * float64(cur.CPU.Period) / float64(cur.CPU.Quota))
+	}
+	u.CPU.NrPeriods = tools.CounterDelta(cur.CPU.NrPeriods, prior.CPU.NrPeriods)
+	u.CPU.NrThrottled = tools.CounterDelta(cur.CPU.NrThrottled, prior.CPU.NrThrottled)
+	if u.CPU.NrPeriods > 0 {
+		u.CPU.ThrottledPercent = float32(float64(u.CPU.NrThrottled) / float64(u.CPU.NrPeriods) * 100)
+	}
+	u.CPU.ThrottledUsec = tools.CounterDelta(cur.CPU.ThrottledUsec, prior.CPU.ThrottledUsec)
+
+	u.Memory.Current = cur.Memory.Current
+	u.Memory.Max = cur.Memory.Max
+	if cur.Memory.Max > 0 {
+		u.Memory.Percent = float32(float64(cur.Memory.Current) / float64(cur.Memory.Max) * 100)
+	}
+	u.Memory.PgFault = tools.CounterDelta(cur.Memory.PgFault, prior.Memory.PgFault)
+	u.Memory.PgMajFault = tools.CounterDelta(cur.Memory.PgMajFault, prior.Memory.PgMajFault)
+
+	if len(cur.IO) > 0 {
+		u.IO = make([]IODeviceUsage, len(cur.IO))
+	}
+	for i := range cur.IO {
+		c := &cur.IO[i]
+		// a device that isn't in the prior snapshot had no io then.
+		var p IODevice
+		for j := range prior.IO {
+			if prior.IO[j].Major == c.Major && prior.IO[j].Minor == c.Minor {
+				p = prior.IO[j]
+				break
+			}
+		}
+		u.IO[i] = IODeviceUsage{
+			Major:  c.Major,
+			Minor:  c.Minor,
+			RBytes: tools.CounterDelta(c.RBytes, p.RBytes),
+			WBytes: tools.CounterDelta(c.WBytes, p.WBytes),
+			RIOs:   tools.CounterDelta(c.RIOs, p.RIOs),
+			WIOs:   tools.CounterDelta(c.WIOs, p.WIOs),
+			DBytes: tools.CounterDelta(c.DBytes, p.DBytes),
+			DIOs:   tools.CounterDelta(c.DIOs, p.DIOs),
+		}
+	}
+	return u
+}
+
+// cpuPercent returns the cpu time, in microseconds, as a percentage of the
+// time delta, in nanoseconds. If the time delta isn't positive, 0 is
+// returned.
+func cpuPercent(usec, timeDelta int64) float32 {
+	if timeDelta <= 0 {
+		return 0
+	}
+	return float32(float64(usec) * float64(time.Microsecond) / float64(timeDelta) * 100)
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get gets the information of the current process's cgroup using the
+// package's global Profiler.
+func Get() (cg Cgroup, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return cg, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage gets the usage of the current process's cgroup using the
+// package's global Profiler. The profiler is lazily instantiated. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func GetUsage() (u Usage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return u, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Ticker delivers the cgroup's information at intervals.
+type Ticker struct {
+	*ticker.Ticker[Cgroup]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDTicker returns a new Ticker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDTickerContext returns a new Ticker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewTickerContext.
+func NewPIDTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (Cgroup, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the cgroup's usage and throttling, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[Usage]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDUsageTicker returns a new UsageTicker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDUsageTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDUsageTickerContext returns a new UsageTicker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewUsageTickerContext.
+func NewPIDUsageTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (Usage, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
@@ -0,0 +1,399 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+const memoryStat = `anon 104857600
+file 52428800
+kernel_stack 327680
+pagetables 1048576
+percpu 0
+sock 4096
+vmalloc 0
+shmem 8192
+file_mapped 2097152
+file_dirty 12288
+file_writeback 0
+swapcached 0
+inactive_anon 94371840
+active_anon 10485760
+inactive_file 41943040
+active_file 10485760
+unevictable 0
+slab_reclaimable 917504
+slab_unreclaimable 262144
+slab 1179648
+pgfault 123456
+pgmajfault 78
+`
+
+// fixture returns a file system with the cgroup v2 hierarchy mounted at
+// /sys/fs/cgroup and the current process in the /kubepods/pod1/abcd cgroup.
+func fixture() fstest.MapFS {
+	dir := "sys/fs/cgroup/kubepods/pod1/abcd/"
+	return fstest.MapFS{
+		"proc/self/cgroup":                 {Data: []byte("0::/kubepods/pod1/abcd\n")},
+		"sys/fs/cgroup/cgroup.controllers": {Data: []byte("cpuset cpu io memory pids\n")},
+		dir + ControllersFile:              {Data: []byte("cpu io memory pids\n")},
+		dir + CPUMaxFile:                   {Data: []byte("50000 100000\n")},
+		dir + CpusetCPUsFile:               {Data: []byte("0-1,4\n")},
+		dir + CPUStatFile: {Data: []byte("usage_usec 7500000\nuser_usec 5000000\nsystem_usec 2500000\n" +
+			"nr_periods 300\nnr_throttled 30\nthrottled_usec 1500000\nnr_bursts 0\nburst_usec 0\n")},
+		dir + MemoryCurrentFile: {Data: []byte("157286400\n")},
+		dir + MemoryMaxFile:     {Data: []byte("536870912\n")},
+		dir + MemoryStatFile:    {Data: []byte(memoryStat)},
+		dir + IOStatFile: {Data: []byte("8:0 rbytes=1459200 wbytes=314773504 rios=192 wios=353 dbytes=0 dios=0\n" +
+			"253:1 rbytes=4096 wbytes=0 rios=1 wios=0 dbytes=512 dios=2\n")},
+		dir + PidsCurrentFile: {Data: []byte("12\n")},
+		dir + PidsMaxFile:     {Data: []byte("max\n")},
+	}
+}
+
+func TestGet(t *testing.T) {
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.Dir != "/sys/fs/cgroup/kubepods/pod1/abcd" {
+		t.Errorf("Dir: got %q; want %q", prof.Dir, "/sys/fs/cgroup/kubepods/pod1/abcd")
+	}
+	cg, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if cg.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	expected := Cgroup{
+		Timestamp: cg.Timestamp,
+		Path:      "/kubepods/pod1/abcd",
+		CPU: CPU{
+			Quota: 50000, Period: 100000, UsageUsec: 7500000, UserUsec: 5000000, SystemUsec: 2500000,
+			NrPeriods: 300, NrThrottled: 30, ThrottledUsec: 1500000, EffectiveCPUs: 3,
+		},
+		Memory: Memory{
+			Current: 157286400, Max: 536870912, Anon: 104857600, File: 52428800, KernelStack: 327680,
+			Slab: 1179648, Sock: 4096, Shmem: 8192, FileMapped: 2097152, FileDirty: 12288,
+			ActiveAnon: 10485760, InactiveAnon: 94371840, ActiveFile: 10485760, InactiveFile: 41943040,
+			PgFault: 123456, PgMajFault: 78,
+		},
+		IO: []IODevice{
+			{Major: 8, Minor: 0, RBytes: 1459200, WBytes: 314773504, RIOs: 192, WIOs: 353},
+			{Major: 253, Minor: 1, RBytes: 4096, RIOs: 1, DBytes: 512, DIOs: 2},
+		},
+		Pids: Pids{Current: 12, Max: Unlimited},
+	}
+	if !reflect.DeepEqual(cg, expected) {
+		t.Errorf("got %#v; want %#v", cg, expected)
+	}
+}
+
+func TestNewPIDProfiler(t *testing.T) {
+	fsys := fixture()
+	fsys["proc/42/cgroup"] = &fstest.MapFile{Data: []byte("1:name=systemd:/\n0::/kubepods/pod1/abcd\n")}
+	prof, err := NewPIDProfiler(42, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.Path != "/kubepods/pod1/abcd" {
+		t.Errorf("Path: got %q; want %q", prof.Path, "/kubepods/pod1/abcd")
+	}
+	path, err := FindPath(42, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != prof.Path {
+		t.Errorf("FindPath: got %q; want %q", path, prof.Path)
+	}
+}
+
+func TestNewProfilerMount(t *testing.T) {
+	tests := []struct {
+		name string
+		fsys fstest.MapFS
+		dir  string
+		err  error
+	}{
+		{
+			"hybrid", fstest.MapFS{
+				"proc/self/cgroup":                                    {Data: []byte("1:cpu:/\n0::/user.slice\n")},
+				"sys/fs/cgroup/unified/cgroup.controllers":            {Data: []byte("\n")},
+				"sys/fs/cgroup/unified/user.slice/cgroup.controllers": {Data: []byte("\n")},
+			},
+			"/sys/fs/cgroup/unified/user.slice", nil,
+		},
+		{
+			// a container without its own cgroup namespace.
+			"host path", fstest.MapFS{
+				"proc/self/cgroup":                 {Data: []byte("0::/kubepods/pod1/abcd\n")},
+				"sys/fs/cgroup/cgroup.controllers": {Data: []byte("cpu memory\n")},
+				"sys/fs/cgroup/memory.max":         {Data: []byte("1024\n")},
+			},
+			"/sys/fs/cgroup", nil,
+		},
+		{
+			"no v2 hierarchy", fstest.MapFS{
+				"proc/self/cgroup":             {Data: []byte("0::/\n")},
+				"sys/fs/cgroup/cpu/cpu.shares": {Data: []byte("1024\n")},
+			},
+			"", ErrNotSupported,
+		},
+		{
+			"no v2 cgroup", fstest.MapFS{
+				"proc/self/cgroup":                 {Data: []byte("4:memory:/\n")},
+				"sys/fs/cgroup/cgroup.controllers": {Data: []byte("\n")},
+			},
+			"", ErrNotSupported,
+		},
+	}
+	for _, test := range tests {
+		prof, err := NewProfiler(joe.WithFS(test.fsys))
+		if err != test.err {
+			t.Errorf("%s: got error %v; want %v", test.name, err, test.err)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if prof.Dir != test.dir {
+			t.Errorf("%s: Dir: got %q; want %q", test.name, prof.Dir, test.dir)
+		}
+	}
+}
+
+func TestGetErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+	}{
+		{"cpu.max: missing period", CPUMaxFile, "max\n"},
+		{"cpu.max: bad quota", CPUMaxFile, "x 100000\n"},
+		{"cpu.stat: not a pair", CPUStatFile, "usage_usec\n"},
+		{"cpu.stat: bad value", CPUStatFile, "usage_usec -1\n"},
+		{"cpuset: bad cpu", CpusetCPUsFile, "0-1,x\n"},
+		{"cpuset: bad range", CpusetCPUsFile, "3-1\n"},
+		{"memory.max: bad value", MemoryMaxFile, "unlimited\n"},
+		{"io.stat: bad device", IOStatFile, "8 rbytes=0\n"},
+		{"io.stat: not a pair", IOStatFile, "8:0 rbytes\n"},
+		{"io.stat: bad value", IOStatFile, "8:0 rbytes=x\n"},
+	}
+	for _, test := range tests {
+		fsys := fixture()
+		fsys["sys/fs/cgroup/kubepods/pod1/abcd/"+test.file] = &fstest.MapFile{Data: []byte(test.data)}
+		_, err := NewProfiler(joe.WithFS(fsys))
+		if err == nil {
+			t.Errorf("%s: expected an error; got none", test.name)
+		} else if _, ok := err.(*joe.ParseError); !ok {
+			t.Errorf("%s: expected a ParseError; got %#v", test.name, err)
+		}
+	}
+}
+
+func TestCalculateUsage(t *testing.T) {
+	sec := int64(time.Second)
+	prior := Cgroup{
+		Timestamp: 1000,
+		CPU:       CPU{Quota: 50000, Period: 100000, UsageUsec: 1000000, UserUsec: 600000, SystemUsec: 400000, NrPeriods: 100, NrThrottled: 10, ThrottledUsec: 50000},
+		Memory:    Memory{PgFault: 100, PgMajFault: 5},
+		IO:        []IODevice{{Major: 8, Minor: 0, RBytes: 4096, WBytes: 8192, RIOs: 1, WIOs: 2}},
+	}
+	cur := Cgroup{
+		Timestamp: 1000 + sec,
+		CPU:       CPU{Quota: 50000, Period: 100000, UsageUsec: 1250000, UserUsec: 800000, SystemUsec: 450000, NrPeriods: 110, NrThrottled: 15, ThrottledUsec: 80000},
+		Memory:    Memory{Current: 256, Max: 1024, PgFault: 150, PgMajFault: 5},
+		IO: []IODevice{
+			{Major: 8, Minor: 0, RBytes: 12288, WBytes: 8192, RIOs: 3, WIOs: 2},
+			{Major: 253, Minor: 1, RBytes: 512, RIOs: 1},
+		},
+		Pids: Pids{Current: 3, Max: Unlimited},
+	}
+	u := CalculateUsage(&cur, &prior)
+	expected := Usage{
+		Timestamp: cur.Timestamp,
+		TimeDelta: sec,
+		CPU: CPUUsage{
+			Usage: 25, User: 20, System: 5, Quota: 50,
+			NrPeriods: 10, NrThrottled: 5, ThrottledPercent: 50, ThrottledUsec: 30000,
+		},
+		Memory: MemoryUsage{Current: 256, Max: 1024, Percent: 25, PgFault: 50},
+		IO: []IODeviceUsage{
+			{Major: 8, Minor: 0, RBytes: 8192, RIOs: 2},
+			{Major: 253, Minor: 1, RBytes: 512, RIOs: 1},
+		},
+		Pids: Pids{Current: 3, Max: Unlimited},
+	}
+	if !reflect.DeepEqual(u, expected) {
+		t.Errorf("got %#v; want %#v", u, expected)
+	}
+
+	// unlimited, reset counters, and no time delta.
+	tests := []struct {
+		name     string
+		cpu      CPU
+		delta    int64
+		expected CPUUsage
+	}{
+		{"unlimited", CPU{Quota: Unlimited, Period: 100000, UsageUsec: 3000000}, sec, CPUUsage{Usage: 200}},
+		{"reset", CPU{UsageUsec: 500000, NrPeriods: 4}, sec, CPUUsage{Usage: 50, NrPeriods: 4}},
+		{"no time delta", CPU{UsageUsec: 3000000}, 0, CPUUsage{}},
+	}
+	for _, test := range tests {
+		cur := Cgroup{Timestamp: prior.Timestamp + test.delta, CPU: test.cpu, Memory: Memory{Max: Unlimited}}
+		u := CalculateUsage(&cur, &prior)
+		if u.CPU != test.expected {
+			t.Errorf("%s: got %#v; want %#v", test.name, u.CPU, test.expected)
+		}
+		if u.Memory.Percent != 0 {
+			t.Errorf("%s: memory Percent: got %f; want 0", test.name, u.Memory.Percent)
+		}
+	}
+}
+
+func TestGetLive(t *testing.T) {
+	cg, err := Get()
+	if err != nil {
+		if err == ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if cg.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	t.Logf("%#v\n", cg)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		if err == ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			if v.Timestamp == 0 {
+				t.Error("ticker: Timestamp: wanted non-zero value; got 0")
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100*time.Millisecond, joe.WithFS(fixture()))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			if v.Timestamp == 0 {
+				t.Error("ticker: Timestamp: wanted non-zero value; got 0")
+			}
+			if v.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+			// the fixture's counters don't change.
+			if v.CPU.Usage != 0 || v.CPU.NrThrottled != 0 {
+				t.Errorf("ticker: got %#v; want no cpu usage", v.CPU)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func TestPIDTicker(t *testing.T) {
+	fsys := fixture()
+	fsys["proc/42/cgroup"] = &fstest.MapFile{Data: []byte("0::/kubepods/pod2/efgh\n")}
+	fsys["sys/fs/cgroup/kubepods/pod2/efgh/"+ControllersFile] = &fstest.MapFile{Data: []byte("pids\n")}
+	fsys["sys/fs/cgroup/kubepods/pod2/efgh/"+PidsCurrentFile] = &fstest.MapFile{Data: []byte("3\n")}
+	tkr, err := NewPIDTicker(42, time.Millisecond, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tk := tkr.(*Ticker)
+	defer tk.Close()
+	select {
+	case v := <-tk.Data:
+		if v.Path != "/kubepods/pod2/efgh" || v.Pids.Current != 3 {
+			t.Errorf("ticker: got %q with %d pids; want %q with 3", v.Path, v.Pids.Current, "/kubepods/pod2/efgh")
+		}
+	case err := <-tk.Errs:
+		t.Fatalf("ticker: unexpected error: %s", err)
+	}
+
+	utkr, err := NewPIDUsageTicker(42, time.Millisecond, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	utk := utkr.(*UsageTicker)
+	defer utk.Close()
+	if utk.Path != "/kubepods/pod2/efgh" {
+		t.Errorf("usage ticker: Path: got %q; want %q", utk.Path, "/kubepods/pod2/efgh")
+	}
+	select {
+	case v := <-utk.Data:
+		if v.Pids.Current != 3 {
+			t.Errorf("usage ticker: Pids: got %d; want 3", v.Pids.Current)
+		}
+	case err := <-utk.Errs:
+		t.Fatalf("usage ticker: unexpected error: %s", err)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var cg Cgroup
+	p, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		cg, _ = p.Get()
+	}
+	_ = cg
+}
@@ -0,0 +1,518 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup gets the resource limits and usage of a control group
+// (cgroup) from the cgroup v2 unified hierarchy or the cgroup v1 controllers.
+// Instead of returning a Go struct, it returns Flatbuffer serialized bytes.
+// Functions to deserialize the Flatbuffer serialized bytes into a
+// cgroup.Cgroup or cgroup.Usage struct are provided.
+//
+// Note: the package name is cgroup and not the final element of the import
+// path (flat).
+package cgroup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	cg "github.com/hmmftg/joefriday/cgroup"
+	"github.com/hmmftg/joefriday/cgroup/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the cgroup information using Flatbuffers.
+type Profiler struct {
+	*cg.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := cg.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// NewPIDProfiler returns an initialized Profiler for the cgroup of the
+// process with the pid; if pid is 0, the cgroup of the current process is
+// used.
+func NewPIDProfiler(pid int, opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := cg.NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current cgroup information as Flatbuffer serialized bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	c, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(c), nil
+}
+
+// GetUsage returns the cgroup's usage since the prior snapshot as Flatbuffer
+// serialized bytes.
+func (prof *Profiler) GetUsage() ([]byte, error) {
+	u, err := prof.Profiler.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeUsage(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current cgroup information as Flatbuffer serialized bytes
+// using the package's global Profiler.
+func Get() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage returns the cgroup's usage as Flatbuffer serialized bytes using
+// the package's global Profiler. The profiler is lazily instantiated. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func GetUsage() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Serialize serializes cgroup information using Flatbuffers.
+func (prof *Profiler) Serialize(c cg.Cgroup) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	path := prof.Builder.CreateString(c.Path)
+	cpu := prof.serializeCPU(&c.CPU)
+	mem := prof.serializeMemory(&c.Memory)
+	uoffs := make([]fb.UOffsetT, len(c.IO))
+	for i := range c.IO {
+		uoffs[i] = prof.serializeIODevice(&c.IO[i])
+	}
+	structs.CgroupStartIOVector(prof.Builder, len(uoffs))
+	for i := len(uoffs) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(uoffs[i])
+	}
+	io := prof.Builder.EndVector(len(uoffs))
+	pids := prof.serializePids(&c.Pids)
+	structs.CgroupStart(prof.Builder)
+	structs.CgroupAddTimestamp(prof.Builder, c.Timestamp)
+	structs.CgroupAddPath(prof.Builder, path)
+	structs.CgroupAddCPU(prof.Builder, cpu)
+	structs.CgroupAddMemory(prof.Builder, mem)
+	structs.CgroupAddIO(prof.Builder, io)
+	structs.CgroupAddPids(prof.Builder, pids)
+	prof.Builder.Finish(structs.CgroupEnd(prof.Builder))
+	b := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(b))
+	copy(tmp, b)
+	return tmp
+}
+
+func (prof *Profiler) serializeCPU(c *cg.CPU) fb.UOffsetT {
+	structs.CPUStart(prof.Builder)
+	structs.CPUAddQuota(prof.Builder, c.Quota)
+	structs.CPUAddPeriod(prof.Builder, c.Period)
+	structs.CPUAddUsageUsec(prof.Builder, c.UsageUsec)
+	structs.CPUAddUserUsec(prof.Builder, c.UserUsec)
+	structs.CPUAddSystemUsec(prof.Builder, c.SystemUsec)
+	structs.CPUAddNrPeriods(prof.Builder, c.NrPeriods)
+	structs.CPUAddNrThrottled(prof.Builder, c.NrThrottled)
+	structs.CPUAddThrottledUsec(prof.Builder, c.ThrottledUsec)
+	structs.CPUAddEffectiveCPUs(prof.Builder, c.EffectiveCPUs)
+	return structs.CPUEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializeMemory(m *cg.Memory) fb.UOffsetT {
+	structs.MemoryStart(prof.Builder)
+	structs.MemoryAddCurrent(prof.Builder, m.Current)
+	structs.MemoryAddMax(prof.Builder, m.Max)
+	structs.MemoryAddAnon(prof.Builder, m.Anon)
+	structs.MemoryAddFile(prof.Builder, m.File)
+	structs.MemoryAddKernelStack(prof.Builder, m.KernelStack)
+	structs.MemoryAddSlab(prof.Builder, m.Slab)
+	structs.MemoryAddSock(prof.Builder, m.Sock)
+	structs.MemoryAddShmem(prof.Builder, m.Shmem)
+	structs.MemoryAddFileMapped(prof.Builder, m.FileMapped)
+	structs.MemoryAddFileDirty(prof.Builder, m.FileDirty)
+	structs.MemoryAddFileWriteback(prof.Builder, m.FileWriteback)
+	structs.MemoryAddActiveAnon(prof.Builder, m.ActiveAnon)
+	structs.MemoryAddInactiveAnon(prof.Builder, m.InactiveAnon)
+	structs.MemoryAddActiveFile(prof.Builder, m.ActiveFile)
+	structs.MemoryAddInactiveFile(prof.Builder, m.InactiveFile)
+	structs.MemoryAddUnevictable(prof.Builder, m.Unevictable)
+	structs.MemoryAddPgFault(prof.Builder, m.PgFault)
+	structs.MemoryAddPgMajFault(prof.Builder, m.PgMajFault)
+	return structs.MemoryEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializeIODevice(d *cg.IODevice) fb.UOffsetT {
+	structs.IODeviceStart(prof.Builder)
+	structs.IODeviceAddMajor(prof.Builder, d.Major)
+	structs.IODeviceAddMinor(prof.Builder, d.Minor)
+	structs.IODeviceAddRBytes(prof.Builder, d.RBytes)
+	structs.IODeviceAddWBytes(prof.Builder, d.WBytes)
+	structs.IODeviceAddRIOs(prof.Builder, d.RIOs)
+	structs.IODeviceAddWIOs(prof.Builder, d.WIOs)
+	structs.IODeviceAddDBytes(prof.Builder, d.DBytes)
+	structs.IODeviceAddDIOs(prof.Builder, d.DIOs)
+	return structs.IODeviceEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializePids(p *cg.Pids) fb.UOffsetT {
+	structs.PidsStart(prof.Builder)
+	structs.PidsAddCurrent(prof.Builder, p.Current)
+	structs.PidsAddMax(prof.Builder, p.Max)
+	return structs.PidsEnd(prof.Builder)
+}
+
+// Serialize serializes cgroup information using Flatbuffers with the
+// package's global Profiler.
+func Serialize(c cg.Cgroup) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(c), nil
+}
+
+// SerializeUsage serializes the cgroup's usage using Flatbuffers.
+func (prof *Profiler) SerializeUsage(u cg.Usage) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	cpu := prof.serializeCPUUsage(&u.CPU)
+	mem := prof.serializeMemoryUsage(&u.Memory)
+	uoffs := make([]fb.UOffsetT, len(u.IO))
+	for i := range u.IO {
+		uoffs[i] = prof.serializeIODeviceUsage(&u.IO[i])
+	}
+	structs.UsageStartIOVector(prof.Builder, len(uoffs))
+	for i := len(uoffs) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(uoffs[i])
+	}
+	io := prof.Builder.EndVector(len(uoffs))
+	pids := prof.serializePids(&u.Pids)
+	structs.UsageStart(prof.Builder)
+	structs.UsageAddTimestamp(prof.Builder, u.Timestamp)
+	structs.UsageAddTimeDelta(prof.Builder, u.TimeDelta)
+	structs.UsageAddCPU(prof.Builder, cpu)
+	structs.UsageAddMemory(prof.Builder, mem)
+	structs.UsageAddIO(prof.Builder, io)
+	structs.UsageAddPids(prof.Builder, pids)
+	prof.Builder.Finish(structs.UsageEnd(prof.Builder))
+	b := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(b))
+	copy(tmp, b)
+	return tmp
+}
+
+func (prof *Profiler) serializeCPUUsage(c *cg.CPUUsage) fb.UOffsetT {
+	structs.CPUUsageStart(prof.Builder)
+	structs.CPUUsageAddUsage(prof.Builder, c.Usage)
+	structs.CPUUsageAddUser(prof.Builder, c.User)
+	structs.CPUUsageAddSystem(prof.Builder, c.System)
+	structs.CPUUsageAddQuota(prof.Builder, c.Quota)
+	structs.CPUUsageAddNrPeriods(prof.Builder, c.NrPeriods)
+	structs.CPUUsageAddNrThrottled(prof.Builder, c.NrThrottled)
+	structs.CPUUsageAddThrottledPercent(prof.Builder, c.ThrottledPercent)
+	structs.CPUUsageAddThrottledUsec(prof.Builder, c.ThrottledUsec)
+	return structs.CPUUsageEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializeMemoryUsage(m *cg.MemoryUsage) fb.UOffsetT {
+	structs.MemoryUsageStart(prof.Builder)
+	structs.MemoryUsageAddCurrent(prof.Builder, m.Current)
+	structs.MemoryUsageAddMax(prof.Builder, m.Max)
+	structs.MemoryUsageAddPercent(prof.Builder, m.Percent)
+	structs.MemoryUsageAddPgFault(prof.Builder, m.PgFault)
+	structs.MemoryUsageAddPgMajFault(prof.Builder, m.PgMajFault)
+	return structs.MemoryUsageEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializeIODeviceUsage(d *cg.IODeviceUsage) fb.UOffsetT {
+	structs.IODeviceUsageStart(prof.Builder)
+	structs.IODeviceUsageAddMajor(prof.Builder, d.Major)
+	structs.IODeviceUsageAddMinor(prof.Builder, d.Minor)
+	structs.IODeviceUsageAddRBytes(prof.Builder, d.RBytes)
+	structs.IODeviceUsageAddWBytes(prof.Builder, d.WBytes)
+	structs.IODeviceUsageAddRIOs(prof.Builder, d.RIOs)
+	structs.IODeviceUsageAddWIOs(prof.Builder, d.WIOs)
+	structs.IODeviceUsageAddDBytes(prof.Builder, d.DBytes)
+	structs.IODeviceUsageAddDIOs(prof.Builder, d.DIOs)
+	return structs.IODeviceUsageEnd(prof.Builder)
+}
+
+// SerializeUsage serializes the cgroup's usage using Flatbuffers with the
+// package's global Profiler.
+func SerializeUsage(u cg.Usage) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeUsage(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them as
+// cgroup.Cgroup.
+func Deserialize(b []byte) cg.Cgroup {
+	flatC := structs.GetRootAsCgroup(b, 0)
+	var c cg.Cgroup
+	c.Timestamp = flatC.Timestamp()
+	c.Path = string(flatC.Path())
+	c.CPU = deserializeCPU(flatC.CPU(nil))
+	c.Memory = deserializeMemory(flatC.Memory(nil))
+	if n := flatC.IOLength(); n > 0 {
+		c.IO = make([]cg.IODevice, n)
+		flatD := &structs.IODevice{}
+		for i := range c.IO {
+			if !flatC.IO(flatD, i) {
+				continue
+			}
+			c.IO[i] = cg.IODevice{
+				Major:  flatD.Major(),
+				Minor:  flatD.Minor(),
+				RBytes: flatD.RBytes(),
+				WBytes: flatD.WBytes(),
+				RIOs:   flatD.RIOs(),
+				WIOs:   flatD.WIOs(),
+				DBytes: flatD.DBytes(),
+				DIOs:   flatD.DIOs(),
+			}
+		}
+	}
+	c.Pids = deserializePids(flatC.Pids(nil))
+	return c
+}
+
+func deserializeCPU(flatC *structs.CPU) (c cg.CPU) {
+	if flatC == nil {
+		return c
+	}
+	c.Quota = flatC.Quota()
+	c.Period = flatC.Period()
+	c.UsageUsec = flatC.UsageUsec()
+	c.UserUsec = flatC.UserUsec()
+	c.SystemUsec = flatC.SystemUsec()
+	c.NrPeriods = flatC.NrPeriods()
+	c.NrThrottled = flatC.NrThrottled()
+	c.ThrottledUsec = flatC.ThrottledUsec()
+	c.EffectiveCPUs = flatC.EffectiveCPUs()
+	return c
+}
+
+func deserializeMemory(flatM *structs.Memory) (m cg.Memory) {
+	if flatM == nil {
+		return m
+	}
+	m.Current = flatM.Current()
+	m.Max = flatM.Max()
+	m.Anon = flatM.Anon()
+	m.File = flatM.File()
+	m.KernelStack = flatM.KernelStack()
+	m.Slab = flatM.Slab()
+	m.Sock = flatM.Sock()
+	m.Shmem = flatM.Shmem()
+	m.FileMapped = flatM.FileMapped()
+	m.FileDirty = flatM.FileDirty()
+	m.FileWriteback = flatM.FileWriteback()
+	m.ActiveAnon = flatM.ActiveAnon()
+	m.InactiveAnon = flatM.InactiveAnon()
+	m.ActiveFile = flatM.ActiveFile()
+	m.InactiveFile = flatM.InactiveFile()
+	m.Unevictable = flatM.Unevictable()
+	m.PgFault = flatM.PgFault()
+	m.PgMajFault = flatM.PgMajFault()
+	return m
+}
+
+func deserializePids(flatP *structs.Pids) (p cg.Pids) {
+	if flatP == nil {
+		return p
+	}
+	p.Current = flatP.Current()
+	p.Max = flatP.Max()
+	return p
+}
+
+// DeserializeUsage takes some Flatbuffer serialized bytes and deserializes
+// them as cgroup.Usage.
+func DeserializeUsage(b []byte) cg.Usage {
+	flatU := structs.GetRootAsUsage(b, 0)
+	var u cg.Usage
+	u.Timestamp = flatU.Timestamp()
+	u.TimeDelta = flatU.TimeDelta()
+	if flatC := flatU.CPU(nil); flatC != nil {
+		u.CPU = cg.CPUUsage{
+			Usage:            flatC.Usage(),
+			User:             flatC.User(),
+			System:           flatC.System(),
+			Quota:            flatC.Quota(),
+			NrPeriods:        flatC.NrPeriods(),
+			NrThrottled:      flatC.NrThrottled(),
+			ThrottledPercent: flatC.ThrottledPercent(),
+			ThrottledUsec:    flatC.ThrottledUsec(),
+		}
+	}
+	if flatM := flatU.Memory(nil); flatM != nil {
+		u.Memory = cg.MemoryUsage{
+			Current:    flatM.Current(),
+			Max:        flatM.Max(),
+			Percent:    flatM.Percent(),
+			PgFault:    flatM.PgFault(),
+			PgMajFault: flatM.PgMajFault(),
+		}
+	}
+	if n := flatU.IOLength(); n > 0 {
+		u.IO = make([]cg.IODeviceUsage, n)
+		flatD := &structs.IODeviceUsage{}
+		for i := range u.IO {
+			if !flatU.IO(flatD, i) {
+				continue
+			}
+			u.IO[i] = cg.IODeviceUsage{
+				Major:  flatD.Major(),
+				Minor:  flatD.Minor(),
+				RBytes: flatD.RBytes(),
+				WBytes: flatD.WBytes(),
+				RIOs:   flatD.RIOs(),
+				WIOs:   flatD.WIOs(),
+				DBytes: flatD.DBytes(),
+				DIOs:   flatD.DIOs(),
+			}
+		}
+	}
+	u.Pids = deserializePids(flatU.Pids(nil))
+	return u
+}
+
+// Ticker delivers the cgroup's information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDTicker returns a new Ticker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDTickerContext returns a new Ticker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewTickerContext.
+func NewPIDTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the cgroup's usage and throttling, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDUsageTicker returns a new UsageTicker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDUsageTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDUsageTickerContext returns a new UsageTicker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewUsageTickerContext.
+func NewPIDUsageTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
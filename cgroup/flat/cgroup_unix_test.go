@@ -0,0 +1,163 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	cg "github.com/hmmftg/joefriday/cgroup"
+)
+
+// fixture returns a file system with the cgroup v2 hierarchy mounted at
+// /sys/fs/cgroup and the current process in the /app cgroup.
+func fixture() fstest.MapFS {
+	return fstest.MapFS{
+		"proc/self/cgroup":                     {Data: []byte("0::/app\n")},
+		"sys/fs/cgroup/cgroup.controllers":     {Data: []byte("cpu io memory pids\n")},
+		"sys/fs/cgroup/app/cgroup.controllers": {Data: []byte("cpu io memory pids\n")},
+		"sys/fs/cgroup/app/cpu.max":            {Data: []byte("max 100000\n")},
+		"sys/fs/cgroup/app/cpu.stat":           {Data: []byte("usage_usec 900\nuser_usec 600\nsystem_usec 300\n")},
+		"sys/fs/cgroup/app/memory.current":     {Data: []byte("4096\n")},
+		"sys/fs/cgroup/app/memory.max":         {Data: []byte("8192\n")},
+		"sys/fs/cgroup/app/memory.stat":        {Data: []byte("anon 2048\nfile 1024\npgfault 7\n")},
+		"sys/fs/cgroup/app/pids.current":       {Data: []byte("2\n")},
+	}
+}
+
+func TestSerializeDeserialize(t *testing.T) {
+	prof := &Profiler{Builder: fb.NewBuilder(0)}
+	c := cg.Cgroup{
+		Timestamp: 1000,
+		Path:      "/kubepods/pod1/abcd",
+		CPU: cg.CPU{
+			Quota: 50000, Period: 100000, UsageUsec: 7500000, UserUsec: 5000000, SystemUsec: 2500000,
+			NrPeriods: 300, NrThrottled: 30, ThrottledUsec: 1500000, EffectiveCPUs: 3,
+		},
+		Memory: cg.Memory{
+			Current: 157286400, Max: 536870912, Anon: 104857600, File: 52428800, KernelStack: 327680,
+			Slab: 1179648, Sock: 4096, Shmem: 8192, FileMapped: 2097152, FileDirty: 12288, FileWriteback: 1,
+			ActiveAnon: 10485760, InactiveAnon: 94371840, ActiveFile: 10485760, InactiveFile: 41943040,
+			Unevictable: 2, PgFault: 123456, PgMajFault: 78,
+		},
+		IO: []cg.IODevice{
+			{Major: 8, Minor: 0, RBytes: 1459200, WBytes: 314773504, RIOs: 192, WIOs: 353},
+			{Major: 253, Minor: 1, RBytes: 4096, RIOs: 1, DBytes: 512, DIOs: 2},
+		},
+		Pids: cg.Pids{Current: 12, Max: cg.Unlimited},
+	}
+	cD := Deserialize(prof.Serialize(c))
+	if !reflect.DeepEqual(cD, c) {
+		t.Errorf("got %#v; want %#v", cD, c)
+	}
+
+	u := cg.Usage{
+		Timestamp: 2000, TimeDelta: 1000,
+		CPU:    cg.CPUUsage{Usage: 150, User: 100, System: 50, Quota: 75, NrPeriods: 10, NrThrottled: 2, ThrottledPercent: 20, ThrottledUsec: 4000},
+		Memory: cg.MemoryUsage{Current: 256, Max: 1024, Percent: 25, PgFault: 3, PgMajFault: 1},
+		IO:     []cg.IODeviceUsage{{Major: 8, Minor: 0, RBytes: 4096, WBytes: 1, RIOs: 2, WIOs: 1, DBytes: 3, DIOs: 4}},
+		Pids:   cg.Pids{Current: 3, Max: 100},
+	}
+	uD := DeserializeUsage(prof.SerializeUsage(u))
+	if !reflect.DeepEqual(uD, u) {
+		t.Errorf("usage: got %#v; want %#v", uD, u)
+	}
+}
+
+func TestGet(t *testing.T) {
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c := Deserialize(b)
+	if c.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	expected := cg.Cgroup{
+		Timestamp: c.Timestamp,
+		Path:      "/app",
+		CPU:       cg.CPU{Quota: cg.Unlimited, Period: 100000, UsageUsec: 900, UserUsec: 600, SystemUsec: 300},
+		Memory:    cg.Memory{Current: 4096, Max: 8192, Anon: 2048, File: 1024, PgFault: 7},
+		Pids:      cg.Pids{Current: 2},
+	}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("got %#v; want %#v", c, expected)
+	}
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100*time.Millisecond, joe.WithFS(fixture()))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u := DeserializeUsage(v)
+			if u.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+			if u.Memory.Percent != 50 {
+				t.Errorf("ticker: memory Percent: got %f; want 50", u.Memory.Percent)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	c, _ := prof.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = prof.Serialize(c)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var c cg.Cgroup
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	tmp, _ := prof.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c = Deserialize(tmp)
+	}
+	_ = c
+}
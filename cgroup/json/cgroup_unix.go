@@ -0,0 +1,271 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cgroup gets the resource limits and usage of a control group
+// (cgroup) from the cgroup v2 unified hierarchy or the cgroup v1 controllers.
+// Instead of returning a Go struct, it returns JSON serialized bytes.
+// Functions to deserialize the JSON serialized bytes into a cgroup.Cgroup or
+// cgroup.Usage struct are provided.
+//
+// Note: the package name is cgroup and not the final element of the import
+// path (json).
+package cgroup
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	cg "github.com/hmmftg/joefriday/cgroup"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the cgroup information using JSON.
+type Profiler struct {
+	*cg.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := cg.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// NewPIDProfiler returns an initialized Profiler for the cgroup of the
+// process with the pid; if pid is 0, the cgroup of the current process is
+// used.
+func NewPIDProfiler(pid int, opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := cg.NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current cgroup information as JSON serialized bytes.
+func (prof *Profiler) Get() (b []byte, err error) {
+	c, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(c)
+}
+
+// GetUsage returns the cgroup's usage since the prior snapshot as JSON
+// serialized bytes.
+func (prof *Profiler) GetUsage() (b []byte, err error) {
+	u, err := prof.Profiler.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeUsage(u)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current cgroup information as JSON serialized bytes using
+// the package's global Profiler.
+func Get() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage returns the cgroup's usage as JSON serialized bytes using the
+// package's global Profiler. The profiler is lazily instantiated. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func GetUsage() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Serialize cgroup.Cgroup using JSON.
+func (prof *Profiler) Serialize(c cg.Cgroup) ([]byte, error) {
+	return json.Marshal(c)
+}
+
+// Serialize cgroup.Cgroup using JSON with the package's global Profiler.
+func Serialize(c cg.Cgroup) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(c)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(c cg.Cgroup) ([]byte, error) {
+	return prof.Serialize(c)
+}
+
+// Marshal is an alias for Serialize using the package's global profiler.
+func Marshal(c cg.Cgroup) ([]byte, error) {
+	return Serialize(c)
+}
+
+// SerializeUsage serializes cgroup.Usage using JSON.
+func (prof *Profiler) SerializeUsage(u cg.Usage) ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// SerializeUsage serializes cgroup.Usage using JSON with the package's
+// global Profiler.
+func SerializeUsage(u cg.Usage) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeUsage(u)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// cgroup.Cgroup.
+func Deserialize(b []byte) (c cg.Cgroup, err error) {
+	err = json.Unmarshal(b, &c)
+	if err != nil {
+		return c, err
+	}
+	return c, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(b []byte) (cg.Cgroup, error) {
+	return Deserialize(b)
+}
+
+// DeserializeUsage takes some JSON serialized bytes and unmarshals them as
+// cgroup.Usage.
+func DeserializeUsage(b []byte) (u cg.Usage, err error) {
+	err = json.Unmarshal(b, &u)
+	if err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
+// Ticker delivers the cgroup's information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDTicker returns a new Ticker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDTickerContext returns a new Ticker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewTickerContext.
+func NewPIDTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the cgroup's usage and throttling, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(ctx, 0, d, cfg, opts...)
+}
+
+// NewPIDUsageTicker returns a new UsageTicker for the cgroup of the process with
+// the pid; if pid is 0, the cgroup of the current process is used.
+func NewPIDUsageTicker(pid int, d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewPIDUsageTickerContext(context.Background(), pid, d, ticker.Config{}, opts...)
+}
+
+// NewPIDUsageTickerContext returns a new UsageTicker, for the cgroup of the
+// process with the pid, that stops when ctx is done; see
+// NewUsageTickerContext.
+func NewPIDUsageTickerContext(ctx context.Context, pid int, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewPIDProfiler(pid, opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
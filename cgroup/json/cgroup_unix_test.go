@@ -0,0 +1,144 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	cg "github.com/hmmftg/joefriday/cgroup"
+)
+
+// fixture returns a file system with the cgroup v2 hierarchy mounted at
+// /sys/fs/cgroup and the current process in the /app cgroup.
+func fixture() fstest.MapFS {
+	return fstest.MapFS{
+		"proc/self/cgroup":                     {Data: []byte("0::/app\n")},
+		"sys/fs/cgroup/cgroup.controllers":     {Data: []byte("cpu io memory pids\n")},
+		"sys/fs/cgroup/app/cgroup.controllers": {Data: []byte("cpu io memory pids\n")},
+		"sys/fs/cgroup/app/cpu.max":            {Data: []byte("200000 100000\n")},
+		"sys/fs/cgroup/app/cpu.stat":           {Data: []byte("usage_usec 900\nnr_periods 4\nnr_throttled 1\n")},
+		"sys/fs/cgroup/app/memory.current":     {Data: []byte("4096\n")},
+		"sys/fs/cgroup/app/memory.max":         {Data: []byte("max\n")},
+		"sys/fs/cgroup/app/io.stat":            {Data: []byte("8:16 rbytes=512 wbytes=1024 rios=1 wios=2 dbytes=0 dios=0\n")},
+		"sys/fs/cgroup/app/pids.max":           {Data: []byte("100\n")},
+	}
+}
+
+func TestGet(t *testing.T) {
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	c, err := Deserialize(b)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := cg.Cgroup{
+		Timestamp: c.Timestamp,
+		Path:      "/app",
+		CPU:       cg.CPU{Quota: 200000, Period: 100000, UsageUsec: 900, NrPeriods: 4, NrThrottled: 1},
+		Memory:    cg.Memory{Current: 4096, Max: cg.Unlimited},
+		IO:        []cg.IODevice{{Major: 8, Minor: 16, RBytes: 512, WBytes: 1024, RIOs: 1, WIOs: 2}},
+		Pids:      cg.Pids{Max: 100},
+	}
+	if c.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	if !reflect.DeepEqual(c, expected) {
+		t.Errorf("got %#v; want %#v", c, expected)
+	}
+	for _, k := range []string{`"usage_usec":900`, `"nr_throttled":1`, `"rbytes":512`} {
+		if !bytes.Contains(b, []byte(k)) {
+			t.Errorf("%s: not found in %s", k, b)
+		}
+	}
+}
+
+func TestSerializeDeserializeUsage(t *testing.T) {
+	prof := &Profiler{}
+	u := cg.Usage{
+		Timestamp: 2000, TimeDelta: 1000,
+		CPU:    cg.CPUUsage{Usage: 150, User: 100, System: 50, Quota: 75, NrPeriods: 10, NrThrottled: 2, ThrottledPercent: 20, ThrottledUsec: 4000},
+		Memory: cg.MemoryUsage{Current: 256, Max: 1024, Percent: 25, PgFault: 3},
+		IO:     []cg.IODeviceUsage{{Major: 8, Minor: 0, RBytes: 4096, WIOs: 1}},
+		Pids:   cg.Pids{Current: 3, Max: cg.Unlimited},
+	}
+	b, err := prof.SerializeUsage(u)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uD, err := DeserializeUsage(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(uD, u) {
+		t.Errorf("got %#v; want %#v", uD, u)
+	}
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100*time.Millisecond, joe.WithFS(fixture()))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := DeserializeUsage(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			if u.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+			if u.Pids.Max != 100 {
+				t.Errorf("ticker: Pids.Max: got %d; want 100", u.Pids.Max)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	prof, err := NewProfiler(joe.WithFS(fixture()))
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = prof.Get()
+	}
+	_ = tmp
+}
@@ -0,0 +1,419 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync/atomic"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+// MountInfoFile lists the mounts of the current process's mount namespace;
+// it's used to find the cgroup v1 controllers' hierarchies.
+const MountInfoFile = "/proc/self/mountinfo"
+
+// The cgroup v1 files that are processed. The blkio files are the recursive
+// ones, which include the io of the cgroup's descendants as io.stat does; if
+// they don't exist, the non-recursive ones are used.
+const (
+	CPUQuotaFile        = "cpu.cfs_quota_us"
+	CPUPeriodFile       = "cpu.cfs_period_us"
+	CPUAcctUsageFile    = "cpuacct.usage"
+	CPUAcctStatFile     = "cpuacct.stat"
+	CpusetV1CPUsFile    = "cpuset.effective_cpus"
+	MemoryUsageFile     = "memory.usage_in_bytes"
+	MemoryLimitFile     = "memory.limit_in_bytes"
+	BlkioBytesFile      = "blkio.throttle.io_service_bytes_recursive"
+	BlkioIOsFile        = "blkio.throttle.io_serviced_recursive"
+	blkioBytesFileNoRec = "blkio.throttle.io_service_bytes"
+	blkioIOsFileNoRec   = "blkio.throttle.io_serviced"
+)
+
+// v1Controllers are the cgroup v1 controllers whose hierarchies are used, in
+// the order that the Profiler's Path is chosen from.
+var v1Controllers = []string{"cpu", "cpuacct", "memory", "blkio", "pids", "cpuset"}
+
+// v1Unlimited is the smallest memory.limit_in_bytes that is treated as
+// Unlimited: without a limit, its value is the largest page aligned int64,
+// which depends on the page size.
+const v1Unlimited = 1 << 62
+
+// v1Mount is a cgroup v1 hierarchy's mount: root is the cgroup, within the
+// hierarchy, that is mounted and point is where it is mounted.
+type v1Mount struct {
+	root  string
+	point string
+}
+
+// newV1Profiler returns a Profiler for the cgroup v1 cgroups of the process
+// with the pid; if pid is 0, the cgroups of the current process are used. If
+// none of the controllers are mounted, ErrNotSupported is returned.
+func newV1Profiler(o joe.Options, pid int) (prof *Profiler, err error) {
+	mounts, err := v1Mounts(o)
+	if err != nil {
+		return nil, err
+	}
+	if len(mounts) == 0 {
+		return nil, ErrNotSupported
+	}
+	paths, err := v1Paths(o, pid)
+	if err != nil {
+		return nil, err
+	}
+	prof = &Profiler{Buffer: joe.NewBuffer(), Version: 1, dirs: make(map[string]string)}
+	for _, name := range v1Controllers {
+		m, ok := mounts[name]
+		if !ok {
+			continue
+		}
+		p, ok := paths[name]
+		if !ok {
+			continue
+		}
+		prof.dirs[name], err = v1Dir(o, m, p)
+		if err != nil {
+			return nil, err
+		}
+		if prof.Dir == "" && name != "cpuset" {
+			prof.Path = p
+			prof.Dir = prof.dirs[name]
+		}
+	}
+	if len(prof.dirs) == 0 {
+		return nil, ErrNotSupported
+	}
+	for _, f := range []struct {
+		proc       *joe.Procer
+		controller string
+		names      []string
+	}{
+		{&prof.CPUQuota, "cpu", []string{CPUQuotaFile}},
+		{&prof.CPUPeriod, "cpu", []string{CPUPeriodFile}},
+		{&prof.CPUStat, "cpu", []string{CPUStatFile}},
+		{&prof.CPUAcctUsage, "cpuacct", []string{CPUAcctUsageFile}},
+		{&prof.CPUAcctStat, "cpuacct", []string{CPUAcctStatFile}},
+		{&prof.Cpuset, "cpuset", []string{CpusetV1CPUsFile}},
+		{&prof.MemoryCurrent, "memory", []string{MemoryUsageFile}},
+		{&prof.MemoryMax, "memory", []string{MemoryLimitFile}},
+		{&prof.MemoryStat, "memory", []string{MemoryStatFile}},
+		{&prof.BlkioBytes, "blkio", []string{BlkioBytesFile, blkioBytesFileNoRec}},
+		{&prof.BlkioIOs, "blkio", []string{BlkioIOsFile, blkioIOsFileNoRec}},
+		{&prof.PidsCurrent, "pids", []string{PidsCurrentFile}},
+		{&prof.PidsMax, "pids", []string{PidsMaxFile}},
+	} {
+		dir, ok := prof.dirs[f.controller]
+		if !ok {
+			continue
+		}
+		for _, name := range f.names {
+			*f.proc, err = newProc(o, path.Join(dir, name))
+			if err != nil {
+				return nil, err
+			}
+			if *f.proc != nil {
+				break
+			}
+		}
+	}
+	if prof.CPUAcctStat != nil {
+		// if it hasn't been set, set it.
+		if atomic.LoadInt32(&cpustats.CLK_TCK) == 0 {
+			err = cpustats.ClkTck()
+			if err != nil {
+				return nil, err
+			}
+		}
+		prof.clkTck = int64(atomic.LoadInt32(&cpustats.CLK_TCK))
+	}
+	prof.prior, err = prof.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// v1Mounts processes /proc/self/mountinfo for the cgroup v1 hierarchies of
+// the controllers, e.g.:
+//
+//	33 25 0:29 / /sys/fs/cgroup/cpu,cpuacct rw,relatime shared:9 - cgroup cgroup rw,cpu,cpuacct
+//
+// The mount's root, the fourth field, and its mount point, the fifth field,
+// are returned for each controller that is in its super options, the last
+// field. If the file doesn't exist, no mounts are returned.
+func v1Mounts(o joe.Options) (map[string]v1Mount, error) {
+	b, err := o.ReadFile(o.Path(MountInfoFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, &joe.ReadError{Info: MountInfoFile, Err: err}
+	}
+	mounts := make(map[string]v1Mount)
+	for _, line := range bytes.Split(b, []byte{'\n'}) {
+		// the optional fields end with a separator.
+		i := bytes.Index(line, []byte(" - "))
+		if i < 0 {
+			continue
+		}
+		fields := bytes.Fields(line[:i])
+		post := bytes.Fields(line[i+3:])
+		if len(fields) < 5 || len(post) < 3 || string(post[0]) != "cgroup" {
+			continue
+		}
+		for _, opt := range bytes.Split(post[2], []byte{','}) {
+			for _, name := range v1Controllers {
+				if string(opt) == name {
+					mounts[name] = v1Mount{root: string(fields[3]), point: string(fields[4])}
+				}
+			}
+		}
+	}
+	return mounts, nil
+}
+
+// v1Paths processes /proc/[pid]/cgroup for the paths of the cgroup v1
+// controllers' cgroups, e.g.:
+//
+//	4:cpu,cpuacct:/kubepods/burstable/pod1234/abcd
+func v1Paths(o joe.Options, pid int) (map[string]string, error) {
+	fname := SelfFile
+	if pid != 0 {
+		fname = fmt.Sprintf("/proc/%d/cgroup", pid)
+	}
+	b, err := o.ReadFile(o.Path(fname))
+	if err != nil {
+		return nil, &joe.ReadError{Info: fname, Err: err}
+	}
+	paths := make(map[string]string)
+	for _, line := range bytes.Split(b, []byte{'\n'}) {
+		fields := bytes.SplitN(line, []byte{':'}, 3)
+		if len(fields) != 3 {
+			continue
+		}
+		for _, name := range bytes.Split(fields[1], []byte{','}) {
+			paths[string(name)] = string(fields[2])
+		}
+	}
+	return paths, nil
+}
+
+// v1Dir returns the directory of the cgroup, at path p, in the hierarchy
+// mounted at m. Inside of a container, the mount's root is usually the
+// container's cgroup, which p starts with. If the directory doesn't exist,
+// e.g. because the container's cgroup is mounted but the root isn't, the
+// mount point is returned.
+func v1Dir(o joe.Options, m v1Mount, p string) (string, error) {
+	if m.root != "/" && (p == m.root || strings.HasPrefix(p, m.root+"/")) {
+		p = p[len(m.root):]
+	}
+	mnt := o.Path(m.point)
+	dir := path.Join(mnt, p)
+	_, err := o.Stat(dir)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		return mnt, nil
+	}
+	return dir, nil
+}
+
+// getV1 gets the information from the cgroup v1 files that differ from the
+// cgroup v2 ones and converts it to the cgroup v2 units.
+func (prof *Profiler) getV1(c *Cgroup) (err error) {
+	if prof.CPUQuota != nil {
+		c.CPU.Quota, err = prof.parseValue(CPUQuotaFile, prof.CPUQuota)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.CPUPeriod != nil {
+		c.CPU.Period, err = prof.parseValue(CPUPeriodFile, prof.CPUPeriod)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.CPUAcctUsage != nil {
+		// nanoseconds
+		c.CPU.UsageUsec, err = prof.parseValue(CPUAcctUsageFile, prof.CPUAcctUsage)
+		if err != nil {
+			return err
+		}
+		c.CPU.UsageUsec /= 1000
+	}
+	if prof.CPUAcctStat != nil {
+		// ticks
+		err = prof.parseStat(CPUAcctStatFile, prof.CPUAcctStat, func(k []byte, v int64) {
+			switch string(k) {
+			case "user":
+				c.CPU.UserUsec = v * 1000000 / prof.clkTck
+			case "system":
+				c.CPU.SystemUsec = v * 1000000 / prof.clkTck
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if prof.MemoryCurrent != nil {
+		c.Memory.Current, err = prof.parseValue(MemoryUsageFile, prof.MemoryCurrent)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.MemoryMax != nil {
+		c.Memory.Max, err = prof.parseValue(MemoryLimitFile, prof.MemoryMax)
+		if err != nil {
+			return err
+		}
+		if c.Memory.Max >= v1Unlimited {
+			c.Memory.Max = Unlimited
+		}
+	}
+	if prof.MemoryStat != nil {
+		// the total_ values include the cgroup's descendants, as the cgroup
+		// v2 values do.
+		err = prof.parseStat(MemoryStatFile, prof.MemoryStat, func(k []byte, v int64) {
+			switch string(k) {
+			case "total_rss":
+				c.Memory.Anon = v
+			case "total_cache":
+				c.Memory.File = v
+			case "total_shmem":
+				c.Memory.Shmem = v
+			case "total_mapped_file":
+				c.Memory.FileMapped = v
+			case "total_dirty":
+				c.Memory.FileDirty = v
+			case "total_writeback":
+				c.Memory.FileWriteback = v
+			case "total_active_anon":
+				c.Memory.ActiveAnon = v
+			case "total_inactive_anon":
+				c.Memory.InactiveAnon = v
+			case "total_active_file":
+				c.Memory.ActiveFile = v
+			case "total_inactive_file":
+				c.Memory.InactiveFile = v
+			case "total_unevictable":
+				c.Memory.Unevictable = v
+			case "total_pgfault":
+				c.Memory.PgFault = v
+			case "total_pgmajfault":
+				c.Memory.PgMajFault = v
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if prof.BlkioBytes != nil {
+		c.IO, err = prof.parseBlkio(BlkioBytesFile, prof.BlkioBytes, c.IO, false)
+		if err != nil {
+			return err
+		}
+	}
+	if prof.BlkioIOs != nil {
+		c.IO, err = prof.parseBlkio(BlkioIOsFile, prof.BlkioIOs, c.IO, true)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseBlkio processes a blkio.throttle file, which has a line per device and
+// operation, e.g.:
+//
+//	8:0 Read 1459200
+//	8:0 Write 314773504
+//	8:0 Sync 314773504
+//	8:0 Async 1459200
+//	8:0 Discard 0
+//	8:0 Total 316232704
+//	Total 316232704
+//
+// The Read, Write, and Discard values are set as the device's bytes or,
+// if ios, its operations; devices that aren't in devs are appended.
+func (prof *Profiler) parseBlkio(name string, proc joe.Procer, devs []IODevice, ios bool) ([]IODevice, error) {
+	var (
+		line         int
+		n            uint64
+		major, minor uint32
+		err          error
+	)
+	for {
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return devs, nil
+			}
+			return nil, &joe.ReadError{Info: name, Err: err}
+		}
+		line++
+		fields := bytes.Fields(prof.Line)
+		if len(fields) == 2 && string(fields[0]) == "Total" {
+			continue
+		}
+		if len(fields) != 3 {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", name, line), Err: fmt.Errorf("%q: expected a device, an operation, and a value", bytes.TrimSpace(prof.Line))}
+		}
+		major, minor, err = parseDevice(fields[0])
+		if err != nil {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", name, line), Err: err}
+		}
+		n, err = tools.ParseUint(fields[2])
+		if err != nil {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d: %s", name, line, fields[1]), Err: err}
+		}
+		i := 0
+		for ; i < len(devs); i++ {
+			if devs[i].Major == major && devs[i].Minor == minor {
+				break
+			}
+		}
+		if i == len(devs) {
+			devs = append(devs, IODevice{Major: major, Minor: minor})
+		}
+		d := &devs[i]
+		switch string(fields[1]) {
+		case "Read":
+			if ios {
+				d.RIOs = int64(n)
+			} else {
+				d.RBytes = int64(n)
+			}
+		case "Write":
+			if ios {
+				d.WIOs = int64(n)
+			} else {
+				d.WBytes = int64(n)
+			}
+		case "Discard":
+			if ios {
+				d.DIOs = int64(n)
+			} else {
+				d.DBytes = int64(n)
+			}
+		}
+	}
+}
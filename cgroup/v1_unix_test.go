@@ -0,0 +1,207 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cgroup
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+const mountInfo = `22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw
+25 22 0:23 / /sys/fs/cgroup ro,nosuid,nodev,noexec shared:9 - tmpfs tmpfs ro,mode=755
+26 25 0:24 / /sys/fs/cgroup/unified rw,nosuid,nodev,noexec,relatime shared:10 - cgroup2 cgroup2 rw
+27 25 0:25 / /sys/fs/cgroup/systemd rw,nosuid,nodev,noexec,relatime shared:11 - cgroup cgroup rw,xattr,name=systemd
+30 25 0:28 / /sys/fs/cgroup/cpu,cpuacct rw,nosuid,nodev,noexec,relatime shared:14 - cgroup cgroup rw,cpu,cpuacct
+31 25 0:29 / /sys/fs/cgroup/memory rw,nosuid,nodev,noexec,relatime shared:15 - cgroup cgroup rw,memory
+32 25 0:30 / /sys/fs/cgroup/blkio rw,nosuid,nodev,noexec,relatime shared:16 - cgroup cgroup rw,blkio
+33 25 0:31 / /sys/fs/cgroup/pids rw,nosuid,nodev,noexec,relatime shared:17 - cgroup cgroup rw,pids
+34 25 0:32 / /sys/fs/cgroup/cpuset rw,nosuid,nodev,noexec,relatime shared:18 - cgroup cgroup rw,cpuset
+`
+
+const procCgroupV1 = `12:pids:/docker/abcd
+11:cpuset:/docker/abcd
+8:blkio:/docker/abcd
+7:memory:/docker/abcd
+4:cpu,cpuacct:/docker/abcd
+1:name=systemd:/docker/abcd
+0::/docker/abcd
+`
+
+const memoryStatV1 = `cache 1000
+rss 2000
+mapped_file 3000
+active_anon 4000
+pgfault 5000
+hierarchical_memory_limit 9223372036854771712
+total_cache 52428800
+total_rss 104857600
+total_rss_huge 0
+total_shmem 8192
+total_mapped_file 2097152
+total_dirty 12288
+total_writeback 4096
+total_pgfault 123456
+total_pgmajfault 78
+total_inactive_anon 94371840
+total_active_anon 10485760
+total_inactive_file 41943040
+total_active_file 10485760
+total_unevictable 0
+`
+
+// fixtureV1 returns a file system with the cgroup v1 controllers mounted at
+// /sys/fs/cgroup and the current process in the /docker/abcd cgroups. The
+// cgroup v2 hierarchy is mounted at /sys/fs/cgroup/unified without any
+// controllers.
+func fixtureV1() fstest.MapFS {
+	cpu := "sys/fs/cgroup/cpu,cpuacct/docker/abcd/"
+	mem := "sys/fs/cgroup/memory/docker/abcd/"
+	blkio := "sys/fs/cgroup/blkio/docker/abcd/"
+	pids := "sys/fs/cgroup/pids/docker/abcd/"
+	return fstest.MapFS{
+		"proc/self/mountinfo":                                  {Data: []byte(mountInfo)},
+		"proc/self/cgroup":                                     {Data: []byte(procCgroupV1)},
+		"sys/fs/cgroup/unified/cgroup.controllers":             {Data: []byte("hugetlb\n")},
+		"sys/fs/cgroup/cpuset/docker/abcd/" + CpusetV1CPUsFile: {Data: []byte("0-1\n")},
+		cpu + CPUQuotaFile:                                     {Data: []byte("50000\n")},
+		cpu + CPUPeriodFile:                                    {Data: []byte("100000\n")},
+		cpu + CPUStatFile:                                      {Data: []byte("nr_periods 300\nnr_throttled 30\nthrottled_time 1500000000\n")},
+		cpu + CPUAcctUsageFile:                                 {Data: []byte("7500000000\n")},
+		cpu + CPUAcctStatFile:                                  {Data: []byte("user 500\nsystem 250\n")},
+		mem + MemoryUsageFile:                                  {Data: []byte("157286400\n")},
+		mem + MemoryLimitFile:                                  {Data: []byte("9223372036854771712\n")},
+		mem + MemoryStatFile:                                   {Data: []byte(memoryStatV1)},
+		blkio + BlkioBytesFile: {Data: []byte("8:0 Read 1459200\n8:0 Write 314773504\n8:0 Sync 314773504\n" +
+			"8:0 Async 1459200\n8:0 Discard 0\n8:0 Total 316232704\n253:1 Read 4096\n253:1 Discard 512\nTotal 316237312\n")},
+		blkio + BlkioIOsFile:   {Data: []byte("8:0 Read 192\n8:0 Write 353\n8:0 Total 545\n253:1 Read 1\n253:1 Discard 2\nTotal 548\n")},
+		pids + PidsCurrentFile: {Data: []byte("12\n")},
+		pids + PidsMaxFile:     {Data: []byte("max\n")},
+	}
+}
+
+func TestGetV1(t *testing.T) {
+	prof, err := NewProfiler(joe.WithFS(fixtureV1()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.Version != 1 {
+		t.Errorf("Version: got %d; want 1", prof.Version)
+	}
+	if prof.Dir != "/sys/fs/cgroup/cpu,cpuacct/docker/abcd" {
+		t.Errorf("Dir: got %q; want %q", prof.Dir, "/sys/fs/cgroup/cpu,cpuacct/docker/abcd")
+	}
+	if prof.dirs["cpuset"] != "/sys/fs/cgroup/cpuset/docker/abcd" {
+		t.Errorf("cpuset dir: got %q; want %q", prof.dirs["cpuset"], "/sys/fs/cgroup/cpuset/docker/abcd")
+	}
+	cg, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	// cpuacct.stat is in ticks.
+	expected := Cgroup{
+		Timestamp: cg.Timestamp,
+		Path:      "/docker/abcd",
+		CPU: CPU{
+			Quota: 50000, Period: 100000, UsageUsec: 7500000, UserUsec: 500 * 1000000 / prof.clkTck,
+			SystemUsec: 250 * 1000000 / prof.clkTck, NrPeriods: 300, NrThrottled: 30, ThrottledUsec: 1500000,
+			EffectiveCPUs: 2,
+		},
+		Memory: Memory{
+			Current: 157286400, Max: Unlimited, Anon: 104857600, File: 52428800, Shmem: 8192,
+			FileMapped: 2097152, FileDirty: 12288, FileWriteback: 4096, ActiveAnon: 10485760,
+			InactiveAnon: 94371840, ActiveFile: 10485760, InactiveFile: 41943040, PgFault: 123456, PgMajFault: 78,
+		},
+		IO: []IODevice{
+			{Major: 8, Minor: 0, RBytes: 1459200, WBytes: 314773504, RIOs: 192, WIOs: 353},
+			{Major: 253, Minor: 1, RBytes: 4096, RIOs: 1, DBytes: 512, DIOs: 2},
+		},
+		Pids: Pids{Current: 12, Max: Unlimited},
+	}
+	if !reflect.DeepEqual(cg, expected) {
+		t.Errorf("got %#v; want %#v", cg, expected)
+	}
+}
+
+func TestNewProfilerV1Mount(t *testing.T) {
+	// v2 with the controllers is preferred.
+	fsys := fixtureV1()
+	fsys["sys/fs/cgroup/unified/cgroup.controllers"] = &fstest.MapFile{Data: []byte("cpu io memory pids\n")}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.Version != 2 {
+		t.Errorf("v2 with controllers: Version: got %d; want 2", prof.Version)
+	}
+
+	// a container: the mounts' roots are the container's cgroups.
+	fsys = fstest.MapFS{
+		"proc/self/mountinfo": {Data: []byte("1 0 0:28 /docker/abcd /sys/fs/cgroup/memory ro - cgroup cgroup rw,memory\n" +
+			"2 0 0:29 /docker/abcd /sys/fs/cgroup/cpu,cpuacct ro - cgroup cgroup rw,cpu,cpuacct\n")},
+		"proc/self/cgroup":                          {Data: []byte("4:memory:/docker/abcd\n2:cpu,cpuacct:/docker/abcd\n")},
+		"sys/fs/cgroup/memory/" + MemoryLimitFile:   {Data: []byte("1073741824\n")},
+		"sys/fs/cgroup/cpu,cpuacct/" + CPUQuotaFile: {Data: []byte("-1\n")},
+	}
+	prof, err = NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.Dir != "/sys/fs/cgroup/cpu,cpuacct" {
+		t.Errorf("container: Dir: got %q; want %q", prof.Dir, "/sys/fs/cgroup/cpu,cpuacct")
+	}
+	cg, err := prof.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cg.Memory.Max != 1073741824 || cg.CPU.Quota != Unlimited {
+		t.Errorf("container: got %#v and %#v; want a memory limit of 1073741824 and an unlimited quota", cg.Memory, cg.CPU)
+	}
+
+	// neither hierarchy.
+	fsys = fstest.MapFS{
+		"proc/self/mountinfo": {Data: []byte("22 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw\n")},
+		"proc/self/cgroup":    {Data: []byte("1:name=systemd:/\n")},
+	}
+	_, err = NewProfiler(joe.WithFS(fsys))
+	if err != ErrNotSupported {
+		t.Errorf("no cgroups: got %v; want %v", err, ErrNotSupported)
+	}
+}
+
+func TestGetV1Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		file string
+		data string
+	}{
+		{"cfs_quota_us: bad value", "sys/fs/cgroup/cpu,cpuacct/docker/abcd/" + CPUQuotaFile, "-2\n"},
+		{"cpuacct.usage: bad value", "sys/fs/cgroup/cpu,cpuacct/docker/abcd/" + CPUAcctUsageFile, "x\n"},
+		{"blkio: bad device", "sys/fs/cgroup/blkio/docker/abcd/" + BlkioBytesFile, "8 Read 0\n"},
+		{"blkio: missing value", "sys/fs/cgroup/blkio/docker/abcd/" + BlkioBytesFile, "8:0 Read\n"},
+		{"blkio: bad value", "sys/fs/cgroup/blkio/docker/abcd/" + BlkioIOsFile, "8:0 Read x\n"},
+	}
+	for _, test := range tests {
+		fsys := fixtureV1()
+		fsys[test.file] = &fstest.MapFile{Data: []byte(test.data)}
+		_, err := NewProfiler(joe.WithFS(fsys))
+		if err == nil {
+			t.Errorf("%s: expected an error; got none", test.name)
+		} else if _, ok := err.(*joe.ParseError); !ok {
+			t.Errorf("%s: expected a ParseError; got %#v", test.name, err)
+		}
+	}
+}
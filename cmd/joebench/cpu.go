@@ -19,15 +19,19 @@ import (
 	"github.com/hmmftg/joefriday/cpu/cpufreq"
 	freqfb "github.com/hmmftg/joefriday/cpu/cpufreq/flat"
 	freqjson "github.com/hmmftg/joefriday/cpu/cpufreq/json"
+	freqmp "github.com/hmmftg/joefriday/cpu/cpufreq/msgpack"
 	"github.com/hmmftg/joefriday/cpu/cpuinfo"
 	infofb "github.com/hmmftg/joefriday/cpu/cpuinfo/flat"
 	infojson "github.com/hmmftg/joefriday/cpu/cpuinfo/json"
+	infomp "github.com/hmmftg/joefriday/cpu/cpuinfo/msgpack"
 	"github.com/hmmftg/joefriday/cpu/cpustats"
 	statsfb "github.com/hmmftg/joefriday/cpu/cpustats/flat"
 	statsjson "github.com/hmmftg/joefriday/cpu/cpustats/json"
+	statsmp "github.com/hmmftg/joefriday/cpu/cpustats/msgpack"
 	"github.com/hmmftg/joefriday/cpu/cpuutil"
 	utilfb "github.com/hmmftg/joefriday/cpu/cpuutil/flat"
 	utiljson "github.com/hmmftg/joefriday/cpu/cpuutil/json"
+	utilmp "github.com/hmmftg/joefriday/cpu/cpuutil/msgpack"
 	"github.com/mohae/benchutil"
 )
 
@@ -60,6 +64,15 @@ func runCPUBenchmarks(bench benchutil.Benchmarker) {
 	b = CPUFreqDeserializeJSON()
 	bench.Append(b)
 
+	b = CPUFreqGetMP()
+	bench.Append(b)
+
+	b = CPUFreqSerializeMP()
+	bench.Append(b)
+
+	b = CPUFreqDeserializeMP()
+	bench.Append(b)
+
 	b = CPUInfoGet()
 	bench.Append(b)
 
@@ -81,6 +94,15 @@ func runCPUBenchmarks(bench benchutil.Benchmarker) {
 	b = CPUInfoDeserializeJSON()
 	bench.Append(b)
 
+	b = CPUInfoGetMP()
+	bench.Append(b)
+
+	b = CPUInfoSerializeMP()
+	bench.Append(b)
+
+	b = CPUInfoDeserializeMP()
+	bench.Append(b)
+
 	b = CPUStatsGet()
 	bench.Append(b)
 
@@ -102,6 +124,15 @@ func runCPUBenchmarks(bench benchutil.Benchmarker) {
 	b = CPUStatsDeserializeJSON()
 	bench.Append(b)
 
+	b = CPUStatsGetMP()
+	bench.Append(b)
+
+	b = CPUStatsSerializeMP()
+	bench.Append(b)
+
+	b = CPUStatsDeserializeMP()
+	bench.Append(b)
+
 	b = CPUUtilGet()
 	bench.Append(b)
 
@@ -122,6 +153,15 @@ func runCPUBenchmarks(bench benchutil.Benchmarker) {
 
 	b = CPUUtilDeserializeJSON()
 	bench.Append(b)
+
+	b = CPUUtilGetMP()
+	bench.Append(b)
+
+	b = CPUUtilSerializeMP()
+	bench.Append(b)
+
+	b = CPUUtilDeserializeMP()
+	bench.Append(b)
 }
 
 // Freq
@@ -643,3 +683,227 @@ func CPUUtilDeserializeJSON() benchutil.Bench {
 	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUUtilDeserializeJSON))
 	return bench
 }
+
+func BenchCPUFreqGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := freqmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func CPUFreqGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = CPUFreq
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUFreqGetMP))
+	return bench
+}
+
+func BenchCPUFreqSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := freqmp.NewProfiler()
+	fct, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = freqmp.Serialize(fct)
+	}
+	_ = tmp
+}
+
+func CPUFreqSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = CPUFreq
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUFreqSerializeMP))
+	return bench
+}
+
+func BenchCPUFreqDeserializeMP(b *testing.B) {
+	var f *cpufreq.Frequency
+	p, _ := freqmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f, _ = freqmp.Deserialize(tmp)
+	}
+	_ = f
+}
+
+func CPUFreqDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = CPUFreq
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUFreqDeserializeMP))
+	return bench
+}
+
+func BenchCPUInfoGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := infomp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func CPUInfoGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = CPUInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUInfoGetMP))
+	return bench
+}
+
+func BenchCPUInfoSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := infomp.NewProfiler()
+	fct, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = infomp.Serialize(fct)
+	}
+	_ = tmp
+}
+
+func CPUInfoSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = CPUInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUInfoSerializeMP))
+	return bench
+}
+
+func BenchCPUInfoDeserializeMP(b *testing.B) {
+	var fct *cpuinfo.CPUInfo
+	p, _ := infomp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		fct, _ = infomp.Deserialize(tmp)
+	}
+	_ = fct
+}
+
+func CPUInfoDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = CPUInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUInfoDeserializeMP))
+	return bench
+}
+
+func BenchCPUStatsGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := statsmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func CPUStatsGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = CPUStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUStatsGetMP))
+	return bench
+}
+
+func BenchCPUStatsSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := cpustats.NewProfiler()
+	sts, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = statsmp.Serialize(sts)
+	}
+	_ = tmp
+}
+
+func CPUStatsSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = CPUStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUStatsSerializeMP))
+	return bench
+}
+
+func BenchCPUStatsDeserializeMP(b *testing.B) {
+	var sts *cpustats.CPUStats
+	p, _ := statsmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sts, _ = statsmp.Deserialize(tmp)
+	}
+	_ = sts
+}
+
+func CPUStatsDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = CPUStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUStatsDeserializeMP))
+	return bench
+}
+
+func BenchCPUUtilGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := utilmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func CPUUtilGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = CPUUtil
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUUtilGetMP))
+	return bench
+}
+
+func BenchCPUUtilSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := cpuutil.NewProfiler()
+	u, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = utilmp.Serialize(u)
+	}
+	_ = tmp
+}
+
+func CPUUtilSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = CPUUtil
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUUtilSerializeMP))
+	return bench
+}
+
+func BenchCPUUtilDeserializeMP(b *testing.B) {
+	var u *cpuutil.CPUUtil
+	p, _ := utilmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = utilmp.Deserialize(tmp)
+	}
+	_ = u
+}
+
+func CPUUtilDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = CPUUtil
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchCPUUtilDeserializeMP))
+	return bench
+}
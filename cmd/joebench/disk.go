@@ -19,9 +19,11 @@ import (
 	stats "github.com/hmmftg/joefriday/disk/diskstats"
 	sfb "github.com/hmmftg/joefriday/disk/diskstats/flat"
 	sjson "github.com/hmmftg/joefriday/disk/diskstats/json"
+	smp "github.com/hmmftg/joefriday/disk/diskstats/msgpack"
 	usage "github.com/hmmftg/joefriday/disk/diskusage"
 	ufb "github.com/hmmftg/joefriday/disk/diskusage/flat"
 	ujson "github.com/hmmftg/joefriday/disk/diskusage/json"
+	ump "github.com/hmmftg/joefriday/disk/diskusage/msgpack"
 	"github.com/hmmftg/joefriday/disk/structs"
 	"github.com/mohae/benchutil"
 )
@@ -44,6 +46,15 @@ func runDiskBenchmarks(bench benchutil.Benchmarker) {
 	b = DiskStatsDeserializeJSON()
 	bench.Append(b)
 
+	b = DiskGetStatsMP()
+	bench.Append(b)
+
+	b = DiskStatsSerializeMP()
+	bench.Append(b)
+
+	b = DiskStatsDeserializeMP()
+	bench.Append(b)
+
 	b = DiskGetUsage()
 	bench.Append(b)
 
@@ -64,6 +75,15 @@ func runDiskBenchmarks(bench benchutil.Benchmarker) {
 
 	b = DiskUsageDeserializeJSON()
 	bench.Append(b)
+
+	b = DiskGetUsageMP()
+	bench.Append(b)
+
+	b = DiskUsageSerializeMP()
+	bench.Append(b)
+
+	b = DiskUsageDeserializeMP()
+	bench.Append(b)
 }
 
 func BenchDiskGetStats(b *testing.B) {
@@ -324,3 +344,115 @@ func DiskUsageDeserializeJSON() benchutil.Bench {
 	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskUsageDeserializeJSON))
 	return bench
 }
+
+func BenchDiskStatsGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := smp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func DiskGetStatsMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = DiskStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskStatsGetMP))
+	return bench
+}
+
+func BenchDiskStatsSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := stats.NewProfiler()
+	sts, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = smp.Serialize(sts)
+	}
+	_ = tmp
+}
+
+func DiskStatsSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = DiskStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskStatsSerializeMP))
+	return bench
+}
+
+func BenchDiskStatsDeserializeMP(b *testing.B) {
+	var sts *structs.DiskStats
+	p, _ := smp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sts, _ = smp.Deserialize(tmp)
+	}
+	_ = sts
+}
+
+func DiskStatsDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = DiskStats
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskStatsDeserializeMP))
+	return bench
+}
+
+func BenchDiskUsageGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := ump.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func DiskGetUsageMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = DiskUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskUsageGetMP))
+	return bench
+}
+
+func BenchDiskUsageSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := usage.NewProfiler()
+	u, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = ump.Serialize(u)
+	}
+	_ = tmp
+}
+
+func DiskUsageSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = DiskUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskUsageSerializeMP))
+	return bench
+}
+
+func BenchDiskUsageDeserializeMP(b *testing.B) {
+	var u *structs.DiskUsage
+	p, _ := ump.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = ump.Deserialize(tmp)
+	}
+	_ = u
+}
+
+func DiskUsageDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = DiskUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchDiskUsageDeserializeMP))
+	return bench
+}
@@ -23,8 +23,9 @@ import (
 )
 
 const (
-	Flat = "FlatBuffers"
-	JSON = "JSON"
+	Flat    = "FlatBuffers"
+	JSON    = "JSON"
+	MsgPack = "MessagePack"
 )
 
 // flags
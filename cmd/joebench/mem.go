@@ -19,6 +19,7 @@ import (
 	"github.com/hmmftg/joefriday/mem/meminfo"
 	mfb "github.com/hmmftg/joefriday/mem/meminfo/flat"
 	mjson "github.com/hmmftg/joefriday/mem/meminfo/json"
+	mmp "github.com/hmmftg/joefriday/mem/meminfo/msgpack"
 	"github.com/mohae/benchutil"
 )
 
@@ -47,6 +48,15 @@ func runMemBenchmarks(bench benchutil.Benchmarker) {
 
 	b = MemInfoDeserializeJSON()
 	bench.Append(b)
+
+	b = MemInfoGetMP()
+	bench.Append(b)
+
+	b = MemInfoSerializeMP()
+	bench.Append(b)
+
+	b = MemInfoDeserializeMP()
+	bench.Append(b)
 }
 
 func BenchMemInfoGet(b *testing.B) {
@@ -177,3 +187,59 @@ func MemInfoDeserializeJSON() benchutil.Bench {
 	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchMemInfoDeserializeJSON))
 	return bench
 }
+
+func BenchMemInfoGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := mmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func MemInfoGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = MemInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchMemInfoGetMP))
+	return bench
+}
+
+func BenchMemInfoSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := meminfo.NewProfiler()
+	sts, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = mmp.Serialize(sts)
+	}
+	_ = tmp
+}
+
+func MemInfoSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = MemInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchMemInfoSerializeMP))
+	return bench
+}
+
+func BenchMemInfoDeserializeMP(b *testing.B) {
+	var inf *meminfo.Info
+	p, _ := mmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inf, _ = mmp.Deserialize(tmp)
+	}
+	_ = inf
+}
+
+func MemInfoDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = MemInfo
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchMemInfoDeserializeMP))
+	return bench
+}
@@ -19,9 +19,11 @@ import (
 	"github.com/hmmftg/joefriday/net/netdev"
 	dfb "github.com/hmmftg/joefriday/net/netdev/flat"
 	djson "github.com/hmmftg/joefriday/net/netdev/json"
+	dmp "github.com/hmmftg/joefriday/net/netdev/msgpack"
 	"github.com/hmmftg/joefriday/net/netusage"
 	ufb "github.com/hmmftg/joefriday/net/netusage/flat"
 	ujson "github.com/hmmftg/joefriday/net/netusage/json"
+	ump "github.com/hmmftg/joefriday/net/netusage/msgpack"
 	"github.com/hmmftg/joefriday/net/structs"
 	"github.com/mohae/benchutil"
 )
@@ -53,6 +55,15 @@ func runNetBenchmarks(bench benchutil.Benchmarker) {
 	b = NetDevDeserializeJSON()
 	bench.Append(b)
 
+	b = NetDevGetMP()
+	bench.Append(b)
+
+	b = NetDevSerializeMP()
+	bench.Append(b)
+
+	b = NetDevDeserializeMP()
+	bench.Append(b)
+
 	b = NetUsageGet()
 	bench.Append(b)
 
@@ -73,6 +84,15 @@ func runNetBenchmarks(bench benchutil.Benchmarker) {
 
 	b = NetUsageDeserializeJSON()
 	bench.Append(b)
+
+	b = NetUsageGetMP()
+	bench.Append(b)
+
+	b = NetUsageSerializeMP()
+	bench.Append(b)
+
+	b = NetUsageDeserializeMP()
+	bench.Append(b)
 }
 
 func BenchNetDevGet(b *testing.B) {
@@ -333,3 +353,115 @@ func NetUsageDeserializeJSON() benchutil.Bench {
 	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetUsageDeserializeJSON))
 	return bench
 }
+
+func BenchNetDevGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := dmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func NetDevGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = NetDev
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetDevGetMP))
+	return bench
+}
+
+func BenchNetDevSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := netdev.NewProfiler()
+	sts, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = dmp.Serialize(sts)
+	}
+	_ = tmp
+}
+
+func NetDevSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = NetDev
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetDevSerializeMP))
+	return bench
+}
+
+func BenchNetDevDeserializeMP(b *testing.B) {
+	var inf *structs.DevInfo
+	p, _ := dmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inf, _ = dmp.Deserialize(tmp)
+	}
+	_ = inf
+}
+
+func NetDevDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = NetDev
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetDevDeserializeMP))
+	return bench
+}
+
+func BenchNetUsageGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := ump.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func NetUsageGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = NetUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetUsageGetMP))
+	return bench
+}
+
+func BenchNetUsageSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := netusage.NewProfiler()
+	u, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = ump.Serialize(u)
+	}
+	_ = tmp
+}
+
+func NetUsageSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = NetUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetUsageSerializeMP))
+	return bench
+}
+
+func BenchNetUsageDeserializeMP(b *testing.B) {
+	var u *structs.DevUsage
+	p, _ := ump.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = ump.Deserialize(tmp)
+	}
+	_ = u
+}
+
+func NetUsageDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = NetUsage
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchNetUsageDeserializeMP))
+	return bench
+}
@@ -19,15 +19,19 @@ import (
 	"github.com/hmmftg/joefriday/system/loadavg"
 	lfb "github.com/hmmftg/joefriday/system/loadavg/flat"
 	ljson "github.com/hmmftg/joefriday/system/loadavg/json"
+	lmp "github.com/hmmftg/joefriday/system/loadavg/msgpack"
 	"github.com/hmmftg/joefriday/system/os"
 	osfb "github.com/hmmftg/joefriday/system/os/flat"
 	osjson "github.com/hmmftg/joefriday/system/os/json"
+	osmp "github.com/hmmftg/joefriday/system/os/msgpack"
 	"github.com/hmmftg/joefriday/system/uptime"
 	ufb "github.com/hmmftg/joefriday/system/uptime/flat"
 	ujson "github.com/hmmftg/joefriday/system/uptime/json"
+	ump "github.com/hmmftg/joefriday/system/uptime/msgpack"
 	"github.com/hmmftg/joefriday/system/version"
 	vfb "github.com/hmmftg/joefriday/system/version/flat"
 	vjson "github.com/hmmftg/joefriday/system/version/json"
+	vmp "github.com/hmmftg/joefriday/system/version/msgpack"
 	"github.com/mohae/benchutil"
 )
 
@@ -60,6 +64,15 @@ func runSystemBenchmarks(bench benchutil.Benchmarker) {
 	b = SystemLoadAvgDeserializeJSON()
 	bench.Append(b)
 
+	b = SystemLoadAvgGetMP()
+	bench.Append(b)
+
+	b = SystemLoadAvgSerializeMP()
+	bench.Append(b)
+
+	b = SystemLoadAvgDeserializeMP()
+	bench.Append(b)
+
 	b = SystemOSGet()
 	bench.Append(b)
 
@@ -81,6 +94,15 @@ func runSystemBenchmarks(bench benchutil.Benchmarker) {
 	b = SystemOSDeserializeJSON()
 	bench.Append(b)
 
+	b = SystemOSGetMP()
+	bench.Append(b)
+
+	b = SystemOSSerializeMP()
+	bench.Append(b)
+
+	b = SystemOSDeserializeMP()
+	bench.Append(b)
+
 	b = SystemUptimeGet()
 	bench.Append(b)
 
@@ -102,6 +124,15 @@ func runSystemBenchmarks(bench benchutil.Benchmarker) {
 	b = SystemUptimeDeserializeJSON()
 	bench.Append(b)
 
+	b = SystemUptimeGetMP()
+	bench.Append(b)
+
+	b = SystemUptimeSerializeMP()
+	bench.Append(b)
+
+	b = SystemUptimeDeserializeMP()
+	bench.Append(b)
+
 	b = SystemVersionGet()
 	bench.Append(b)
 
@@ -122,6 +153,15 @@ func runSystemBenchmarks(bench benchutil.Benchmarker) {
 
 	b = SystemVersionDeserializeJSON()
 	bench.Append(b)
+
+	b = SystemVersionGetMP()
+	bench.Append(b)
+
+	b = SystemVersionSerializeMP()
+	bench.Append(b)
+
+	b = SystemVersionDeserializeMP()
+	bench.Append(b)
 }
 
 // LoadAvg
@@ -643,3 +683,227 @@ func SystemVersionDeserializeJSON() benchutil.Bench {
 	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemVersionDeserializeJSON))
 	return bench
 }
+
+func BenchSystemLoadAvgGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := lmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func SystemLoadAvgGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = SystemLoadAvg
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemLoadAvgGetMP))
+	return bench
+}
+
+func BenchSystemLoadAvgSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := loadavg.NewProfiler()
+	l, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = lmp.Serialize(l)
+	}
+	_ = tmp
+}
+
+func SystemLoadAvgSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = SystemLoadAvg
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemLoadAvgSerializeMP))
+	return bench
+}
+
+func BenchSystemLoadAvgDeserializeMP(b *testing.B) {
+	var l loadavg.LoadAvg
+	p, _ := lmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l, _ = lmp.Deserialize(tmp)
+	}
+	_ = l
+}
+
+func SystemLoadAvgDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = SystemLoadAvg
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemLoadAvgDeserializeMP))
+	return bench
+}
+
+func BenchSystemOSGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := osmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func SystemOSGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = SystemOS
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemOSGetMP))
+	return bench
+}
+
+func BenchSystemOSSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := os.NewProfiler()
+	l, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = osmp.Serialize(l)
+	}
+	_ = tmp
+}
+
+func SystemOSSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = SystemOS
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemOSSerializeMP))
+	return bench
+}
+
+func BenchSystemOSDeserializeMP(b *testing.B) {
+	var o *os.OS
+	p, _ := osmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		o, _ = osmp.Deserialize(tmp)
+	}
+	_ = o
+}
+
+func SystemOSDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = SystemOS
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemOSDeserializeMP))
+	return bench
+}
+
+func BenchSystemUptimeGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := ump.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func SystemUptimeGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = SystemUptime
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemUptimeGetMP))
+	return bench
+}
+
+func BenchSystemUptimeSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := uptime.NewProfiler()
+	u, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = ump.Serialize(u)
+	}
+	_ = tmp
+}
+
+func SystemUptimeSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = SystemUptime
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemUptimeSerializeMP))
+	return bench
+}
+
+func BenchSystemUptimeDeserializeMP(b *testing.B) {
+	var u uptime.Uptime
+	p, _ := ump.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = ump.Deserialize(tmp)
+	}
+	_ = u
+}
+
+func SystemUptimeDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = SystemUptime
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemUptimeDeserializeMP))
+	return bench
+}
+
+func BenchSystemVersionGetMP(b *testing.B) {
+	var tmp []byte
+	p, _ := vmp.NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func SystemVersionGetMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Get")
+	bench.Group = SystemVersion
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemVersionGetMP))
+	return bench
+}
+
+func BenchSystemVersionSerializeMP(b *testing.B) {
+	var tmp []byte
+	p, _ := version.NewProfiler()
+	k, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = vmp.Serialize(k)
+	}
+	_ = tmp
+}
+
+func SystemVersionSerializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Serialize")
+	bench.Group = SystemVersion
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemVersionSerializeMP))
+	return bench
+}
+
+func BenchSystemVersionDeserializeMP(b *testing.B) {
+	var k *version.Kernel
+	p, _ := vmp.NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k, _ = vmp.Deserialize(tmp)
+	}
+	_ = k
+}
+
+func SystemVersionDeserializeMP() benchutil.Bench {
+	bench := benchutil.NewBench("msgpack.Deserialize")
+	bench.Group = SystemVersion
+	bench.Desc = MsgPack
+	bench.Result = benchutil.ResultFromBenchmarkResult(testing.Benchmark(BenchSystemVersionDeserializeMP))
+	return bench
+}
@@ -0,0 +1,289 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package collector gathers the information of multiple JoeFriday packages,
+// its modules, into a single Snapshot: cpu stats and utilization, memory
+// information, disk and network usage, load average, and uptime. Each module
+// is collected independently; if a module's information can't be collected,
+// its error is recorded in the Snapshot's Errors and the rest of the modules
+// are still collected.
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/cpu/cpuutil"
+	"github.com/hmmftg/joefriday/disk/diskusage"
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	"github.com/hmmftg/joefriday/net/netusage"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/uptime"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Module is the name of a package whose information can be collected.
+type Module string
+
+// The modules. The Collector collects the Modules; the other modules are
+// collected by other consumers of the module set, e.g. the prometheus
+// exporter.
+const (
+	CPUStats  Module = "cpustats"
+	CPUUtil   Module = "cpuutil"
+	MemInfo   Module = "meminfo"
+	DiskStats Module = "diskstats"
+	DiskUsage Module = "diskusage"
+	NetDev    Module = "netdev"
+	NetUsage  Module = "netusage"
+	LoadAvg   Module = "loadavg"
+	Uptime    Module = "uptime"
+	Pressure  Module = "pressure"
+)
+
+// Modules is the list of the modules that the Collector collects, in the
+// order that they are collected.
+var Modules = []Module{CPUStats, CPUUtil, MemInfo, DiskUsage, NetUsage, LoadAvg, Uptime}
+
+// ErrUnknownModule is returned when a module is not one of the supported
+// modules.
+var ErrUnknownModule = errors.New("unknown module")
+
+// Select returns a copy of the modules, in order and without repeats; if no
+// modules are provided, def is used. An ErrUnknownModule is returned if any of
+// the modules is not one of the supported modules.
+func Select(modules, supported, def []Module) ([]Module, error) {
+	if len(modules) == 0 {
+		modules = def
+	}
+	selected := make([]Module, 0, len(modules))
+	for _, m := range modules {
+		if !contains(supported, m) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownModule, m)
+		}
+		if !contains(selected, m) {
+			selected = append(selected, m)
+		}
+	}
+	return selected, nil
+}
+
+// contains returns whether m is in modules.
+func contains(modules []Module, m Module) bool {
+	for _, v := range modules {
+		if m == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Snapshot holds the information of the collected modules. The information
+// of a module that wasn't enabled, or whose information couldn't be
+// collected, is nil.
+type Snapshot struct {
+	Timestamp int64               `json:"timestamp"`
+	CPUStats  *cpustats.CPUStats  `json:"cpu_stats,omitempty"`
+	CPUUtil   *cpuutil.CPUUtil    `json:"cpu_util,omitempty"`
+	MemInfo   *meminfo.Info       `json:"mem_info,omitempty"`
+	DiskUsage *dstructs.DiskUsage `json:"disk_usage,omitempty"`
+	NetUsage  *nstructs.DevUsage  `json:"net_usage,omitempty"`
+	LoadAvg   *loadavg.LoadAvg    `json:"load_avg,omitempty"`
+	Uptime    *uptime.Uptime      `json:"uptime,omitempty"`
+	// Errors holds the error message of each module whose information
+	// couldn't be collected.
+	Errors map[Module]string `json:"errors,omitempty"`
+}
+
+// Collector collects the information of the enabled modules.
+type Collector struct {
+	modules   []Module
+	opts      []joe.Option
+	cpuStats  *cpustats.Profiler
+	cpuUtil   *cpuutil.Profiler
+	memInfo   *meminfo.Profiler
+	diskUsage *diskusage.Profiler
+	netUsage  *netusage.Profiler
+	loadAvg   *loadavg.Profiler
+	uptime    *uptime.Profiler
+}
+
+// NewCollector returns a Collector for the modules; if no modules are
+// provided, all of the Modules are collected; a repeated module is collected
+// once. The opts are used by the profilers of each module. An
+// ErrUnknownModule is returned if any of the modules is not one of the
+// Modules.
+//
+// The modules' profilers are created upon creation, so that the usage and
+// utilization modules have a snapshot to calculate the first Get's
+// information from. A module whose profiler can't be created is retried on
+// each Get; until then, its error is recorded in the Snapshot's Errors.
+func NewCollector(modules []Module, opts ...joe.Option) (*Collector, error) {
+	modules, err := Select(modules, Modules, Modules)
+	if err != nil {
+		return nil, err
+	}
+	c := &Collector{modules: modules, opts: opts}
+	for _, m := range modules {
+		c.init(m)
+	}
+	return c, nil
+}
+
+// Modules returns the Collector's enabled modules.
+func (c *Collector) Modules() []Module {
+	return c.modules
+}
+
+// init creates the module's profiler, if it hasn't already been created.
+func (c *Collector) init(m Module) (err error) {
+	switch m {
+	case CPUStats:
+		if c.cpuStats == nil {
+			c.cpuStats, err = cpustats.NewProfiler(c.opts...)
+		}
+	case CPUUtil:
+		if c.cpuUtil == nil {
+			c.cpuUtil, err = cpuutil.NewProfiler(c.opts...)
+		}
+	case MemInfo:
+		if c.memInfo == nil {
+			c.memInfo, err = meminfo.NewProfiler(c.opts...)
+		}
+	case DiskUsage:
+		if c.diskUsage == nil {
+			c.diskUsage, err = diskusage.NewProfiler(c.opts...)
+		}
+	case NetUsage:
+		if c.netUsage == nil {
+			c.netUsage, err = netusage.NewProfiler(c.opts...)
+		}
+	case LoadAvg:
+		if c.loadAvg == nil {
+			c.loadAvg, err = loadavg.NewProfiler(c.opts...)
+		}
+	case Uptime:
+		if c.uptime == nil {
+			c.uptime, err = uptime.NewProfiler(c.opts...)
+		}
+	}
+	return err
+}
+
+// Get returns a Snapshot of the enabled modules' current information. The
+// usage and utilization modules calculate their information using the
+// difference between the current snapshot and the prior one, see the
+// module's package for more information.
+func (c *Collector) Get() *Snapshot {
+	s := &Snapshot{Timestamp: time.Now().UTC().UnixNano()}
+	for _, m := range c.modules {
+		err := c.collect(m, s)
+		if err != nil {
+			if s.Errors == nil {
+				s.Errors = make(map[Module]string)
+			}
+			s.Errors[m] = err.Error()
+		}
+	}
+	return s
+}
+
+// collect gets the module's information and adds it to the Snapshot.
+func (c *Collector) collect(m Module, s *Snapshot) (err error) {
+	err = c.init(m)
+	if err != nil {
+		return err
+	}
+	switch m {
+	case CPUStats:
+		s.CPUStats, err = c.cpuStats.Get()
+	case CPUUtil:
+		s.CPUUtil, err = c.cpuUtil.Get()
+	case MemInfo:
+		s.MemInfo, err = c.memInfo.Get()
+	case DiskUsage:
+		s.DiskUsage, err = c.diskUsage.Get()
+	case NetUsage:
+		s.NetUsage, err = c.netUsage.Get()
+	case LoadAvg:
+		var la loadavg.LoadAvg
+		la, err = c.loadAvg.Get()
+		if err == nil {
+			s.LoadAvg = &la
+		}
+	case Uptime:
+		var u uptime.Uptime
+		u, err = c.uptime.Get()
+		if err == nil {
+			s.Uptime = &u
+		}
+	}
+	return err
+}
+
+var std *Collector
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns a Snapshot of all of the Modules using the package's global
+// Collector. The collector is lazily instantiated. If the collector doesn't
+// already exist, the usage and utilization information of the first Snapshot
+// will not be useful due to minimal time elapsing between the initial and
+// second snapshots used for their calculations; the results of the first call
+// should be discarded.
+func Get() *Snapshot {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		// all of the Modules are valid so there won't be an error.
+		std, _ = NewCollector(nil)
+	}
+	return std.Get()
+}
+
+// Ticker delivers a Snapshot of the enabled modules at intervals.
+type Ticker struct {
+	*ticker.Ticker[*Snapshot]
+	*Collector
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// The modules' errors are not sent to the error channel; they are part of the
+// Snapshot. Stop the ticker to signal the ticker to stop running. Stopping the
+// ticker does not close the Data channel; call Close to close both the ticker
+// and the data channel.
+func NewTicker(d time.Duration, modules []Module, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, modules, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, modules []Module, opts ...joe.Option) (joe.Tocker, error) {
+	c, err := NewCollector(modules, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// Get can't fail; the modules' errors are part of the Snapshot.
+	get := func() (*Snapshot, error) {
+		return c.Get(), nil
+	}
+	return &Ticker{Ticker: ticker.Start(ctx, d, cfg, get), Collector: c}, nil
+}
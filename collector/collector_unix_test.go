@@ -0,0 +1,185 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+// testFS returns the files needed by all of the modules except uptime.
+func testFS() fstest.MapFS {
+	return fstest.MapFS{
+		"proc/stat": &fstest.MapFile{Data: []byte(`cpu  100 2 30 4000 5 6 7 8 9 10
+cpu0 100 2 30 4000 5 6 7 8 9 10
+ctxt 67890
+btime 1500000000
+processes 4242
+`)},
+		"proc/meminfo": &fstest.MapFile{Data: []byte(`MemTotal:       16312560 kB
+MemFree:         8113436 kB
+`)},
+		"proc/diskstats": &fstest.MapFile{Data: []byte("   8       0 sda 1 2 3 4 5 6 7 8 9 10 11\n")},
+		"proc/net/dev": &fstest.MapFile{Data: []byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+  eth0:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+`)},
+		"proc/loadavg": &fstest.MapFile{Data: []byte("0.24 0.17 0.11 2/71 21496\n")},
+	}
+}
+
+func TestCollector(t *testing.T) {
+	fsys := testFS()
+	c, err := NewCollector(nil, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Modules()) != len(Modules) {
+		t.Errorf("modules: got %v; want %v", c.Modules(), Modules)
+	}
+	s := c.Get()
+	if s.Timestamp == 0 {
+		t.Error("Timestamp: wanted a non-zero value; got 0")
+	}
+	if s.CPUStats == nil || s.CPUStats.Ctxt != 67890 {
+		t.Errorf("CPUStats: got %#v; want ctxt 67890", s.CPUStats)
+	}
+	if s.CPUUtil == nil || len(s.CPUUtil.CPU) != 2 {
+		t.Errorf("CPUUtil: got %#v; want 2 cpu entries", s.CPUUtil)
+	}
+	if s.MemInfo == nil || s.MemInfo.MemTotal != 16312560 {
+		t.Errorf("MemInfo: got %#v; want MemTotal 16312560", s.MemInfo)
+	}
+	if s.DiskUsage == nil || len(s.DiskUsage.Device) != 1 || s.DiskUsage.Device[0].Name != "sda" {
+		t.Errorf("DiskUsage: got %#v; want sda", s.DiskUsage)
+	}
+	if s.NetUsage == nil || len(s.NetUsage.Device) != 1 || s.NetUsage.Device[0].Name != "eth0" {
+		t.Errorf("NetUsage: got %#v; want eth0", s.NetUsage)
+	}
+	if s.LoadAvg == nil || s.LoadAvg.Minute != 0.24 || s.LoadAvg.PID != 21496 {
+		t.Errorf("LoadAvg: got %#v; want 0.24 and pid 21496", s.LoadAvg)
+	}
+	// there isn't a proc/uptime; only uptime should have an error.
+	if s.Uptime != nil {
+		t.Errorf("Uptime: got %#v; want nil", s.Uptime)
+	}
+	if len(s.Errors) != 1 || s.Errors[Uptime] == "" {
+		t.Errorf("Errors: got %v; want an uptime error", s.Errors)
+	}
+
+	// the failed module is retried.
+	fsys["proc/uptime"] = &fstest.MapFile{Data: []byte("3607.44 3219.92\n")}
+	s = c.Get()
+	if len(s.Errors) != 0 {
+		t.Errorf("Errors: got %v; want none", s.Errors)
+	}
+	if s.Uptime == nil || s.Uptime.Total != 3607.44 || s.Uptime.Idle != 3219.92 {
+		t.Errorf("Uptime: got %#v; want 3607.44 3219.92", s.Uptime)
+	}
+}
+
+func TestCollectorModules(t *testing.T) {
+	c, err := NewCollector([]Module{MemInfo, LoadAvg}, joe.WithFS(testFS()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := c.Get()
+	if s.MemInfo == nil || s.LoadAvg == nil {
+		t.Errorf("got MemInfo %v, LoadAvg %v; want both", s.MemInfo, s.LoadAvg)
+	}
+	if s.CPUStats != nil || s.CPUUtil != nil || s.DiskUsage != nil || s.NetUsage != nil || s.Uptime != nil {
+		t.Errorf("got %#v; want only MemInfo and LoadAvg", s)
+	}
+	if len(s.Errors) != 0 {
+		t.Errorf("Errors: got %v; want none", s.Errors)
+	}
+
+	_, err = NewCollector([]Module{MemInfo, "cpufreq"})
+	if !errors.Is(err, ErrUnknownModule) {
+		t.Errorf("unknown module: got %v; want %v", err, ErrUnknownModule)
+	}
+	_, err = NewCollector([]Module{MemInfo, Pressure})
+	if !errors.Is(err, ErrUnknownModule) {
+		t.Errorf("pressure: got %v; want %v", err, ErrUnknownModule)
+	}
+}
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name     string
+		modules  []Module
+		expected []Module
+	}{
+		{"default", nil, []Module{MemInfo, Uptime}},
+		{"in order", []Module{LoadAvg, MemInfo}, []Module{LoadAvg, MemInfo}},
+		{"repeated", []Module{LoadAvg, MemInfo, LoadAvg, LoadAvg}, []Module{LoadAvg, MemInfo}},
+	}
+	supported := []Module{MemInfo, LoadAvg, Uptime}
+	for _, test := range tests {
+		modules, err := Select(test.modules, supported, []Module{MemInfo, Uptime})
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(modules, test.expected) {
+			t.Errorf("%s: got %v; want %v", test.name, modules, test.expected)
+		}
+	}
+	_, err := Select([]Module{MemInfo, CPUUtil}, supported, nil)
+	if !errors.Is(err, ErrUnknownModule) {
+		t.Errorf("unsupported: got %v; want %v", err, ErrUnknownModule)
+	}
+}
+
+func TestGet(t *testing.T) {
+	s := Get()
+	if len(s.Errors) != 0 {
+		t.Errorf("Errors: got %v; want none", s.Errors)
+	}
+	if s.CPUStats == nil || s.CPUUtil == nil || s.MemInfo == nil || s.DiskUsage == nil || s.NetUsage == nil ||
+		s.LoadAvg == nil || s.Uptime == nil {
+		t.Errorf("got %#v; want the information of all of the modules", s)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100*time.Millisecond, []Module{CPUUtil, LoadAvg})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case s, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			if s.CPUUtil == nil || s.LoadAvg == nil || len(s.Errors) != 0 {
+				t.Errorf("ticker: got %#v; want CPUUtil and LoadAvg", s)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
@@ -0,0 +1,286 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package collector handles Flatbuffers based processing of a Snapshot of
+// multiple JoeFriday packages' information. Instead of returning a Go struct,
+// it returns Flatbuffer serialized bytes. A function to deserialize the
+// Flatbuffer serialized bytes into a collector.Snapshot struct is provided.
+// Each module's information is serialized, as a nested Flatbuffer, by the
+// module's flat package.
+//
+// Note: the package name is collector and not the final element of the import
+// path (flat).
+package collector
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/collector/flat/structs"
+	cpustats "github.com/hmmftg/joefriday/cpu/cpustats/flat"
+	cpuutil "github.com/hmmftg/joefriday/cpu/cpuutil/flat"
+	diskusage "github.com/hmmftg/joefriday/disk/diskusage/flat"
+	meminfo "github.com/hmmftg/joefriday/mem/meminfo/flat"
+	netusage "github.com/hmmftg/joefriday/net/netusage/flat"
+	loadavg "github.com/hmmftg/joefriday/system/loadavg/flat"
+	uptime "github.com/hmmftg/joefriday/system/uptime/flat"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Collector is used to process a Snapshot of the enabled modules as
+// Flatbuffer serialized bytes.
+type Collector struct {
+	*collector.Collector
+	*Serializer
+}
+
+// NewCollector returns a Collector for the modules; if no modules are
+// provided, all of the modules are collected. See collector.NewCollector for
+// more information.
+func NewCollector(modules []collector.Module, opts ...joe.Option) (*Collector, error) {
+	c, err := collector.NewCollector(modules, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Collector{Collector: c, Serializer: NewSerializer()}, nil
+}
+
+// Get returns a Snapshot of the enabled modules' current information as
+// Flatbuffer serialized bytes.
+func (c *Collector) Get() []byte {
+	return c.Serialize(c.Collector.Get())
+}
+
+var std *Collector
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns a Snapshot of all of the modules as Flatbuffer serialized bytes
+// using the package's global Collector. The collector is lazily instantiated.
+// If the collector doesn't already exist, the usage and utilization
+// information of the first Snapshot will not be useful; the results of the
+// first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewCollector(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get(), nil
+}
+
+// Serializer serializes collector.Snapshot using Flatbuffers. Serializing a
+// Snapshot doesn't require a Collector; each module is serialized with the
+// Builder of its flat package's Profiler.
+type Serializer struct {
+	*fb.Builder
+	cpuStats  *cpustats.Profiler
+	cpuUtil   *cpuutil.Profiler
+	memInfo   *meminfo.Profiler
+	diskUsage *diskusage.Profiler
+	netUsage  *netusage.Profiler
+	loadAvg   *loadavg.Profiler
+	uptime    *uptime.Profiler
+	errs      []fb.UOffsetT
+	modules   []string
+}
+
+// NewSerializer returns an initialized Serializer; ready to use.
+func NewSerializer() *Serializer {
+	return &Serializer{
+		Builder:   fb.NewBuilder(0),
+		cpuStats:  &cpustats.Profiler{Builder: fb.NewBuilder(0)},
+		cpuUtil:   &cpuutil.Profiler{Builder: fb.NewBuilder(0)},
+		memInfo:   &meminfo.Profiler{Builder: fb.NewBuilder(0)},
+		diskUsage: &diskusage.Profiler{Builder: fb.NewBuilder(0)},
+		netUsage:  &netusage.Profiler{Builder: fb.NewBuilder(0)},
+		loadAvg:   &loadavg.Profiler{Builder: fb.NewBuilder(0)},
+		uptime:    &uptime.Profiler{Builder: fb.NewBuilder(0)},
+	}
+}
+
+// Serialize serializes collector.Snapshot using Flatbuffers.
+func (ser *Serializer) Serialize(s *collector.Snapshot) []byte {
+	// ensure the Builder is in a usable state.
+	ser.Builder.Reset()
+	var cpuStats, cpuUtil, memInfo, diskUsage, netUsage, loadAvg, uptime, errs fb.UOffsetT
+	if s.CPUStats != nil {
+		cpuStats = ser.Builder.CreateByteVector(ser.cpuStats.Serialize(s.CPUStats))
+	}
+	if s.CPUUtil != nil {
+		cpuUtil = ser.Builder.CreateByteVector(ser.cpuUtil.Serialize(s.CPUUtil))
+	}
+	if s.MemInfo != nil {
+		memInfo = ser.Builder.CreateByteVector(ser.memInfo.Serialize(s.MemInfo))
+	}
+	if s.DiskUsage != nil {
+		diskUsage = ser.Builder.CreateByteVector(ser.diskUsage.Serialize(s.DiskUsage))
+	}
+	if s.NetUsage != nil {
+		netUsage = ser.Builder.CreateByteVector(ser.netUsage.Serialize(s.NetUsage))
+	}
+	if s.LoadAvg != nil {
+		loadAvg = ser.Builder.CreateByteVector(ser.loadAvg.Serialize(*s.LoadAvg))
+	}
+	if s.Uptime != nil {
+		uptime = ser.Builder.CreateByteVector(ser.uptime.Serialize(*s.Uptime))
+	}
+	if len(s.Errors) > 0 {
+		// the errors are serialized in module name order.
+		ser.modules = ser.modules[:0]
+		for m := range s.Errors {
+			ser.modules = append(ser.modules, string(m))
+		}
+		sort.Strings(ser.modules)
+		ser.errs = ser.errs[:0]
+		for _, m := range ser.modules {
+			mod := ser.Builder.CreateString(m)
+			msg := ser.Builder.CreateString(s.Errors[collector.Module(m)])
+			structs.ModuleErrorStart(ser.Builder)
+			structs.ModuleErrorAddModule(ser.Builder, mod)
+			structs.ModuleErrorAddErr(ser.Builder, msg)
+			ser.errs = append(ser.errs, structs.ModuleErrorEnd(ser.Builder))
+		}
+		structs.SnapshotStartErrorsVector(ser.Builder, len(ser.errs))
+		for i := len(ser.errs) - 1; i >= 0; i-- {
+			ser.Builder.PrependUOffsetT(ser.errs[i])
+		}
+		errs = ser.Builder.EndVector(len(ser.errs))
+	}
+	structs.SnapshotStart(ser.Builder)
+	structs.SnapshotAddTimestamp(ser.Builder, s.Timestamp)
+	if s.CPUStats != nil {
+		structs.SnapshotAddCPUStats(ser.Builder, cpuStats)
+	}
+	if s.CPUUtil != nil {
+		structs.SnapshotAddCPUUtil(ser.Builder, cpuUtil)
+	}
+	if s.MemInfo != nil {
+		structs.SnapshotAddMemInfo(ser.Builder, memInfo)
+	}
+	if s.DiskUsage != nil {
+		structs.SnapshotAddDiskUsage(ser.Builder, diskUsage)
+	}
+	if s.NetUsage != nil {
+		structs.SnapshotAddNetUsage(ser.Builder, netUsage)
+	}
+	if s.LoadAvg != nil {
+		structs.SnapshotAddLoadAvg(ser.Builder, loadAvg)
+	}
+	if s.Uptime != nil {
+		structs.SnapshotAddUptime(ser.Builder, uptime)
+	}
+	if len(s.Errors) > 0 {
+		structs.SnapshotAddErrors(ser.Builder, errs)
+	}
+	ser.Builder.Finish(structs.SnapshotEnd(ser.Builder))
+	p := ser.Builder.Bytes[ser.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+var stdSer *Serializer
+var stdSerMu sync.Mutex
+
+// Serialize serializes collector.Snapshot using Flatbuffers with the package's
+// global Serializer.
+func Serialize(s *collector.Snapshot) []byte {
+	stdSerMu.Lock()
+	defer stdSerMu.Unlock()
+	if stdSer == nil {
+		stdSer = NewSerializer()
+	}
+	return stdSer.Serialize(s)
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them
+// as collector.Snapshot.
+func Deserialize(p []byte) *collector.Snapshot {
+	flatS := structs.GetRootAsSnapshot(p, 0)
+	s := &collector.Snapshot{Timestamp: flatS.Timestamp()}
+	if b := flatS.CPUStatsBytes(); b != nil {
+		s.CPUStats = cpustats.Deserialize(b)
+	}
+	if b := flatS.CPUUtilBytes(); b != nil {
+		s.CPUUtil = cpuutil.Deserialize(b)
+	}
+	if b := flatS.MemInfoBytes(); b != nil {
+		s.MemInfo = meminfo.Deserialize(b)
+	}
+	if b := flatS.DiskUsageBytes(); b != nil {
+		s.DiskUsage = diskusage.Deserialize(b)
+	}
+	if b := flatS.NetUsageBytes(); b != nil {
+		s.NetUsage = netusage.Deserialize(b)
+	}
+	if b := flatS.LoadAvgBytes(); b != nil {
+		la := loadavg.Deserialize(b)
+		s.LoadAvg = &la
+	}
+	if b := flatS.UptimeBytes(); b != nil {
+		up := uptime.Deserialize(b)
+		s.Uptime = &up
+	}
+	l := flatS.ErrorsLength()
+	if l > 0 {
+		s.Errors = make(map[collector.Module]string, l)
+		flatErr := &structs.ModuleError{}
+		for i := 0; i < l; i++ {
+			if !flatS.Errors(flatErr, i) {
+				continue
+			}
+			s.Errors[collector.Module(flatErr.Module())] = string(flatErr.Err())
+		}
+	}
+	return s
+}
+
+// Ticker delivers a Snapshot of the enabled modules at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Collector
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// The modules' errors are not sent to the error channel; they are part of the
+// Snapshot. Stop the ticker to signal the ticker to stop running. Stopping the
+// ticker does not close the Data channel; call Close to close both the ticker
+// and the data channel.
+func NewTicker(d time.Duration, modules []collector.Module, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, modules, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, modules []collector.Module, opts ...joe.Option) (joe.Tocker, error) {
+	c, err := NewCollector(modules, opts...)
+	if err != nil {
+		return nil, err
+	}
+	// Get can't fail; the modules' errors are part of the Snapshot.
+	get := func() ([]byte, error) {
+		return c.Get(), nil
+	}
+	return &Ticker{Ticker: ticker.Start(ctx, d, cfg, get), Collector: c}, nil
+}
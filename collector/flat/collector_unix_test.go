@@ -0,0 +1,123 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/cpu/cpuutil"
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/uptime"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	tests := []struct {
+		name string
+		s    *collector.Snapshot
+	}{
+		{
+			"all", &collector.Snapshot{
+				Timestamp: 1000,
+				CPUStats:  &cpustats.CPUStats{ClkTck: 100, Timestamp: 1000, Ctxt: 42, BTime: 7, Processes: 9, CPU: []cpustats.CPU{{ID: "cpu", User: 10, Idle: 20}}},
+				CPUUtil:   &cpuutil.CPUUtil{Timestamp: 1000, TimeDelta: 100, Processes: 9, CPU: []cpuutil.Utilization{{ID: "cpu", Usage: 12.5, User: 10, Idle: 87.5}}},
+				MemInfo:   &meminfo.Info{Timestamp: 1000, MemTotal: 16312560, MemFree: 8113436},
+				DiskUsage: &dstructs.DiskUsage{
+					Timestamp: 1000, TimeDelta: 100,
+					Device:  []dstructs.Device{{Major: 8, Name: "sda", ReadsCompleted: 1}},
+					Rates:   []dstructs.DeviceRates{{Name: "sda", ReadsPerSec: 10}},
+					Added:   []string{"sda"},
+					Removed: []string{"sdb"},
+				},
+				NetUsage: &nstructs.DevUsage{
+					Timestamp: 1000, TimeDelta: 100,
+					Device:  []nstructs.Device{{Name: "eth0", RBytes: 1000}},
+					Rates:   []nstructs.DeviceRates{{Name: "eth0", RBytesPerSec: 10000}},
+					Added:   []string{"eth0"},
+					Removed: []string{"eth1"},
+				},
+				LoadAvg: &loadavg.LoadAvg{Timestamp: 1000, Minute: 0.24, Five: 0.17, Fifteen: 0.11, Running: 2, Total: 71, PID: 21496},
+				Uptime:  &uptime.Uptime{Timestamp: 1000, Total: 3607.44, Idle: 3219.92},
+			},
+		},
+		{
+			"errors", &collector.Snapshot{
+				Timestamp: 1000,
+				MemInfo:   &meminfo.Info{Timestamp: 1000, MemTotal: 16312560},
+				Errors: map[collector.Module]string{
+					collector.Uptime:   "open /proc/uptime: no such file or directory",
+					collector.CPUStats: "open /proc/stat: no such file or directory",
+				},
+			},
+		},
+		{"empty", &collector.Snapshot{Timestamp: 1000}},
+	}
+	for _, test := range tests {
+		p := Serialize(test.s)
+		s := Deserialize(p)
+		if !reflect.DeepEqual(s, test.s) {
+			t.Errorf("%s: got %#v; want %#v", test.name, s, test.s)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	c, err := NewCollector([]collector.Module{collector.MemInfo, collector.LoadAvg, collector.Uptime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := Deserialize(c.Get())
+	if len(s.Errors) != 0 {
+		t.Errorf("Errors: got %v; want none", s.Errors)
+	}
+	if s.MemInfo == nil || s.LoadAvg == nil || s.Uptime == nil {
+		t.Errorf("got %#v; want MemInfo, LoadAvg, and Uptime", s)
+	}
+	if s.CPUStats != nil || s.CPUUtil != nil || s.DiskUsage != nil || s.NetUsage != nil {
+		t.Errorf("got %#v; want only MemInfo, LoadAvg, and Uptime", s)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100*time.Millisecond, []collector.Module{collector.CPUUtil, collector.NetUsage})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			s := Deserialize(v)
+			if s.CPUUtil == nil || s.NetUsage == nil || len(s.Errors) != 0 {
+				t.Errorf("ticker: got %#v; want CPUUtil and NetUsage", s)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
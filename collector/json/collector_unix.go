@@ -0,0 +1,138 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package collector handles JSON based processing of a Snapshot of multiple
+// JoeFriday packages' information. Instead of returning a Go struct, it
+// returns JSON serialized bytes. A function to deserialize the JSON
+// serialized bytes into a collector.Snapshot struct is provided.
+//
+// Note: the package name is collector and not the final element of the import
+// path (json).
+package collector
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Collector is used to process a Snapshot of the enabled modules as JSON
+// serialized bytes.
+type Collector struct {
+	*collector.Collector
+}
+
+// NewCollector returns a Collector for the modules; if no modules are
+// provided, all of the modules are collected. See collector.NewCollector for
+// more information.
+func NewCollector(modules []collector.Module, opts ...joe.Option) (*Collector, error) {
+	c, err := collector.NewCollector(modules, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Collector{Collector: c}, nil
+}
+
+// Get returns a Snapshot of the enabled modules' current information as JSON
+// serialized bytes.
+func (c *Collector) Get() (p []byte, err error) {
+	return c.Serialize(c.Collector.Get())
+}
+
+var std *Collector
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns a Snapshot of all of the modules as JSON serialized bytes using
+// the package's global Collector. The collector is lazily instantiated. If the
+// collector doesn't already exist, the usage and utilization information of
+// the first Snapshot will not be useful; the results of the first call should
+// be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewCollector(nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize collector.Snapshot using JSON.
+func (c *Collector) Serialize(s *collector.Snapshot) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// Serialize collector.Snapshot using JSON.
+func Serialize(s *collector.Snapshot) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// Marshal is an alias for Serialize.
+func (c *Collector) Marshal(s *collector.Snapshot) ([]byte, error) {
+	return c.Serialize(s)
+}
+
+// Marshal is an alias for Serialize.
+func Marshal(s *collector.Snapshot) ([]byte, error) {
+	return Serialize(s)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// collector.Snapshot.
+func Deserialize(p []byte) (*collector.Snapshot, error) {
+	s := &collector.Snapshot{}
+	err := json.Unmarshal(p, s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*collector.Snapshot, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers a Snapshot of the enabled modules at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Collector
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// The modules' errors are not sent to the error channel; they are part of the
+// Snapshot. Stop the ticker to signal the ticker to stop running. Stopping the
+// ticker does not close the Data channel; call Close to close both the ticker
+// and the data channel.
+func NewTicker(d time.Duration, modules []collector.Module, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, modules, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, modules []collector.Module, opts ...joe.Option) (joe.Tocker, error) {
+	c, err := NewCollector(modules, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Ticker{Ticker: ticker.Start(ctx, d, cfg, c.Get), Collector: c}, nil
+}
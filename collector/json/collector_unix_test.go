@@ -0,0 +1,97 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	"github.com/hmmftg/joefriday/system/loadavg"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	s := &collector.Snapshot{
+		Timestamp: 1000,
+		CPUStats:  &cpustats.CPUStats{ClkTck: 100, Timestamp: 1000, Ctxt: 42, CPU: []cpustats.CPU{{ID: "cpu", User: 10}}},
+		MemInfo:   &meminfo.Info{Timestamp: 1000, MemTotal: 16312560, MemFree: 8113436},
+		LoadAvg:   &loadavg.LoadAvg{Timestamp: 1000, Minute: 0.24, Running: 2, Total: 71, PID: 21496},
+		Errors:    map[collector.Module]string{collector.Uptime: "open /proc/uptime: no such file or directory"},
+	}
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sD, err := Deserialize(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(sD, s) {
+		t.Errorf("got %#v; want %#v", sD, s)
+	}
+}
+
+func TestGet(t *testing.T) {
+	// the first Snapshot's utilization information isn't useful; discard it.
+	Get()
+	time.Sleep(100 * time.Millisecond)
+	p, err := Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	s, err := Deserialize(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(s.Errors) != 0 {
+		t.Errorf("Errors: got %v; want none", s.Errors)
+	}
+	if s.CPUStats == nil || s.MemInfo == nil || s.Uptime == nil {
+		t.Errorf("got %#v; want the information of all of the modules", s)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100*time.Millisecond, []collector.Module{collector.MemInfo})
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			s, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			if s.MemInfo == nil || len(s.Errors) != 0 {
+				t.Errorf("ticker: got %#v; want MemInfo", s)
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
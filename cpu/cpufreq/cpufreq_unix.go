@@ -16,12 +16,14 @@
 package cpufreq
 
 import (
+	"context"
 	"io"
 	"strconv"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -53,8 +55,9 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
@@ -268,8 +271,7 @@ func Get() (f *Frequency, err error) {
 
 // Ticker delivers the CPU Frequencies at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *Frequency
+	*ticker.Ticker[*Frequency]
 	*Profiler
 	Sockets uint8
 }
@@ -279,36 +281,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *Frequency), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*Frequency, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			s, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- s
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -0,0 +1,186 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpufreq provides the current CPU frequency, in MHz, as reported by
+// /proc/cpuinfo. Instead of returning a Go struct, it returns protobuf
+// serialized bytes. A function to deserialize the protobuf serialized bytes
+// into a cpufreq.Frequency struct is provided.
+//
+// Note: the package name is cpufreq and not the final element of the import
+// path (pb).
+package cpufreq
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	freq "github.com/hmmftg/joefriday/cpu/cpufreq"
+	"github.com/hmmftg/joefriday/cpu/cpufreq/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the frequency information as protobuf serialized
+// bytes.
+type Profiler struct {
+	*freq.Profiler
+}
+
+// Initializes and returns a cpufreq profiler.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := freq.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the frequency as protobuf serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	f, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(f)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent data race on checking/instantiation
+
+// Get returns the frequency as protobuf serialized bytes using the package's
+// global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize Frequency as protobuf.
+func (prof *Profiler) Serialize(f *freq.Frequency) ([]byte, error) {
+	return proto.Marshal(toProto(f))
+}
+
+// Serialize Frequency as protobuf using package globals.
+func Serialize(f *freq.Frequency) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(f)
+}
+
+// Marshal is an alias for serialize.
+func (prof *Profiler) Marshal(f *freq.Frequency) ([]byte, error) {
+	return prof.Serialize(f)
+}
+
+// Marshal is an alias for Serialize using package globals.
+func Marshal(f *freq.Frequency) ([]byte, error) {
+	return std.Serialize(f)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// cpufreq.Frequency.
+func Deserialize(p []byte) (*freq.Frequency, error) {
+	m := &structs.Frequency{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize using package globals.
+func Unmarshal(p []byte) (*freq.Frequency, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a cpufreq.Frequency into a new structs.Frequency message.
+func toProto(f *freq.Frequency) *structs.Frequency {
+	m := &structs.Frequency{
+		Timestamp: f.Timestamp,
+		Sockets:   f.Sockets,
+		Cpu:       make([]*structs.CPU, len(f.CPU)),
+	}
+	for i, c := range f.CPU {
+		m.Cpu[i] = &structs.CPU{
+			Processor:  c.Processor,
+			CpuMhz:     c.CPUMHz,
+			PhysicalId: c.PhysicalID,
+			CoreId:     c.CoreID,
+			ApicId:     c.APICID,
+		}
+	}
+	return m
+}
+
+// fromProto copies a structs.Frequency message into a cpufreq.Frequency.
+func fromProto(m *structs.Frequency) *freq.Frequency {
+	f := &freq.Frequency{
+		Timestamp: m.Timestamp,
+		Sockets:   m.Sockets,
+		CPU:       make([]freq.CPU, len(m.Cpu)),
+	}
+	for i, c := range m.Cpu {
+		f.CPU[i] = freq.CPU{
+			Processor:  c.Processor,
+			CPUMHz:     c.CpuMhz,
+			PhysicalID: c.PhysicalId,
+			CoreID:     c.CoreId,
+			APICID:     c.ApicId,
+		}
+	}
+	return f
+}
+
+// Ticker delivers the CPU Frequencies at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,239 @@
+// frequency.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: cpu/cpufreq/pb/frequency.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Frequency struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sockets       int32                  `protobuf:"varint,2,opt,name=sockets,proto3" json:"sockets,omitempty"`
+	Cpu           []*CPU                 `protobuf:"bytes,3,rep,name=cpu,proto3" json:"cpu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Frequency) Reset() {
+	*x = Frequency{}
+	mi := &file_cpu_cpufreq_pb_frequency_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Frequency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Frequency) ProtoMessage() {}
+
+func (x *Frequency) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpufreq_pb_frequency_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Frequency.ProtoReflect.Descriptor instead.
+func (*Frequency) Descriptor() ([]byte, []int) {
+	return file_cpu_cpufreq_pb_frequency_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Frequency) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Frequency) GetSockets() int32 {
+	if x != nil {
+		return x.Sockets
+	}
+	return 0
+}
+
+func (x *Frequency) GetCpu() []*CPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+type CPU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Processor     int32                  `protobuf:"varint,1,opt,name=processor,proto3" json:"processor,omitempty"`
+	CpuMhz        float32                `protobuf:"fixed32,2,opt,name=cpu_mhz,json=cpuMhz,proto3" json:"cpu_mhz,omitempty"`
+	PhysicalId    int32                  `protobuf:"varint,3,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	CoreId        int32                  `protobuf:"varint,4,opt,name=core_id,json=coreId,proto3" json:"core_id,omitempty"`
+	ApicId        int32                  `protobuf:"varint,5,opt,name=apic_id,json=apicId,proto3" json:"apic_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPU) Reset() {
+	*x = CPU{}
+	mi := &file_cpu_cpufreq_pb_frequency_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPU) ProtoMessage() {}
+
+func (x *CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpufreq_pb_frequency_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPU.ProtoReflect.Descriptor instead.
+func (*CPU) Descriptor() ([]byte, []int) {
+	return file_cpu_cpufreq_pb_frequency_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CPU) GetProcessor() int32 {
+	if x != nil {
+		return x.Processor
+	}
+	return 0
+}
+
+func (x *CPU) GetCpuMhz() float32 {
+	if x != nil {
+		return x.CpuMhz
+	}
+	return 0
+}
+
+func (x *CPU) GetPhysicalId() int32 {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return 0
+}
+
+func (x *CPU) GetCoreId() int32 {
+	if x != nil {
+		return x.CoreId
+	}
+	return 0
+}
+
+func (x *CPU) GetApicId() int32 {
+	if x != nil {
+		return x.ApicId
+	}
+	return 0
+}
+
+var File_cpu_cpufreq_pb_frequency_proto protoreflect.FileDescriptor
+
+var file_cpu_cpufreq_pb_frequency_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x66, 0x72, 0x65, 0x71, 0x2f, 0x70, 0x62,
+	0x2f, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x11, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x66,
+	0x72, 0x65, 0x71, 0x22, 0x6d, 0x0a, 0x09, 0x46, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61,
+	0x79, 0x2e, 0x63, 0x70, 0x75, 0x66, 0x72, 0x65, 0x71, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63,
+	0x70, 0x75, 0x22, 0x8f, 0x01, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x70, 0x75, 0x5f,
+	0x6d, 0x68, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x63, 0x70, 0x75, 0x4d, 0x68,
+	0x7a, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c,
+	0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x61,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x70,
+	0x69, 0x63, 0x49, 0x64, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69,
+	0x64, 0x61, 0x79, 0x2f, 0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x66, 0x72, 0x65, 0x71, 0x2f,
+	0x70, 0x62, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_cpu_cpufreq_pb_frequency_proto_rawDescOnce sync.Once
+	file_cpu_cpufreq_pb_frequency_proto_rawDescData []byte
+)
+
+func file_cpu_cpufreq_pb_frequency_proto_rawDescGZIP() []byte {
+	file_cpu_cpufreq_pb_frequency_proto_rawDescOnce.Do(func() {
+		file_cpu_cpufreq_pb_frequency_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cpu_cpufreq_pb_frequency_proto_rawDesc), len(file_cpu_cpufreq_pb_frequency_proto_rawDesc)))
+	})
+	return file_cpu_cpufreq_pb_frequency_proto_rawDescData
+}
+
+var file_cpu_cpufreq_pb_frequency_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cpu_cpufreq_pb_frequency_proto_goTypes = []any{
+	(*Frequency)(nil), // 0: joefriday.cpufreq.Frequency
+	(*CPU)(nil),       // 1: joefriday.cpufreq.CPU
+}
+var file_cpu_cpufreq_pb_frequency_proto_depIdxs = []int32{
+	1, // 0: joefriday.cpufreq.Frequency.cpu:type_name -> joefriday.cpufreq.CPU
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cpu_cpufreq_pb_frequency_proto_init() }
+func file_cpu_cpufreq_pb_frequency_proto_init() {
+	if File_cpu_cpufreq_pb_frequency_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cpu_cpufreq_pb_frequency_proto_rawDesc), len(file_cpu_cpufreq_pb_frequency_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cpu_cpufreq_pb_frequency_proto_goTypes,
+		DependencyIndexes: file_cpu_cpufreq_pb_frequency_proto_depIdxs,
+		MessageInfos:      file_cpu_cpufreq_pb_frequency_proto_msgTypes,
+	}.Build()
+	File_cpu_cpufreq_pb_frequency_proto = out.File
+	file_cpu_cpufreq_pb_frequency_proto_goTypes = nil
+	file_cpu_cpufreq_pb_frequency_proto_depIdxs = nil
+}
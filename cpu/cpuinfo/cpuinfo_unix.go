@@ -74,8 +74,9 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
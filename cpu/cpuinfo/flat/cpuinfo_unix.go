@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	info "github.com/hmmftg/joefriday/cpu/cpuinfo"
 	"github.com/hmmftg/joefriday/cpu/cpuinfo/flat/structs"
 )
@@ -36,8 +37,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpuinfo profiler.
-func NewProfiler() (p *Profiler, err error) {
-	prof, err := info.NewProfiler()
+func NewProfiler(opts ...joe.Option) (p *Profiler, err error) {
+	prof, err := info.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	info "github.com/hmmftg/joefriday/cpu/cpuinfo"
 )
 
@@ -33,8 +34,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpuinfo profiler.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := info.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := info.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,197 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpuinfo (pb) handles protobuf based processing of /proc/cpuinfo.
+// Instead of returning a Go struct, it returns protobuf serialized bytes. A
+// function to deserialize the protobuf serialized bytes into a cpuinfo.CPUInfo
+// struct is provided.
+//
+// Note: the package name is cpuinfo and not the final element of the import
+// path (pb).
+package cpuinfo
+
+import (
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	info "github.com/hmmftg/joefriday/cpu/cpuinfo"
+	"github.com/hmmftg/joefriday/cpu/cpuinfo/pb/structs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the /proc/cpuinfo file as protobuf serialized
+// bytes.
+type Profiler struct {
+	*info.Profiler
+}
+
+// Initializes and returns a cpuinfo profiler.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := info.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current cpuinfo as protobuf serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent data race on checking/instantiation
+
+// Get returns the current cpuinfo as protobuf serialized bytes using the
+// package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize cpuinfo as protobuf.
+func (prof *Profiler) Serialize(inf *info.CPUInfo) ([]byte, error) {
+	return proto.Marshal(toProto(inf))
+}
+
+// Serialize cpuinfo as protobuf using package globals.
+func Serialize(inf *info.CPUInfo) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Marshal is an alias for serialize.
+func (prof *Profiler) Marshal(inf *info.CPUInfo) ([]byte, error) {
+	return prof.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize using package globals.
+func Marshal(inf *info.CPUInfo) ([]byte, error) {
+	return std.Serialize(inf)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// cpuinfo.CPUInfo.
+func Deserialize(p []byte) (*info.CPUInfo, error) {
+	m := &structs.CPUInfo{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize using package globals.
+func Unmarshal(p []byte) (*info.CPUInfo, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a cpuinfo.CPUInfo into a new structs.CPUInfo message.
+func toProto(inf *info.CPUInfo) *structs.CPUInfo {
+	m := &structs.CPUInfo{
+		Timestamp: inf.Timestamp,
+		Sockets:   inf.Sockets,
+		Cpu:       make([]*structs.CPU, len(inf.CPU)),
+	}
+	for i, c := range inf.CPU {
+		m.Cpu[i] = &structs.CPU{
+			Processor:       c.Processor,
+			VendorId:        c.VendorID,
+			CpuFamily:       c.CPUFamily,
+			Model:           c.Model,
+			ModelName:       c.ModelName,
+			Stepping:        c.Stepping,
+			Microcode:       c.Microcode,
+			CpuMhz:          c.CPUMHz,
+			CacheSize:       c.CacheSize,
+			PhysicalId:      c.PhysicalID,
+			Siblings:        int32(c.Siblings),
+			CoreId:          c.CoreID,
+			CpuCores:        c.CPUCores,
+			ApicId:          c.APICID,
+			InitialApicId:   c.InitialAPICID,
+			Fpu:             c.FPU,
+			FpuException:    c.FPUException,
+			CpuidLevel:      c.CPUIDLevel,
+			Wp:              c.WP,
+			Flags:           c.Flags,
+			Bugs:            c.Bugs,
+			Bogomips:        c.BogoMIPS,
+			ClflushSize:     uint32(c.CLFlushSize),
+			CacheAlignment:  uint32(c.CacheAlignment),
+			AddressSizes:    c.AddressSizes,
+			PowerManagement: c.PowerManagement,
+			TlbSize:         c.TLBSize,
+		}
+	}
+	return m
+}
+
+// fromProto copies a structs.CPUInfo message into a cpuinfo.CPUInfo.
+func fromProto(m *structs.CPUInfo) *info.CPUInfo {
+	inf := &info.CPUInfo{
+		Timestamp: m.Timestamp,
+		Sockets:   m.Sockets,
+		CPU:       make([]info.CPU, len(m.Cpu)),
+	}
+	for i, c := range m.Cpu {
+		inf.CPU[i] = info.CPU{
+			Processor:       c.Processor,
+			VendorID:        c.VendorId,
+			CPUFamily:       c.CpuFamily,
+			Model:           c.Model,
+			ModelName:       c.ModelName,
+			Stepping:        c.Stepping,
+			Microcode:       c.Microcode,
+			CPUMHz:          c.CpuMhz,
+			CacheSize:       c.CacheSize,
+			PhysicalID:      c.PhysicalId,
+			Siblings:        int8(c.Siblings),
+			CoreID:          c.CoreId,
+			CPUCores:        c.CpuCores,
+			APICID:          c.ApicId,
+			InitialAPICID:   c.InitialApicId,
+			FPU:             c.Fpu,
+			FPUException:    c.FpuException,
+			CPUIDLevel:      c.CpuidLevel,
+			WP:              c.Wp,
+			Flags:           c.Flags,
+			BogoMIPS:        c.Bogomips,
+			Bugs:            c.Bugs,
+			CLFlushSize:     uint16(c.ClflushSize),
+			CacheAlignment:  uint16(c.CacheAlignment),
+			AddressSizes:    c.AddressSizes,
+			PowerManagement: c.PowerManagement,
+			TLBSize:         c.TlbSize,
+		}
+	}
+	return inf
+}
@@ -0,0 +1,456 @@
+// cpuinfo.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: cpu/cpuinfo/pb/cpuinfo.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CPUInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Sockets       int32                  `protobuf:"varint,2,opt,name=sockets,proto3" json:"sockets,omitempty"`
+	Cpu           []*CPU                 `protobuf:"bytes,3,rep,name=cpu,proto3" json:"cpu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUInfo) Reset() {
+	*x = CPUInfo{}
+	mi := &file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUInfo) ProtoMessage() {}
+
+func (x *CPUInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUInfo.ProtoReflect.Descriptor instead.
+func (*CPUInfo) Descriptor() ([]byte, []int) {
+	return file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CPUInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CPUInfo) GetSockets() int32 {
+	if x != nil {
+		return x.Sockets
+	}
+	return 0
+}
+
+func (x *CPUInfo) GetCpu() []*CPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+type CPU struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Processor       int32                  `protobuf:"varint,1,opt,name=processor,proto3" json:"processor,omitempty"`
+	VendorId        string                 `protobuf:"bytes,2,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	CpuFamily       string                 `protobuf:"bytes,3,opt,name=cpu_family,json=cpuFamily,proto3" json:"cpu_family,omitempty"`
+	Model           string                 `protobuf:"bytes,4,opt,name=model,proto3" json:"model,omitempty"`
+	ModelName       string                 `protobuf:"bytes,5,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	Stepping        string                 `protobuf:"bytes,6,opt,name=stepping,proto3" json:"stepping,omitempty"`
+	Microcode       string                 `protobuf:"bytes,7,opt,name=microcode,proto3" json:"microcode,omitempty"`
+	CpuMhz          float32                `protobuf:"fixed32,8,opt,name=cpu_mhz,json=cpuMhz,proto3" json:"cpu_mhz,omitempty"`
+	CacheSize       string                 `protobuf:"bytes,9,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	PhysicalId      int32                  `protobuf:"varint,10,opt,name=physical_id,json=physicalId,proto3" json:"physical_id,omitempty"`
+	Siblings        int32                  `protobuf:"varint,11,opt,name=siblings,proto3" json:"siblings,omitempty"`
+	CoreId          int32                  `protobuf:"varint,12,opt,name=core_id,json=coreId,proto3" json:"core_id,omitempty"`
+	CpuCores        int32                  `protobuf:"varint,13,opt,name=cpu_cores,json=cpuCores,proto3" json:"cpu_cores,omitempty"`
+	ApicId          int32                  `protobuf:"varint,14,opt,name=apic_id,json=apicId,proto3" json:"apic_id,omitempty"`
+	InitialApicId   int32                  `protobuf:"varint,15,opt,name=initial_apic_id,json=initialApicId,proto3" json:"initial_apic_id,omitempty"`
+	Fpu             string                 `protobuf:"bytes,16,opt,name=fpu,proto3" json:"fpu,omitempty"`
+	FpuException    string                 `protobuf:"bytes,17,opt,name=fpu_exception,json=fpuException,proto3" json:"fpu_exception,omitempty"`
+	CpuidLevel      string                 `protobuf:"bytes,18,opt,name=cpuid_level,json=cpuidLevel,proto3" json:"cpuid_level,omitempty"`
+	Wp              string                 `protobuf:"bytes,19,opt,name=wp,proto3" json:"wp,omitempty"`
+	Flags           []string               `protobuf:"bytes,20,rep,name=flags,proto3" json:"flags,omitempty"`
+	Bugs            []string               `protobuf:"bytes,21,rep,name=bugs,proto3" json:"bugs,omitempty"`
+	Bogomips        float32                `protobuf:"fixed32,22,opt,name=bogomips,proto3" json:"bogomips,omitempty"`
+	ClflushSize     uint32                 `protobuf:"varint,23,opt,name=clflush_size,json=clflushSize,proto3" json:"clflush_size,omitempty"`
+	CacheAlignment  uint32                 `protobuf:"varint,24,opt,name=cache_alignment,json=cacheAlignment,proto3" json:"cache_alignment,omitempty"`
+	AddressSizes    []string               `protobuf:"bytes,25,rep,name=address_sizes,json=addressSizes,proto3" json:"address_sizes,omitempty"`
+	PowerManagement []string               `protobuf:"bytes,26,rep,name=power_management,json=powerManagement,proto3" json:"power_management,omitempty"`
+	TlbSize         string                 `protobuf:"bytes,27,opt,name=tlb_size,json=tlbSize,proto3" json:"tlb_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CPU) Reset() {
+	*x = CPU{}
+	mi := &file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPU) ProtoMessage() {}
+
+func (x *CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPU.ProtoReflect.Descriptor instead.
+func (*CPU) Descriptor() ([]byte, []int) {
+	return file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CPU) GetProcessor() int32 {
+	if x != nil {
+		return x.Processor
+	}
+	return 0
+}
+
+func (x *CPU) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *CPU) GetCpuFamily() string {
+	if x != nil {
+		return x.CpuFamily
+	}
+	return ""
+}
+
+func (x *CPU) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *CPU) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *CPU) GetStepping() string {
+	if x != nil {
+		return x.Stepping
+	}
+	return ""
+}
+
+func (x *CPU) GetMicrocode() string {
+	if x != nil {
+		return x.Microcode
+	}
+	return ""
+}
+
+func (x *CPU) GetCpuMhz() float32 {
+	if x != nil {
+		return x.CpuMhz
+	}
+	return 0
+}
+
+func (x *CPU) GetCacheSize() string {
+	if x != nil {
+		return x.CacheSize
+	}
+	return ""
+}
+
+func (x *CPU) GetPhysicalId() int32 {
+	if x != nil {
+		return x.PhysicalId
+	}
+	return 0
+}
+
+func (x *CPU) GetSiblings() int32 {
+	if x != nil {
+		return x.Siblings
+	}
+	return 0
+}
+
+func (x *CPU) GetCoreId() int32 {
+	if x != nil {
+		return x.CoreId
+	}
+	return 0
+}
+
+func (x *CPU) GetCpuCores() int32 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *CPU) GetApicId() int32 {
+	if x != nil {
+		return x.ApicId
+	}
+	return 0
+}
+
+func (x *CPU) GetInitialApicId() int32 {
+	if x != nil {
+		return x.InitialApicId
+	}
+	return 0
+}
+
+func (x *CPU) GetFpu() string {
+	if x != nil {
+		return x.Fpu
+	}
+	return ""
+}
+
+func (x *CPU) GetFpuException() string {
+	if x != nil {
+		return x.FpuException
+	}
+	return ""
+}
+
+func (x *CPU) GetCpuidLevel() string {
+	if x != nil {
+		return x.CpuidLevel
+	}
+	return ""
+}
+
+func (x *CPU) GetWp() string {
+	if x != nil {
+		return x.Wp
+	}
+	return ""
+}
+
+func (x *CPU) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *CPU) GetBugs() []string {
+	if x != nil {
+		return x.Bugs
+	}
+	return nil
+}
+
+func (x *CPU) GetBogomips() float32 {
+	if x != nil {
+		return x.Bogomips
+	}
+	return 0
+}
+
+func (x *CPU) GetClflushSize() uint32 {
+	if x != nil {
+		return x.ClflushSize
+	}
+	return 0
+}
+
+func (x *CPU) GetCacheAlignment() uint32 {
+	if x != nil {
+		return x.CacheAlignment
+	}
+	return 0
+}
+
+func (x *CPU) GetAddressSizes() []string {
+	if x != nil {
+		return x.AddressSizes
+	}
+	return nil
+}
+
+func (x *CPU) GetPowerManagement() []string {
+	if x != nil {
+		return x.PowerManagement
+	}
+	return nil
+}
+
+func (x *CPU) GetTlbSize() string {
+	if x != nil {
+		return x.TlbSize
+	}
+	return ""
+}
+
+var File_cpu_cpuinfo_pb_cpuinfo_proto protoreflect.FileDescriptor
+
+var file_cpu_cpuinfo_pb_cpuinfo_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x70, 0x62,
+	0x2f, 0x63, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0x6b, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x12, 0x28, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70,
+	0x75, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x22, 0x9f,
+	0x06, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x70, 0x75, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x63, 0x70, 0x75, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x08, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x06, 0x63, 0x70, 0x75, 0x4d, 0x68, 0x7a, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61,
+	0x63, 0x68, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x68, 0x79, 0x73, 0x69,
+	0x63, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x69, 0x62, 0x6c,
+	0x69, 0x6e, 0x67, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6f, 0x72, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x63, 0x6f, 0x72, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x70, 0x75, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x63, 0x70, 0x75, 0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x70,
+	0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x61, 0x70, 0x69,
+	0x63, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x5f, 0x61,
+	0x70, 0x69, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x69, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x41, 0x70, 0x69, 0x63, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66,
+	0x70, 0x75, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x70, 0x75, 0x12, 0x23, 0x0a,
+	0x0d, 0x66, 0x70, 0x75, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x70, 0x75, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x70, 0x75, 0x69, 0x64, 0x5f, 0x6c, 0x65, 0x76, 0x65,
+	0x6c, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x69, 0x64, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x77, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x77, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x14, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x75, 0x67,
+	0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x62, 0x75, 0x67, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x6f, 0x67, 0x6f, 0x6d, 0x69, 0x70, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x62, 0x6f, 0x67, 0x6f, 0x6d, 0x69, 0x70, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6c, 0x66,
+	0x6c, 0x75, 0x73, 0x68, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x63, 0x6c, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x27, 0x0a, 0x0f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x5f, 0x61, 0x6c, 0x69, 0x67, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x18, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x41, 0x6c, 0x69, 0x67,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6c, 0x62, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6c, 0x62, 0x53, 0x69, 0x7a, 0x65,
+	0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f,
+	0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescOnce sync.Once
+	file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescData []byte
+)
+
+func file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescGZIP() []byte {
+	file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescOnce.Do(func() {
+		file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cpu_cpuinfo_pb_cpuinfo_proto_rawDesc), len(file_cpu_cpuinfo_pb_cpuinfo_proto_rawDesc)))
+	})
+	return file_cpu_cpuinfo_pb_cpuinfo_proto_rawDescData
+}
+
+var file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cpu_cpuinfo_pb_cpuinfo_proto_goTypes = []any{
+	(*CPUInfo)(nil), // 0: joefriday.cpuinfo.CPUInfo
+	(*CPU)(nil),     // 1: joefriday.cpuinfo.CPU
+}
+var file_cpu_cpuinfo_pb_cpuinfo_proto_depIdxs = []int32{
+	1, // 0: joefriday.cpuinfo.CPUInfo.cpu:type_name -> joefriday.cpuinfo.CPU
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cpu_cpuinfo_pb_cpuinfo_proto_init() }
+func file_cpu_cpuinfo_pb_cpuinfo_proto_init() {
+	if File_cpu_cpuinfo_pb_cpuinfo_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cpu_cpuinfo_pb_cpuinfo_proto_rawDesc), len(file_cpu_cpuinfo_pb_cpuinfo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cpu_cpuinfo_pb_cpuinfo_proto_goTypes,
+		DependencyIndexes: file_cpu_cpuinfo_pb_cpuinfo_proto_depIdxs,
+		MessageInfos:      file_cpu_cpuinfo_pb_cpuinfo_proto_msgTypes,
+	}.Build()
+	File_cpu_cpuinfo_pb_cpuinfo_proto = out.File
+	file_cpu_cpuinfo_pb_cpuinfo_proto_goTypes = nil
+	file_cpu_cpuinfo_pb_cpuinfo_proto_depIdxs = nil
+}
@@ -14,10 +14,17 @@
 // Package cpustats handles the processing of information about kernel activity,
 // /proc/stat. The first CPUStats.CPU element aggregates the values for all
 // other CPU elements. The values are aggregated since system boot.
+//
+// Along with the per CPU times, the number of interrupts, softirqs, in total
+// and by category, and context switches, the number of forks, and the number
+// of processes that are runnable and that are blocked waiting for I/O are
+// provided.
 package cpustats
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os/exec"
@@ -27,6 +34,7 @@ import (
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -68,6 +76,29 @@ type CPUStats struct {
 	BTime     int64 `json:"btime"`
 	Processes int64 `json:"processes"`
 	CPU       []CPU `json:"cpu"`
+	// the number of interrupts serviced.
+	Intr    int64   `json:"intr"`
+	SoftIRQ SoftIRQ `json:"softirq"`
+	// the number of processes that are runnable.
+	ProcsRunning int64 `json:"procs_running"`
+	// the number of processes that are blocked waiting for I/O.
+	ProcsBlocked int64 `json:"procs_blocked"`
+}
+
+// SoftIRQ holds the number of softirqs serviced, in total and for each
+// category of softirq.
+type SoftIRQ struct {
+	Total   int64 `json:"total"`
+	Hi      int64 `json:"hi"`
+	Timer   int64 `json:"timer"`
+	NetTx   int64 `json:"net_tx"`
+	NetRx   int64 `json:"net_rx"`
+	Block   int64 `json:"block"`
+	IRQPoll int64 `json:"irq_poll"`
+	Tasklet int64 `json:"tasklet"`
+	Sched   int64 `json:"sched"`
+	HRTimer int64 `json:"hrtimer"`
+	RCU     int64 `json:"rcu"`
 }
 
 // CPU holds the stats for a single CPU entry in the /proc/stat file.
@@ -93,7 +124,7 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
 	// if it hasn't been set, set it.
 	if atomic.LoadInt32(&CLK_TCK) == 0 {
 		err = ClkTck()
@@ -101,7 +132,8 @@ func NewProfiler() (prof *Profiler, err error) {
 			return nil, err
 		}
 	}
-	proc, err := joe.NewProc(procFile)
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
@@ -124,7 +156,7 @@ func (prof *Profiler) Get() (stats *CPUStats, err error) {
 		i, j, pos, fieldNum int
 		n                   uint64
 		v                   byte
-		stop                bool
+		stop, full          bool
 	)
 
 	stats = &CPUStats{Timestamp: time.Now().UTC().UnixNano(), ClkTck: prof.ClkTck, CPU: make([]CPU, 0, 2)}
@@ -132,11 +164,17 @@ func (prof *Profiler) Get() (stats *CPUStats, err error) {
 	// read each line until eof
 	for {
 		prof.Line, err = prof.ReadSlice('\n')
+		full = err == bufio.ErrBufferFull
 		if err != nil {
 			if err == io.EOF {
 				break
 			}
-			return nil, &joe.ReadError{Err: err}
+			// The intr line has a count for every interrupt; with enough
+			// interrupts, it doesn't fit in the buffer. Only its total, at
+			// the start of the line, is used.
+			if !full || !bytes.HasPrefix(prof.Line, []byte("intr ")) {
+				return nil, &joe.ReadError{Err: err}
+			}
 		}
 		prof.Val = prof.Val[:0]
 		// Get everything up to the first space, this is the key.  Not all keys are processed.
@@ -147,8 +185,27 @@ func (prof *Profiler) Get() (stats *CPUStats, err error) {
 				break
 			}
 		}
-		// skip the intr line
 		if prof.Val[0] == 'i' {
+			// the total is the first field; the rest are per interrupt.
+			n, err = tools.ParseUint(field(prof.Line[pos:]))
+			if err != nil {
+				return stats, &joe.ParseError{Info: string(prof.Val[:]), Err: err}
+			}
+			stats.Intr = int64(n)
+			for full {
+				_, err = prof.ReadSlice('\n')
+				full = err == bufio.ErrBufferFull
+			}
+			if err != nil && err != io.EOF {
+				return nil, &joe.ReadError{Err: err}
+			}
+			continue
+		}
+		if prof.Val[0] == 's' { // softirq
+			err = parseSoftIRQ(prof.Line[pos:], &stats.SoftIRQ)
+			if err != nil {
+				return stats, &joe.ParseError{Info: string(prof.Val[:]), Err: err}
+			}
 			continue
 		}
 		if prof.Val[0] == 'c' {
@@ -245,10 +302,51 @@ func (prof *Profiler) Get() (stats *CPUStats, err error) {
 			stats.Processes = int64(n)
 			continue
 		}
+		if prof.Val[0] == 'p' && prof.Val[4] == 's' { // procs_running or procs_blocked
+			n, err = tools.ParseUint(prof.Line[pos : len(prof.Line)-1])
+			if err != nil {
+				return stats, &joe.ParseError{Info: string(prof.Val[:]), Err: err}
+			}
+			if prof.Val[6] == 'r' {
+				stats.ProcsRunning = int64(n)
+				continue
+			}
+			stats.ProcsBlocked = int64(n)
+			continue
+		}
 	}
 	return stats, nil
 }
 
+// field returns the first field in b; fields are separated by a space.
+func field(b []byte) []byte {
+	for i, v := range b {
+		if v == 0x20 || v == '\n' {
+			return b[:i]
+		}
+	}
+	return b
+}
+
+// parseSoftIRQ parses the softirq line's values: the total followed by the
+// count for each category, in the order that the kernel enumerates them.
+func parseSoftIRQ(b []byte, s *SoftIRQ) error {
+	vals := [...]*int64{&s.Total, &s.Hi, &s.Timer, &s.NetTx, &s.NetRx, &s.Block, &s.IRQPoll, &s.Tasklet, &s.Sched, &s.HRTimer, &s.RCU}
+	for i := 0; i < len(vals) && len(b) > 0 && b[0] != '\n'; i++ {
+		f := field(b)
+		n, err := tools.ParseUint(f)
+		if err != nil {
+			return err
+		}
+		*vals[i] = int64(n)
+		b = b[len(f):]
+		if len(b) > 0 && b[0] == 0x20 {
+			b = b[1:]
+		}
+	}
+	return nil
+}
+
 var std *Profiler
 var stdMu sync.Mutex
 
@@ -268,8 +366,7 @@ func Get() (stat *CPUStats, err error) {
 
 // Ticker delivers the system's kernel activity information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *CPUStats
+	*ticker.Ticker[*CPUStats]
 	*Profiler
 }
 
@@ -278,36 +375,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *CPUStats), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*CPUStats, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			s, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- s
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -14,8 +14,13 @@
 package cpustats
 
 import (
+	"reflect"
+	"strings"
 	"testing"
+	"testing/fstest"
 	"time"
+
+	joe "github.com/hmmftg/joefriday"
 )
 
 func TestClkTck(t *testing.T) {
@@ -28,6 +33,69 @@ func TestClkTck(t *testing.T) {
 	}
 }
 
+func TestGetFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/stat": &fstest.MapFile{Data: []byte(`cpu  100 2 30 4000 5 6 7 8 9 10
+cpu0 50 1 15 2000 2 3 3 4 4 5
+cpu1 50 1 15 2000 3 3 4 4 5 5
+intr 12345 0 0
+ctxt 67890
+btime 1500000000
+processes 4242
+procs_running 2
+procs_blocked 1
+softirq 550 1 100 2 50 30 0 7 200 3 157
+`)},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.Ctxt != 67890 || s.BTime != 1500000000 || s.Processes != 4242 {
+		t.Errorf("got ctxt %d, btime %d, processes %d; want 67890, 1500000000, 4242", s.Ctxt, s.BTime, s.Processes)
+	}
+	if s.Intr != 12345 || s.ProcsRunning != 2 || s.ProcsBlocked != 1 {
+		t.Errorf("got intr %d, procs_running %d, procs_blocked %d; want 12345, 2, 1", s.Intr, s.ProcsRunning, s.ProcsBlocked)
+	}
+	softIRQ := SoftIRQ{Total: 550, Hi: 1, Timer: 100, NetTx: 2, NetRx: 50, Block: 30, Tasklet: 7, Sched: 200, HRTimer: 3, RCU: 157}
+	if s.SoftIRQ != softIRQ {
+		t.Errorf("got %#v; want %#v", s.SoftIRQ, softIRQ)
+	}
+	expected := []CPU{
+		{ID: "cpu", User: 100, Nice: 2, System: 30, Idle: 4000, IOWait: 5, IRQ: 6, SoftIRQ: 7, Steal: 8, Quest: 9, QuestNice: 10},
+		{ID: "cpu0", User: 50, Nice: 1, System: 15, Idle: 2000, IOWait: 2, IRQ: 3, SoftIRQ: 3, Steal: 4, Quest: 4, QuestNice: 5},
+		{ID: "cpu1", User: 50, Nice: 1, System: 15, Idle: 2000, IOWait: 3, IRQ: 3, SoftIRQ: 4, Steal: 4, Quest: 5, QuestNice: 5},
+	}
+	if !reflect.DeepEqual(s.CPU, expected) {
+		t.Errorf("got %#v; want %#v", s.CPU, expected)
+	}
+}
+
+// The intr line has a count per interrupt and can be longer than the
+// Profiler's buffer.
+func TestGetLongIntr(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/stat": &fstest.MapFile{Data: []byte("cpu  100 2 30 4000 5 6 7 8 9 10\n" +
+			"intr 12345" + strings.Repeat(" 0", 5000) + "\n" +
+			"ctxt 67890\nbtime 1500000000\nprocesses 4242\nprocs_running 2\nprocs_blocked 1\n")},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.Intr != 12345 || s.Ctxt != 67890 || s.ProcsBlocked != 1 {
+		t.Errorf("got intr %d, ctxt %d, procs_blocked %d; want 12345, 67890, 1", s.Intr, s.Ctxt, s.ProcsBlocked)
+	}
+}
+
 func TestGet(t *testing.T) {
 	s, err := Get()
 	if err != nil {
@@ -77,6 +145,16 @@ func checkStats(n string, s *CPUStats, t *testing.T) {
 	if s.Processes == 0 {
 		t.Errorf("%s: Processes: wanted non-zero value; got 0", n)
 	}
+	if s.Intr == 0 {
+		t.Errorf("%s: Intr: wanted non-zero value; got 0", n)
+	}
+	// the process reading /proc/stat is runnable.
+	if s.ProcsRunning == 0 {
+		t.Errorf("%s: ProcsRunning: wanted non-zero value; got 0", n)
+	}
+	if s.SoftIRQ.Total == 0 {
+		t.Errorf("%s: SoftIRQ.Total: wanted non-zero value; got 0", n)
+	}
 	if len(s.CPU) < 2 {
 		t.Errorf("%s: expected stats for at least 2 CPU entries, got %d", n, len(s.CPU))
 	}
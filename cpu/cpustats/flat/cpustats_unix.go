@@ -23,6 +23,7 @@
 package cpustats
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	stats "github.com/hmmftg/joefriday/cpu/cpustats"
 	"github.com/hmmftg/joefriday/cpu/cpustats/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process the /proc/stats file as Flatbuffer serialized
@@ -40,8 +42,8 @@ type Profiler struct {
 }
 
 // Returns an initialized profiler that uses Flatbuffers.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := stats.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -107,6 +109,19 @@ func (prof *Profiler) Serialize(stts *stats.CPUStats) []byte {
 		prof.Builder.PrependUOffsetT(cpusF[i])
 	}
 	cpusV := prof.Builder.EndVector(len(cpusF))
+	structs.SoftIRQStart(prof.Builder)
+	structs.SoftIRQAddTotal(prof.Builder, stts.SoftIRQ.Total)
+	structs.SoftIRQAddHi(prof.Builder, stts.SoftIRQ.Hi)
+	structs.SoftIRQAddTimer(prof.Builder, stts.SoftIRQ.Timer)
+	structs.SoftIRQAddNetTx(prof.Builder, stts.SoftIRQ.NetTx)
+	structs.SoftIRQAddNetRx(prof.Builder, stts.SoftIRQ.NetRx)
+	structs.SoftIRQAddBlock(prof.Builder, stts.SoftIRQ.Block)
+	structs.SoftIRQAddIRQPoll(prof.Builder, stts.SoftIRQ.IRQPoll)
+	structs.SoftIRQAddTasklet(prof.Builder, stts.SoftIRQ.Tasklet)
+	structs.SoftIRQAddSched(prof.Builder, stts.SoftIRQ.Sched)
+	structs.SoftIRQAddHRTimer(prof.Builder, stts.SoftIRQ.HRTimer)
+	structs.SoftIRQAddRCU(prof.Builder, stts.SoftIRQ.RCU)
+	softIRQ := structs.SoftIRQEnd(prof.Builder)
 	structs.CPUStatsStart(prof.Builder)
 	structs.CPUStatsAddClkTck(prof.Builder, stts.ClkTck)
 	structs.CPUStatsAddTimestamp(prof.Builder, stts.Timestamp)
@@ -114,6 +129,10 @@ func (prof *Profiler) Serialize(stts *stats.CPUStats) []byte {
 	structs.CPUStatsAddBTime(prof.Builder, stts.BTime)
 	structs.CPUStatsAddProcesses(prof.Builder, stts.Processes)
 	structs.CPUStatsAddCPU(prof.Builder, cpusV)
+	structs.CPUStatsAddIntr(prof.Builder, stts.Intr)
+	structs.CPUStatsAddSoftIRQ(prof.Builder, softIRQ)
+	structs.CPUStatsAddProcsRunning(prof.Builder, stts.ProcsRunning)
+	structs.CPUStatsAddProcsBlocked(prof.Builder, stts.ProcsBlocked)
 	prof.Builder.Finish(structs.CPUStatsEnd(prof.Builder))
 	p := prof.Builder.Bytes[prof.Builder.Head():]
 	// copy them (otherwise gets lost in reset)
@@ -147,6 +166,24 @@ func Deserialize(p []byte) *stats.CPUStats {
 	statsS.Ctxt = statsF.Ctxt()
 	statsS.BTime = statsF.BTime()
 	statsS.Processes = statsF.Processes()
+	statsS.Intr = statsF.Intr()
+	statsS.ProcsRunning = statsF.ProcsRunning()
+	statsS.ProcsBlocked = statsF.ProcsBlocked()
+	if softIRQ := statsF.SoftIRQ(nil); softIRQ != nil {
+		statsS.SoftIRQ = stats.SoftIRQ{
+			Total:   softIRQ.Total(),
+			Hi:      softIRQ.Hi(),
+			Timer:   softIRQ.Timer(),
+			NetTx:   softIRQ.NetTx(),
+			NetRx:   softIRQ.NetRx(),
+			Block:   softIRQ.Block(),
+			IRQPoll: softIRQ.IRQPoll(),
+			Tasklet: softIRQ.Tasklet(),
+			Sched:   softIRQ.Sched(),
+			HRTimer: softIRQ.HRTimer(),
+			RCU:     softIRQ.RCU(),
+		}
+	}
 	len := statsF.CPULength()
 	statsS.CPU = make([]stats.CPU, len)
 	for i := 0; i < len; i++ {
@@ -171,8 +208,7 @@ func Deserialize(p []byte) *stats.CPUStats {
 
 // Ticker delivers the system's kernel activity at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -181,35 +217,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -84,7 +84,44 @@ func (rcv *CPUStats) CPULength() int {
 	return 0
 }
 
-func CPUStatsStart(builder *flatbuffers.Builder) { builder.StartObject(6) }
+func (rcv *CPUStats) Intr() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *CPUStats) SoftIRQ(obj *SoftIRQ) *SoftIRQ {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(SoftIRQ)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func (rcv *CPUStats) ProcsRunning() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func (rcv *CPUStats) ProcsBlocked() int64 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		return rcv._tab.GetInt64(o + rcv._tab.Pos)
+	}
+	return 0
+}
+
+func CPUStatsStart(builder *flatbuffers.Builder) { builder.StartObject(10) }
 func CPUStatsAddClkTck(builder *flatbuffers.Builder, ClkTck int16) { builder.PrependInt16Slot(0, ClkTck, 0) }
 func CPUStatsAddTimestamp(builder *flatbuffers.Builder, Timestamp int64) { builder.PrependInt64Slot(1, Timestamp, 0) }
 func CPUStatsAddCtxt(builder *flatbuffers.Builder, Ctxt int64) { builder.PrependInt64Slot(2, Ctxt, 0) }
@@ -93,4 +130,8 @@ func CPUStatsAddProcesses(builder *flatbuffers.Builder, Processes int64) { build
 func CPUStatsAddCPU(builder *flatbuffers.Builder, CPU flatbuffers.UOffsetT) { builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(CPU), 0) }
 func CPUStatsStartCPUVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT { return builder.StartVector(4, numElems, 4)
 }
+func CPUStatsAddIntr(builder *flatbuffers.Builder, Intr int64) { builder.PrependInt64Slot(6, Intr, 0) }
+func CPUStatsAddSoftIRQ(builder *flatbuffers.Builder, SoftIRQ flatbuffers.UOffsetT) { builder.PrependUOffsetTSlot(7, flatbuffers.UOffsetT(SoftIRQ), 0) }
+func CPUStatsAddProcsRunning(builder *flatbuffers.Builder, ProcsRunning int64) { builder.PrependInt64Slot(8, ProcsRunning, 0) }
+func CPUStatsAddProcsBlocked(builder *flatbuffers.Builder, ProcsBlocked int64) { builder.PrependInt64Slot(9, ProcsBlocked, 0) }
 func CPUStatsEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT { return builder.EndObject() }
@@ -0,0 +1,176 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpustats
+
+import (
+	fb "github.com/google/flatbuffers/go"
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/cpu/cpustats/flat/structs"
+)
+
+// View provides read-only access to Flatbuffer serialized cpustats.CPUStats
+// without deserializing it. Values are read from the serialized bytes as they
+// are requested, so reading a few fields, or iterating the CPUs, does not
+// allocate.
+type View struct {
+	t structs.CPUStats
+}
+
+// NewView returns a View of the Flatbuffer serialized cpustats.CPUStats in p.
+// p is not copied; it must not be modified while the View, or anything
+// returned by it, is in use.
+func NewView(p []byte) View {
+	var v View
+	v.t.Init(p, fb.GetUOffsetT(p))
+	return v
+}
+
+// ClkTck returns the ClkTck field.
+func (v View) ClkTck() int16 {
+	return v.t.ClkTck()
+}
+
+// Timestamp returns the Timestamp field.
+func (v View) Timestamp() int64 {
+	return v.t.Timestamp()
+}
+
+// Ctxt returns the Ctxt field.
+func (v View) Ctxt() int64 {
+	return v.t.Ctxt()
+}
+
+// BTime returns the BTime field.
+func (v View) BTime() int64 {
+	return v.t.BTime()
+}
+
+// Processes returns the Processes field.
+func (v View) Processes() int64 {
+	return v.t.Processes()
+}
+
+// Intr returns the Intr field.
+func (v View) Intr() int64 {
+	return v.t.Intr()
+}
+
+// SoftIRQ returns the SoftIRQ field. It's returned by value, so it doesn't
+// allocate.
+func (v View) SoftIRQ() stats.SoftIRQ {
+	var t structs.SoftIRQ
+	if v.t.SoftIRQ(&t) == nil {
+		return stats.SoftIRQ{}
+	}
+	return stats.SoftIRQ{
+		Total:   t.Total(),
+		Hi:      t.Hi(),
+		Timer:   t.Timer(),
+		NetTx:   t.NetTx(),
+		NetRx:   t.NetRx(),
+		Block:   t.Block(),
+		IRQPoll: t.IRQPoll(),
+		Tasklet: t.Tasklet(),
+		Sched:   t.Sched(),
+		HRTimer: t.HRTimer(),
+		RCU:     t.RCU(),
+	}
+}
+
+// ProcsRunning returns the ProcsRunning field.
+func (v View) ProcsRunning() int64 {
+	return v.t.ProcsRunning()
+}
+
+// ProcsBlocked returns the ProcsBlocked field.
+func (v View) ProcsBlocked() int64 {
+	return v.t.ProcsBlocked()
+}
+
+// CPULen returns the number of CPU entries.
+func (v View) CPULen() int {
+	return v.t.CPULength()
+}
+
+// CPU returns a view of the i-th CPU entry. It panics if i is out of
+// range.
+func (v View) CPU(i int) CPUView {
+	if i < 0 || i >= v.t.CPULength() {
+		panic("cpustats: CPU index out of range")
+	}
+	var e CPUView
+	v.t.CPU(&e.t, i)
+	return e
+}
+
+// CPUView is a read-only view of a single CPU entry of a View.
+type CPUView struct {
+	t structs.CPU
+}
+
+// ID returns the ID field. The returned slice refers to the
+// serialized bytes.
+func (v CPUView) ID() []byte {
+	return v.t.ID()
+}
+
+// User returns the User field.
+func (v CPUView) User() int64 {
+	return v.t.User()
+}
+
+// Nice returns the Nice field.
+func (v CPUView) Nice() int64 {
+	return v.t.Nice()
+}
+
+// System returns the System field.
+func (v CPUView) System() int64 {
+	return v.t.System()
+}
+
+// Idle returns the Idle field.
+func (v CPUView) Idle() int64 {
+	return v.t.Idle()
+}
+
+// IOWait returns the IOWait field.
+func (v CPUView) IOWait() int64 {
+	return v.t.IOWait()
+}
+
+// IRQ returns the IRQ field.
+func (v CPUView) IRQ() int64 {
+	return v.t.IRQ()
+}
+
+// SoftIRQ returns the SoftIRQ field.
+func (v CPUView) SoftIRQ() int64 {
+	return v.t.SoftIRQ()
+}
+
+// Steal returns the Steal field.
+func (v CPUView) Steal() int64 {
+	return v.t.Steal()
+}
+
+// Quest returns the Quest field.
+func (v CPUView) Quest() int64 {
+	return v.t.Quest()
+}
+
+// QuestNice returns the QuestNice field.
+func (v CPUView) QuestNice() int64 {
+	return v.t.QuestNice()
+}
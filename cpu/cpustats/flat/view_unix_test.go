@@ -0,0 +1,174 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpustats
+
+import (
+	"reflect"
+	"testing"
+
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+)
+
+func viewFixture() *stats.CPUStats {
+	return &stats.CPUStats{
+		ClkTck:    1,
+		Timestamp: 2,
+		Ctxt:      3,
+		BTime:     4,
+		Processes: 5,
+		CPU: []stats.CPU{
+			{
+				ID:        "id-7",
+				User:      8,
+				Nice:      9,
+				System:    10,
+				Idle:      11,
+				IOWait:    12,
+				IRQ:       13,
+				SoftIRQ:   14,
+				Steal:     15,
+				Quest:     16,
+				QuestNice: 17,
+			},
+			{
+				ID:        "id-18",
+				User:      19,
+				Nice:      20,
+				System:    21,
+				Idle:      22,
+				IOWait:    23,
+				IRQ:       24,
+				SoftIRQ:   25,
+				Steal:     26,
+				Quest:     27,
+				QuestNice: 28,
+			},
+		},
+		Intr:         29,
+		SoftIRQ:      stats.SoftIRQ{Total: 30, Hi: 31, Timer: 32, NetTx: 33, NetRx: 34, Block: 35, IRQPoll: 36, Tasklet: 37, Sched: 38, HRTimer: 39, RCU: 40},
+		ProcsRunning: 41,
+		ProcsBlocked: 42,
+	}
+}
+
+func TestView(t *testing.T) {
+	s := viewFixture()
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v := NewView(p)
+	if v.ClkTck() != s.ClkTck {
+		t.Errorf("s.ClkTck: got %v; want %v", v.ClkTck(), s.ClkTck)
+	}
+	if v.Timestamp() != s.Timestamp {
+		t.Errorf("s.Timestamp: got %v; want %v", v.Timestamp(), s.Timestamp)
+	}
+	if v.Ctxt() != s.Ctxt {
+		t.Errorf("s.Ctxt: got %v; want %v", v.Ctxt(), s.Ctxt)
+	}
+	if v.BTime() != s.BTime {
+		t.Errorf("s.BTime: got %v; want %v", v.BTime(), s.BTime)
+	}
+	if v.Processes() != s.Processes {
+		t.Errorf("s.Processes: got %v; want %v", v.Processes(), s.Processes)
+	}
+	if v.Intr() != s.Intr {
+		t.Errorf("s.Intr: got %v; want %v", v.Intr(), s.Intr)
+	}
+	if v.SoftIRQ() != s.SoftIRQ {
+		t.Errorf("s.SoftIRQ: got %v; want %v", v.SoftIRQ(), s.SoftIRQ)
+	}
+	if v.ProcsRunning() != s.ProcsRunning {
+		t.Errorf("s.ProcsRunning: got %v; want %v", v.ProcsRunning(), s.ProcsRunning)
+	}
+	if v.ProcsBlocked() != s.ProcsBlocked {
+		t.Errorf("s.ProcsBlocked: got %v; want %v", v.ProcsBlocked(), s.ProcsBlocked)
+	}
+	if v.CPULen() != len(s.CPU) {
+		t.Fatalf("CPULen: got %d; want %d", v.CPULen(), len(s.CPU))
+	}
+	for i := 0; i < v.CPULen(); i++ {
+		e, w := v.CPU(i), s.CPU[i]
+		if string(e.ID()) != w.ID {
+			t.Errorf("w.ID: got %s; want %s", e.ID(), w.ID)
+		}
+		if e.User() != w.User {
+			t.Errorf("w.User: got %v; want %v", e.User(), w.User)
+		}
+		if e.Nice() != w.Nice {
+			t.Errorf("w.Nice: got %v; want %v", e.Nice(), w.Nice)
+		}
+		if e.System() != w.System {
+			t.Errorf("w.System: got %v; want %v", e.System(), w.System)
+		}
+		if e.Idle() != w.Idle {
+			t.Errorf("w.Idle: got %v; want %v", e.Idle(), w.Idle)
+		}
+		if e.IOWait() != w.IOWait {
+			t.Errorf("w.IOWait: got %v; want %v", e.IOWait(), w.IOWait)
+		}
+		if e.IRQ() != w.IRQ {
+			t.Errorf("w.IRQ: got %v; want %v", e.IRQ(), w.IRQ)
+		}
+		if e.SoftIRQ() != w.SoftIRQ {
+			t.Errorf("w.SoftIRQ: got %v; want %v", e.SoftIRQ(), w.SoftIRQ)
+		}
+		if e.Steal() != w.Steal {
+			t.Errorf("w.Steal: got %v; want %v", e.Steal(), w.Steal)
+		}
+		if e.Quest() != w.Quest {
+			t.Errorf("w.Quest: got %v; want %v", e.Quest(), w.Quest)
+		}
+		if e.QuestNice() != w.QuestNice {
+			t.Errorf("w.QuestNice: got %v; want %v", e.QuestNice(), w.QuestNice)
+		}
+	}
+	if got := Deserialize(p); !reflect.DeepEqual(got, s) {
+		t.Errorf("Deserialize: got %#v; want %#v", got, s)
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	var n int64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := NewView(p)
+		n = 0
+		for j := 0; j < v.CPULen(); j++ {
+			c := v.CPU(j)
+			n += c.User() + c.System()
+		}
+	}
+	_ = n
+}
+
+// BenchmarkViewDeserialize reads the same values as BenchmarkView using
+// Deserialize.
+func BenchmarkViewDeserialize(b *testing.B) {
+	var n int64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := Deserialize(p)
+		n = 0
+		for _, c := range s.CPU {
+			n += c.User + c.System
+		}
+	}
+	_ = n
+}
@@ -0,0 +1,165 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpustats handles MessagePack based processing of kernel activity,
+// /proc/stat. The first Stats.CPU element aggregates the values for all other
+// CPU elements. The values are aggregated since system boot. Instead of
+// returning a Go struct, it returns MessagePack serialized bytes. A function to
+// deserialize the MessagePack serialized bytes into a cpustats.CPUStats struct
+// is provided.
+//
+// Struct fields are keyed by their json tag names so that the MessagePack and
+// JSON encodings of a struct use the same keys; integers are written in their
+// most compact form.
+//
+// Note: the package name is cpustats and not the final element of the import
+// path (msgpack).
+package cpustats
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Profiler is used to process the /proc/stats file as MessagePack serialized
+// bytes.
+type Profiler struct {
+	*stats.Profiler
+}
+
+// Returns an initialized profiler that uses MessagePack.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns information about current kernel activity as MessagePack
+// serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	st, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(st)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns information about current kernel activity as MessagePack
+// serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize cpustats.CPUStats as MessagePack.
+func (prof *Profiler) Serialize(st *stats.CPUStats) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	err := enc.Encode(st)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Serialize cpustats.CPUStats as MessagePack using package globals.
+func Serialize(st *stats.CPUStats) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(st)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(st *stats.CPUStats) ([]byte, error) {
+	return prof.Serialize(st)
+}
+
+// Marshal is an alias for Serialize using package globals.
+func Marshal(st *stats.CPUStats) ([]byte, error) {
+	return std.Serialize(st)
+}
+
+// Deserialize takes some MessagePack serialized bytes and unmarshals them as
+// cpustats.Stats
+func Deserialize(p []byte) (*stats.CPUStats, error) {
+	st := &stats.CPUStats{}
+	dec := msgpack.NewDecoder(bytes.NewReader(p))
+	dec.SetCustomStructTag("json")
+	err := dec.Decode(st)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// Unmarshal is an alias for Deserialize
+func Unmarshal(p []byte) (*stats.CPUStats, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the system's kernel activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpustats
+
+import (
+	"testing"
+	"time"
+
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/testinfo/codectest"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	codectest.RoundTrip(t, codectest.CPUStats(), Serialize, Deserialize)
+}
+
+// The MessagePack keys are the json tag names so consumers can use the same
+// keys for both encodings.
+func TestKeys(t *testing.T) {
+	p, err := Serialize(&stats.CPUStats{ClkTck: 100, CPU: []stats.CPU{{ID: "cpu", IOWait: 3}}})
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	var m map[string]interface{}
+	err = msgpack.Unmarshal(p, &m)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	for _, k := range []string{"clk_tck", "timestamp", "ctxt", "btime", "processes", "cpu", "intr", "softirq", "procs_running", "procs_blocked"} {
+		if _, ok := m[k]; !ok {
+			t.Errorf("expected key %q; got %v", k, m)
+		}
+	}
+	cpus, ok := m["cpu"].([]interface{})
+	if !ok || len(cpus) != 1 {
+		t.Errorf("cpu: got %#v; want a 1 element array", m["cpu"])
+		return
+	}
+	cpu, ok := cpus[0].(map[string]interface{})
+	if !ok {
+		t.Errorf("cpu[0]: got %#v; want a map", cpus[0])
+		return
+	}
+	if cpu["ID"] != "cpu" {
+		t.Errorf("cpu[0].ID: got %v; want cpu", cpu["ID"])
+	}
+	if v, ok := cpu["io_wait"].(int8); !ok || v != 3 {
+		t.Errorf("cpu[0].io_wait: got %#v; want int8(3)", cpu["io_wait"])
+	}
+}
+
+func TestGet(t *testing.T) {
+	stt, err := Get()
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	stts, err := Unmarshal(stt)
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	codectest.CheckCPUStats(t, "get", stts)
+	t.Logf("%#v\n", stts)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			st, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			codectest.CheckCPUStats(t, "ticker", st)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	v, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Serialize(v)
+	}
+	_ = tmp
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	v, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Marshal(v)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var stts *stats.CPUStats
+	p, _ := NewProfiler()
+	sttsB, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stts, _ = Deserialize(sttsB)
+	}
+	_ = stts
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	var stts *stats.CPUStats
+	p, _ := NewProfiler()
+	sttsB, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		stts, _ = Unmarshal(sttsB)
+	}
+	_ = stts
+}
@@ -0,0 +1,239 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpustats handles protobuf based processing of kernel activity,
+// /proc/stat. The first Stats.CPU element aggregates the values for all other
+// CPU elements. The values are aggregated since system boot. Instead of
+// returning a Go struct, it returns protobuf serialized bytes. A function to
+// deserialize the protobuf serialized bytes into a cpustats.CPUStats struct is
+// provided.
+//
+// Note: the package name is cpustats and not the final element of the import
+// path (pb).
+package cpustats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/cpu/cpustats/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the /proc/stats file as protobuf serialized
+// bytes.
+type Profiler struct {
+	*stats.Profiler
+}
+
+// Returns an initialized profiler that uses protobuf.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns information about current kernel activity as protobuf serialized
+// bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	st, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(st)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns information about current kernel activity as protobuf serialized
+// bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize cpustats.CPUStats as protobuf.
+func (prof *Profiler) Serialize(st *stats.CPUStats) ([]byte, error) {
+	return proto.Marshal(toProto(st))
+}
+
+// Serialize cpustats.CPUStats as protobuf using package globals.
+func Serialize(st *stats.CPUStats) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(st)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(st *stats.CPUStats) ([]byte, error) {
+	return prof.Serialize(st)
+}
+
+// Marshal is an alias for Serialize using package globals.
+func Marshal(st *stats.CPUStats) ([]byte, error) {
+	return std.Serialize(st)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// cpustats.Stats
+func Deserialize(p []byte) (*stats.CPUStats, error) {
+	m := &structs.CPUStats{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize
+func Unmarshal(p []byte) (*stats.CPUStats, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a cpustats.CPUStats into a new structs.CPUStats message.
+func toProto(st *stats.CPUStats) *structs.CPUStats {
+	m := &structs.CPUStats{
+		ClkTck:    int32(st.ClkTck),
+		Timestamp: st.Timestamp,
+		Ctxt:      st.Ctxt,
+		Btime:     st.BTime,
+		Processes: st.Processes,
+		Cpu:       make([]*structs.CPU, len(st.CPU)),
+		Intr:      st.Intr,
+		Softirq: &structs.SoftIRQ{
+			Total:   st.SoftIRQ.Total,
+			Hi:      st.SoftIRQ.Hi,
+			Timer:   st.SoftIRQ.Timer,
+			NetTx:   st.SoftIRQ.NetTx,
+			NetRx:   st.SoftIRQ.NetRx,
+			Block:   st.SoftIRQ.Block,
+			IrqPoll: st.SoftIRQ.IRQPoll,
+			Tasklet: st.SoftIRQ.Tasklet,
+			Sched:   st.SoftIRQ.Sched,
+			Hrtimer: st.SoftIRQ.HRTimer,
+			Rcu:     st.SoftIRQ.RCU,
+		},
+		ProcsRunning: st.ProcsRunning,
+		ProcsBlocked: st.ProcsBlocked,
+	}
+	for i, c := range st.CPU {
+		m.Cpu[i] = &structs.CPU{
+			Id:        c.ID,
+			User:      c.User,
+			Nice:      c.Nice,
+			System:    c.System,
+			Idle:      c.Idle,
+			IoWait:    c.IOWait,
+			Irq:       c.IRQ,
+			SoftIrq:   c.SoftIRQ,
+			Steal:     c.Steal,
+			Guest:     c.Quest,
+			GuestNice: c.QuestNice,
+		}
+	}
+	return m
+}
+
+// fromProto copies a structs.CPUStats message into a cpustats.CPUStats.
+func fromProto(m *structs.CPUStats) *stats.CPUStats {
+	st := &stats.CPUStats{
+		ClkTck:    int16(m.ClkTck),
+		Timestamp: m.Timestamp,
+		Ctxt:      m.Ctxt,
+		BTime:     m.Btime,
+		Processes: m.Processes,
+		CPU:       make([]stats.CPU, len(m.Cpu)),
+		Intr:      m.Intr,
+		SoftIRQ: stats.SoftIRQ{
+			Total:   m.Softirq.GetTotal(),
+			Hi:      m.Softirq.GetHi(),
+			Timer:   m.Softirq.GetTimer(),
+			NetTx:   m.Softirq.GetNetTx(),
+			NetRx:   m.Softirq.GetNetRx(),
+			Block:   m.Softirq.GetBlock(),
+			IRQPoll: m.Softirq.GetIrqPoll(),
+			Tasklet: m.Softirq.GetTasklet(),
+			Sched:   m.Softirq.GetSched(),
+			HRTimer: m.Softirq.GetHrtimer(),
+			RCU:     m.Softirq.GetRcu(),
+		},
+		ProcsRunning: m.ProcsRunning,
+		ProcsBlocked: m.ProcsBlocked,
+	}
+	for i, c := range m.Cpu {
+		st.CPU[i] = stats.CPU{
+			ID:        c.Id,
+			User:      c.User,
+			Nice:      c.Nice,
+			System:    c.System,
+			Idle:      c.Idle,
+			IOWait:    c.IoWait,
+			IRQ:       c.Irq,
+			SoftIRQ:   c.SoftIrq,
+			Steal:     c.Steal,
+			Quest:     c.Guest,
+			QuestNice: c.GuestNice,
+		}
+	}
+	return st
+}
+
+// Ticker delivers the system's kernel activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
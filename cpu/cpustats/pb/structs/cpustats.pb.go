@@ -0,0 +1,506 @@
+// cpustats.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: cpu/cpustats/pb/cpustats.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CPUStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClkTck        int32                  `protobuf:"varint,1,opt,name=clk_tck,json=clkTck,proto3" json:"clk_tck,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Ctxt          int64                  `protobuf:"varint,3,opt,name=ctxt,proto3" json:"ctxt,omitempty"`
+	Btime         int64                  `protobuf:"varint,4,opt,name=btime,proto3" json:"btime,omitempty"`
+	Processes     int64                  `protobuf:"varint,5,opt,name=processes,proto3" json:"processes,omitempty"`
+	Cpu           []*CPU                 `protobuf:"bytes,6,rep,name=cpu,proto3" json:"cpu,omitempty"`
+	Intr          int64                  `protobuf:"varint,7,opt,name=intr,proto3" json:"intr,omitempty"`
+	Softirq       *SoftIRQ               `protobuf:"bytes,8,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	ProcsRunning  int64                  `protobuf:"varint,9,opt,name=procs_running,json=procsRunning,proto3" json:"procs_running,omitempty"`
+	ProcsBlocked  int64                  `protobuf:"varint,10,opt,name=procs_blocked,json=procsBlocked,proto3" json:"procs_blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUStats) Reset() {
+	*x = CPUStats{}
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUStats) ProtoMessage() {}
+
+func (x *CPUStats) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUStats.ProtoReflect.Descriptor instead.
+func (*CPUStats) Descriptor() ([]byte, []int) {
+	return file_cpu_cpustats_pb_cpustats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CPUStats) GetClkTck() int32 {
+	if x != nil {
+		return x.ClkTck
+	}
+	return 0
+}
+
+func (x *CPUStats) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CPUStats) GetCtxt() int64 {
+	if x != nil {
+		return x.Ctxt
+	}
+	return 0
+}
+
+func (x *CPUStats) GetBtime() int64 {
+	if x != nil {
+		return x.Btime
+	}
+	return 0
+}
+
+func (x *CPUStats) GetProcesses() int64 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *CPUStats) GetCpu() []*CPU {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *CPUStats) GetIntr() int64 {
+	if x != nil {
+		return x.Intr
+	}
+	return 0
+}
+
+func (x *CPUStats) GetSoftirq() *SoftIRQ {
+	if x != nil {
+		return x.Softirq
+	}
+	return nil
+}
+
+func (x *CPUStats) GetProcsRunning() int64 {
+	if x != nil {
+		return x.ProcsRunning
+	}
+	return 0
+}
+
+func (x *CPUStats) GetProcsBlocked() int64 {
+	if x != nil {
+		return x.ProcsBlocked
+	}
+	return 0
+}
+
+type SoftIRQ struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Hi            int64                  `protobuf:"varint,2,opt,name=hi,proto3" json:"hi,omitempty"`
+	Timer         int64                  `protobuf:"varint,3,opt,name=timer,proto3" json:"timer,omitempty"`
+	NetTx         int64                  `protobuf:"varint,4,opt,name=net_tx,json=netTx,proto3" json:"net_tx,omitempty"`
+	NetRx         int64                  `protobuf:"varint,5,opt,name=net_rx,json=netRx,proto3" json:"net_rx,omitempty"`
+	Block         int64                  `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	IrqPoll       int64                  `protobuf:"varint,7,opt,name=irq_poll,json=irqPoll,proto3" json:"irq_poll,omitempty"`
+	Tasklet       int64                  `protobuf:"varint,8,opt,name=tasklet,proto3" json:"tasklet,omitempty"`
+	Sched         int64                  `protobuf:"varint,9,opt,name=sched,proto3" json:"sched,omitempty"`
+	Hrtimer       int64                  `protobuf:"varint,10,opt,name=hrtimer,proto3" json:"hrtimer,omitempty"`
+	Rcu           int64                  `protobuf:"varint,11,opt,name=rcu,proto3" json:"rcu,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SoftIRQ) Reset() {
+	*x = SoftIRQ{}
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SoftIRQ) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SoftIRQ) ProtoMessage() {}
+
+func (x *SoftIRQ) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SoftIRQ.ProtoReflect.Descriptor instead.
+func (*SoftIRQ) Descriptor() ([]byte, []int) {
+	return file_cpu_cpustats_pb_cpustats_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SoftIRQ) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetHi() int64 {
+	if x != nil {
+		return x.Hi
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetTimer() int64 {
+	if x != nil {
+		return x.Timer
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetNetTx() int64 {
+	if x != nil {
+		return x.NetTx
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetNetRx() int64 {
+	if x != nil {
+		return x.NetRx
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetIrqPoll() int64 {
+	if x != nil {
+		return x.IrqPoll
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetTasklet() int64 {
+	if x != nil {
+		return x.Tasklet
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetSched() int64 {
+	if x != nil {
+		return x.Sched
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetHrtimer() int64 {
+	if x != nil {
+		return x.Hrtimer
+	}
+	return 0
+}
+
+func (x *SoftIRQ) GetRcu() int64 {
+	if x != nil {
+		return x.Rcu
+	}
+	return 0
+}
+
+type CPU struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	User          int64                  `protobuf:"varint,2,opt,name=user,proto3" json:"user,omitempty"`
+	Nice          int64                  `protobuf:"varint,3,opt,name=nice,proto3" json:"nice,omitempty"`
+	System        int64                  `protobuf:"varint,4,opt,name=system,proto3" json:"system,omitempty"`
+	Idle          int64                  `protobuf:"varint,5,opt,name=idle,proto3" json:"idle,omitempty"`
+	IoWait        int64                  `protobuf:"varint,6,opt,name=io_wait,json=ioWait,proto3" json:"io_wait,omitempty"`
+	Irq           int64                  `protobuf:"varint,7,opt,name=irq,proto3" json:"irq,omitempty"`
+	SoftIrq       int64                  `protobuf:"varint,8,opt,name=soft_irq,json=softIrq,proto3" json:"soft_irq,omitempty"`
+	Steal         int64                  `protobuf:"varint,9,opt,name=steal,proto3" json:"steal,omitempty"`
+	Guest         int64                  `protobuf:"varint,10,opt,name=guest,proto3" json:"guest,omitempty"`
+	GuestNice     int64                  `protobuf:"varint,11,opt,name=guest_nice,json=guestNice,proto3" json:"guest_nice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPU) Reset() {
+	*x = CPU{}
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPU) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPU) ProtoMessage() {}
+
+func (x *CPU) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpustats_pb_cpustats_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPU.ProtoReflect.Descriptor instead.
+func (*CPU) Descriptor() ([]byte, []int) {
+	return file_cpu_cpustats_pb_cpustats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CPU) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CPU) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *CPU) GetNice() int64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *CPU) GetSystem() int64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *CPU) GetIdle() int64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *CPU) GetIoWait() int64 {
+	if x != nil {
+		return x.IoWait
+	}
+	return 0
+}
+
+func (x *CPU) GetIrq() int64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *CPU) GetSoftIrq() int64 {
+	if x != nil {
+		return x.SoftIrq
+	}
+	return 0
+}
+
+func (x *CPU) GetSteal() int64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+func (x *CPU) GetGuest() int64 {
+	if x != nil {
+		return x.Guest
+	}
+	return 0
+}
+
+func (x *CPU) GetGuestNice() int64 {
+	if x != nil {
+		return x.GuestNice
+	}
+	return 0
+}
+
+var File_cpu_cpustats_pb_cpustats_proto protoreflect.FileDescriptor
+
+var file_cpu_cpustats_pb_cpustats_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70,
+	0x62, 0x2f, 0x63, 0x70, 0x75, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x12, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x22, 0xc9, 0x02, 0x0a, 0x08, 0x43, 0x50, 0x55, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x6c, 0x6b, 0x5f, 0x74, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x63, 0x6c, 0x6b, 0x54, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x74, 0x78, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x74, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x62, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x74, 0x69,
+	0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x2e, 0x43, 0x50, 0x55, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x6e, 0x74, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x69, 0x6e, 0x74, 0x72, 0x12,
+	0x35, 0x0a, 0x07, 0x73, 0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x53, 0x6f, 0x66, 0x74, 0x49, 0x52, 0x51, 0x52, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x69, 0x72, 0x71, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x5f,
+	0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x70,
+	0x72, 0x6f, 0x63, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x22, 0x80, 0x02, 0x0a, 0x07, 0x53, 0x6f, 0x66, 0x74, 0x49, 0x52, 0x51, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x68, 0x69, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x12, 0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f,
+	0x74, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6e, 0x65, 0x74, 0x54, 0x78, 0x12,
+	0x15, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x5f, 0x72, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x6e, 0x65, 0x74, 0x52, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x72, 0x71, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x69, 0x72, 0x71, 0x50, 0x6f, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x6c,
+	0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x61, 0x73, 0x6b, 0x6c, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x73, 0x63, 0x68, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x72, 0x74, 0x69, 0x6d,
+	0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x68, 0x72, 0x74, 0x69, 0x6d, 0x65,
+	0x72, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x63, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x72, 0x63, 0x75, 0x22, 0xfa, 0x01, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6e,
+	0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x69,
+	0x64, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x69, 0x6f, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x69, 0x6f, 0x57, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x73, 0x6f,
+	0x66, 0x74, 0x5f, 0x69, 0x72, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x6f,
+	0x66, 0x74, 0x49, 0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x67,
+	0x75, 0x65, 0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x69, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x65,
+	0x42, 0x3d, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68,
+	0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f,
+	0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_cpu_cpustats_pb_cpustats_proto_rawDescOnce sync.Once
+	file_cpu_cpustats_pb_cpustats_proto_rawDescData []byte
+)
+
+func file_cpu_cpustats_pb_cpustats_proto_rawDescGZIP() []byte {
+	file_cpu_cpustats_pb_cpustats_proto_rawDescOnce.Do(func() {
+		file_cpu_cpustats_pb_cpustats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cpu_cpustats_pb_cpustats_proto_rawDesc), len(file_cpu_cpustats_pb_cpustats_proto_rawDesc)))
+	})
+	return file_cpu_cpustats_pb_cpustats_proto_rawDescData
+}
+
+var file_cpu_cpustats_pb_cpustats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cpu_cpustats_pb_cpustats_proto_goTypes = []any{
+	(*CPUStats)(nil), // 0: joefriday.cpustats.CPUStats
+	(*SoftIRQ)(nil),  // 1: joefriday.cpustats.SoftIRQ
+	(*CPU)(nil),      // 2: joefriday.cpustats.CPU
+}
+var file_cpu_cpustats_pb_cpustats_proto_depIdxs = []int32{
+	2, // 0: joefriday.cpustats.CPUStats.cpu:type_name -> joefriday.cpustats.CPU
+	1, // 1: joefriday.cpustats.CPUStats.softirq:type_name -> joefriday.cpustats.SoftIRQ
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cpu_cpustats_pb_cpustats_proto_init() }
+func file_cpu_cpustats_pb_cpustats_proto_init() {
+	if File_cpu_cpustats_pb_cpustats_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cpu_cpustats_pb_cpustats_proto_rawDesc), len(file_cpu_cpustats_pb_cpustats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cpu_cpustats_pb_cpustats_proto_goTypes,
+		DependencyIndexes: file_cpu_cpustats_pb_cpustats_proto_depIdxs,
+		MessageInfos:      file_cpu_cpustats_pb_cpustats_proto_msgTypes,
+	}.Build()
+	File_cpu_cpustats_pb_cpustats_proto = out.File
+	file_cpu_cpustats_pb_cpustats_proto_goTypes = nil
+	file_cpu_cpustats_pb_cpustats_proto_depIdxs = nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpustatsusage gets the interrupt, softirq, context switch, and fork
+// activity of the system as per second rates, along with the number of
+// processes that are runnable and that are blocked waiting for I/O. The rates
+// are calculated from the difference between two /proc/stat snapshots and the
+// time elapsed between them, which is stored in the TimeDelta field.
+package cpustatsusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+// Usage holds the per second rates of the kernel activity. IntrPerSec is the
+// rate of interrupts serviced, CtxtPerSec the rate of context switches, and
+// ForksPerSec the rate of processes created. SoftIRQPerSec holds the rates of
+// softirqs serviced, in total and for each category. ProcsRunning and
+// ProcsBlocked are not rates; they are the number of processes that were
+// runnable and that were blocked waiting for I/O when the current snapshot
+// was taken.
+type Usage struct {
+	Timestamp     int64        `json:"timestamp"`
+	TimeDelta     int64        `json:"time_delta"`
+	IntrPerSec    float64      `json:"intr_per_sec"`
+	CtxtPerSec    float64      `json:"ctxt_per_sec"`
+	ForksPerSec   float64      `json:"forks_per_sec"`
+	SoftIRQPerSec SoftIRQRates `json:"softirq_per_sec"`
+	ProcsRunning  int64        `json:"procs_running"`
+	ProcsBlocked  int64        `json:"procs_blocked"`
+}
+
+// SoftIRQRates holds the per second rates of softirqs serviced, in total and
+// for each category of softirq.
+type SoftIRQRates struct {
+	Total   float64 `json:"total"`
+	Hi      float64 `json:"hi"`
+	Timer   float64 `json:"timer"`
+	NetTx   float64 `json:"net_tx"`
+	NetRx   float64 `json:"net_rx"`
+	Block   float64 `json:"block"`
+	IRQPoll float64 `json:"irq_poll"`
+	Tasklet float64 `json:"tasklet"`
+	Sched   float64 `json:"sched"`
+	HRTimer float64 `json:"hrtimer"`
+	RCU     float64 `json:"rcu"`
+}
+
+// Profiler is used to process the kernel activity.
+type Profiler struct {
+	*cpustats.Profiler
+	prior cpustats.CPUStats
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a /proc/stat
+// snapshot is taken so that any Get() will return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := cpustats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	prior, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, prior: *prior}, nil
+}
+
+// Get returns the current kernel activity. Calculating the rates requires two
+// snapshots. This func gets the current snapshot of /proc/stat and calculates
+// the rates using the difference between that and the prior snapshot. The
+// current snapshot is stored for use as the prior snapshot on the next Get
+// call. If ongoing usage information is desired, the Ticker should be used;
+// it's better suited for ongoing usage information.
+func (prof *Profiler) Get() (u *Usage, err error) {
+	cur, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	u = prof.CalculateUsage(cur)
+	prof.prior = *cur
+	return u, nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current kernel activity using the package's global
+// Profiler. The profiler is lazily instantiated. If the profiler doesn't
+// already exist, the first usage information will not be useful due to
+// minimal time elapsing between the initial and second snapshots used for
+// usage calculations; the results of the first call should be discarded.
+func Get() (u *Usage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// CalculateUsage returns the per second rates using the difference between
+// the current /proc/stat snapshot and the prior one. If no time has elapsed
+// between the snapshots, the rates are 0.
+func (prof *Profiler) CalculateUsage(cur *cpustats.CPUStats) *Usage {
+	u := &Usage{
+		Timestamp:    cur.Timestamp,
+		TimeDelta:    cur.Timestamp - prof.prior.Timestamp,
+		ProcsRunning: cur.ProcsRunning,
+		ProcsBlocked: cur.ProcsBlocked,
+	}
+	if u.TimeDelta <= 0 {
+		return u
+	}
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	rate := func(cur, prior int64) float64 {
+		return float64(tools.CounterDelta(cur, prior)) / secs
+	}
+	u.IntrPerSec = rate(cur.Intr, prof.prior.Intr)
+	u.CtxtPerSec = rate(cur.Ctxt, prof.prior.Ctxt)
+	u.ForksPerSec = rate(cur.Processes, prof.prior.Processes)
+	s, p := &cur.SoftIRQ, &prof.prior.SoftIRQ
+	u.SoftIRQPerSec = SoftIRQRates{
+		Total:   rate(s.Total, p.Total),
+		Hi:      rate(s.Hi, p.Hi),
+		Timer:   rate(s.Timer, p.Timer),
+		NetTx:   rate(s.NetTx, p.NetTx),
+		NetRx:   rate(s.NetRx, p.NetRx),
+		Block:   rate(s.Block, p.Block),
+		IRQPoll: rate(s.IRQPoll, p.IRQPoll),
+		Tasklet: rate(s.Tasklet, p.Tasklet),
+		Sched:   rate(s.Sched, p.Sched),
+		HRTimer: rate(s.HRTimer, p.HRTimer),
+		RCU:     rate(s.RCU, p.RCU),
+	}
+	return u
+}
+
+// Ticker delivers the system's kernel activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[*Usage]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*Usage, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
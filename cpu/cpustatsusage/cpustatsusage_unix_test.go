@@ -0,0 +1,129 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cpustatsusage
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+)
+
+func TestCalculateUsage(t *testing.T) {
+	sec := int64(time.Second)
+	tests := []struct {
+		name     string
+		prior    cpustats.CPUStats
+		cur      cpustats.CPUStats
+		expected Usage
+	}{
+		{
+			"rates",
+			cpustats.CPUStats{Timestamp: sec, Intr: 1000, Ctxt: 500, Processes: 10, SoftIRQ: cpustats.SoftIRQ{Total: 300, Timer: 100, NetRx: 200}},
+			cpustats.CPUStats{Timestamp: 3 * sec, Intr: 3000, Ctxt: 900, Processes: 14, SoftIRQ: cpustats.SoftIRQ{Total: 700, Timer: 300, NetRx: 400}, ProcsRunning: 3, ProcsBlocked: 1},
+			Usage{Timestamp: 3 * sec, TimeDelta: 2 * sec, IntrPerSec: 1000, CtxtPerSec: 200, ForksPerSec: 2, SoftIRQPerSec: SoftIRQRates{Total: 200, Timer: 100, NetRx: 100}, ProcsRunning: 3, ProcsBlocked: 1},
+		},
+		{
+			"reset",
+			cpustats.CPUStats{Timestamp: sec, Intr: 1 << 40},
+			cpustats.CPUStats{Timestamp: 2 * sec, Intr: 500},
+			Usage{Timestamp: 2 * sec, TimeDelta: sec, IntrPerSec: 500},
+		},
+		{
+			"no time delta",
+			cpustats.CPUStats{Timestamp: sec, Intr: 1000},
+			cpustats.CPUStats{Timestamp: sec, Intr: 2000, ProcsRunning: 2},
+			Usage{Timestamp: sec, ProcsRunning: 2},
+		},
+	}
+	for _, test := range tests {
+		prof := &Profiler{prior: test.prior}
+		u := prof.CalculateUsage(&test.cur)
+		if *u != test.expected {
+			t.Errorf("%s: got %#v; want %#v", test.name, *u, test.expected)
+		}
+	}
+}
+
+func TestGetFixture(t *testing.T) {
+	stat := &fstest.MapFile{Data: []byte("cpu  1 0 1 10 0 0 0 0 0 0\nintr 1000 0 0\nctxt 500\n" +
+		"btime 1500000000\nprocesses 10\nprocs_running 1\nprocs_blocked 0\nsoftirq 300 0 100 0 200 0 0 0 0 0 0\n")}
+	prof, err := NewProfiler(joe.WithFS(fstest.MapFS{"proc/stat": stat}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat.Data = []byte("cpu  2 0 2 20 0 0 0 0 0 0\nintr 2000 0 0\nctxt 700\n" +
+		"btime 1500000000\nprocesses 11\nprocs_running 4\nprocs_blocked 2\nsoftirq 500 0 150 0 350 0 0 0 0 0 0\n")
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if u.TimeDelta <= 0 {
+		t.Fatalf("TimeDelta: got %d; want a value > 0", u.TimeDelta)
+	}
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	if u.IntrPerSec != 1000/secs {
+		t.Errorf("IntrPerSec: got %v; want %v", u.IntrPerSec, 1000/secs)
+	}
+	if u.CtxtPerSec != 200/secs {
+		t.Errorf("CtxtPerSec: got %v; want %v", u.CtxtPerSec, 200/secs)
+	}
+	if u.SoftIRQPerSec.NetRx != 150/secs {
+		t.Errorf("SoftIRQPerSec.NetRx: got %v; want %v", u.SoftIRQPerSec.NetRx, 150/secs)
+	}
+	if u.ProcsRunning != 4 || u.ProcsBlocked != 2 {
+		t.Errorf("got procs_running %d, procs_blocked %d; want 4, 2", u.ProcsRunning, u.ProcsBlocked)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *Usage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	if u.IntrPerSec <= 0 {
+		t.Errorf("%s: IntrPerSec: got %v; want a value > 0", n, u.IntrPerSec)
+	}
+	if u.ProcsRunning == 0 {
+		t.Errorf("%s: ProcsRunning: wanted non-zero value; got 0", n)
+	}
+}
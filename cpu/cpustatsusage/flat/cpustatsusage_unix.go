@@ -0,0 +1,199 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpustatsusage gets the interrupt, softirq, context switch, and fork
+// activity of the system as per second rates. The rates are calculated from
+// the difference between two /proc/stat snapshots and the time elapsed
+// between them, which is stored in the TimeDelta field. Instead of returning
+// a Go struct, it returns Flatbuffer serialized bytes. A function to
+// deserialize the Flatbuffer serialized bytes into a cpustatsusage.Usage
+// struct is provided.
+//
+// Note: the package name is cpustatsusage and not the final element of the
+// import path (flat).
+package cpustatsusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/cpu/cpustatsusage"
+	"github.com/hmmftg/joefriday/cpu/cpustatsusage/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the kernel activity as Flatbuffer
+// serialized bytes.
+type Profiler struct {
+	*usage.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a
+// /proc/stat snapshot is taken so that any Get() will return valid
+// information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current kernel activity as Flatbuffer serialized
+// bytes. Calculating the rates requires two snapshots. This func gets the
+// current snapshot of /proc/stat and calculates the rates using the
+// difference between that and the prior snapshot. The current snapshot is
+// stored for use as the prior snapshot on the next Get call. If ongoing usage
+// information is desired, the Ticker should be used; it's better suited for
+// ongoing usage information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current kernel activity as Flatbuffer serialized
+// bytes using the package's global Profiler. The profiler is lazily
+// instantiated. If the profiler doesn't already exist, the first usage
+// information will not be useful due to minimal time elapsing between the
+// initial and second snapshots used for usage calculations; the results of
+// the first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize serializes cpustatsusage.Usage using Flatbuffers.
+func (prof *Profiler) Serialize(u *usage.Usage) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	structs.SoftIRQRatesStart(prof.Builder)
+	structs.SoftIRQRatesAddTotal(prof.Builder, u.SoftIRQPerSec.Total)
+	structs.SoftIRQRatesAddHi(prof.Builder, u.SoftIRQPerSec.Hi)
+	structs.SoftIRQRatesAddTimer(prof.Builder, u.SoftIRQPerSec.Timer)
+	structs.SoftIRQRatesAddNetTx(prof.Builder, u.SoftIRQPerSec.NetTx)
+	structs.SoftIRQRatesAddNetRx(prof.Builder, u.SoftIRQPerSec.NetRx)
+	structs.SoftIRQRatesAddBlock(prof.Builder, u.SoftIRQPerSec.Block)
+	structs.SoftIRQRatesAddIRQPoll(prof.Builder, u.SoftIRQPerSec.IRQPoll)
+	structs.SoftIRQRatesAddTasklet(prof.Builder, u.SoftIRQPerSec.Tasklet)
+	structs.SoftIRQRatesAddSched(prof.Builder, u.SoftIRQPerSec.Sched)
+	structs.SoftIRQRatesAddHRTimer(prof.Builder, u.SoftIRQPerSec.HRTimer)
+	structs.SoftIRQRatesAddRCU(prof.Builder, u.SoftIRQPerSec.RCU)
+	softIRQ := structs.SoftIRQRatesEnd(prof.Builder)
+	structs.UsageStart(prof.Builder)
+	structs.UsageAddTimestamp(prof.Builder, u.Timestamp)
+	structs.UsageAddTimeDelta(prof.Builder, u.TimeDelta)
+	structs.UsageAddIntrPerSec(prof.Builder, u.IntrPerSec)
+	structs.UsageAddCtxtPerSec(prof.Builder, u.CtxtPerSec)
+	structs.UsageAddForksPerSec(prof.Builder, u.ForksPerSec)
+	structs.UsageAddSoftIRQPerSec(prof.Builder, softIRQ)
+	structs.UsageAddProcsRunning(prof.Builder, u.ProcsRunning)
+	structs.UsageAddProcsBlocked(prof.Builder, u.ProcsBlocked)
+	prof.Builder.Finish(structs.UsageEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize serializes cpustatsusage.Usage using Flatbuffers with the package's
+// global Profiler.
+func Serialize(u *usage.Usage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them
+// as cpustatsusage.Usage.
+func Deserialize(p []byte) *usage.Usage {
+	flatU := structs.GetRootAsUsage(p, 0)
+	u := &usage.Usage{
+		Timestamp:    flatU.Timestamp(),
+		TimeDelta:    flatU.TimeDelta(),
+		IntrPerSec:   flatU.IntrPerSec(),
+		CtxtPerSec:   flatU.CtxtPerSec(),
+		ForksPerSec:  flatU.ForksPerSec(),
+		ProcsRunning: flatU.ProcsRunning(),
+		ProcsBlocked: flatU.ProcsBlocked(),
+	}
+	if s := flatU.SoftIRQPerSec(nil); s != nil {
+		u.SoftIRQPerSec = usage.SoftIRQRates{
+			Total:   s.Total(),
+			Hi:      s.Hi(),
+			Timer:   s.Timer(),
+			NetTx:   s.NetTx(),
+			NetRx:   s.NetRx(),
+			Block:   s.Block(),
+			IRQPoll: s.IRQPoll(),
+			Tasklet: s.Tasklet(),
+			Sched:   s.Sched(),
+			HRTimer: s.HRTimer(),
+			RCU:     s.RCU(),
+		}
+	}
+	return u
+}
+
+// Ticker delivers the system's kernel activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
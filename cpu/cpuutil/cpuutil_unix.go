@@ -15,15 +15,25 @@
 // This information is calculated using the difference between two CPU (kernel)
 // stats snapshots, /proc/stat, and represented as a percentage. The time
 // elapsed between the two snapshots is stored in the TimeDelta field.
+//
+// Every /proc/stat column is accounted for: the time a cpu spent in each
+// state is a percentage of its total time, so the states sum to 100.
+//
+// Inside of a container, the utilization of the host's cpus isn't
+// meaningful. A container Profiler, NewContainerProfiler, also calculates the
+// utilization of the process's cgroup as a percentage of the cpu time that
+// the cgroup is allowed to use, along with its throttling; see Container.
 package cpuutil
 
 import (
-	"io"
+	"context"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cgroup"
 	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -43,17 +53,53 @@ type CPUUtil struct {
 	Processes int32 `json:"processes"`
 	// cpu specific utilization information
 	CPU []Utilization `json:"cpu"`
+	// the utilization of the process's cgroup; only a container Profiler
+	// sets it.
+	Container *Container `json:"container,omitempty"`
 }
 
 // Utilization holds kernel utilization information, as percentages, for a CPU.
+// User, Nice, System, Idle, IOWait, IRQ, SoftIRQ, Steal, Guest, and
+// GuestNice are the percentages of the cpu's time that it spent in each
+// state; they sum to 100. The kernel counts the time spent running guests
+// as user and nice time too; here, User and Nice exclude it. Usage is the
+// percentage of time that the cpu was busy: everything but Idle and IOWait.
+//
+// If a cpu has no prior snapshot, e.g. it came online between the
+// snapshots, or none of its counters advanced, only the ID is set.
 type Utilization struct {
-	ID     string  `json:"id"`
-	Usage  float32 `json:"usage"`
-	User   float32 `json:"user"`
-	Nice   float32 `json:"nice"`
-	System float32 `json:"system"`
-	Idle   float32 `json:"idle"`
-	IOWait float32 `json:"io_wait"`
+	ID        string  `json:"id"`
+	Usage     float32 `json:"usage"`
+	User      float32 `json:"user"`
+	Nice      float32 `json:"nice"`
+	System    float32 `json:"system"`
+	Idle      float32 `json:"idle"`
+	IOWait    float32 `json:"io_wait"`
+	IRQ       float32 `json:"irq"`
+	SoftIRQ   float32 `json:"soft_irq"`
+	Steal     float32 `json:"steal"`
+	Guest     float32 `json:"guest"`
+	GuestNice float32 `json:"guest_nice"`
+}
+
+// Container holds the cpu utilization of a cgroup, e.g. a container's,
+// relative to the cpu time that the cgroup is allowed to use. CPUs is the
+// number of cpus that the cgroup may use: the lesser of its cpu quota divided
+// by its period, the number of cpus in its cpuset, and the number of online
+// cpus. Usage, User, and System are percentages of the cpu time of CPUs cpus
+// during the interval between the two snapshots: a cgroup allowed 2 cpus
+// that used 1 cpu has a Usage of 50. NrPeriods and NrThrottled are the number
+// of cpu quota periods that elapsed and in which the cgroup was throttled and
+// ThrottledUsec is the time that it was throttled, in microseconds, during
+// the interval.
+type Container struct {
+	CPUs          float32 `json:"cpus"`
+	Usage         float32 `json:"usage"`
+	User          float32 `json:"user"`
+	System        float32 `json:"system"`
+	NrPeriods     int64   `json:"nr_periods"`
+	NrThrottled   int64   `json:"nr_throttled"`
+	ThrottledUsec int64   `json:"throttled_usec"`
 }
 
 // Profiler is used to process the /proc/stats file and calculate Utilization
@@ -61,12 +107,15 @@ type Utilization struct {
 type Profiler struct {
 	*stats.Profiler
 	prior stats.CPUStats
+	// Cgroup, if not nil, is used to calculate the Container utilization.
+	Cgroup      *cgroup.Profiler
+	priorCgroup cgroup.Cgroup
 }
 
 // Returns an initialized Profiler; ready to use. Upon creation, a /proc/stat
 // snapshot is taken so that any Get() will return valid information.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := stats.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +126,27 @@ func NewProfiler() (prof *Profiler, err error) {
 	return &Profiler{Profiler: p, prior: *s}, nil
 }
 
+// NewContainerProfiler returns an initialized Profiler that also calculates
+// the Container utilization of the current process's cgroup. If cgroups
+// aren't supported, cgroup.ErrNotSupported is returned. Upon creation,
+// snapshots of /proc/stat and the cgroup are taken so that any Get() will
+// return valid information.
+func NewContainerProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	prof, err = NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	prof.Cgroup, err = cgroup.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	prof.priorCgroup, err = prof.Cgroup.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
 // Get returns the cpu utilization. Utilization calculations requires two
 // snapshots. This func gets the current snapshot of /proc/stat and calculates
 // the utilization using the difference between the current snapshot and the
@@ -91,6 +161,15 @@ func (prof *Profiler) Get() (u *CPUUtil, err error) {
 	}
 	u = prof.calculateUtilization(stat)
 	prof.prior = *stat
+	if prof.Cgroup != nil {
+		cg, err := prof.Cgroup.Get()
+		if err != nil {
+			return nil, err
+		}
+		// the first CPU entry is the aggregate.
+		u.Container = CalculateContainer(&cg, &prof.priorCgroup, len(stat.CPU)-1)
+		prof.priorCgroup = cg
+	}
 	return u, nil
 }
 
@@ -115,9 +194,9 @@ func Get() (*CPUUtil, error) {
 	return std.Get()
 }
 
-// utilizaton =
-//
-//	(Δuser + Δnice + Δsystem)/(Δuser+Δnice+Δsystem+Δidle)) * CLK_TCK
+// calculateUtilization calculates the utilization of each cpu in cur since
+// the prior snapshot. The cpus are matched by ID because cpus may go offline,
+// or come online, between the snapshots.
 func (prof *Profiler) calculateUtilization(cur *stats.CPUStats) *CPUUtil {
 	u := &CPUUtil{
 		Timestamp:  cur.Timestamp,
@@ -127,30 +206,103 @@ func (prof *Profiler) calculateUtilization(cur *stats.CPUStats) *CPUUtil {
 		Processes:  int32(cur.Processes),
 		CPU:        make([]Utilization, len(cur.CPU)),
 	}
-	var dUser, dNice, dSys, dIdle, tot float32
-	// Rest of the calculations are per core
-	for i := 0; i < len(cur.CPU); i++ {
-		v := Utilization{ID: cur.CPU[i].ID}
-		dUser = float32(cur.CPU[i].User - prof.prior.CPU[i].User)
-		dNice = float32(cur.CPU[i].Nice - prof.prior.CPU[i].Nice)
-		dSys = float32(cur.CPU[i].System - prof.prior.CPU[i].System)
-		dIdle = float32(cur.CPU[i].Idle - prof.prior.CPU[i].Idle)
-		tot = dUser + dNice + dSys + dIdle
-		v.Usage = (dUser + dNice + dSys) / tot * float32(cur.ClkTck)
-		v.User = dUser / tot * float32(cur.ClkTck)
-		v.Nice = dNice / tot * float32(cur.ClkTck)
-		v.System = dSys / tot * float32(cur.ClkTck)
-		v.Idle = dIdle / tot * float32(cur.ClkTck)
-		v.IOWait = float32(cur.CPU[i].IOWait-prof.prior.CPU[i].IOWait) / tot * float32(cur.ClkTck)
-		u.CPU[i] = v
+	for i := range cur.CPU {
+		prior := prof.priorCPU(i, cur.CPU[i].ID)
+		if prior == nil {
+			u.CPU[i] = Utilization{ID: cur.CPU[i].ID}
+			continue
+		}
+		u.CPU[i] = utilization(&cur.CPU[i], prior)
 	}
 	return u
 }
 
+// priorCPU returns the prior snapshot of the cpu with id, which is usually at
+// the same index, i, as in the current snapshot; nil is returned if there
+// isn't one.
+func (prof *Profiler) priorCPU(i int, id string) *stats.CPU {
+	if i < len(prof.prior.CPU) && prof.prior.CPU[i].ID == id {
+		return &prof.prior.CPU[i]
+	}
+	for j := range prof.prior.CPU {
+		if prof.prior.CPU[j].ID == id {
+			return &prof.prior.CPU[j]
+		}
+	}
+	return nil
+}
+
+// utilization =
+//
+//	Δstate / (Δuser + Δnice + Δsystem + Δidle + Δiowait + Δirq + Δsoftirq + Δsteal) * 100
+//
+// Guest and guest_nice time is already included in user and nice time, so it
+// isn't added to the total; it's subtracted from user and nice instead. The
+// times are deltas from tools.CounterAdvance since iowait can go backwards.
+func utilization(cur, prior *stats.CPU) Utilization {
+	v := Utilization{ID: cur.ID}
+	dUser := tools.CounterAdvance(cur.User, prior.User)
+	dNice := tools.CounterAdvance(cur.Nice, prior.Nice)
+	dSys := tools.CounterAdvance(cur.System, prior.System)
+	dIdle := tools.CounterAdvance(cur.Idle, prior.Idle)
+	dIOWait := tools.CounterAdvance(cur.IOWait, prior.IOWait)
+	dIRQ := tools.CounterAdvance(cur.IRQ, prior.IRQ)
+	dSoftIRQ := tools.CounterAdvance(cur.SoftIRQ, prior.SoftIRQ)
+	dSteal := tools.CounterAdvance(cur.Steal, prior.Steal)
+	dGuest := min(tools.CounterAdvance(cur.Quest, prior.Quest), dUser)
+	dGuestNice := min(tools.CounterAdvance(cur.QuestNice, prior.QuestNice), dNice)
+	tot := float64(dUser + dNice + dSys + dIdle + dIOWait + dIRQ + dSoftIRQ + dSteal)
+	if tot == 0 {
+		return v
+	}
+	pct := func(d int64) float32 { return float32(float64(d) / tot * 100) }
+	v.User = pct(dUser - dGuest)
+	v.Nice = pct(dNice - dGuestNice)
+	v.System = pct(dSys)
+	v.Idle = pct(dIdle)
+	v.IOWait = pct(dIOWait)
+	v.IRQ = pct(dIRQ)
+	v.SoftIRQ = pct(dSoftIRQ)
+	v.Steal = pct(dSteal)
+	v.Guest = pct(dGuest)
+	v.GuestNice = pct(dGuestNice)
+	v.Usage = pct(dUser + dNice + dSys + dIRQ + dSoftIRQ + dSteal)
+	return v
+}
+
+// CalculateContainer returns the cpu utilization of the cgroup between the
+// prior and current snapshots relative to the cpu time that it is allowed to
+// use; online is the number of online cpus.
+func CalculateContainer(cur, prior *cgroup.Cgroup, online int) *Container {
+	cpus := float64(online)
+	if cur.CPU.EffectiveCPUs > 0 && float64(cur.CPU.EffectiveCPUs) < cpus {
+		cpus = float64(cur.CPU.EffectiveCPUs)
+	}
+	if cur.CPU.Quota > 0 && cur.CPU.Period > 0 {
+		q := float64(cur.CPU.Quota) / float64(cur.CPU.Period)
+		if q < cpus || cpus <= 0 {
+			cpus = q
+		}
+	}
+	// the cgroup's usage is in percentages of a single cpu.
+	u := cgroup.CalculateUsage(cur, prior)
+	c := &Container{
+		CPUs:          float32(cpus),
+		NrPeriods:     u.CPU.NrPeriods,
+		NrThrottled:   u.CPU.NrThrottled,
+		ThrottledUsec: u.CPU.ThrottledUsec,
+	}
+	if cpus > 0 {
+		c.Usage = float32(float64(u.CPU.Usage) / cpus)
+		c.User = float32(float64(u.CPU.User) / cpus)
+		c.System = float32(float64(u.CPU.System) / cpus)
+	}
+	return c
+}
+
 // Ticker delivers the system's CPU utilization information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *CPUUtil
+	*ticker.Ticker[*CPUUtil]
 	*Profiler
 }
 
@@ -159,175 +311,42 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *CPUUtil), Profiler: p}
-	go t.Run()
-	return &t, nil
+	return newTicker(ctx, d, cfg, p), nil
 }
 
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// predeclare some vars
-	var (
-		i, j, pos, fieldNum int
-		n                   uint64
-		v                   byte
-		stop                bool
-		err                 error
-		cur                 stats.CPUStats
-		cpu                 stats.CPU
-	)
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			cur.Timestamp = time.Now().UTC().UnixNano()
-			err = t.Procer.Reset()
-			if err != nil {
-				t.Errs <- err
-				break
-			}
-			cur.CPU = cur.CPU[:0]
-			// read each line until eof
-			for {
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					t.Errs <- &joe.ReadError{Err: err}
-					break
-				}
-				// Get everything up to the first space, this is the key.  Not all keys are processed.
-				for i, v = range t.Line {
-					if v == 0x20 {
-						t.Val = t.Line[:i]
-						pos = i + 1
-						break
-					}
-				}
-				// skip the intr line
-				if t.Val[0] == 'i' {
-					continue
-				}
-				if t.Val[0] == 'c' {
-					if t.Val[1] == 'p' { // process CPU
-						cpu.ID = string(t.Val[:])
-						j = 0
-						// skip over any remaining spaces
-						for i, v = range t.Line[pos:] {
-							if v != 0x20 {
-								break
-							}
-							j++
-						}
-						fieldNum = 0
-						pos, j = j+pos, j+pos
-						// space is the field separator
-						for i, v = range t.Line[pos:] {
-							if v == '\n' {
-								stop = true
-							}
-							if v == 0x20 || stop {
-								fieldNum++
-								n, err = tools.ParseUint(t.Line[j : pos+i])
-								if err != nil {
-									t.Errs <- &joe.ParseError{Info: string(t.Val[:]), Err: err}
-									continue
-								}
-								j = pos + i + 1
-								if fieldNum < 6 {
-									if fieldNum < 4 {
-										if fieldNum == 1 {
-											cpu.User = int64(n)
-											continue
-										}
-										if fieldNum == 2 {
-											cpu.Nice = int64(n)
-											continue
-										}
-										cpu.System = int64(n) // 3
-										continue
-									}
-									if fieldNum == 4 {
-										cpu.Idle = int64(n)
-										continue
-									}
-									cpu.IOWait = int64(n) // 5
-									continue
-								}
-								if fieldNum < 8 {
-									if fieldNum == 6 {
-										cpu.IRQ = int64(n)
-										continue
-									}
-									cpu.SoftIRQ = int64(n) // 7
-									continue
-								}
-								if fieldNum == 8 {
-									cpu.Steal = int64(n)
-									continue
-								}
-								if fieldNum == 9 {
-									cpu.Quest = int64(n)
-									continue
-								}
-								cpu.QuestNice = int64(n) // 10
-							}
-						}
-						cur.CPU = append(cur.CPU, cpu)
-						stop = false
-						continue
-					}
-					// Otherwise it's ctxt info; rest of the line is the data.
-					n, err = tools.ParseUint(t.Line[pos : len(t.Line)-1])
-					if err != nil {
-						t.Errs <- &joe.ParseError{Info: string(t.Val[:]), Err: err}
-						continue
-					}
-					cur.Ctxt = int64(n)
-					continue
-				}
-				if t.Val[0] == 'b' {
-					// rest of the line is the data
-					n, err = tools.ParseUint(t.Line[pos : len(t.Line)-1])
-					if err != nil {
-						t.Errs <- &joe.ParseError{Info: string(t.Val[:]), Err: err}
-						continue
-					}
-					cur.BTime = int64(n)
-					continue
-				}
-				if t.Val[0] == 'p' && t.Val[4] == 'e' { // processes info
-					// rest of the line is the data
-					n, err = tools.ParseUint(t.Line[pos : len(t.Line)-1])
-					if err != nil {
-						t.Errs <- &joe.ParseError{Info: string(t.Val[:]), Err: err}
-						continue
-					}
-					cur.Processes = int64(n)
-					continue
-				}
-			}
-			t.Data <- t.Profiler.calculateUtilization(&cur)
-			t.Profiler.prior.Ctxt = cur.Ctxt
-			t.Profiler.prior.BTime = cur.BTime
-			t.Profiler.prior.Processes = cur.Processes
-			if len(t.Profiler.prior.CPU) != len(cur.CPU) {
-				t.Profiler.prior.CPU = make([]stats.CPU, len(cur.CPU))
-			}
-			copy(t.Profiler.prior.CPU, cur.CPU)
-		}
+// NewContainerTicker returns a new Ticker, like NewTicker, whose utilization
+// includes the Container utilization of the current process's cgroup.
+func NewContainerTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewContainerTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewContainerTickerContext returns a new Ticker, like NewTickerContext,
+// whose utilization includes the Container utilization of the current
+// process's cgroup.
+func NewContainerTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewContainerProfiler(opts...)
+	if err != nil {
+		return nil, err
 	}
+	return newTicker(ctx, d, cfg, p), nil
 }
 
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+func newTicker(ctx context.Context, d time.Duration, cfg ticker.Config, p *Profiler) *Ticker {
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*CPUUtil, error) {
+		return t.Get()
+	})
+	return &t
 }
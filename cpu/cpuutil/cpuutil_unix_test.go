@@ -14,8 +14,14 @@
 package cpuutil
 
 import (
+	"math"
 	"testing"
+	"testing/fstest"
 	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cgroup"
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
 )
 
 func TestGet(t *testing.T) {
@@ -33,6 +39,194 @@ func TestGet(t *testing.T) {
 	checkCPUUtil("get", u, t)
 }
 
+// statFixture returns the snapshot of a /proc/stat fixture with the cpu
+// lines.
+func statFixture(t *testing.T, cpus string) *stats.CPUStats {
+	t.Helper()
+	fsys := fstest.MapFS{
+		"proc/stat": &fstest.MapFile{Data: []byte(cpus + "ctxt 67890\nbtime 1500000000\nprocesses 4242\n")},
+	}
+	p, err := stats.NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	st, err := p.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return st
+}
+
+func TestCalculateUtilization(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		cur      string
+		expected []Utilization
+	}{
+		{
+			name:  "user system idle",
+			prior: "cpu  100 0 100 800 0 0 0 0 0 0\n",
+			cur:   "cpu  150 0 150 900 0 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Usage: 50, User: 25, System: 25, Idle: 50},
+			},
+		},
+		{
+			name:  "every column",
+			prior: "cpu  0 0 0 0 0 0 0 0 0 0\n",
+			cur:   "cpu  30 20 10 10 10 5 5 10 20 10\n",
+			expected: []Utilization{
+				{ID: "cpu", Usage: 80, User: 10, Nice: 10, System: 10, Idle: 10, IOWait: 10, IRQ: 5, SoftIRQ: 5, Steal: 10, Guest: 20, GuestNice: 10},
+			},
+		},
+		{
+			name:  "steal",
+			prior: "cpu  100 0 100 100 0 0 0 100 0 0\ncpu0 100 0 100 100 0 0 0 100 0 0\n",
+			cur:   "cpu  110 0 110 110 10 0 0 160 0 0\ncpu0 110 0 110 110 10 0 0 160 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Usage: 80, User: 10, System: 10, Idle: 10, IOWait: 10, Steal: 60},
+				{ID: "cpu0", Usage: 80, User: 10, System: 10, Idle: 10, IOWait: 10, Steal: 60},
+			},
+		},
+		{
+			name:  "no change",
+			prior: "cpu  100 0 100 800 0 0 0 0 0 0\n",
+			cur:   "cpu  100 0 100 800 0 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu"},
+			},
+		},
+		{
+			name:  "counter reset",
+			prior: "cpu  100 0 100 800 0 0 0 0 0 0\n",
+			cur:   "cpu  10 0 10 900 0 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Idle: 100},
+			},
+		},
+		{
+			name:  "iowait went backwards",
+			prior: "cpu  1000 0 1000 100000 500000 0 0 0 0 0\n",
+			cur:   "cpu  1100 0 1100 100200 499999 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Usage: 50, User: 25, System: 25, Idle: 50},
+			},
+		},
+		{
+			name:  "cpu offline",
+			prior: "cpu  0 0 0 0 0 0 0 0 0 0\ncpu0 0 0 0 0 0 0 0 0 0 0\ncpu1 0 0 0 0 0 0 0 0 0 0\n",
+			cur:   "cpu  0 0 0 40 0 0 0 0 0 0\ncpu1 10 0 10 20 0 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Idle: 100},
+				{ID: "cpu1", Usage: 50, User: 25, System: 25, Idle: 50},
+			},
+		},
+		{
+			name:  "cpu online",
+			prior: "cpu  0 0 0 0 0 0 0 0 0 0\ncpu1 0 0 0 0 0 0 0 0 0 0\n",
+			cur:   "cpu  0 0 0 40 0 0 0 0 0 0\ncpu0 10 0 10 20 0 0 0 0 0 0\ncpu1 0 0 0 20 0 0 0 0 0 0\n",
+			expected: []Utilization{
+				{ID: "cpu", Idle: 100},
+				{ID: "cpu0"},
+				{ID: "cpu1", Idle: 100},
+			},
+		},
+	}
+	for _, test := range tests {
+		prof := Profiler{prior: *statFixture(t, test.prior)}
+		u := prof.calculateUtilization(statFixture(t, test.cur))
+		if len(u.CPU) != len(test.expected) {
+			t.Errorf("%s: got %d cpus; want %d", test.name, len(u.CPU), len(test.expected))
+			continue
+		}
+		for i, v := range u.CPU {
+			if v != test.expected[i] {
+				t.Errorf("%s: %s: got %#v; want %#v", test.name, test.expected[i].ID, v, test.expected[i])
+			}
+		}
+	}
+}
+
+func TestCalculateContainer(t *testing.T) {
+	sec := int64(time.Second)
+	prior := cgroup.Cgroup{
+		Timestamp: 1000,
+		CPU:       cgroup.CPU{UsageUsec: 1000000, UserUsec: 800000, SystemUsec: 200000, NrPeriods: 100, NrThrottled: 10, ThrottledUsec: 5000},
+	}
+	tests := []struct {
+		name     string
+		cpu      cgroup.CPU
+		online   int
+		expected Container
+	}{
+		{
+			"quota", cgroup.CPU{Quota: 200000, Period: 100000, UsageUsec: 2000000, UserUsec: 1600000, SystemUsec: 400000, NrPeriods: 110, NrThrottled: 14, ThrottledUsec: 9000},
+			64, Container{CPUs: 2, Usage: 50, User: 40, System: 10, NrPeriods: 10, NrThrottled: 4, ThrottledUsec: 4000},
+		},
+		{
+			"cpuset", cgroup.CPU{Quota: cgroup.Unlimited, Period: 100000, UsageUsec: 3000000, EffectiveCPUs: 4},
+			64, Container{CPUs: 4, Usage: 50},
+		},
+		{
+			"quota less than cpuset", cgroup.CPU{Quota: 50000, Period: 100000, UsageUsec: 1250000, EffectiveCPUs: 4},
+			64, Container{CPUs: 0.5, Usage: 50},
+		},
+		{
+			"unlimited", cgroup.CPU{Quota: cgroup.Unlimited, Period: 100000, UsageUsec: 3000000},
+			8, Container{CPUs: 8, Usage: 25},
+		},
+	}
+	for _, test := range tests {
+		cur := cgroup.Cgroup{Timestamp: prior.Timestamp + sec, CPU: test.cpu}
+		c := CalculateContainer(&cur, &prior, test.online)
+		if *c != test.expected {
+			t.Errorf("%s: got %#v; want %#v", test.name, *c, test.expected)
+		}
+	}
+}
+
+func TestContainerProfiler(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/stat": &fstest.MapFile{Data: []byte("cpu  100 2 30 4000 5 6 7 8 9 10\n" +
+			"cpu0 50 1 15 2000 2 3 3 4 4 5\ncpu1 50 1 15 2000 3 3 4 4 5 5\n" +
+			"ctxt 67890\nbtime 1500000000\nprocesses 4242\n")},
+		"proc/self/cgroup":                     &fstest.MapFile{Data: []byte("0::/app\n")},
+		"sys/fs/cgroup/cgroup.controllers":     &fstest.MapFile{Data: []byte("cpu\n")},
+		"sys/fs/cgroup/app/cgroup.controllers": &fstest.MapFile{Data: []byte("cpu\n")},
+		"sys/fs/cgroup/app/cpu.max":            &fstest.MapFile{Data: []byte("50000 100000\n")},
+		"sys/fs/cgroup/app/cpu.stat":           &fstest.MapFile{Data: []byte("usage_usec 100\nnr_periods 1\n")},
+	}
+	p, err := NewContainerProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err := p.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if u.Container == nil {
+		t.Fatal("Container: got nil; want the cgroup's utilization")
+	}
+	// the quota is half of a cpu and the cgroup's cpu time didn't change.
+	if *u.Container != (Container{CPUs: 0.5}) {
+		t.Errorf("Container: got %#v; want %#v", *u.Container, Container{CPUs: 0.5})
+	}
+
+	// the host Profiler doesn't set it.
+	p, err = NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, err = p.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if u.Container != nil {
+		t.Errorf("Container: got %#v; want nil", u.Container)
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -80,6 +274,11 @@ func checkCPUUtil(name string, u *CPUUtil, t *testing.T) {
 		if v.ID == "" {
 			t.Errorf("%s: %d: expected ID to have a value, was empty", name, i)
 		}
+		// the states sum to 100 unless the cpu's counters didn't advance.
+		sum := v.User + v.Nice + v.System + v.Idle + v.IOWait + v.IRQ + v.SoftIRQ + v.Steal + v.Guest + v.GuestNice
+		if sum != 0 && math.Abs(float64(sum)-100) > 0.01 {
+			t.Errorf("%s: %s: got a total of %f; want 100", name, v.ID, sum)
+		}
 	}
 }
 
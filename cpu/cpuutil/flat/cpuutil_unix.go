@@ -25,6 +25,7 @@
 package cpuutil
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	util "github.com/hmmftg/joefriday/cpu/cpuutil"
 	"github.com/hmmftg/joefriday/cpu/cpuutil/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process the /proc/stats file and calculate utilization
@@ -42,8 +44,19 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpu utilization profiler that uses FlatBuffers.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := util.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// NewContainerProfiler returns an initialized cpu utilization profiler whose
+// utilization includes the Container utilization of the current process's
+// cgroup. If cgroups aren't supported, cgroup.ErrNotSupported is returned.
+func NewContainerProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewContainerProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -59,6 +72,9 @@ func NewProfiler() (prof *Profiler, err error) {
 // ongoing utilization information.
 func (prof *Profiler) Get() (p []byte, err error) {
 	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
 	return prof.Serialize(u), nil
 }
 
@@ -101,6 +117,11 @@ func (prof *Profiler) Serialize(u *util.CPUUtil) []byte {
 		structs.UtilizationAddSystem(prof.Builder, u.CPU[i].System)
 		structs.UtilizationAddIdle(prof.Builder, u.CPU[i].Idle)
 		structs.UtilizationAddIOWait(prof.Builder, u.CPU[i].IOWait)
+		structs.UtilizationAddIRQ(prof.Builder, u.CPU[i].IRQ)
+		structs.UtilizationAddSoftIRQ(prof.Builder, u.CPU[i].SoftIRQ)
+		structs.UtilizationAddSteal(prof.Builder, u.CPU[i].Steal)
+		structs.UtilizationAddGuest(prof.Builder, u.CPU[i].Guest)
+		structs.UtilizationAddGuestNice(prof.Builder, u.CPU[i].GuestNice)
 		utils[i] = structs.UtilizationEnd(prof.Builder)
 	}
 	structs.CPUUtilStartCPUVector(prof.Builder, len(utils))
@@ -108,6 +129,18 @@ func (prof *Profiler) Serialize(u *util.CPUUtil) []byte {
 		prof.Builder.PrependUOffsetT(utils[i])
 	}
 	utilsV := prof.Builder.EndVector(len(utils))
+	var container fb.UOffsetT
+	if u.Container != nil {
+		structs.ContainerStart(prof.Builder)
+		structs.ContainerAddCPUs(prof.Builder, u.Container.CPUs)
+		structs.ContainerAddUsage(prof.Builder, u.Container.Usage)
+		structs.ContainerAddUser(prof.Builder, u.Container.User)
+		structs.ContainerAddSystem(prof.Builder, u.Container.System)
+		structs.ContainerAddNrPeriods(prof.Builder, u.Container.NrPeriods)
+		structs.ContainerAddNrThrottled(prof.Builder, u.Container.NrThrottled)
+		structs.ContainerAddThrottledUsec(prof.Builder, u.Container.ThrottledUsec)
+		container = structs.ContainerEnd(prof.Builder)
+	}
 	structs.CPUUtilStart(prof.Builder)
 	structs.CPUUtilAddTimestamp(prof.Builder, u.Timestamp)
 	structs.CPUUtilAddTimeDelta(prof.Builder, u.TimeDelta)
@@ -115,6 +148,9 @@ func (prof *Profiler) Serialize(u *util.CPUUtil) []byte {
 	structs.CPUUtilAddCtxtDelta(prof.Builder, u.CtxtDelta)
 	structs.CPUUtilAddProcesses(prof.Builder, u.Processes)
 	structs.CPUUtilAddCPU(prof.Builder, utilsV)
+	if u.Container != nil {
+		structs.CPUUtilAddContainer(prof.Builder, container)
+	}
 	prof.Builder.Finish(structs.CPUUtilEnd(prof.Builder))
 	p := prof.Builder.Bytes[prof.Builder.Head():]
 	// copy them (otherwise gets lost in reset)
@@ -159,16 +195,31 @@ func Deserialize(p []byte) *util.CPUUtil {
 			util.System = uF.System()
 			util.Idle = uF.Idle()
 			util.IOWait = uF.IOWait()
+			util.IRQ = uF.IRQ()
+			util.SoftIRQ = uF.SoftIRQ()
+			util.Steal = uF.Steal()
+			util.Guest = uF.Guest()
+			util.GuestNice = uF.GuestNice()
 		}
 		cpuU.CPU[i] = util
 	}
+	if c := flatCPUUtil.Container(nil); c != nil {
+		cpuU.Container = &util.Container{
+			CPUs:          c.CPUs(),
+			Usage:         c.Usage(),
+			User:          c.User(),
+			System:        c.System(),
+			NrPeriods:     c.NrPeriods(),
+			NrThrottled:   c.NrThrottled(),
+			ThrottledUsec: c.ThrottledUsec(),
+		}
+	}
 	return cpuU
 }
 
 // Ticker delivers the system's CPU utilization information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -177,35 +228,42 @@ type Ticker struct {
 // encountered. Stop the ticker to signal the ticker to stop running. Stopping
 // the ticker does not close the Data channel; call Close to close both the
 // ticker and the data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
-	return &t, nil
+	return newTicker(ctx, d, cfg, p), nil
 }
 
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
+// NewContainerTicker returns a new Ticker, like NewTicker, whose utilization
+// includes the Container utilization of the current process's cgroup.
+func NewContainerTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewContainerTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewContainerTickerContext returns a new Ticker, like NewTickerContext,
+// whose utilization includes the Container utilization of the current
+// process's cgroup.
+func NewContainerTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewContainerProfiler(opts...)
+	if err != nil {
+		return nil, err
 	}
+	return newTicker(ctx, d, cfg, p), nil
 }
 
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+func newTicker(ctx context.Context, d time.Duration, cfg ticker.Config, p *Profiler) *Ticker {
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t
 }
@@ -36,6 +36,30 @@ func TestGet(t *testing.T) {
 	checkCPUUtil("get", u, t)
 }
 
+func TestSerializeContainer(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	u := &util.CPUUtil{
+		Timestamp: 1,
+		CPU:       []util.Utilization{{ID: "cpu", Usage: 12.5}},
+		Container: &util.Container{CPUs: 1.5, Usage: 40, User: 30, System: 10, NrPeriods: 10, NrThrottled: 2, ThrottledUsec: 1500},
+	}
+	got := Deserialize(p.Serialize(u))
+	if got.Container == nil {
+		t.Fatal("Container: got nil")
+	}
+	if *got.Container != *u.Container {
+		t.Errorf("Container: got %#v; want %#v", *got.Container, *u.Container)
+	}
+	u.Container = nil
+	got = Deserialize(p.Serialize(u))
+	if got.Container != nil {
+		t.Errorf("Container: got %#v; want nil", got.Container)
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -84,7 +84,20 @@ func (rcv *CPUUtil) CPULength() int {
 	return 0
 }
 
-func CPUUtilStart(builder *flatbuffers.Builder) { builder.StartObject(6) }
+func (rcv *CPUUtil) Container(obj *Container) *Container {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(16))
+	if o != 0 {
+		x := rcv._tab.Indirect(o + rcv._tab.Pos)
+		if obj == nil {
+			obj = new(Container)
+		}
+		obj.Init(rcv._tab.Bytes, x)
+		return obj
+	}
+	return nil
+}
+
+func CPUUtilStart(builder *flatbuffers.Builder) { builder.StartObject(7) }
 func CPUUtilAddTimestamp(builder *flatbuffers.Builder, Timestamp int64) { builder.PrependInt64Slot(0, Timestamp, 0) }
 func CPUUtilAddTimeDelta(builder *flatbuffers.Builder, TimeDelta int64) { builder.PrependInt64Slot(1, TimeDelta, 0) }
 func CPUUtilAddBTimeDelta(builder *flatbuffers.Builder, BTimeDelta int32) { builder.PrependInt32Slot(2, BTimeDelta, 0) }
@@ -93,4 +106,5 @@ func CPUUtilAddProcesses(builder *flatbuffers.Builder, Processes int32) { builde
 func CPUUtilAddCPU(builder *flatbuffers.Builder, CPU flatbuffers.UOffsetT) { builder.PrependUOffsetTSlot(5, flatbuffers.UOffsetT(CPU), 0) }
 func CPUUtilStartCPUVector(builder *flatbuffers.Builder, numElems int) flatbuffers.UOffsetT { return builder.StartVector(4, numElems, 4)
 }
+func CPUUtilAddContainer(builder *flatbuffers.Builder, Container flatbuffers.UOffsetT) { builder.PrependUOffsetTSlot(6, flatbuffers.UOffsetT(Container), 0) }
 func CPUUtilEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT { return builder.EndObject() }
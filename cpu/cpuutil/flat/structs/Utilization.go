@@ -70,7 +70,47 @@ func (rcv *Utilization) IOWait() float32 {
 	return 0.0
 }
 
-func UtilizationStart(builder *flatbuffers.Builder) { builder.StartObject(7) }
+func (rcv *Utilization) IRQ() float32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(18))
+	if o != 0 {
+		return rcv._tab.GetFloat32(o + rcv._tab.Pos)
+	}
+	return 0.0
+}
+
+func (rcv *Utilization) SoftIRQ() float32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(20))
+	if o != 0 {
+		return rcv._tab.GetFloat32(o + rcv._tab.Pos)
+	}
+	return 0.0
+}
+
+func (rcv *Utilization) Steal() float32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(22))
+	if o != 0 {
+		return rcv._tab.GetFloat32(o + rcv._tab.Pos)
+	}
+	return 0.0
+}
+
+func (rcv *Utilization) Guest() float32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(24))
+	if o != 0 {
+		return rcv._tab.GetFloat32(o + rcv._tab.Pos)
+	}
+	return 0.0
+}
+
+func (rcv *Utilization) GuestNice() float32 {
+	o := flatbuffers.UOffsetT(rcv._tab.Offset(26))
+	if o != 0 {
+		return rcv._tab.GetFloat32(o + rcv._tab.Pos)
+	}
+	return 0.0
+}
+
+func UtilizationStart(builder *flatbuffers.Builder) { builder.StartObject(12) }
 func UtilizationAddID(builder *flatbuffers.Builder, ID flatbuffers.UOffsetT) { builder.PrependUOffsetTSlot(0, flatbuffers.UOffsetT(ID), 0) }
 func UtilizationAddUsage(builder *flatbuffers.Builder, Usage float32) { builder.PrependFloat32Slot(1, Usage, 0.0) }
 func UtilizationAddUser(builder *flatbuffers.Builder, User float32) { builder.PrependFloat32Slot(2, User, 0.0) }
@@ -78,4 +118,9 @@ func UtilizationAddNice(builder *flatbuffers.Builder, Nice float32) { builder.Pr
 func UtilizationAddSystem(builder *flatbuffers.Builder, System float32) { builder.PrependFloat32Slot(4, System, 0.0) }
 func UtilizationAddIdle(builder *flatbuffers.Builder, Idle float32) { builder.PrependFloat32Slot(5, Idle, 0.0) }
 func UtilizationAddIOWait(builder *flatbuffers.Builder, IOWait float32) { builder.PrependFloat32Slot(6, IOWait, 0.0) }
+func UtilizationAddIRQ(builder *flatbuffers.Builder, IRQ float32) { builder.PrependFloat32Slot(7, IRQ, 0.0) }
+func UtilizationAddSoftIRQ(builder *flatbuffers.Builder, SoftIRQ float32) { builder.PrependFloat32Slot(8, SoftIRQ, 0.0) }
+func UtilizationAddSteal(builder *flatbuffers.Builder, Steal float32) { builder.PrependFloat32Slot(9, Steal, 0.0) }
+func UtilizationAddGuest(builder *flatbuffers.Builder, Guest float32) { builder.PrependFloat32Slot(10, Guest, 0.0) }
+func UtilizationAddGuestNice(builder *flatbuffers.Builder, GuestNice float32) { builder.PrependFloat32Slot(11, GuestNice, 0.0) }
 func UtilizationEnd(builder *flatbuffers.Builder) flatbuffers.UOffsetT { return builder.EndObject() }
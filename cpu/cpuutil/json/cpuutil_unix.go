@@ -24,12 +24,14 @@
 package cpuutil
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
 	util "github.com/hmmftg/joefriday/cpu/cpuutil"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process the /proc/stats file and calculate utilization
@@ -39,8 +41,19 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpu utlization profiler.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := util.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// NewContainerProfiler returns an initialized cpu utilization profiler whose
+// utilization includes the Container utilization of the current process's
+// cgroup. If cgroups aren't supported, cgroup.ErrNotSupported is returned.
+func NewContainerProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewContainerProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -129,8 +142,7 @@ func Unmarshal(p []byte) (*util.CPUUtil, error) {
 
 // Ticker delivers the system's CPU utilization information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -139,35 +151,42 @@ type Ticker struct {
 // encountered. Stop the ticker to signal the ticker to stop running. Stopping
 // the ticker does not close the Data channel; call Close to close both the
 // ticker and the data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
-	return &t, nil
-}
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
+	return newTicker(ctx, d, cfg, p), nil
+}
+
+// NewContainerTicker returns a new Ticker, like NewTicker, whose utilization
+// includes the Container utilization of the current process's cgroup.
+func NewContainerTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewContainerTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewContainerTickerContext returns a new Ticker, like NewTickerContext,
+// whose utilization includes the Container utilization of the current
+// process's cgroup.
+func NewContainerTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewContainerProfiler(opts...)
+	if err != nil {
+		return nil, err
 	}
+	return newTicker(ctx, d, cfg, p), nil
 }
 
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+func newTicker(ctx context.Context, d time.Duration, cfg ticker.Config, p *Profiler) *Ticker {
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t
 }
@@ -0,0 +1,273 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cpuutil handles protobuf based processing of CPU (kernel) utilization
+// information. This information is calculated using the difference between
+// two CPU (kernel) stats snapshots, /proc/stat, and represented as a
+// percentage. The time elapsed between the two snapshots is stored in the
+// TimeDelta field. Instead of returning a Go struct, it returns protobuf
+// serialized bytes. For convenience, a function to deserialize the protobuf
+// serialized bytes into a cpuutil.CPUUtil struct is provided.
+//
+// Note: the package name is cpuutil and not the final element of the import
+// path (pb).
+package cpuutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	util "github.com/hmmftg/joefriday/cpu/cpuutil"
+	"github.com/hmmftg/joefriday/cpu/cpuutil/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the /proc/stats file and calculate utilization
+// information, returning the data as protobuf serialized bytes.
+type Profiler struct {
+	*util.Profiler
+}
+
+// Initializes and returns a cpu utlization profiler.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// NewContainerProfiler returns an initialized cpu utilization profiler whose
+// utilization includes the Container utilization of the current process's
+// cgroup. If cgroups aren't supported, cgroup.ErrNotSupported is returned.
+func NewContainerProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewContainerProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the cpu utilization as protobuf serialized bytes. Utilization
+// calculations requires two snapshots. This func gets the current snapshot of
+// /proc/stat and calculates the utilization using the difference between the
+// current snapshot and the prior one. The current snapshot is stored and for
+// use as the prior snapshot on the next Get call. If ongoing utilitzation
+// information is desired, the Ticker should be used; it's better suited for
+// ongoing utilization information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	st, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(st)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current cpu utilization as protobuf serialized bytes using
+// the package's global Profiler. The Profiler is instantiated lazily. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second snapshots
+// used for usage calculations; the results of the first call should be
+// discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize cpu Utilization using protobuf.
+func (prof *Profiler) Serialize(ut *util.CPUUtil) ([]byte, error) {
+	return proto.Marshal(toProto(ut))
+}
+
+// Serialize the CPU Utilization as protobuf using the package global Profiler.
+func Serialize(ut *util.CPUUtil) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(ut)
+}
+
+// Marshal is an alias for Serialize
+func (prof *Profiler) Marshal(ut *util.CPUUtil) ([]byte, error) {
+	return prof.Serialize(ut)
+}
+
+// Marsha is an alias for Serialize using the package global Profiler.
+func Marshal(ut *util.CPUUtil) ([]byte, error) {
+	return Serialize(ut)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// cpuutil.CPUUtil.
+func Deserialize(p []byte) (*util.CPUUtil, error) {
+	m := &structs.CPUUtil{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*util.CPUUtil, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a cpuutil.CPUUtil into a new structs.CPUUtil message.
+func toProto(ut *util.CPUUtil) *structs.CPUUtil {
+	m := &structs.CPUUtil{
+		Timestamp:  ut.Timestamp,
+		TimeDelta:  ut.TimeDelta,
+		BtimeDelta: ut.BTimeDelta,
+		CtxtDelta:  ut.CtxtDelta,
+		Processes:  ut.Processes,
+		Cpu:        make([]*structs.Utilization, len(ut.CPU)),
+	}
+	for i, u := range ut.CPU {
+		m.Cpu[i] = &structs.Utilization{
+			Id:        u.ID,
+			Usage:     u.Usage,
+			User:      u.User,
+			Nice:      u.Nice,
+			System:    u.System,
+			Idle:      u.Idle,
+			IoWait:    u.IOWait,
+			Irq:       u.IRQ,
+			SoftIrq:   u.SoftIRQ,
+			Steal:     u.Steal,
+			Guest:     u.Guest,
+			GuestNice: u.GuestNice,
+		}
+	}
+	if ut.Container != nil {
+		m.Container = &structs.Container{
+			Cpus:          ut.Container.CPUs,
+			Usage:         ut.Container.Usage,
+			User:          ut.Container.User,
+			System:        ut.Container.System,
+			NrPeriods:     ut.Container.NrPeriods,
+			NrThrottled:   ut.Container.NrThrottled,
+			ThrottledUsec: ut.Container.ThrottledUsec,
+		}
+	}
+	return m
+}
+
+// fromProto copies a structs.CPUUtil message into a cpuutil.CPUUtil.
+func fromProto(m *structs.CPUUtil) *util.CPUUtil {
+	ut := &util.CPUUtil{
+		Timestamp:  m.Timestamp,
+		TimeDelta:  m.TimeDelta,
+		BTimeDelta: m.BtimeDelta,
+		CtxtDelta:  m.CtxtDelta,
+		Processes:  m.Processes,
+		CPU:        make([]util.Utilization, len(m.Cpu)),
+	}
+	for i, u := range m.Cpu {
+		ut.CPU[i] = util.Utilization{
+			ID:        u.Id,
+			Usage:     u.Usage,
+			User:      u.User,
+			Nice:      u.Nice,
+			System:    u.System,
+			Idle:      u.Idle,
+			IOWait:    u.IoWait,
+			IRQ:       u.Irq,
+			SoftIRQ:   u.SoftIrq,
+			Steal:     u.Steal,
+			Guest:     u.Guest,
+			GuestNice: u.GuestNice,
+		}
+	}
+	if c := m.Container; c != nil {
+		ut.Container = &util.Container{
+			CPUs:          c.Cpus,
+			Usage:         c.Usage,
+			User:          c.User,
+			System:        c.System,
+			NrPeriods:     c.NrPeriods,
+			NrThrottled:   c.NrThrottled,
+			ThrottledUsec: c.ThrottledUsec,
+		}
+	}
+	return ut
+}
+
+// Ticker delivers the system's CPU utilization information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers
+// the data at intervals and an error channel that delivers any errors
+// encountered. Stop the ticker to signal the ticker to stop running. Stopping
+// the ticker does not close the Data channel; call Close to close both the
+// ticker and the data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newTicker(ctx, d, cfg, p), nil
+}
+
+// NewContainerTicker returns a new Ticker, like NewTicker, whose utilization
+// includes the Container utilization of the current process's cgroup.
+func NewContainerTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewContainerTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewContainerTickerContext returns a new Ticker, like NewTickerContext,
+// whose utilization includes the Container utilization of the current
+// process's cgroup.
+func NewContainerTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewContainerProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return newTicker(ctx, d, cfg, p), nil
+}
+
+func newTicker(ctx context.Context, d time.Duration, cfg ticker.Config, p *Profiler) *Ticker {
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t
+}
@@ -0,0 +1,453 @@
+// cpuutil.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: cpu/cpuutil/pb/cpuutil.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CPUUtil struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TimeDelta     int64                  `protobuf:"varint,2,opt,name=time_delta,json=timeDelta,proto3" json:"time_delta,omitempty"`
+	BtimeDelta    int32                  `protobuf:"varint,3,opt,name=btime_delta,json=btimeDelta,proto3" json:"btime_delta,omitempty"`
+	CtxtDelta     int64                  `protobuf:"varint,4,opt,name=ctxt_delta,json=ctxtDelta,proto3" json:"ctxt_delta,omitempty"`
+	Processes     int32                  `protobuf:"varint,5,opt,name=processes,proto3" json:"processes,omitempty"`
+	Cpu           []*Utilization         `protobuf:"bytes,6,rep,name=cpu,proto3" json:"cpu,omitempty"`
+	Container     *Container             `protobuf:"bytes,7,opt,name=container,proto3" json:"container,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CPUUtil) Reset() {
+	*x = CPUUtil{}
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CPUUtil) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CPUUtil) ProtoMessage() {}
+
+func (x *CPUUtil) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CPUUtil.ProtoReflect.Descriptor instead.
+func (*CPUUtil) Descriptor() ([]byte, []int) {
+	return file_cpu_cpuutil_pb_cpuutil_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CPUUtil) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *CPUUtil) GetTimeDelta() int64 {
+	if x != nil {
+		return x.TimeDelta
+	}
+	return 0
+}
+
+func (x *CPUUtil) GetBtimeDelta() int32 {
+	if x != nil {
+		return x.BtimeDelta
+	}
+	return 0
+}
+
+func (x *CPUUtil) GetCtxtDelta() int64 {
+	if x != nil {
+		return x.CtxtDelta
+	}
+	return 0
+}
+
+func (x *CPUUtil) GetProcesses() int32 {
+	if x != nil {
+		return x.Processes
+	}
+	return 0
+}
+
+func (x *CPUUtil) GetCpu() []*Utilization {
+	if x != nil {
+		return x.Cpu
+	}
+	return nil
+}
+
+func (x *CPUUtil) GetContainer() *Container {
+	if x != nil {
+		return x.Container
+	}
+	return nil
+}
+
+type Utilization struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Usage         float32                `protobuf:"fixed32,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	User          float32                `protobuf:"fixed32,3,opt,name=user,proto3" json:"user,omitempty"`
+	Nice          float32                `protobuf:"fixed32,4,opt,name=nice,proto3" json:"nice,omitempty"`
+	System        float32                `protobuf:"fixed32,5,opt,name=system,proto3" json:"system,omitempty"`
+	Idle          float32                `protobuf:"fixed32,6,opt,name=idle,proto3" json:"idle,omitempty"`
+	IoWait        float32                `protobuf:"fixed32,7,opt,name=io_wait,json=ioWait,proto3" json:"io_wait,omitempty"`
+	Irq           float32                `protobuf:"fixed32,8,opt,name=irq,proto3" json:"irq,omitempty"`
+	SoftIrq       float32                `protobuf:"fixed32,9,opt,name=soft_irq,json=softIrq,proto3" json:"soft_irq,omitempty"`
+	Steal         float32                `protobuf:"fixed32,10,opt,name=steal,proto3" json:"steal,omitempty"`
+	Guest         float32                `protobuf:"fixed32,11,opt,name=guest,proto3" json:"guest,omitempty"`
+	GuestNice     float32                `protobuf:"fixed32,12,opt,name=guest_nice,json=guestNice,proto3" json:"guest_nice,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Utilization) Reset() {
+	*x = Utilization{}
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Utilization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Utilization) ProtoMessage() {}
+
+func (x *Utilization) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Utilization.ProtoReflect.Descriptor instead.
+func (*Utilization) Descriptor() ([]byte, []int) {
+	return file_cpu_cpuutil_pb_cpuutil_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Utilization) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Utilization) GetUsage() float32 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *Utilization) GetUser() float32 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *Utilization) GetNice() float32 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *Utilization) GetSystem() float32 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *Utilization) GetIdle() float32 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *Utilization) GetIoWait() float32 {
+	if x != nil {
+		return x.IoWait
+	}
+	return 0
+}
+
+func (x *Utilization) GetIrq() float32 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *Utilization) GetSoftIrq() float32 {
+	if x != nil {
+		return x.SoftIrq
+	}
+	return 0
+}
+
+func (x *Utilization) GetSteal() float32 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+func (x *Utilization) GetGuest() float32 {
+	if x != nil {
+		return x.Guest
+	}
+	return 0
+}
+
+func (x *Utilization) GetGuestNice() float32 {
+	if x != nil {
+		return x.GuestNice
+	}
+	return 0
+}
+
+type Container struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cpus          float32                `protobuf:"fixed32,1,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Usage         float32                `protobuf:"fixed32,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	User          float32                `protobuf:"fixed32,3,opt,name=user,proto3" json:"user,omitempty"`
+	System        float32                `protobuf:"fixed32,4,opt,name=system,proto3" json:"system,omitempty"`
+	NrPeriods     int64                  `protobuf:"varint,5,opt,name=nr_periods,json=nrPeriods,proto3" json:"nr_periods,omitempty"`
+	NrThrottled   int64                  `protobuf:"varint,6,opt,name=nr_throttled,json=nrThrottled,proto3" json:"nr_throttled,omitempty"`
+	ThrottledUsec int64                  `protobuf:"varint,7,opt,name=throttled_usec,json=throttledUsec,proto3" json:"throttled_usec,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Container) Reset() {
+	*x = Container{}
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Container) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Container) ProtoMessage() {}
+
+func (x *Container) ProtoReflect() protoreflect.Message {
+	mi := &file_cpu_cpuutil_pb_cpuutil_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Container.ProtoReflect.Descriptor instead.
+func (*Container) Descriptor() ([]byte, []int) {
+	return file_cpu_cpuutil_pb_cpuutil_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Container) GetCpus() float32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *Container) GetUsage() float32 {
+	if x != nil {
+		return x.Usage
+	}
+	return 0
+}
+
+func (x *Container) GetUser() float32 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *Container) GetSystem() float32 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *Container) GetNrPeriods() int64 {
+	if x != nil {
+		return x.NrPeriods
+	}
+	return 0
+}
+
+func (x *Container) GetNrThrottled() int64 {
+	if x != nil {
+		return x.NrThrottled
+	}
+	return 0
+}
+
+func (x *Container) GetThrottledUsec() int64 {
+	if x != nil {
+		return x.ThrottledUsec
+	}
+	return 0
+}
+
+var File_cpu_cpuutil_pb_cpuutil_proto protoreflect.FileDescriptor
+
+var file_cpu_cpuutil_pb_cpuutil_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x63, 0x70, 0x75, 0x2f, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69, 0x6c, 0x2f, 0x70, 0x62,
+	0x2f, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69,
+	0x6c, 0x22, 0x92, 0x02, 0x0a, 0x07, 0x43, 0x50, 0x55, 0x55, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x62, 0x74, 0x69, 0x6d, 0x65, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x74, 0x78, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x74, 0x78, 0x74, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x03, 0x63, 0x70, 0x75, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61,
+	0x79, 0x2e, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69, 0x6c, 0x2e, 0x55, 0x74, 0x69, 0x6c, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x3a, 0x0a, 0x09, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69,
+	0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x09, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x22, 0x98, 0x02, 0x0a, 0x0b, 0x55, 0x74, 0x69, 0x6c, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04,
+	0x6e, 0x69, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04,
+	0x69, 0x64, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x06, 0x69, 0x6f, 0x57, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x19, 0x0a, 0x08, 0x73,
+	0x6f, 0x66, 0x74, 0x5f, 0x69, 0x72, 0x71, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x73,
+	0x6f, 0x66, 0x74, 0x49, 0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x67, 0x75, 0x65, 0x73, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x6e, 0x69, 0x63, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x69, 0x63,
+	0x65, 0x22, 0xca, 0x01, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x63,
+	0x70, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x72, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6e, 0x72, 0x50, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x72, 0x54, 0x68,
+	0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x68, 0x72, 0x6f, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x74, 0x68, 0x72, 0x6f, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x55, 0x73, 0x65, 0x63, 0x42, 0x3c,
+	0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d,
+	0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x63, 0x70,
+	0x75, 0x2f, 0x63, 0x70, 0x75, 0x75, 0x74, 0x69, 0x6c, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_cpu_cpuutil_pb_cpuutil_proto_rawDescOnce sync.Once
+	file_cpu_cpuutil_pb_cpuutil_proto_rawDescData []byte
+)
+
+func file_cpu_cpuutil_pb_cpuutil_proto_rawDescGZIP() []byte {
+	file_cpu_cpuutil_pb_cpuutil_proto_rawDescOnce.Do(func() {
+		file_cpu_cpuutil_pb_cpuutil_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cpu_cpuutil_pb_cpuutil_proto_rawDesc), len(file_cpu_cpuutil_pb_cpuutil_proto_rawDesc)))
+	})
+	return file_cpu_cpuutil_pb_cpuutil_proto_rawDescData
+}
+
+var file_cpu_cpuutil_pb_cpuutil_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cpu_cpuutil_pb_cpuutil_proto_goTypes = []any{
+	(*CPUUtil)(nil),     // 0: joefriday.cpuutil.CPUUtil
+	(*Utilization)(nil), // 1: joefriday.cpuutil.Utilization
+	(*Container)(nil),   // 2: joefriday.cpuutil.Container
+}
+var file_cpu_cpuutil_pb_cpuutil_proto_depIdxs = []int32{
+	1, // 0: joefriday.cpuutil.CPUUtil.cpu:type_name -> joefriday.cpuutil.Utilization
+	2, // 1: joefriday.cpuutil.CPUUtil.container:type_name -> joefriday.cpuutil.Container
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_cpu_cpuutil_pb_cpuutil_proto_init() }
+func file_cpu_cpuutil_pb_cpuutil_proto_init() {
+	if File_cpu_cpuutil_pb_cpuutil_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cpu_cpuutil_pb_cpuutil_proto_rawDesc), len(file_cpu_cpuutil_pb_cpuutil_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cpu_cpuutil_pb_cpuutil_proto_goTypes,
+		DependencyIndexes: file_cpu_cpuutil_pb_cpuutil_proto_depIdxs,
+		MessageInfos:      file_cpu_cpuutil_pb_cpuutil_proto_msgTypes,
+	}.Build()
+	File_cpu_cpuutil_pb_cpuutil_proto = out.File
+	file_cpu_cpuutil_pb_cpuutil_proto_goTypes = nil
+	file_cpu_cpuutil_pb_cpuutil_proto_depIdxs = nil
+}
@@ -23,7 +23,6 @@ package cpux
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -82,15 +81,16 @@ type Profiler struct {
 	// path of the sysfs cpu tree; cached so it doesn't need to be constantly
 	// redone.
 	cpuPath string
+	opts    joefriday.Options
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler) {
+func NewProfiler(opts ...joefriday.Option) (prof *Profiler) {
 	// NumCPU provides the number of logical cpus usable by the current process.
 	// Is this sufficient, or will there ever be a delta between that and either
 	// what /proc/cpuinfo reports or what is available on /sys/devices/system/cpu/
-	prof = &Profiler{NumCPU: runtime.NumCPU()}
-	prof.SysFSSystemPath(joefriday.SysFSSystem)
+	prof = &Profiler{NumCPU: runtime.NumCPU(), opts: joefriday.NewOptions(opts...)}
+	prof.SysFSSystemPath(prof.opts.Path(joefriday.SysFSSystem))
 	return prof
 }
 
@@ -204,7 +204,7 @@ func (prof *Profiler) cachePath(x int) string {
 
 // hasCPUFreq returns if the system has cpufreq information:
 func (prof *Profiler) hasCPUFreq() bool {
-	_, err := os.Stat(filepath.Join(prof.cpuPath, CPUFreq))
+	_, err := prof.opts.Stat(filepath.Join(prof.cpuPath, CPUFreq))
 	if err == nil {
 		return true
 	}
@@ -213,7 +213,7 @@ func (prof *Profiler) hasCPUFreq() bool {
 
 // gets the core_id of cpuX
 func (prof *Profiler) coreID(x int) (int32, error) {
-	v, err := ioutil.ReadFile(prof.coreIDPath(x))
+	v, err := prof.opts.ReadFile(prof.coreIDPath(x))
 	if err != nil {
 		return 0, err
 	}
@@ -226,7 +226,7 @@ func (prof *Profiler) coreID(x int) (int32, error) {
 
 // gets the physical_package_id of cpuX
 func (prof *Profiler) physicalPackageID(x int) (int32, error) {
-	v, err := ioutil.ReadFile(prof.physicalPackageIDPath(x))
+	v, err := prof.opts.ReadFile(prof.physicalPackageIDPath(x))
 	if err != nil {
 		return 0, err
 	}
@@ -239,7 +239,7 @@ func (prof *Profiler) physicalPackageID(x int) (int32, error) {
 
 // gets the cpu_mhz_min information
 func (prof *Profiler) cpuMHzMin(x int) (float32, error) {
-	v, err := ioutil.ReadFile(prof.cpuInfoFreqMinPath(x))
+	v, err := prof.opts.ReadFile(prof.cpuInfoFreqMinPath(x))
 	if err != nil {
 		return 0, err
 	}
@@ -254,7 +254,7 @@ func (prof *Profiler) cpuMHzMin(x int) (float32, error) {
 
 // gets the cpu_mhz_max information
 func (prof *Profiler) cpuMHzMax(x int) (float32, error) {
-	v, err := ioutil.ReadFile(prof.cpuInfoFreqMaxPath(x))
+	v, err := prof.opts.ReadFile(prof.cpuInfoFreqMaxPath(x))
 	if err != nil {
 		return 0, err
 	}
@@ -272,7 +272,7 @@ func (prof *Profiler) cache(x int, cpu *CPU) error {
 	cpu.Cache = map[string]string{}
 	//go through all the entries in cpuX/cache
 	p := prof.cachePath(x)
-	dirs, err := ioutil.ReadDir(p)
+	dirs, err := prof.opts.ReadDir(p)
 	if err != nil {
 		return err
 	}
@@ -283,12 +283,12 @@ func (prof *Profiler) cache(x int, cpu *CPU) error {
 			continue // this shouldn't happen but if it does we just skip the entry
 		}
 		// cache level
-		l, err := ioutil.ReadFile(filepath.Join(p, d.Name(), "level"))
+		l, err := prof.opts.ReadFile(filepath.Join(p, d.Name(), "level"))
 		if err != nil {
 			return err
 		}
 
-		t, err := ioutil.ReadFile(filepath.Join(p, d.Name(), "type"))
+		t, err := prof.opts.ReadFile(filepath.Join(p, d.Name(), "type"))
 		if err != nil {
 			return err
 		}
@@ -302,7 +302,7 @@ func (prof *Profiler) cache(x int, cpu *CPU) error {
 		}
 
 		// cache size
-		s, err := ioutil.ReadFile(filepath.Join(p, d.Name(), "size"))
+		s, err := prof.opts.ReadFile(filepath.Join(p, d.Name(), "size"))
 		if err != nil {
 			return err
 		}
@@ -321,7 +321,7 @@ func (prof *Profiler) cache(x int, cpu *CPU) error {
 // if they are present. [cpu_possible_mask]
 // from: Documentation/cputopology.txt
 func (prof *Profiler) Possible() (string, error) {
-	p, err := ioutil.ReadFile(filepath.Join(prof.cpuPath, Possible))
+	p, err := prof.opts.ReadFile(filepath.Join(prof.cpuPath, Possible))
 	if err != nil {
 		return "", err
 	}
@@ -332,7 +332,7 @@ func (prof *Profiler) Possible() (string, error) {
 // [cpu_present_mask]
 // from: Documentation/cputopology.txt
 func (prof *Profiler) Present() (string, error) {
-	p, err := ioutil.ReadFile(filepath.Join(prof.cpuPath, Present))
+	p, err := prof.opts.ReadFile(filepath.Join(prof.cpuPath, Present))
 	if err != nil {
 		return "", err
 	}
@@ -342,7 +342,7 @@ func (prof *Profiler) Present() (string, error) {
 // Online: CPUs that are online and being scheduled [cpu_online_mask]
 // from: Documentation/cputopology.txt
 func (prof *Profiler) Online() (string, error) {
-	p, err := ioutil.ReadFile(filepath.Join(prof.cpuPath, Online))
+	p, err := prof.opts.ReadFile(filepath.Join(prof.cpuPath, Online))
 	if err != nil {
 		return "", err
 	}
@@ -357,7 +357,7 @@ func (prof *Profiler) Online() (string, error) {
 // This file may not exist or may only contain a new line char, '\n', neither
 // of these conditions are error states and will result in an empty string.
 func (prof *Profiler) Offline() (string, error) {
-	p, err := ioutil.ReadFile(filepath.Join(prof.cpuPath, Offline))
+	p, err := prof.opts.ReadFile(filepath.Join(prof.cpuPath, Offline))
 	if err != nil {
 		if os.IsNotExist(err) {
 			return "", nil
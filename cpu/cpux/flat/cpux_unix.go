@@ -28,6 +28,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/cpu/cpux"
 	"github.com/hmmftg/joefriday/cpu/cpux/flat/structs"
 )
@@ -40,8 +41,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpux profiler.
-func NewProfiler() *Profiler {
-	prof := cpux.NewProfiler()
+func NewProfiler(opts ...joe.Option) *Profiler {
+	prof := cpux.NewProfiler(opts...)
 	return &Profiler{Profiler: prof, Builder: fb.NewBuilder(0)}
 }
 
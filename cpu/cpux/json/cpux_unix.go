@@ -28,6 +28,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	x "github.com/hmmftg/joefriday/cpu/cpux"
 )
 
@@ -37,8 +38,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpuinfo profiler.
-func NewProfiler() *Profiler {
-	p := x.NewProfiler()
+func NewProfiler(opts ...joe.Option) *Profiler {
+	p := x.NewProfiler(opts...)
 	return &Profiler{Profiler: p}
 }
 
@@ -2,8 +2,11 @@ package cpux
 
 import (
 	"encoding/json"
+	"reflect"
 	"testing"
+	"testing/fstest"
 
+	"github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/cpu/cpux"
 	"github.com/hmmftg/joefriday/testinfo"
 )
@@ -148,3 +151,49 @@ noOffline:
 		t.Error(err)
 	}
 }
+
+func TestCPUXFS(t *testing.T) {
+	cpu := "sys/devices/system/cpu/"
+	fsys := fstest.MapFS{
+		cpu + "possible": &fstest.MapFile{Data: []byte("0-1\n")},
+		cpu + "present":  &fstest.MapFile{Data: []byte("0-1\n")},
+		cpu + "online":   &fstest.MapFile{Data: []byte("0-1\n")},
+	}
+	for _, x := range []string{"cpu0", "cpu1"} {
+		fsys[cpu+x+"/topology/physical_package_id"] = &fstest.MapFile{Data: []byte("0\n")}
+		fsys[cpu+x+"/cpufreq/cpuinfo_min_freq"] = &fstest.MapFile{Data: []byte("800000\n")}
+		fsys[cpu+x+"/cpufreq/cpuinfo_max_freq"] = &fstest.MapFile{Data: []byte("3500000\n")}
+		fsys[cpu+x+"/cache/index0/level"] = &fstest.MapFile{Data: []byte("1\n")}
+		fsys[cpu+x+"/cache/index0/type"] = &fstest.MapFile{Data: []byte("Data\n")}
+		fsys[cpu+x+"/cache/index0/size"] = &fstest.MapFile{Data: []byte("32K\n")}
+		fsys[cpu+x+"/cache/index1/level"] = &fstest.MapFile{Data: []byte("2\n")}
+		fsys[cpu+x+"/cache/index1/type"] = &fstest.MapFile{Data: []byte("Unified\n")}
+		fsys[cpu+x+"/cache/index1/size"] = &fstest.MapFile{Data: []byte("256K\n")}
+	}
+	fsys[cpu+"cpu0/topology/core_id"] = &fstest.MapFile{Data: []byte("0\n")}
+	fsys[cpu+"cpu1/topology/core_id"] = &fstest.MapFile{Data: []byte("1\n")}
+	fsys[cpu+"cpufreq/policy0/scaling_driver"] = &fstest.MapFile{Data: []byte("acpi-cpufreq\n")}
+
+	prof := cpux.NewProfiler(joefriday.WithFS(fsys))
+	prof.NumCPU = 2
+	cpus, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := &cpux.CPUs{
+		Sockets:  1,
+		Possible: "0-1",
+		Online:   "0-1",
+		Present:  "0-1",
+	}
+	for i := int32(0); i < 2; i++ {
+		expected.CPU = append(expected.CPU, cpux.CPU{
+			CoreID: i, MHzMin: 800, MHzMax: 3500,
+			Cache:    map[string]string{"L1d cache": "32K", "L2 cache": "256K"},
+			CacheIDs: []string{"L1d cache", "L2 cache"},
+		})
+	}
+	if !reflect.DeepEqual(cpus, expected) {
+		t.Errorf("got %#v; want %#v", cpus, expected)
+	}
+}
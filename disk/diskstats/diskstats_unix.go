@@ -16,6 +16,7 @@
 package diskstats
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"sync"
@@ -23,6 +24,7 @@ import (
 
 	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -35,8 +37,9 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
@@ -102,7 +105,7 @@ func (prof *Profiler) Get() (stats *structs.DiskStats, err error) {
 			priorPos, pos = pos, pos+i+1
 			if fieldNum < 8 {
 				if fieldNum < 4 {
-					if fieldNum < 2 {
+					if fieldNum < 3 {
 						if fieldNum == 1 {
 							dev.Major = uint32(n)
 							continue
@@ -110,7 +113,7 @@ func (prof *Profiler) Get() (stats *structs.DiskStats, err error) {
 						dev.Minor = uint32(n)
 						continue
 					}
-					dev.Name = string(prof.Line[priorPos:pos])
+					dev.Name = string(prof.Line[priorPos : pos-1])
 					continue
 				}
 				if fieldNum < 6 {
@@ -180,8 +183,7 @@ func Get() (stat *structs.DiskStats, err error) {
 // Ticker delivers the system's IO statistics of the block devices at
 // intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *structs.DiskStats
+	*ticker.Ticker[*structs.DiskStats]
 	*Profiler
 }
 
@@ -190,36 +192,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *structs.DiskStats), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.DiskStats, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			s, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- s
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -14,12 +14,46 @@
 package diskstats
 
 import (
+	"reflect"
 	"testing"
+	"testing/fstest"
 	"time"
 
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/disk/structs"
 )
 
+func TestParse(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/diskstats": &fstest.MapFile{Data: []byte(
+			"   7       2 loop2 1 2 3 4 5 6 7 8 9 10 11\n" +
+				" 259       1 nvme0n1p1 12 13 14 15 16 17 18 19 20 21 22 0 0 0 0 0 0\n")},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []structs.Device{
+		{
+			Major: 7, Minor: 2, Name: "loop2", ReadsCompleted: 1, ReadsMerged: 2, ReadSectors: 3, ReadingTime: 4,
+			WritesCompleted: 5, WritesMerged: 6, WrittenSectors: 7, WritingTime: 8, IOInProgress: 9, IOTime: 10,
+			WeightedIOTime: 11,
+		},
+		{
+			Major: 259, Minor: 1, Name: "nvme0n1p1", ReadsCompleted: 12, ReadsMerged: 13, ReadSectors: 14, ReadingTime: 15,
+			WritesCompleted: 16, WritesMerged: 17, WrittenSectors: 18, WritingTime: 19, IOInProgress: 20, IOTime: 21,
+			WeightedIOTime: 22,
+		},
+	}
+	if !reflect.DeepEqual(s.Device, expected) {
+		t.Errorf("got %#v; want %#v", s.Device, expected)
+	}
+}
+
 func TestGet(t *testing.T) {
 	s, err := Get()
 	if err != nil {
@@ -0,0 +1,215 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskstats handles processing of IO statistics of each block device,
+// /proc/diskstats. Instead of returning a Go struct, it returns protobuf
+// serialized bytes. A function to deserialize the protobuf serialized bytes
+// into a structs.DiskStats struct is provided.
+//
+// Note: the package name is diskstats and not the final element of the import
+// path (pb).
+package diskstats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/disk/diskstats"
+	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/disk/structs/pb"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the /proc/diskstats file.
+type Profiler struct {
+	*stats.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns information about current IO statistics of the block devices as
+// protobuf serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	st, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(st)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns information about current IO statistics of the block devices as
+// protobuf serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize structs.DiskStats using protobuf.
+func (prof *Profiler) Serialize(st *structs.DiskStats) ([]byte, error) {
+	return proto.Marshal(toProto(st))
+}
+
+// Serialize structs.DiskStats using protobuf with the package's global
+// Profiler.
+func Serialize(st *structs.DiskStats) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(st)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(st *structs.DiskStats) ([]byte, error) {
+	return prof.Serialize(st)
+}
+
+// Marshal is an alias for Serialize; uses the package's global Profiler.
+func Marshal(st *structs.DiskStats) ([]byte, error) {
+	return Serialize(st)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// structs.DiskStats.
+func Deserialize(p []byte) (*structs.DiskStats, error) {
+	m := &pb.DiskStats{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.DiskStats, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a structs.DiskStats into a new pb.DiskStats message.
+func toProto(st *structs.DiskStats) *pb.DiskStats {
+	m := &pb.DiskStats{
+		Timestamp: st.Timestamp,
+		Device:    make([]*pb.Device, len(st.Device)),
+	}
+	for i := range st.Device {
+		m.Device[i] = deviceToProto(&st.Device[i])
+	}
+	return m
+}
+
+// fromProto copies a pb.DiskStats message into a structs.DiskStats.
+func fromProto(m *pb.DiskStats) *structs.DiskStats {
+	st := &structs.DiskStats{
+		Timestamp: m.Timestamp,
+		Device:    make([]structs.Device, len(m.Device)),
+	}
+	for i, d := range m.Device {
+		st.Device[i] = deviceFromProto(d)
+	}
+	return st
+}
+
+// deviceToProto copies a structs.Device into a new pb.Device message.
+func deviceToProto(d *structs.Device) *pb.Device {
+	return &pb.Device{
+		Major:           d.Major,
+		Minor:           d.Minor,
+		Name:            d.Name,
+		ReadsCompleted:  d.ReadsCompleted,
+		ReadsMerged:     d.ReadsMerged,
+		ReadSectors:     d.ReadSectors,
+		ReadingTime:     d.ReadingTime,
+		WritesCompleted: d.WritesCompleted,
+		WritesMerged:    d.WritesMerged,
+		WrittenSectors:  d.WrittenSectors,
+		WritingTime:     d.WritingTime,
+		IoInProgress:    d.IOInProgress,
+		IoTime:          d.IOTime,
+		WeightedIoTime:  d.WeightedIOTime,
+	}
+}
+
+// deviceFromProto copies a pb.Device message into a structs.Device.
+func deviceFromProto(m *pb.Device) structs.Device {
+	return structs.Device{
+		Major:           m.Major,
+		Minor:           m.Minor,
+		Name:            m.Name,
+		ReadsCompleted:  m.ReadsCompleted,
+		ReadsMerged:     m.ReadsMerged,
+		ReadSectors:     m.ReadSectors,
+		ReadingTime:     m.ReadingTime,
+		WritesCompleted: m.WritesCompleted,
+		WritesMerged:    m.WritesMerged,
+		WrittenSectors:  m.WrittenSectors,
+		WritingTime:     m.WritingTime,
+		IOInProgress:    m.IoInProgress,
+		IOTime:          m.IoTime,
+		WeightedIOTime:  m.WeightedIoTime,
+	}
+}
+
+// Ticker delivers the system's IO statistics of the block devices at
+// intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -15,31 +15,53 @@
 // calculated by taking the difference between two snapshots of IO statistics
 // for block devices, /procd/diskstats. The time elapsed between the two
 // snapshots is stored in the TimeDelta field.
+//
+// In addition to the raw counter deltas, the IO rates for each device are
+// derived, like iostat -x: reads and writes per second, bytes per second,
+// average await, average queue size, and %util. /proc/diskstats counts
+// sectors in 512 byte units, regardless of the device's sector size, so bytes
+// are calculated using 512 byte sectors. Each device's logical sector size,
+// /sys/block/[device]/queue/logical_block_size, is provided for information.
 package diskusage
 
 import (
-	"fmt"
-	"io"
+	"context"
+	"path/filepath"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
 	stats "github.com/hmmftg/joefriday/disk/diskstats"
 	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
+const (
+	sysFSBlock = "/sys/block"
+	// sectorBytes is the size of a sector in /proc/diskstats, regardless of
+	// the device's logical sector size.
+	sectorBytes       = 512
+	defaultSectorSize = 512
+)
+
 // Profiler is used to process the IO usage of the block devices.
 type Profiler struct {
 	*stats.Profiler
-	prior *structs.DiskStats
+	prior          *structs.DiskStats
+	index          map[deviceKey]int // prior device index by key
+	matched        []bool            // whether the prior device was matched to a current one
+	sysFSBlockPath string
+	sectorSizes    map[string]uint32 // logical sector size by device name
+	opts           joe.Options
 }
 
 // Returns an initialized Profiler; ready to use. Upon creation, a
 // /proc/diskstats snapshot is taken so that any Get() will return valid
 // information.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := stats.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	p, err := stats.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +69,14 @@ func NewProfiler() (prof *Profiler, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Profiler{Profiler: p, prior: s}, nil
+	return &Profiler{Profiler: p, prior: s, sysFSBlockPath: o.Path(sysFSBlock), sectorSizes: map[string]uint32{}, opts: o}, nil
+}
+
+// SysFSBlockPath enables overriding the default value. This is for testing
+// and should not be used outside of tests.
+func (prof *Profiler) SysFSBlockPath(s string) {
+	prof.sysFSBlockPath = s
+	prof.sectorSizes = map[string]uint32{}
 }
 
 // Get returns the current IO usage of the block devices. Calculating usage
@@ -87,33 +116,155 @@ func Get() (u *structs.DiskUsage, err error) {
 }
 
 // CalculateUsage returns the difference between the current /proc/diskstats
-// snapshot and the prior one.
+// snapshot and the prior one along with the IO rates derived from them.
+// Devices are matched by name, major, and minor, so devices that are added or
+// removed between the snapshots don't affect the usage of the other devices.
+// A device that wasn't in the prior snapshot has its counters used as is,
+// since they were accumulated since the prior snapshot, and is added to Added.
+// A device in the prior snapshot that is no longer present is added to
+// Removed.
 func (prof *Profiler) CalculateUsage(cur *structs.DiskStats) *structs.DiskUsage {
 	u := &structs.DiskUsage{Timestamp: cur.Timestamp, Device: make([]structs.Device, len(cur.Device))}
 	u.TimeDelta = cur.Timestamp - prof.prior.Timestamp
+	if prof.index == nil {
+		prof.index = make(map[deviceKey]int, len(prof.prior.Device))
+	}
+	for k := range prof.index {
+		delete(prof.index, k)
+	}
+	for i := 0; i < len(prof.prior.Device); i++ {
+		prof.index[keyOf(&prof.prior.Device[i])] = i
+	}
+	if cap(prof.matched) < len(prof.prior.Device) {
+		prof.matched = make([]bool, len(prof.prior.Device))
+	}
+	prof.matched = prof.matched[:len(prof.prior.Device)]
+	for i := range prof.matched {
+		prof.matched[i] = false
+	}
+	var prior structs.Device
 	for i := 0; i < len(cur.Device); i++ {
+		j, ok := prof.index[keyOf(&cur.Device[i])]
+		if ok {
+			prof.matched[j] = true
+			prior = prof.prior.Device[j]
+		} else {
+			prior = structs.Device{}
+			u.Added = append(u.Added, cur.Device[i].Name)
+		}
 		u.Device[i].Major = cur.Device[i].Major
 		u.Device[i].Minor = cur.Device[i].Minor
 		u.Device[i].Name = cur.Device[i].Name
-		u.Device[i].ReadsCompleted = cur.Device[i].ReadsCompleted - prof.prior.Device[i].ReadsCompleted
-		u.Device[i].ReadsMerged = cur.Device[i].ReadsMerged - prof.prior.Device[i].ReadsMerged
-		u.Device[i].ReadSectors = cur.Device[i].ReadSectors - prof.prior.Device[i].ReadSectors
-		u.Device[i].ReadingTime = cur.Device[i].ReadingTime - prof.prior.Device[i].ReadingTime
-		u.Device[i].WritesCompleted = cur.Device[i].WritesCompleted - prof.prior.Device[i].WritesCompleted
-		u.Device[i].WritesMerged = cur.Device[i].WritesMerged - prof.prior.Device[i].WritesMerged
-		u.Device[i].WrittenSectors = cur.Device[i].WrittenSectors - prof.prior.Device[i].WrittenSectors
-		u.Device[i].WritingTime = cur.Device[i].WritingTime - prof.prior.Device[i].WritingTime
-		u.Device[i].IOInProgress = cur.Device[i].IOInProgress - prof.prior.Device[i].IOInProgress
-		u.Device[i].IOTime = cur.Device[i].IOTime - prof.prior.Device[i].IOTime
-		u.Device[i].WeightedIOTime = cur.Device[i].WeightedIOTime - prof.prior.Device[i].WeightedIOTime
+		u.Device[i].ReadsCompleted = tools.CounterDelta(cur.Device[i].ReadsCompleted, prior.ReadsCompleted)
+		u.Device[i].ReadsMerged = tools.CounterDelta(cur.Device[i].ReadsMerged, prior.ReadsMerged)
+		u.Device[i].ReadSectors = tools.CounterDelta(cur.Device[i].ReadSectors, prior.ReadSectors)
+		u.Device[i].ReadingTime = tools.CounterDelta(cur.Device[i].ReadingTime, prior.ReadingTime)
+		u.Device[i].WritesCompleted = tools.CounterDelta(cur.Device[i].WritesCompleted, prior.WritesCompleted)
+		u.Device[i].WritesMerged = tools.CounterDelta(cur.Device[i].WritesMerged, prior.WritesMerged)
+		u.Device[i].WrittenSectors = tools.CounterDelta(cur.Device[i].WrittenSectors, prior.WrittenSectors)
+		u.Device[i].WritingTime = tools.CounterDelta(cur.Device[i].WritingTime, prior.WritingTime)
+		// IOInProgress is a gauge, not a counter.
+		u.Device[i].IOInProgress = cur.Device[i].IOInProgress - prior.IOInProgress
+		u.Device[i].IOTime = tools.CounterDelta(cur.Device[i].IOTime, prior.IOTime)
+		u.Device[i].WeightedIOTime = tools.CounterDelta(cur.Device[i].WeightedIOTime, prior.WeightedIOTime)
 	}
+	for i, ok := range prof.matched {
+		if !ok {
+			u.Removed = append(u.Removed, prof.prior.Device[i].Name)
+			delete(prof.sectorSizes, prof.prior.Device[i].Name)
+		}
+	}
+	u.Rates = prof.calculateRates(u)
 	return u
 }
 
+// deviceKey identifies a block device.
+type deviceKey struct {
+	major uint32
+	minor uint32
+	name  string
+}
+
+func keyOf(dev *structs.Device) deviceKey {
+	return deviceKey{major: dev.Major, minor: dev.Minor, name: dev.Name}
+}
+
+// calculateRates derives the per device IO rates from the usage's counter
+// deltas. The await values are the average time, in milliseconds, that the
+// requests completed during the interval took; they are 0 if no requests were
+// completed.
+func (prof *Profiler) calculateRates(u *structs.DiskUsage) []structs.DeviceRates {
+	rates := make([]structs.DeviceRates, len(u.Device))
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	ms := float64(u.TimeDelta) / float64(time.Millisecond)
+	for i := 0; i < len(u.Device); i++ {
+		dev := &u.Device[i]
+		r := &rates[i]
+		r.Major = dev.Major
+		r.Minor = dev.Minor
+		r.Name = dev.Name
+		r.SectorSize = prof.sectorSize(dev.Name)
+		if u.TimeDelta <= 0 {
+			continue
+		}
+		r.ReadsPerSec = float64(dev.ReadsCompleted) / secs
+		r.WritesPerSec = float64(dev.WritesCompleted) / secs
+		r.ReadsMergedPerSec = float64(dev.ReadsMerged) / secs
+		r.WritesMergedPerSec = float64(dev.WritesMerged) / secs
+		r.ReadBytesPerSec = float64(dev.ReadSectors*sectorBytes) / secs
+		r.WriteBytesPerSec = float64(dev.WrittenSectors*sectorBytes) / secs
+		if dev.ReadsCompleted > 0 {
+			r.ReadAwait = float64(dev.ReadingTime) / float64(dev.ReadsCompleted)
+		}
+		if dev.WritesCompleted > 0 {
+			r.WriteAwait = float64(dev.WritingTime) / float64(dev.WritesCompleted)
+		}
+		if dev.ReadsCompleted+dev.WritesCompleted > 0 {
+			r.Await = float64(dev.ReadingTime+dev.WritingTime) / float64(dev.ReadsCompleted+dev.WritesCompleted)
+		}
+		r.AvgQueueSize = float64(dev.WeightedIOTime) / ms
+		r.Util = float64(dev.IOTime) / ms * 100
+		if r.Util > 100 {
+			r.Util = 100
+		}
+	}
+	return rates
+}
+
+// sectorSize returns the logical sector size of the device. Partitions don't
+// have their own queue; the queue of the device that they are a partition of
+// is used. If the sector size can't be determined, 512 is used. Sector sizes
+// are cached by device name and evicted when the device is removed. The
+// sector size is informational, it doesn't affect the rates; see sectorBytes.
+func (prof *Profiler) sectorSize(name string) uint32 {
+	n, ok := prof.sectorSizes[name]
+	if ok {
+		return n
+	}
+	n = defaultSectorSize
+	fname := filepath.Join(prof.sysFSBlockPath, name, "queue", "logical_block_size")
+	_, err := prof.opts.Stat(fname)
+	if err != nil {
+		// a partition's directory is in its device's directory.
+		matches, _ := prof.opts.Glob(filepath.Join(prof.sysFSBlockPath, "*", name))
+		if len(matches) > 0 {
+			fname = filepath.Join(filepath.Dir(matches[0]), "queue", "logical_block_size")
+		}
+	}
+	b, err := prof.opts.ReadFile(fname)
+	if err == nil {
+		v, err := tools.ParseUint(joe.TrimTrailingSpaces(b))
+		if err == nil && v > 0 {
+			n = uint32(v)
+		}
+	}
+	prof.sectorSizes[name] = n
+	return n
+}
+
 // Ticker delivers the system's IO usage of the block devices at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *structs.DiskUsage
+	*ticker.Ticker[*structs.DiskUsage]
 	*Profiler
 }
 
@@ -122,148 +273,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *structs.DiskUsage), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.DiskUsage, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	var (
-		i, priorPos, pos, line, fieldNum int
-		n                                uint64
-		v                                byte
-		err                              error
-		dev                              structs.Device
-		cur                              structs.DiskStats
-	)
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			cur.Timestamp = time.Now().UTC().UnixNano()
-			err = t.Procer.Reset()
-			if err != nil {
-				t.Errs <- err
-				break
-			}
-			cur.Device = cur.Device[:0]
-			// read each line until eof
-			for {
-				t.Val = t.Val[:0]
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					t.Errs <- &joe.ReadError{Err: err}
-					break
-				}
-				line++
-				pos = 0
-				fieldNum = 0
-				// process the fields in the line
-				for {
-					// ignore spaces on the first two fields
-					if fieldNum < 2 {
-						for i, v = range t.Line[pos:] {
-							if v != 0x20 {
-								break
-							}
-						}
-						pos += i
-					}
-					fieldNum++
-					for i, v = range t.Line[pos:] {
-						if v == 0x20 || v == '\n' {
-							break
-						}
-					}
-					if fieldNum != 3 {
-						n, err = tools.ParseUint(t.Line[pos : pos+i])
-						if err != nil {
-							t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-							continue
-						}
-					}
-					priorPos, pos = pos, pos+i+1
-					if fieldNum < 8 {
-						if fieldNum < 4 {
-							if fieldNum < 2 {
-								if fieldNum == 1 {
-									dev.Major = uint32(n)
-									continue
-								}
-								dev.Minor = uint32(n)
-								continue
-							}
-							dev.Name = string(t.Line[priorPos:pos])
-							continue
-						}
-						if fieldNum < 6 {
-							if fieldNum == 4 {
-								dev.ReadsCompleted = n
-								continue
-							}
-							dev.ReadsMerged = n
-							continue
-						}
-						if fieldNum == 6 {
-							dev.ReadSectors = n
-							continue
-						}
-						dev.ReadingTime = n
-						continue
-					}
-					if fieldNum < 12 {
-						if fieldNum < 10 {
-							if fieldNum == 8 {
-								dev.WritesCompleted = n
-								continue
-							}
-							dev.WritesMerged = n
-							continue
-						}
-						if fieldNum == 10 {
-							dev.WrittenSectors = n
-							continue
-						}
-						dev.WritingTime = n
-						continue
-					}
-					if fieldNum == 12 {
-						dev.IOInProgress = int32(n)
-						continue
-					}
-					if fieldNum == 13 {
-						dev.IOTime = n
-						continue
-					}
-					dev.WeightedIOTime = n
-					break
-				}
-				cur.Device = append(cur.Device, dev)
-			}
-			t.Data <- t.CalculateUsage(&cur)
-			// set prior info
-			t.prior.Timestamp = cur.Timestamp
-			if len(t.prior.Device) != len(cur.Device) {
-				t.prior.Device = make([]structs.Device, len(cur.Device))
-			}
-			copy(t.prior.Device, cur.Device)
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
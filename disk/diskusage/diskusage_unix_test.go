@@ -14,12 +14,103 @@
 package diskusage
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/disk/structs"
 )
 
+// statLine returns a /proc/diskstats line for the device with all of its
+// counters set to v and IOInProgress set to inProgress.
+func statLine(major, minor uint32, name string, v uint64, inProgress int32) string {
+	return fmt.Sprintf("%4d %7d %s %d %d %d %d %d %d %d %d %d %d %d\n", major, minor, name, v, v, v, v, v, v, v, v, inProgress, v, v)
+}
+
+// device returns a device with all of its counters set to v and
+// IOInProgress set to inProgress.
+func device(major, minor uint32, name string, v uint64, inProgress int32) structs.Device {
+	return structs.Device{
+		Major: major, Minor: minor, Name: name, ReadsCompleted: v, ReadsMerged: v, ReadSectors: v, ReadingTime: v,
+		WritesCompleted: v, WritesMerged: v, WrittenSectors: v, WritingTime: v, IOInProgress: inProgress, IOTime: v,
+		WeightedIOTime: v,
+	}
+}
+
+func TestCalculateUsageChurn(t *testing.T) {
+	prior, err := joe.NewTempFileProc("diskusage", "diskstats", []byte(
+		statLine(7, 0, "loop0", 100, 0)+
+			statLine(7, 1, "loop1", 200, 0)+
+			statLine(8, 0, "sda", 4294967000, 2)+
+			statLine(8, 16, "sdb", 5000000000, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prior.Remove()
+	// loop0 was detached and a new loop0 was created with a different minor,
+	// loop1 was removed, sdc was plugged in, sda's counters wrapped at 32 bits,
+	// and sdb's counters were reset.
+	cur, err := joe.NewTempFileProc("diskusage", "diskstats", []byte(
+		statLine(7, 2, "loop0", 10, 0)+
+			statLine(8, 0, "sda", 100, 1)+
+			statLine(8, 16, "sdb", 300, 0)+
+			statLine(8, 32, "sdc", 42, 3)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cur.Remove()
+
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// use an empty sysfs tree so the default sector size is used.
+	dir, err := ioutil.TempDir("", "diskusage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	prof.SysFSBlockPath(dir)
+	prof.Procer = prior
+	_, err = prof.Get()
+	if err != nil {
+		t.Fatalf("prior: unexpected error: %s", err)
+	}
+	prof.Procer = cur
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("cur: unexpected error: %s", err)
+	}
+	expected := []structs.Device{
+		device(7, 2, "loop0", 10, 0),
+		device(8, 0, "sda", 396, -1),
+		device(8, 16, "sdb", 300, 0),
+		device(8, 32, "sdc", 42, 3),
+	}
+	if !reflect.DeepEqual(u.Device, expected) {
+		t.Errorf("Device: got %#v; want %#v", u.Device, expected)
+	}
+	if !reflect.DeepEqual(u.Added, []string{"loop0", "sdc"}) {
+		t.Errorf("Added: got %v; want [loop0 sdc]", u.Added)
+	}
+	if !reflect.DeepEqual(u.Removed, []string{"loop0", "loop1"}) {
+		t.Errorf("Removed: got %v; want [loop0 loop1]", u.Removed)
+	}
+	if len(u.Rates) != len(u.Device) {
+		t.Fatalf("Rates: got %d; want %d", len(u.Rates), len(u.Device))
+	}
+	for i := range u.Rates {
+		if u.Rates[i].Name != u.Device[i].Name {
+			t.Errorf("Rates %d: got %s; want %s", i, u.Rates[i].Name, u.Device[i].Name)
+		}
+	}
+}
+
 func TestGet(t *testing.T) {
 	p, err := NewProfiler()
 	if err != nil {
@@ -35,6 +126,95 @@ func TestGet(t *testing.T) {
 	checkUsage("get", st, t)
 }
 
+func TestCalculateRates(t *testing.T) {
+	// sysfs block tree: vda has 4096 byte logical sectors, vda1 is one of its
+	// partitions, and sdz doesn't exist so the default is used. The byte
+	// rates use 512 byte sectors regardless.
+	dir, err := ioutil.TempDir("", "diskusage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.MkdirAll(filepath.Join(dir, "vda", "queue"), 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = os.MkdirAll(filepath.Join(dir, "vda", "vda1"), 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "vda", "queue", "logical_block_size"), []byte("4096\n"), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prior := &structs.DiskStats{
+		Timestamp: 0,
+		Device: []structs.Device{
+			{Major: 253, Minor: 0, Name: "vda", ReadsCompleted: 100, ReadSectors: 1000, ReadingTime: 50, WritesCompleted: 10, WrittenSectors: 80, WritingTime: 20, IOTime: 100, WeightedIOTime: 70},
+			{Major: 253, Minor: 1, Name: "vda1", ReadsCompleted: 100, ReadSectors: 1000, ReadingTime: 50},
+			{Major: 8, Minor: 0, Name: "sdz", IOTime: 1000},
+		},
+	}
+	cur := &structs.DiskStats{
+		Timestamp: int64(2 * time.Second),
+		Device: []structs.Device{
+			{Major: 253, Minor: 0, Name: "vda", ReadsCompleted: 300, ReadsMerged: 10, ReadSectors: 1400, ReadingTime: 450, WritesCompleted: 110, WritesMerged: 4, WrittenSectors: 280, WritingTime: 320, IOTime: 1100, WeightedIOTime: 770},
+			{Major: 253, Minor: 1, Name: "vda1", ReadsCompleted: 300, ReadSectors: 1400, ReadingTime: 450},
+			{Major: 8, Minor: 0, Name: "sdz", IOTime: 4000},
+		},
+	}
+	expected := []structs.DeviceRates{
+		{
+			Major: 253, Minor: 0, Name: "vda", SectorSize: 4096, ReadsPerSec: 100, WritesPerSec: 50,
+			ReadsMergedPerSec: 5, WritesMergedPerSec: 2, ReadBytesPerSec: 102400, WriteBytesPerSec: 51200,
+			ReadAwait: 2, WriteAwait: 3, Await: 7.0 / 3.0, AvgQueueSize: 0.35, Util: 50,
+		},
+		{
+			Major: 253, Minor: 1, Name: "vda1", SectorSize: 4096, ReadsPerSec: 100, ReadBytesPerSec: 102400,
+			ReadAwait: 2, Await: 2,
+		},
+		// IOTime can exceed the elapsed time; util is capped at 100.
+		{Major: 8, Minor: 0, Name: "sdz", SectorSize: 512, Util: 100},
+	}
+	prof := &Profiler{prior: prior}
+	prof.SysFSBlockPath(dir)
+	u := prof.CalculateUsage(cur)
+	if u.TimeDelta != int64(2*time.Second) {
+		t.Errorf("TimeDelta: got %d; want %d", u.TimeDelta, int64(2*time.Second))
+	}
+	if len(u.Rates) != len(expected) {
+		t.Fatalf("Rates: got %d; want %d", len(u.Rates), len(expected))
+	}
+	for i, v := range expected {
+		if u.Rates[i] != v {
+			t.Errorf("%s: got %#v; want %#v", v.Name, u.Rates[i], v)
+		}
+	}
+
+	// a zero time delta results in no rates.
+	prof.prior = cur
+	u = prof.CalculateUsage(cur)
+	for i, v := range u.Rates {
+		if v.ReadsPerSec != 0 || v.Util != 0 || v.AvgQueueSize != 0 {
+			t.Errorf("zero delta: %d: expected rates to be 0; got %#v", i, v)
+		}
+	}
+
+	// the sector size of a removed device is evicted.
+	prof.prior = cur
+	u = prof.CalculateUsage(&structs.DiskStats{Timestamp: cur.Timestamp, Device: cur.Device[:2]})
+	if !reflect.DeepEqual(u.Removed, []string{"sdz"}) {
+		t.Errorf("Removed: got %v; want [sdz]", u.Removed)
+	}
+	if _, ok := prof.sectorSizes["sdz"]; ok {
+		t.Error("sdz: expected the sector size to be evicted")
+	}
+	if _, ok := prof.sectorSizes["vda"]; !ok {
+		t.Error("vda: expected the sector size to be cached")
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -69,6 +249,9 @@ func checkUsage(n string, s *structs.DiskUsage, t *testing.T) {
 	if len(s.Device) == 0 {
 		t.Errorf("%s: expected there to be devices; didn't get any", n)
 	}
+	if len(s.Rates) != len(s.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(s.Rates), len(s.Device))
+	}
 	for i := 0; i < len(s.Device); i++ {
 		if s.Device[i].Major == 0 {
 			t.Errorf("%s: Device %d: Major: wanted a non-zero value, was 0", n, i)
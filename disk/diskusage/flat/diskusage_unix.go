@@ -23,6 +23,7 @@
 package diskusage
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	usage "github.com/hmmftg/joefriday/disk/diskusage"
 	"github.com/hmmftg/joefriday/disk/structs"
 	"github.com/hmmftg/joefriday/disk/structs/flat"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process IO usage of the block devices using Flatbuffers.
@@ -42,8 +44,8 @@ type Profiler struct {
 // Returns an initialized Profiler; ready to use. Upon creation, a
 // /proc/diskstats snapshot is taken so that any Get() will return valid
 // information
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := usage.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -98,6 +100,11 @@ func (prof *Profiler) Serialize(u *structs.DiskUsage) []byte {
 	for i := 0; i < len(names); i++ {
 		names[i] = prof.Builder.CreateString(u.Device[i].Name)
 	}
+	ratesF := make([]fb.UOffsetT, len(u.Rates))
+	rateNames := make([]fb.UOffsetT, len(u.Rates))
+	for i := 0; i < len(rateNames); i++ {
+		rateNames[i] = prof.Builder.CreateString(u.Rates[i].Name)
+	}
 	for i := 0; i < len(devF); i++ {
 		flat.DeviceStart(prof.Builder)
 		flat.DeviceAddMajor(prof.Builder, u.Device[i].Major)
@@ -121,10 +128,55 @@ func (prof *Profiler) Serialize(u *structs.DiskUsage) []byte {
 		prof.Builder.PrependUOffsetT(devF[i])
 	}
 	devV := prof.Builder.EndVector(len(devF))
+	for i := 0; i < len(ratesF); i++ {
+		flat.DeviceRatesStart(prof.Builder)
+		flat.DeviceRatesAddMajor(prof.Builder, u.Rates[i].Major)
+		flat.DeviceRatesAddMinor(prof.Builder, u.Rates[i].Minor)
+		flat.DeviceRatesAddName(prof.Builder, rateNames[i])
+		flat.DeviceRatesAddSectorSize(prof.Builder, u.Rates[i].SectorSize)
+		flat.DeviceRatesAddReadsPerSec(prof.Builder, u.Rates[i].ReadsPerSec)
+		flat.DeviceRatesAddWritesPerSec(prof.Builder, u.Rates[i].WritesPerSec)
+		flat.DeviceRatesAddReadsMergedPerSec(prof.Builder, u.Rates[i].ReadsMergedPerSec)
+		flat.DeviceRatesAddWritesMergedPerSec(prof.Builder, u.Rates[i].WritesMergedPerSec)
+		flat.DeviceRatesAddReadBytesPerSec(prof.Builder, u.Rates[i].ReadBytesPerSec)
+		flat.DeviceRatesAddWriteBytesPerSec(prof.Builder, u.Rates[i].WriteBytesPerSec)
+		flat.DeviceRatesAddReadAwait(prof.Builder, u.Rates[i].ReadAwait)
+		flat.DeviceRatesAddWriteAwait(prof.Builder, u.Rates[i].WriteAwait)
+		flat.DeviceRatesAddAwait(prof.Builder, u.Rates[i].Await)
+		flat.DeviceRatesAddAvgQueueSize(prof.Builder, u.Rates[i].AvgQueueSize)
+		flat.DeviceRatesAddUtil(prof.Builder, u.Rates[i].Util)
+		ratesF[i] = flat.DeviceRatesEnd(prof.Builder)
+	}
+	flat.DiskUsageStartRatesVector(prof.Builder, len(ratesF))
+	for i := len(ratesF) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(ratesF[i])
+	}
+	ratesV := prof.Builder.EndVector(len(ratesF))
+	added := make([]fb.UOffsetT, len(u.Added))
+	for i := 0; i < len(u.Added); i++ {
+		added[i] = prof.Builder.CreateString(u.Added[i])
+	}
+	flat.DiskUsageStartAddedVector(prof.Builder, len(added))
+	for i := len(added) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(added[i])
+	}
+	addedV := prof.Builder.EndVector(len(added))
+	removed := make([]fb.UOffsetT, len(u.Removed))
+	for i := 0; i < len(u.Removed); i++ {
+		removed[i] = prof.Builder.CreateString(u.Removed[i])
+	}
+	flat.DiskUsageStartRemovedVector(prof.Builder, len(removed))
+	for i := len(removed) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(removed[i])
+	}
+	removedV := prof.Builder.EndVector(len(removed))
 	flat.DiskUsageStart(prof.Builder)
 	flat.DiskUsageAddTimestamp(prof.Builder, u.Timestamp)
 	flat.DiskUsageAddTimeDelta(prof.Builder, u.TimeDelta)
 	flat.DiskUsageAddDevice(prof.Builder, devV)
+	flat.DiskUsageAddRates(prof.Builder, ratesV)
+	flat.DiskUsageAddAdded(prof.Builder, addedV)
+	flat.DiskUsageAddRemoved(prof.Builder, removedV)
 	prof.Builder.Finish(flat.DiskUsageEnd(prof.Builder))
 	p := prof.Builder.Bytes[prof.Builder.Head():]
 	// copy them (otherwise gets lost in reset)
@@ -152,6 +204,7 @@ func Serialize(u *structs.DiskUsage) (p []byte, err error) {
 func Deserialize(p []byte) *structs.DiskUsage {
 	u := &structs.DiskUsage{}
 	devF := &flat.Device{}
+	rateF := &flat.DeviceRates{}
 	uF := flat.GetRootAsDiskUsage(p, 0)
 	u.Timestamp = uF.Timestamp()
 	u.TimeDelta = uF.TimeDelta()
@@ -177,13 +230,46 @@ func Deserialize(p []byte) *structs.DiskUsage {
 		}
 		u.Device[i] = dev
 	}
+	u.Rates = make([]structs.DeviceRates, uF.RatesLength())
+	for i := 0; i < uF.RatesLength(); i++ {
+		var r structs.DeviceRates
+		if uF.Rates(rateF, i) {
+			r.Major = rateF.Major()
+			r.Minor = rateF.Minor()
+			r.Name = string(rateF.Name())
+			r.SectorSize = rateF.SectorSize()
+			r.ReadsPerSec = rateF.ReadsPerSec()
+			r.WritesPerSec = rateF.WritesPerSec()
+			r.ReadsMergedPerSec = rateF.ReadsMergedPerSec()
+			r.WritesMergedPerSec = rateF.WritesMergedPerSec()
+			r.ReadBytesPerSec = rateF.ReadBytesPerSec()
+			r.WriteBytesPerSec = rateF.WriteBytesPerSec()
+			r.ReadAwait = rateF.ReadAwait()
+			r.WriteAwait = rateF.WriteAwait()
+			r.Await = rateF.Await()
+			r.AvgQueueSize = rateF.AvgQueueSize()
+			r.Util = rateF.Util()
+		}
+		u.Rates[i] = r
+	}
+	if uF.AddedLength() > 0 {
+		u.Added = make([]string, uF.AddedLength())
+		for i := 0; i < uF.AddedLength(); i++ {
+			u.Added[i] = string(uF.Added(i))
+		}
+	}
+	if uF.RemovedLength() > 0 {
+		u.Removed = make([]string, uF.RemovedLength())
+		for i := 0; i < uF.RemovedLength(); i++ {
+			u.Removed[i] = string(uF.Removed(i))
+		}
+	}
 	return u
 }
 
 // Ticker delivers the system's IO usage of the block devices at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -192,35 +278,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -14,6 +14,7 @@
 package diskusage
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -35,6 +36,32 @@ func TestSerializeDeserialize(t *testing.T) {
 	checkUsage("get", u, t)
 }
 
+func TestSerializeDeserializeRates(t *testing.T) {
+	u := &structs.DiskUsage{
+		Timestamp: 2000, TimeDelta: 1000,
+		Device: []structs.Device{
+			{Major: 253, Minor: 0, Name: "vda", ReadsCompleted: 200, ReadSectors: 400, IOTime: 500},
+		},
+		Rates: []structs.DeviceRates{
+			{
+				Major: 253, Minor: 0, Name: "vda", SectorSize: 512, ReadsPerSec: 100, WritesPerSec: 50,
+				ReadsMergedPerSec: 5, WritesMergedPerSec: 2, ReadBytesPerSec: 102400, WriteBytesPerSec: 51200,
+				ReadAwait: 2, WriteAwait: 3, Await: 2.5, AvgQueueSize: 0.35, Util: 50,
+			},
+		},
+		Added:   []string{"vda"},
+		Removed: []string{"sdb", "sdc"},
+	}
+	p, err := Serialize(u)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	uD := Deserialize(p)
+	if !reflect.DeepEqual(u, uD) {
+		t.Errorf("got %#v; want %#v", uD, u)
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -70,6 +97,9 @@ func checkUsage(n string, u *structs.DiskUsage, t *testing.T) {
 	if len(u.Device) == 0 {
 		t.Errorf("%s: expected there to be devices; didn't get any", n)
 	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
 	for i := 0; i < len(u.Device); i++ {
 		if u.Device[i].Major == 0 {
 			t.Errorf("%s: Device %d: Major: wanted a non-zero value, was 0", n, i)
@@ -23,6 +23,7 @@
 package diskusage
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	usage "github.com/hmmftg/joefriday/disk/diskusage"
 	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process IO usage of the block devices using JSON.
@@ -40,8 +42,8 @@ type Profiler struct {
 // Returns an initialized Profiler; ready to use. Upon creation, a
 // /proc/diskstats snapshot is taken so that any Get() will return valid
 // information.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := usage.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -130,8 +132,7 @@ func Unmarshal(p []byte) (*structs.DiskUsage, error) {
 
 // Ticker delivers the system's IO usage of the block devices at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -140,35 +141,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -79,6 +79,9 @@ func checkUsage(n string, u *structs.DiskUsage, t *testing.T) {
 	if len(u.Device) == 0 {
 		t.Errorf("%s: expected there to be devices; didn't get any", n)
 	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
 	for i := 0; i < len(u.Device); i++ {
 		if u.Device[i].Major == 0 {
 			t.Errorf("%s: Device %d: Major: wanted a non-zero value, was 0", n, i)
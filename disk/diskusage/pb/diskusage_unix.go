@@ -0,0 +1,284 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diskusage calculates IO usage the of block devices. Usage is
+// calculated by taking the difference between tow snapshots of IO statistics
+// of block devices, /proc/diskstats. The time elapsed between the two
+// snapshots is stored in the TimeDelta field. Instead of returning a Go
+// struct, it returns protobuf serialized bytes. A function to deserialize the
+// protobuf serialized bytes into a struct.DiskUsage struct is provided.
+//
+// Note: the package name is diskusage and not the final element of the import
+// path (pb).
+package diskusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/disk/diskusage"
+	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/disk/structs/pb"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process IO usage of the block devices using protobuf.
+type Profiler struct {
+	*usage.Profiler
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a
+// /proc/diskstats snapshot is taken so that any Get() will return valid
+// information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current IO usage of the block devices as protobuf serialized
+// bytes. Calculating usage requires two snapshots. This func gets the current
+// snapshot of /proc/diskstats and calculates the difference between that and
+// the prior snapshot. The current snapshot is stored for use as the prior
+// snapshot on the next Get call. If ongoing usage information is desired, the
+// Ticker should be used; it's better suited for ongoing usage information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current IO usage of the block devices as protobuf serialized
+// bytes using the package's global Profiler.  The Profiler is instantiated
+// lazily. If the profiler doesn't already exist, the first utilization
+// information will not be useful due to minimal time elapsing between the
+// initial and second snapshots used for utilization calculations; the results
+// of the first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize IO usage of the block devices using protobuf.
+func (prof *Profiler) Serialize(u *structs.DiskUsage) ([]byte, error) {
+	return proto.Marshal(toProto(u))
+}
+
+// Serialize IO usage of the block devices as protobuf using the package's
+// global Profiler.
+func Serialize(u *structs.DiskUsage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(u *structs.DiskUsage) ([]byte, error) {
+	return prof.Serialize(u)
+}
+
+// Marshal is an alias for Serialize using the package global Profiler.
+func Marshal(u *structs.DiskUsage) ([]byte, error) {
+	return Serialize(u)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// structs.DiskUsage.
+func Deserialize(p []byte) (*structs.DiskUsage, error) {
+	m := &pb.DiskUsage{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.DiskUsage, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a structs.DiskUsage into a new pb.DiskUsage message.
+func toProto(u *structs.DiskUsage) *pb.DiskUsage {
+	m := &pb.DiskUsage{
+		Timestamp: u.Timestamp,
+		TimeDelta: u.TimeDelta,
+		Device:    make([]*pb.Device, len(u.Device)),
+		Rates:     make([]*pb.DeviceRates, len(u.Rates)),
+		Added:     u.Added,
+		Removed:   u.Removed,
+	}
+	for i := range u.Device {
+		m.Device[i] = deviceToProto(&u.Device[i])
+	}
+	for i := range u.Rates {
+		m.Rates[i] = deviceRatesToProto(&u.Rates[i])
+	}
+	return m
+}
+
+// fromProto copies a pb.DiskUsage message into a structs.DiskUsage.
+func fromProto(m *pb.DiskUsage) *structs.DiskUsage {
+	u := &structs.DiskUsage{
+		Timestamp: m.Timestamp,
+		TimeDelta: m.TimeDelta,
+		Device:    make([]structs.Device, len(m.Device)),
+		Rates:     make([]structs.DeviceRates, len(m.Rates)),
+		Added:     m.Added,
+		Removed:   m.Removed,
+	}
+	for i, d := range m.Device {
+		u.Device[i] = deviceFromProto(d)
+	}
+	for i, r := range m.Rates {
+		u.Rates[i] = deviceRatesFromProto(r)
+	}
+	return u
+}
+
+// deviceToProto copies a structs.Device into a new pb.Device message.
+func deviceToProto(d *structs.Device) *pb.Device {
+	return &pb.Device{
+		Major:           d.Major,
+		Minor:           d.Minor,
+		Name:            d.Name,
+		ReadsCompleted:  d.ReadsCompleted,
+		ReadsMerged:     d.ReadsMerged,
+		ReadSectors:     d.ReadSectors,
+		ReadingTime:     d.ReadingTime,
+		WritesCompleted: d.WritesCompleted,
+		WritesMerged:    d.WritesMerged,
+		WrittenSectors:  d.WrittenSectors,
+		WritingTime:     d.WritingTime,
+		IoInProgress:    d.IOInProgress,
+		IoTime:          d.IOTime,
+		WeightedIoTime:  d.WeightedIOTime,
+	}
+}
+
+// deviceFromProto copies a pb.Device message into a structs.Device.
+func deviceFromProto(m *pb.Device) structs.Device {
+	return structs.Device{
+		Major:           m.Major,
+		Minor:           m.Minor,
+		Name:            m.Name,
+		ReadsCompleted:  m.ReadsCompleted,
+		ReadsMerged:     m.ReadsMerged,
+		ReadSectors:     m.ReadSectors,
+		ReadingTime:     m.ReadingTime,
+		WritesCompleted: m.WritesCompleted,
+		WritesMerged:    m.WritesMerged,
+		WrittenSectors:  m.WrittenSectors,
+		WritingTime:     m.WritingTime,
+		IOInProgress:    m.IoInProgress,
+		IOTime:          m.IoTime,
+		WeightedIOTime:  m.WeightedIoTime,
+	}
+}
+
+// deviceRatesToProto copies a structs.DeviceRates into a new pb.DeviceRates
+// message.
+func deviceRatesToProto(d *structs.DeviceRates) *pb.DeviceRates {
+	return &pb.DeviceRates{
+		Major:              d.Major,
+		Minor:              d.Minor,
+		Name:               d.Name,
+		SectorSize:         d.SectorSize,
+		ReadsPerSec:        d.ReadsPerSec,
+		WritesPerSec:       d.WritesPerSec,
+		ReadsMergedPerSec:  d.ReadsMergedPerSec,
+		WritesMergedPerSec: d.WritesMergedPerSec,
+		ReadBytesPerSec:    d.ReadBytesPerSec,
+		WriteBytesPerSec:   d.WriteBytesPerSec,
+		ReadAwait:          d.ReadAwait,
+		WriteAwait:         d.WriteAwait,
+		Await:              d.Await,
+		AvgQueueSize:       d.AvgQueueSize,
+		Util:               d.Util,
+	}
+}
+
+// deviceRatesFromProto copies a pb.DeviceRates message into a
+// structs.DeviceRates.
+func deviceRatesFromProto(m *pb.DeviceRates) structs.DeviceRates {
+	return structs.DeviceRates{
+		Major:              m.Major,
+		Minor:              m.Minor,
+		Name:               m.Name,
+		SectorSize:         m.SectorSize,
+		ReadsPerSec:        m.ReadsPerSec,
+		WritesPerSec:       m.WritesPerSec,
+		ReadsMergedPerSec:  m.ReadsMergedPerSec,
+		WritesMergedPerSec: m.WritesMergedPerSec,
+		ReadBytesPerSec:    m.ReadBytesPerSec,
+		WriteBytesPerSec:   m.WriteBytesPerSec,
+		ReadAwait:          m.ReadAwait,
+		WriteAwait:         m.WriteAwait,
+		Await:              m.Await,
+		AvgQueueSize:       m.AvgQueueSize,
+		Util:               m.Util,
+	}
+}
+
+// Ticker delivers the system's IO usage of the block devices at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,74 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diskusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/testinfo/codectest"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	codectest.RoundTrip(t, codectest.DiskUsage(), Serialize, Deserialize)
+}
+
+func TestGet(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	b, err := p.Get()
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	u, err := Unmarshal(b)
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	codectest.CheckDiskUsage(t, "get", u)
+	t.Logf("%#v\n", u)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			codectest.CheckDiskUsage(t, "ticker", u)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
@@ -0,0 +1,208 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fsusage handles processing of the capacity and inode usage of
+// mounted filesystems. Instead of returning a Go struct, it returns
+// Flatbuffer serialized bytes. A function to deserialize the Flatbuffer
+// serialized bytes into a structs.FSUsage struct is provided.
+//
+// Note: the package name is fsusage and not the final element of the import
+// path (flat).
+package fsusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/disk/fsusage"
+	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/disk/structs/flat"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to get the usage of the mounted filesystems as Flatbuffer
+// serialized bytes.
+type Profiler struct {
+	*usage.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current usage information of the mounted filesystems as
+// Flatbuffer serialized bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current usage information of the mounted filesystems as
+// Flatbuffer serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		std.Builder.Reset()
+	}
+
+	return std.Get()
+}
+
+// Serialize serializes structs.FSUsage as Flatbuffer serialized bytes.
+func (prof *Profiler) Serialize(u *structs.FSUsage) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	fsF := make([]fb.UOffsetT, len(u.Filesystem))
+	sources := make([]fb.UOffsetT, len(u.Filesystem))
+	mountPoints := make([]fb.UOffsetT, len(u.Filesystem))
+	roots := make([]fb.UOffsetT, len(u.Filesystem))
+	fsTypes := make([]fb.UOffsetT, len(u.Filesystem))
+	options := make([]fb.UOffsetT, len(u.Filesystem))
+	for i := 0; i < len(fsF); i++ {
+		sources[i] = prof.Builder.CreateString(u.Filesystem[i].Source)
+		mountPoints[i] = prof.Builder.CreateString(u.Filesystem[i].MountPoint)
+		roots[i] = prof.Builder.CreateString(u.Filesystem[i].Root)
+		fsTypes[i] = prof.Builder.CreateString(u.Filesystem[i].FSType)
+		options[i] = prof.Builder.CreateString(u.Filesystem[i].Options)
+	}
+	for i := 0; i < len(fsF); i++ {
+		flat.FilesystemStart(prof.Builder)
+		flat.FilesystemAddMajor(prof.Builder, u.Filesystem[i].Major)
+		flat.FilesystemAddMinor(prof.Builder, u.Filesystem[i].Minor)
+		flat.FilesystemAddSource(prof.Builder, sources[i])
+		flat.FilesystemAddMountPoint(prof.Builder, mountPoints[i])
+		flat.FilesystemAddRoot(prof.Builder, roots[i])
+		flat.FilesystemAddFSType(prof.Builder, fsTypes[i])
+		flat.FilesystemAddOptions(prof.Builder, options[i])
+		flat.FilesystemAddBlockSize(prof.Builder, u.Filesystem[i].BlockSize)
+		flat.FilesystemAddSize(prof.Builder, u.Filesystem[i].Size)
+		flat.FilesystemAddUsed(prof.Builder, u.Filesystem[i].Used)
+		flat.FilesystemAddAvailable(prof.Builder, u.Filesystem[i].Available)
+		flat.FilesystemAddInodes(prof.Builder, u.Filesystem[i].Inodes)
+		flat.FilesystemAddInodesUsed(prof.Builder, u.Filesystem[i].InodesUsed)
+		flat.FilesystemAddInodesFree(prof.Builder, u.Filesystem[i].InodesFree)
+		fsF[i] = flat.FilesystemEnd(prof.Builder)
+	}
+	flat.FSUsageStartFilesystemVector(prof.Builder, len(fsF))
+	for i := len(fsF) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(fsF[i])
+	}
+	fsV := prof.Builder.EndVector(len(fsF))
+	flat.FSUsageStart(prof.Builder)
+	flat.FSUsageAddTimestamp(prof.Builder, u.Timestamp)
+	flat.FSUsageAddFilesystem(prof.Builder, fsV)
+	prof.Builder.Finish(flat.FSUsageEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize serializes structs.FSUsage as Flatbuffer serialized bytes using
+// the package's global Profiler.
+func Serialize(u *structs.FSUsage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserialize's them
+// as a structs.FSUsage.
+func Deserialize(p []byte) *structs.FSUsage {
+	u := &structs.FSUsage{}
+	fsF := &flat.Filesystem{}
+	usageFlat := flat.GetRootAsFSUsage(p, 0)
+	u.Timestamp = usageFlat.Timestamp()
+	len := usageFlat.FilesystemLength()
+	u.Filesystem = make([]structs.Filesystem, len)
+	for i := 0; i < len; i++ {
+		var fs structs.Filesystem
+		if usageFlat.Filesystem(fsF, i) {
+			fs.Major = fsF.Major()
+			fs.Minor = fsF.Minor()
+			fs.Source = string(fsF.Source())
+			fs.MountPoint = string(fsF.MountPoint())
+			fs.Root = string(fsF.Root())
+			fs.FSType = string(fsF.FSType())
+			fs.Options = string(fsF.Options())
+			fs.BlockSize = fsF.BlockSize()
+			fs.Size = fsF.Size()
+			fs.Used = fsF.Used()
+			fs.Available = fsF.Available()
+			fs.Inodes = fsF.Inodes()
+			fs.InodesUsed = fsF.InodesUsed()
+			fs.InodesFree = fsF.InodesFree()
+		}
+		u.Filesystem[i] = fs
+	}
+	return u
+}
+
+// Ticker delivers the usage information of the mounted filesystems at
+// intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
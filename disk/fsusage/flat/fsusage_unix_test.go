@@ -0,0 +1,119 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsusage
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/disk/structs"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	u := &structs.FSUsage{
+		Timestamp: 1000,
+		Filesystem: []structs.Filesystem{
+			{
+				Major: 8, Minor: 1, Source: "/dev/sda1", MountPoint: "/", Root: "/", FSType: "ext4",
+				Options: "rw,relatime", BlockSize: 4096, Size: 4096000, Used: 2457600, Available: 1433600,
+				Inodes: 500, InodesUsed: 400, InodesFree: 100,
+			},
+			{
+				Major: 0, Minor: 26, Source: "tmpfs", MountPoint: "/run", Root: "/", FSType: "tmpfs",
+				Options: "rw,nosuid", BlockSize: 4096, Size: 40960, Used: 4096, Available: 36864,
+				Inodes: 100, InodesUsed: 10, InodesFree: 90,
+			},
+		},
+	}
+	p, err := Serialize(u)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	uD := Deserialize(p)
+	if !reflect.DeepEqual(u, uD) {
+		t.Errorf("got %#v; want %#v", uD, u)
+	}
+
+	p, err = Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkUsage("get", Deserialize(p), t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", Deserialize(v), t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *structs.FSUsage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if len(u.Filesystem) == 0 {
+		t.Errorf("%s: expected there to be filesystems; didn't get any", n)
+	}
+	for i := 0; i < len(u.Filesystem); i++ {
+		if u.Filesystem[i].MountPoint == "" {
+			t.Errorf("%s: Filesystem %d: MountPoint: wanted a non-empty value; was empty", n, i)
+		}
+		if u.Filesystem[i].Size == 0 {
+			t.Errorf("%s: Filesystem %d: Size: wanted a non-zero value, was 0", n, i)
+		}
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	u, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = p.Serialize(u)
+	}
+	_ = tmp
+}
+
+var usg *structs.FSUsage
+
+func BenchmarkDeserialize(b *testing.B) {
+	p, _ := NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		usg = Deserialize(tmp)
+	}
+	_ = usg
+}
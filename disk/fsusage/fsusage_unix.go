@@ -0,0 +1,307 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fsusage handles processing of the capacity and inode usage of
+// mounted filesystems. The mounts are read from /proc/self/mountinfo and each
+// mount point is statfs'd. By default, pseudo filesystems, e.g. proc, sysfs,
+// cgroup, and filesystems that report no blocks, are skipped.
+//
+// When a root is configured, e.g. with joe.WithRoot, the mount points are
+// statfs'd within it. When the files are read from a joe.WithFS file system,
+// there is nothing to statfs: the capacity and inode columns are unavailable
+// and are left as zero, and filesystems that report no blocks aren't skipped.
+package fsusage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"syscall"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const procFile = "/proc/self/mountinfo"
+
+// pseudoFS is the set of filesystem types that don't have any backing
+// storage.
+var pseudoFS = map[string]struct{}{
+	"autofs":      {},
+	"binfmt_misc": {},
+	"bpf":         {},
+	"cgroup":      {},
+	"cgroup2":     {},
+	"configfs":    {},
+	"debugfs":     {},
+	"devpts":      {},
+	"efivarfs":    {},
+	"fusectl":     {},
+	"hugetlbfs":   {},
+	"mqueue":      {},
+	"nsfs":        {},
+	"proc":        {},
+	"pstore":      {},
+	"rpc_pipefs":  {},
+	"securityfs":  {},
+	"selinuxfs":   {},
+	"sysfs":       {},
+	"tracefs":     {},
+}
+
+// IsPseudo returns whether or not the filesystem type is a pseudo filesystem.
+func IsPseudo(fsType string) bool {
+	_, ok := pseudoFS[fsType]
+	return ok
+}
+
+// Profiler is used to process the /proc/self/mountinfo file and get the
+// usage information of each mounted filesystem.
+type Profiler struct {
+	joe.Procer
+	*joe.Buffer
+	// IncludePseudo: when true, pseudo filesystems and filesystems that
+	// report no blocks are included in the results.
+	IncludePseudo bool
+	statfs        func(path string, buf *syscall.Statfs_t) error
+	opts          joe.Options
+}
+
+// Returns an initialized Profiler; ready to use. Pseudo filesystems are
+// excluded; set IncludePseudo to include them.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Procer: proc, Buffer: joe.NewBuffer(), statfs: syscall.Statfs, opts: o}, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	return prof.Procer.Reset()
+}
+
+// Get returns the current usage information of the mounted filesystems.
+// Mount points that cannot be statfs'd because they no longer exist, are not
+// accessible, or are stale are skipped.
+func (prof *Profiler) Get() (usage *structs.FSUsage, err error) {
+	err = prof.Reset()
+	if err != nil {
+		return nil, err
+	}
+	var (
+		i, pos, line, fieldNum int
+		n                      uint64
+		optional               bool
+		fs                     structs.Filesystem
+		st                     syscall.Statfs_t
+	)
+
+	usage = &structs.FSUsage{Timestamp: time.Now().UTC().UnixNano(), Filesystem: make([]structs.Filesystem, 0, 8)}
+
+	// read each line until eof
+	for {
+		prof.Line, err = prof.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, &joe.ReadError{Err: err}
+		}
+		line++
+		pos = 0
+		fieldNum = 0
+		optional = false
+		fs = structs.Filesystem{}
+		// process the fields in the line
+		for pos < len(prof.Line) {
+			for i = pos; i < len(prof.Line); i++ {
+				if prof.Line[i] == 0x20 || prof.Line[i] == '\n' {
+					break
+				}
+			}
+			prof.Val = prof.Line[pos:i]
+			pos = i + 1
+			fieldNum++
+			// the optional fields are variable in number and are terminated by a
+			// single hyphen; the fields after it are numbered from 100 so they
+			// are identifiable regardless of how many optional fields there were.
+			if optional {
+				if len(prof.Val) == 1 && prof.Val[0] == '-' {
+					optional = false
+					fieldNum = 100
+				}
+				continue
+			}
+			switch fieldNum {
+			case 3: // major:minor
+				for i = 0; i < len(prof.Val); i++ {
+					if prof.Val[i] == ':' {
+						break
+					}
+				}
+				if i == len(prof.Val) {
+					return nil, &joe.ParseError{Info: fmt.Sprintf("line %d: field %d: major:minor", line, fieldNum), Err: fmt.Errorf("%q: missing ':'", prof.Val)}
+				}
+				n, err = tools.ParseUint(prof.Val[:i])
+				if err != nil {
+					return nil, &joe.ParseError{Info: fmt.Sprintf("line %d: field %d: major", line, fieldNum), Err: err}
+				}
+				fs.Major = uint32(n)
+				n, err = tools.ParseUint(prof.Val[i+1:])
+				if err != nil {
+					return nil, &joe.ParseError{Info: fmt.Sprintf("line %d: field %d: minor", line, fieldNum), Err: err}
+				}
+				fs.Minor = uint32(n)
+			case 4:
+				fs.Root = unescape(prof.Val)
+			case 5:
+				fs.MountPoint = unescape(prof.Val)
+			case 6:
+				fs.Options = string(prof.Val)
+				optional = true
+			case 101:
+				fs.FSType = string(prof.Val)
+			case 102:
+				fs.Source = unescape(prof.Val)
+			}
+		}
+		if fs.FSType == "" {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("line %d", line), Err: fmt.Errorf("unexpected format: filesystem type not found")}
+		}
+		if !prof.IncludePseudo && IsPseudo(fs.FSType) {
+			continue
+		}
+		// a file system's mounts can't be statfs'd.
+		if prof.opts.FS != nil {
+			usage.Filesystem = append(usage.Filesystem, fs)
+			continue
+		}
+		err = prof.statfs(prof.opts.Path(fs.MountPoint), &st)
+		if err != nil {
+			if skipStatfsErr(err) {
+				continue
+			}
+			return nil, &joe.ReadError{Info: fs.MountPoint, Err: err}
+		}
+		if !prof.IncludePseudo && st.Blocks == 0 {
+			continue
+		}
+		fs.BlockSize = uint64(st.Frsize)
+		if fs.BlockSize == 0 {
+			fs.BlockSize = uint64(st.Bsize)
+		}
+		fs.Size = st.Blocks * fs.BlockSize
+		fs.Used = (st.Blocks - st.Bfree) * fs.BlockSize
+		fs.Available = st.Bavail * fs.BlockSize
+		fs.Inodes = st.Files
+		fs.InodesFree = st.Ffree
+		fs.InodesUsed = st.Files - st.Ffree
+		usage.Filesystem = append(usage.Filesystem, fs)
+	}
+	return usage, nil
+}
+
+// skipStatfsErr returns whether or not the statfs error is one that should
+// result in the mount being skipped instead of the error being returned.
+func skipStatfsErr(err error) bool {
+	switch err {
+	case syscall.EACCES, syscall.EPERM, syscall.ENOENT, syscall.ENOTCONN, syscall.ESTALE:
+		return true
+	}
+	return false
+}
+
+// unescape returns the string with the octal escapes that the kernel uses
+// for space, tab, newline, and backslash in mountinfo replaced by their
+// characters.
+func unescape(b []byte) string {
+	var i int
+	for i = 0; i < len(b); i++ {
+		if b[i] == '\\' {
+			break
+		}
+	}
+	if i == len(b) {
+		return string(b)
+	}
+	s := make([]byte, 0, len(b))
+	for i = 0; i < len(b); i++ {
+		if b[i] == '\\' && i+3 < len(b) && isOctal(b[i+1]) && isOctal(b[i+2]) && isOctal(b[i+3]) {
+			s = append(s, (b[i+1]-'0')<<6|(b[i+2]-'0')<<3|(b[i+3]-'0'))
+			i += 3
+			continue
+		}
+		s = append(s, b[i])
+	}
+	return string(s)
+}
+
+func isOctal(v byte) bool {
+	return v >= '0' && v <= '7'
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current usage information of the mounted filesystems using
+// the package's global Profiler.
+func Get() (usage *structs.FSUsage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Ticker delivers the usage information of the mounted filesystems at
+// intervals.
+type Ticker struct {
+	*ticker.Ticker[*structs.FSUsage]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.FSUsage, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
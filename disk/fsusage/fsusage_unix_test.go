@@ -0,0 +1,253 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsusage
+
+import (
+	"reflect"
+	"syscall"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/disk/structs"
+)
+
+var mountinfo = []byte(`22 28 0:21 / /proc rw,nosuid,nodev,noexec,relatime shared:12 - proc proc rw
+28 1 8:1 / / rw,relatime shared:1 - ext4 /dev/sda1 rw,errors=remount-ro
+30 28 8:17 /data /mnt/my\040disk rw,noatime shared:2 master:1 - xfs /dev/sdb1 rw,attr2
+31 28 0:26 / /run rw,nosuid,nodev,noexec - tmpfs tmpfs rw,size=814832k,mode=755
+32 28 0:27 / /gone rw - nfs4 server:/export rw
+`)
+
+// statfs returns canned values for the fixture's mount points.
+func statfs(path string, buf *syscall.Statfs_t) error {
+	*buf = syscall.Statfs_t{}
+	switch path {
+	case "/proc":
+		buf.Bsize = 4096
+	case "/":
+		buf.Bsize = 4096
+		buf.Blocks = 1000
+		buf.Bfree = 400
+		buf.Bavail = 350
+		buf.Files = 500
+		buf.Ffree = 100
+	case "/mnt/my disk":
+		buf.Bsize = 4096
+		buf.Frsize = 512
+		buf.Blocks = 2000
+		buf.Bfree = 2000
+		buf.Bavail = 2000
+		buf.Files = 10
+		buf.Ffree = 10
+	case "/run":
+		buf.Bsize = 4096
+		buf.Blocks = 10
+		buf.Bfree = 9
+		buf.Bavail = 9
+		buf.Files = 100
+		buf.Ffree = 90
+	case "/gone":
+		return syscall.ESTALE
+	}
+	return nil
+}
+
+func TestParse(t *testing.T) {
+	tProc, err := joe.NewTempFileProc("fsusage", "mountinfo", mountinfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tProc.Remove()
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.Procer = tProc
+	prof.statfs = statfs
+
+	expected := []structs.Filesystem{
+		{
+			Major: 8, Minor: 1, Source: "/dev/sda1", MountPoint: "/", Root: "/", FSType: "ext4",
+			Options: "rw,relatime", BlockSize: 4096, Size: 4096000, Used: 2457600, Available: 1433600,
+			Inodes: 500, InodesUsed: 400, InodesFree: 100,
+		},
+		{
+			Major: 8, Minor: 17, Source: "/dev/sdb1", MountPoint: "/mnt/my disk", Root: "/data", FSType: "xfs",
+			Options: "rw,noatime", BlockSize: 512, Size: 1024000, Used: 0, Available: 1024000,
+			Inodes: 10, InodesUsed: 0, InodesFree: 10,
+		},
+		{
+			Major: 0, Minor: 26, Source: "tmpfs", MountPoint: "/run", Root: "/", FSType: "tmpfs",
+			Options: "rw,nosuid,nodev,noexec", BlockSize: 4096, Size: 40960, Used: 4096, Available: 36864,
+			Inodes: 100, InodesUsed: 10, InodesFree: 90,
+		},
+	}
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if u.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	if !reflect.DeepEqual(u.Filesystem, expected) {
+		t.Errorf("got %#v; want %#v", u.Filesystem, expected)
+	}
+
+	// with pseudo filesystems, /proc is included; the stale mount is still
+	// skipped.
+	prof.IncludePseudo = true
+	u, err = prof.Get()
+	if err != nil {
+		t.Fatalf("include pseudo: unexpected error: %s", err)
+	}
+	if len(u.Filesystem) != 4 {
+		t.Fatalf("include pseudo: got %d filesystems; want 4", len(u.Filesystem))
+	}
+	if u.Filesystem[0].FSType != "proc" {
+		t.Errorf("include pseudo: FSType: got %q; want \"proc\"", u.Filesystem[0].FSType)
+	}
+}
+
+func TestOptions(t *testing.T) {
+	// with a root, the mount points are statfs'd within it.
+	tProc, err := joe.NewTempFileProc("fsusage", "mountinfo", mountinfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tProc.Remove()
+	prof := &Profiler{Procer: tProc, Buffer: joe.NewBuffer(), opts: joe.NewOptions(joe.WithRoot("/host"))}
+	var paths []string
+	prof.statfs = func(path string, buf *syscall.Statfs_t) error {
+		paths = append(paths, path)
+		*buf = syscall.Statfs_t{Bsize: 4096, Blocks: 1}
+		return nil
+	}
+	_, err = prof.Get()
+	if err != nil {
+		t.Fatalf("root: unexpected error: %s", err)
+	}
+	want := []string{"/host", "/host/mnt/my disk", "/host/run", "/host/gone"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("root: statfs'd %q; want %q", paths, want)
+	}
+
+	// with a file system, nothing is statfs'd.
+	fsys := fstest.MapFS{"proc/self/mountinfo": &fstest.MapFile{Data: mountinfo}}
+	prof, err = NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.statfs = func(path string, buf *syscall.Statfs_t) error {
+		t.Errorf("fs: unexpected statfs of %q", path)
+		return nil
+	}
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("fs: unexpected error: %s", err)
+	}
+	if len(u.Filesystem) != 4 {
+		t.Fatalf("fs: got %d filesystems; want 4", len(u.Filesystem))
+	}
+	if u.Filesystem[1].MountPoint != "/mnt/my disk" || u.Filesystem[1].Size != 0 {
+		t.Errorf("fs: got %#v; want /mnt/my disk with no capacity", u.Filesystem[1])
+	}
+}
+
+func TestUnescape(t *testing.T) {
+	tests := []struct {
+		v        string
+		expected string
+	}{
+		{"/", "/"},
+		{`/mnt/a\040b`, "/mnt/a b"},
+		{`/a\011b\012c\134d`, "/a\tb\nc\\d"},
+		{`/a\04`, `/a\04`},
+		{`/a\09x`, `/a\09x`},
+	}
+	for _, test := range tests {
+		s := unescape([]byte(test.v))
+		if s != test.expected {
+			t.Errorf("%q: got %q; want %q", test.v, s, test.expected)
+		}
+	}
+}
+
+func TestGet(t *testing.T) {
+	u, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkUsage("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *structs.FSUsage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if len(u.Filesystem) == 0 {
+		t.Errorf("%s: expected there to be filesystems; didn't get any", n)
+	}
+	for i := 0; i < len(u.Filesystem); i++ {
+		if u.Filesystem[i].MountPoint == "" {
+			t.Errorf("%s: Filesystem %d: MountPoint: wanted a non-empty value; was empty", n, i)
+		}
+		if IsPseudo(u.Filesystem[i].FSType) {
+			t.Errorf("%s: Filesystem %d: %s is a pseudo filesystem; expected it to be skipped", n, i, u.Filesystem[i].FSType)
+		}
+		if u.Filesystem[i].Size == 0 {
+			t.Errorf("%s: Filesystem %d: Size: wanted a non-zero value, was 0", n, i)
+		}
+		if u.Filesystem[i].Used > u.Filesystem[i].Size {
+			t.Errorf("%s: Filesystem %d: Used %d is greater than Size %d", n, i, u.Filesystem[i].Used, u.Filesystem[i].Size)
+		}
+	}
+}
+
+var usage *structs.FSUsage
+
+func BenchmarkGet(b *testing.B) {
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		usage, _ = p.Get()
+	}
+	_ = usage
+}
@@ -0,0 +1,150 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fsusage handles processing of the capacity and inode usage of
+// mounted filesystems. Instead of returning a Go struct, it returns JSON
+// serialized bytes. A function to deserialize the JSON serialized bytes into a
+// structs.FSUsage struct is provided.
+//
+// Note: the package name is fsusage and not the final element of the import
+// path (json).
+package fsusage
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/disk/fsusage"
+	"github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to get the usage of the mounted filesystems as JSON
+// serialized bytes.
+type Profiler struct {
+	*usage.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current usage information of the mounted filesystems as
+// JSON serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	st, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(st)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current usage information of the mounted filesystems as
+// JSON serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize structs.FSUsage using JSON.
+func (prof *Profiler) Serialize(st *structs.FSUsage) ([]byte, error) {
+	return json.Marshal(st)
+}
+
+// Serialize structs.FSUsage using JSON with the package's global Profiler.
+func Serialize(st *structs.FSUsage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(st)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(st *structs.FSUsage) ([]byte, error) {
+	return prof.Serialize(st)
+}
+
+// Marshal is an alias for Serialize; uses the package's global Profiler.
+func Marshal(st *structs.FSUsage) ([]byte, error) {
+	return Serialize(st)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// structs.FSUsage.
+func Deserialize(p []byte) (*structs.FSUsage, error) {
+	st := &structs.FSUsage{}
+	err := json.Unmarshal(p, st)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.FSUsage, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the usage information of the mounted filesystems at
+// intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,91 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fsusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/disk/structs"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	u, err := Deserialize(p)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkUsage("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkUsage("ticker", u, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *structs.FSUsage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if len(u.Filesystem) == 0 {
+		t.Errorf("%s: expected there to be filesystems; didn't get any", n)
+	}
+	for i := 0; i < len(u.Filesystem); i++ {
+		if u.Filesystem[i].MountPoint == "" {
+			t.Errorf("%s: Filesystem %d: MountPoint: wanted a non-empty value; was empty", n, i)
+		}
+		if u.Filesystem[i].Size == 0 {
+			t.Errorf("%s: Filesystem %d: Size: wanted a non-zero value, was 0", n, i)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
@@ -0,0 +1,262 @@
+// device.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: disk/structs/device.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Device struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Major           uint32                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor           uint32                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	ReadsCompleted  uint64                 `protobuf:"varint,4,opt,name=reads_completed,json=readsCompleted,proto3" json:"reads_completed,omitempty"`
+	ReadsMerged     uint64                 `protobuf:"varint,5,opt,name=reads_merged,json=readsMerged,proto3" json:"reads_merged,omitempty"`
+	ReadSectors     uint64                 `protobuf:"varint,6,opt,name=read_sectors,json=readSectors,proto3" json:"read_sectors,omitempty"`
+	ReadingTime     uint64                 `protobuf:"varint,7,opt,name=reading_time,json=readingTime,proto3" json:"reading_time,omitempty"`
+	WritesCompleted uint64                 `protobuf:"varint,8,opt,name=writes_completed,json=writesCompleted,proto3" json:"writes_completed,omitempty"`
+	WritesMerged    uint64                 `protobuf:"varint,9,opt,name=writes_merged,json=writesMerged,proto3" json:"writes_merged,omitempty"`
+	WrittenSectors  uint64                 `protobuf:"varint,10,opt,name=written_sectors,json=writtenSectors,proto3" json:"written_sectors,omitempty"`
+	WritingTime     uint64                 `protobuf:"varint,11,opt,name=writing_time,json=writingTime,proto3" json:"writing_time,omitempty"`
+	IoInProgress    int32                  `protobuf:"varint,12,opt,name=io_in_progress,json=ioInProgress,proto3" json:"io_in_progress,omitempty"`
+	IoTime          uint64                 `protobuf:"varint,13,opt,name=io_time,json=ioTime,proto3" json:"io_time,omitempty"`
+	WeightedIoTime  uint64                 `protobuf:"varint,14,opt,name=weighted_io_time,json=weightedIoTime,proto3" json:"weighted_io_time,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_disk_structs_device_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_disk_structs_device_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_disk_structs_device_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *Device) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetReadsCompleted() uint64 {
+	if x != nil {
+		return x.ReadsCompleted
+	}
+	return 0
+}
+
+func (x *Device) GetReadsMerged() uint64 {
+	if x != nil {
+		return x.ReadsMerged
+	}
+	return 0
+}
+
+func (x *Device) GetReadSectors() uint64 {
+	if x != nil {
+		return x.ReadSectors
+	}
+	return 0
+}
+
+func (x *Device) GetReadingTime() uint64 {
+	if x != nil {
+		return x.ReadingTime
+	}
+	return 0
+}
+
+func (x *Device) GetWritesCompleted() uint64 {
+	if x != nil {
+		return x.WritesCompleted
+	}
+	return 0
+}
+
+func (x *Device) GetWritesMerged() uint64 {
+	if x != nil {
+		return x.WritesMerged
+	}
+	return 0
+}
+
+func (x *Device) GetWrittenSectors() uint64 {
+	if x != nil {
+		return x.WrittenSectors
+	}
+	return 0
+}
+
+func (x *Device) GetWritingTime() uint64 {
+	if x != nil {
+		return x.WritingTime
+	}
+	return 0
+}
+
+func (x *Device) GetIoInProgress() int32 {
+	if x != nil {
+		return x.IoInProgress
+	}
+	return 0
+}
+
+func (x *Device) GetIoTime() uint64 {
+	if x != nil {
+		return x.IoTime
+	}
+	return 0
+}
+
+func (x *Device) GetWeightedIoTime() uint64 {
+	if x != nil {
+		return x.WeightedIoTime
+	}
+	return 0
+}
+
+var File_disk_structs_device_proto protoreflect.FileDescriptor
+
+var file_disk_structs_device_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x6a, 0x6f, 0x65,
+	0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x64, 0x69, 0x73, 0x6b, 0x22, 0xdf, 0x03, 0x0a, 0x06,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e,
+	0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x61, 0x64, 0x73, 0x5f,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x72, 0x65, 0x61, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x73, 0x4d, 0x65, 0x72, 0x67,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x53, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x72, 0x65, 0x61,
+	0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x6d, 0x65,
+	0x72, 0x67, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74,
+	0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x74, 0x65, 0x6e, 0x53, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e, 0x67,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0e, 0x69, 0x6f, 0x5f, 0x69, 0x6e, 0x5f, 0x70, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x69, 0x6f,
+	0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6f,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x69, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x5f,
+	0x69, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x65, 0x64, 0x49, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x30, 0x5a,
+	0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66,
+	0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x64, 0x69, 0x73,
+	0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_disk_structs_device_proto_rawDescOnce sync.Once
+	file_disk_structs_device_proto_rawDescData []byte
+)
+
+func file_disk_structs_device_proto_rawDescGZIP() []byte {
+	file_disk_structs_device_proto_rawDescOnce.Do(func() {
+		file_disk_structs_device_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_disk_structs_device_proto_rawDesc), len(file_disk_structs_device_proto_rawDesc)))
+	})
+	return file_disk_structs_device_proto_rawDescData
+}
+
+var file_disk_structs_device_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_disk_structs_device_proto_goTypes = []any{
+	(*Device)(nil), // 0: joefriday.disk.Device
+}
+var file_disk_structs_device_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_disk_structs_device_proto_init() }
+func file_disk_structs_device_proto_init() {
+	if File_disk_structs_device_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_disk_structs_device_proto_rawDesc), len(file_disk_structs_device_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_disk_structs_device_proto_goTypes,
+		DependencyIndexes: file_disk_structs_device_proto_depIdxs,
+		MessageInfos:      file_disk_structs_device_proto_msgTypes,
+	}.Build()
+	File_disk_structs_device_proto = out.File
+	file_disk_structs_device_proto_goTypes = nil
+	file_disk_structs_device_proto_depIdxs = nil
+}
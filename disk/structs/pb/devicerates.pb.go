@@ -0,0 +1,273 @@
+// devicerates.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: disk/structs/devicerates.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeviceRates struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Major              uint32                 `protobuf:"varint,1,opt,name=major,proto3" json:"major,omitempty"`
+	Minor              uint32                 `protobuf:"varint,2,opt,name=minor,proto3" json:"minor,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SectorSize         uint32                 `protobuf:"varint,4,opt,name=sector_size,json=sectorSize,proto3" json:"sector_size,omitempty"`
+	ReadsPerSec        float64                `protobuf:"fixed64,5,opt,name=reads_per_sec,json=readsPerSec,proto3" json:"reads_per_sec,omitempty"`
+	WritesPerSec       float64                `protobuf:"fixed64,6,opt,name=writes_per_sec,json=writesPerSec,proto3" json:"writes_per_sec,omitempty"`
+	ReadsMergedPerSec  float64                `protobuf:"fixed64,7,opt,name=reads_merged_per_sec,json=readsMergedPerSec,proto3" json:"reads_merged_per_sec,omitempty"`
+	WritesMergedPerSec float64                `protobuf:"fixed64,8,opt,name=writes_merged_per_sec,json=writesMergedPerSec,proto3" json:"writes_merged_per_sec,omitempty"`
+	ReadBytesPerSec    float64                `protobuf:"fixed64,9,opt,name=read_bytes_per_sec,json=readBytesPerSec,proto3" json:"read_bytes_per_sec,omitempty"`
+	WriteBytesPerSec   float64                `protobuf:"fixed64,10,opt,name=write_bytes_per_sec,json=writeBytesPerSec,proto3" json:"write_bytes_per_sec,omitempty"`
+	ReadAwait          float64                `protobuf:"fixed64,11,opt,name=read_await,json=readAwait,proto3" json:"read_await,omitempty"`
+	WriteAwait         float64                `protobuf:"fixed64,12,opt,name=write_await,json=writeAwait,proto3" json:"write_await,omitempty"`
+	Await              float64                `protobuf:"fixed64,13,opt,name=await,proto3" json:"await,omitempty"`
+	AvgQueueSize       float64                `protobuf:"fixed64,14,opt,name=avg_queue_size,json=avgQueueSize,proto3" json:"avg_queue_size,omitempty"`
+	Util               float64                `protobuf:"fixed64,15,opt,name=util,proto3" json:"util,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *DeviceRates) Reset() {
+	*x = DeviceRates{}
+	mi := &file_disk_structs_devicerates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRates) ProtoMessage() {}
+
+func (x *DeviceRates) ProtoReflect() protoreflect.Message {
+	mi := &file_disk_structs_devicerates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRates.ProtoReflect.Descriptor instead.
+func (*DeviceRates) Descriptor() ([]byte, []int) {
+	return file_disk_structs_devicerates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceRates) GetMajor() uint32 {
+	if x != nil {
+		return x.Major
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetMinor() uint32 {
+	if x != nil {
+		return x.Minor
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceRates) GetSectorSize() uint32 {
+	if x != nil {
+		return x.SectorSize
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetReadsPerSec() float64 {
+	if x != nil {
+		return x.ReadsPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetWritesPerSec() float64 {
+	if x != nil {
+		return x.WritesPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetReadsMergedPerSec() float64 {
+	if x != nil {
+		return x.ReadsMergedPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetWritesMergedPerSec() float64 {
+	if x != nil {
+		return x.WritesMergedPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetReadBytesPerSec() float64 {
+	if x != nil {
+		return x.ReadBytesPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetWriteBytesPerSec() float64 {
+	if x != nil {
+		return x.WriteBytesPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetReadAwait() float64 {
+	if x != nil {
+		return x.ReadAwait
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetWriteAwait() float64 {
+	if x != nil {
+		return x.WriteAwait
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetAwait() float64 {
+	if x != nil {
+		return x.Await
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetAvgQueueSize() float64 {
+	if x != nil {
+		return x.AvgQueueSize
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetUtil() float64 {
+	if x != nil {
+		return x.Util
+	}
+	return 0
+}
+
+var File_disk_structs_devicerates_proto protoreflect.FileDescriptor
+
+var file_disk_structs_devicerates_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x64, 0x69, 0x73, 0x6b,
+	0x22, 0x88, 0x04, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x72, 0x65, 0x61, 0x64, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73,
+	0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x72, 0x65, 0x61, 0x64, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x24, 0x0a, 0x0e, 0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x77,
+	0x72, 0x69, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2f, 0x0a, 0x14, 0x72,
+	0x65, 0x61, 0x64, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x72, 0x65, 0x61, 0x64, 0x73,
+	0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x31, 0x0a, 0x15,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x73, 0x5f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x77, 0x72, 0x69,
+	0x74, 0x65, 0x73, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x64, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
+	0x2b, 0x0a, 0x12, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x61,
+	0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x2d, 0x0a, 0x13,
+	0x77, 0x72, 0x69, 0x74, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x77, 0x72, 0x69, 0x74, 0x65,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x1d, 0x0a, 0x0a, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x61, 0x77, 0x61, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x72, 0x65, 0x61, 0x64, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x77, 0x72,
+	0x69, 0x74, 0x65, 0x5f, 0x61, 0x77, 0x61, 0x69, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x77, 0x72, 0x69, 0x74, 0x65, 0x41, 0x77, 0x61, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x77, 0x61, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x61, 0x77, 0x61, 0x69,
+	0x74, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x76, 0x67, 0x5f, 0x71, 0x75, 0x65, 0x75, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x61, 0x76, 0x67, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x74, 0x69, 0x6c, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x74, 0x69, 0x6c, 0x42, 0x30, 0x5a, 0x2e, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67,
+	0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_disk_structs_devicerates_proto_rawDescOnce sync.Once
+	file_disk_structs_devicerates_proto_rawDescData []byte
+)
+
+func file_disk_structs_devicerates_proto_rawDescGZIP() []byte {
+	file_disk_structs_devicerates_proto_rawDescOnce.Do(func() {
+		file_disk_structs_devicerates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_disk_structs_devicerates_proto_rawDesc), len(file_disk_structs_devicerates_proto_rawDesc)))
+	})
+	return file_disk_structs_devicerates_proto_rawDescData
+}
+
+var file_disk_structs_devicerates_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_disk_structs_devicerates_proto_goTypes = []any{
+	(*DeviceRates)(nil), // 0: joefriday.disk.DeviceRates
+}
+var file_disk_structs_devicerates_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_disk_structs_devicerates_proto_init() }
+func file_disk_structs_devicerates_proto_init() {
+	if File_disk_structs_devicerates_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_disk_structs_devicerates_proto_rawDesc), len(file_disk_structs_devicerates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_disk_structs_devicerates_proto_goTypes,
+		DependencyIndexes: file_disk_structs_devicerates_proto_depIdxs,
+		MessageInfos:      file_disk_structs_devicerates_proto_msgTypes,
+	}.Build()
+	File_disk_structs_devicerates_proto = out.File
+	file_disk_structs_devicerates_proto_goTypes = nil
+	file_disk_structs_devicerates_proto_depIdxs = nil
+}
@@ -0,0 +1,146 @@
+// diskstats.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: disk/structs/diskstats.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DiskStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Device        []*Device              `protobuf:"bytes,2,rep,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiskStats) Reset() {
+	*x = DiskStats{}
+	mi := &file_disk_structs_diskstats_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiskStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskStats) ProtoMessage() {}
+
+func (x *DiskStats) ProtoReflect() protoreflect.Message {
+	mi := &file_disk_structs_diskstats_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskStats.ProtoReflect.Descriptor instead.
+func (*DiskStats) Descriptor() ([]byte, []int) {
+	return file_disk_structs_diskstats_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DiskStats) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DiskStats) GetDevice() []*Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+var File_disk_structs_diskstats_proto protoreflect.FileDescriptor
+
+var file_disk_structs_diskstats_proto_rawDesc = string([]byte{
+	0x0a, 0x1c, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64,
+	0x69, 0x73, 0x6b, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x64, 0x69, 0x73, 0x6b, 0x1a, 0x19,
+	0x64, 0x69, 0x73, 0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x59, 0x0a, 0x09, 0x44, 0x69, 0x73,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x2e, 0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79,
+	0x2e, 0x64, 0x69, 0x73, 0x6b, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x42, 0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69,
+	0x64, 0x61, 0x79, 0x2f, 0x64, 0x69, 0x73, 0x6b, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73,
+	0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_disk_structs_diskstats_proto_rawDescOnce sync.Once
+	file_disk_structs_diskstats_proto_rawDescData []byte
+)
+
+func file_disk_structs_diskstats_proto_rawDescGZIP() []byte {
+	file_disk_structs_diskstats_proto_rawDescOnce.Do(func() {
+		file_disk_structs_diskstats_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_disk_structs_diskstats_proto_rawDesc), len(file_disk_structs_diskstats_proto_rawDesc)))
+	})
+	return file_disk_structs_diskstats_proto_rawDescData
+}
+
+var file_disk_structs_diskstats_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_disk_structs_diskstats_proto_goTypes = []any{
+	(*DiskStats)(nil), // 0: joefriday.disk.DiskStats
+	(*Device)(nil),    // 1: joefriday.disk.Device
+}
+var file_disk_structs_diskstats_proto_depIdxs = []int32{
+	1, // 0: joefriday.disk.DiskStats.device:type_name -> joefriday.disk.Device
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_disk_structs_diskstats_proto_init() }
+func file_disk_structs_diskstats_proto_init() {
+	if File_disk_structs_diskstats_proto != nil {
+		return
+	}
+	file_disk_structs_device_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_disk_structs_diskstats_proto_rawDesc), len(file_disk_structs_diskstats_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_disk_structs_diskstats_proto_goTypes,
+		DependencyIndexes: file_disk_structs_diskstats_proto_depIdxs,
+		MessageInfos:      file_disk_structs_diskstats_proto_msgTypes,
+	}.Build()
+	File_disk_structs_diskstats_proto = out.File
+	file_disk_structs_diskstats_proto_goTypes = nil
+	file_disk_structs_diskstats_proto_depIdxs = nil
+}
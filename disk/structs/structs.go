@@ -38,9 +38,65 @@ type Device struct {
 	WeightedIOTime  uint64 `json:"weighted_io_time"`
 }
 
-// DiskUsage holds the usage information for all of the block devices.
+// DiskUsage holds the usage information for all of the block devices. Device
+// holds the raw counter deltas; Rates holds the metrics derived from them.
+// Devices are matched by name, major, and minor; Added holds the names of the
+// devices that weren't in the prior snapshot and Removed holds the names of
+// the devices that are no longer present.
 type DiskUsage struct {
-	Timestamp int64    `json:"timestamp"`
-	TimeDelta int64    `json:"time_delta"`
-	Device   []Device `json:"device"`
+	Timestamp int64         `json:"timestamp"`
+	TimeDelta int64         `json:"time_delta"`
+	Device    []Device      `json:"device"`
+	Rates     []DeviceRates `json:"rates"`
+	Added     []string      `json:"added"`
+	Removed   []string      `json:"removed"`
+}
+
+// DeviceRates contains the derived IO metrics for a given block device, like
+// those reported by iostat -x. Await values are in milliseconds, Util is the
+// percentage of elapsed time during which the device had IO in progress.
+// SectorSize is the device's logical sector size; it's informational, the
+// byte rates are calculated using the 512 byte sectors of /proc/diskstats.
+type DeviceRates struct {
+	Major              uint32  `json:"major"`
+	Minor              uint32  `json:"minor"`
+	Name               string  `json:"name"`
+	SectorSize         uint32  `json:"sector_size"`
+	ReadsPerSec        float64 `json:"reads_per_sec"`
+	WritesPerSec       float64 `json:"writes_per_sec"`
+	ReadsMergedPerSec  float64 `json:"reads_merged_per_sec"`
+	WritesMergedPerSec float64 `json:"writes_merged_per_sec"`
+	ReadBytesPerSec    float64 `json:"read_bytes_per_sec"`
+	WriteBytesPerSec   float64 `json:"write_bytes_per_sec"`
+	ReadAwait          float64 `json:"read_await"`
+	WriteAwait         float64 `json:"write_await"`
+	Await              float64 `json:"await"`
+	AvgQueueSize       float64 `json:"avg_queue_size"`
+	Util               float64 `json:"util"`
+}
+
+// FSUsage holds the usage information for all of the mounted filesystems.
+type FSUsage struct {
+	Timestamp  int64        `json:"timestamp"`
+	Filesystem []Filesystem `json:"filesystem"`
+}
+
+// Filesystem contains the capacity and inode usage of a mounted filesystem.
+// Size, Used, and Available are in bytes. Available is the space available
+// to unprivileged users; as with df, it may be less than Size - Used.
+type Filesystem struct {
+	Major      uint32 `json:"major"`
+	Minor      uint32 `json:"minor"`
+	Source     string `json:"source"`
+	MountPoint string `json:"mount_point"`
+	Root       string `json:"root"`
+	FSType     string `json:"fs_type"`
+	Options    string `json:"options"`
+	BlockSize  uint64 `json:"block_size"`
+	Size       uint64 `json:"size"`
+	Used       uint64 `json:"used"`
+	Available  uint64 `json:"available"`
+	Inodes     uint64 `json:"inodes"`
+	InodesUsed uint64 `json:"inodes_used"`
+	InodesFree uint64 `json:"inodes_free"`
 }
@@ -0,0 +1,333 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package export converts JoeFriday's information into Points: the
+// measurements, tags, and fields that time series formats, e.g. InfluxDB's
+// line protocol and Graphite's plaintext protocol, are made of. The encoders
+// for those formats are in the influx and graphite subpackages.
+//
+// Per CPU information is tagged with cpu, per block device information with
+// device, and per network interface information with interface. Memory
+// information is in bytes.
+package export
+
+import (
+	"strconv"
+
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/cpu/cpuutil"
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/uptime"
+)
+
+// Tag is a key value pair that identifies a Point, e.g. cpu=cpu0.
+type Tag struct {
+	Key   string
+	Value string
+}
+
+// Field is a measured value. Value is either an int64 or a float64.
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+// Point is a measurement's tags and fields at a point in time.
+type Point struct {
+	Measurement string
+	Tags        []Tag
+	Fields      []Field
+	// Timestamp is in nanoseconds since the epoch.
+	Timestamp int64
+}
+
+// Float64 returns v as the float64 with the same shortest decimal
+// representation, e.g. 0.1 instead of 0.10000000149011612.
+func Float64(v float32) float64 {
+	f, _ := strconv.ParseFloat(strconv.FormatFloat(float64(v), 'g', -1, 32), 64)
+	return f
+}
+
+// CPUStats returns the CPUStats as a cpustats Point, for the context
+// switches, boot time, and forks, and a cpustats Point per CPU, tagged with
+// the CPU's ID, for its time in each mode, in clock ticks.
+func CPUStats(s *cpustats.CPUStats) []Point {
+	pts := make([]Point, 0, len(s.CPU)+1)
+	pts = append(pts, Point{
+		Measurement: "cpustats",
+		Fields: []Field{
+			{"ctxt", s.Ctxt},
+			{"btime", s.BTime},
+			{"processes", s.Processes},
+		},
+		Timestamp: s.Timestamp,
+	})
+	for _, c := range s.CPU {
+		pts = append(pts, Point{
+			Measurement: "cpustats",
+			Tags:        []Tag{{"cpu", c.ID}},
+			Fields: []Field{
+				{"user", c.User},
+				{"nice", c.Nice},
+				{"system", c.System},
+				{"idle", c.Idle},
+				{"iowait", c.IOWait},
+				{"irq", c.IRQ},
+				{"softirq", c.SoftIRQ},
+				{"steal", c.Steal},
+				{"guest", c.Quest},
+				{"guest_nice", c.QuestNice},
+			},
+			Timestamp: s.Timestamp,
+		})
+	}
+	return pts
+}
+
+// CPUUtil returns the CPUUtil as a cpuutil Point, for the context switches
+// and processes, and a cpuutil Point per CPU, tagged with the CPU's ID, for
+// its utilization.
+func CPUUtil(u *cpuutil.CPUUtil) []Point {
+	pts := make([]Point, 0, len(u.CPU)+1)
+	pts = append(pts, Point{
+		Measurement: "cpuutil",
+		Fields: []Field{
+			{"time_delta", u.TimeDelta},
+			{"ctxt_delta", u.CtxtDelta},
+			{"processes", int64(u.Processes)},
+		},
+		Timestamp: u.Timestamp,
+	})
+	for _, c := range u.CPU {
+		pts = append(pts, Point{
+			Measurement: "cpuutil",
+			Tags:        []Tag{{"cpu", c.ID}},
+			Fields: []Field{
+				{"usage", Float64(c.Usage)},
+				{"user", Float64(c.User)},
+				{"nice", Float64(c.Nice)},
+				{"system", Float64(c.System)},
+				{"idle", Float64(c.Idle)},
+				{"iowait", Float64(c.IOWait)},
+				{"irq", Float64(c.IRQ)},
+				{"softirq", Float64(c.SoftIRQ)},
+				{"steal", Float64(c.Steal)},
+				{"guest", Float64(c.Guest)},
+				{"guest_nice", Float64(c.GuestNice)},
+			},
+			Timestamp: u.Timestamp,
+		})
+	}
+	return pts
+}
+
+// MemInfo returns the meminfo.Info as a meminfo Point. The values that
+// /proc/meminfo reports in kB are converted to bytes.
+func MemInfo(inf *meminfo.Info) []Point {
+	kb := func(k string, v uint64) Field {
+		return Field{k, int64(v) * 1024}
+	}
+	return []Point{{
+		Measurement: "meminfo",
+		Fields: []Field{
+			kb("mem_total", inf.MemTotal),
+			kb("mem_free", inf.MemFree),
+			kb("mem_available", inf.MemAvailable),
+			kb("buffers", inf.Buffers),
+			kb("cached", inf.Cached),
+			kb("swap_cached", inf.SwapCached),
+			kb("active", inf.Active),
+			kb("inactive", inf.Inactive),
+			kb("active_anon", inf.ActiveAnon),
+			kb("inactive_anon", inf.InactiveAnon),
+			kb("active_file", inf.ActiveFile),
+			kb("inactive_file", inf.InactiveFile),
+			kb("unevictable", inf.Unevictable),
+			kb("mlocked", inf.Mlocked),
+			kb("swap_total", inf.SwapTotal),
+			kb("swap_free", inf.SwapFree),
+			kb("dirty", inf.Dirty),
+			kb("writeback", inf.Writeback),
+			kb("anon_pages", inf.AnonPages),
+			kb("mapped", inf.Mapped),
+			kb("shmem", inf.Shmem),
+			kb("slab", inf.Slab),
+			kb("s_reclaimable", inf.SReclaimable),
+			kb("s_unreclaim", inf.SUnreclaim),
+			kb("kernel_stack", inf.KernelStack),
+			kb("page_tables", inf.PageTables),
+			kb("nfs_unstable", inf.NFSUnstable),
+			kb("bounce", inf.Bounce),
+			kb("writeback_tmp", inf.WritebackTmp),
+			kb("commit_limit", inf.CommitLimit),
+			kb("committed_as", inf.CommittedAS),
+			kb("vmalloc_total", inf.VmallocTotal),
+			kb("vmalloc_used", inf.VmallocUsed),
+			kb("vmalloc_chunk", inf.VmallocChunk),
+			kb("hardware_corrupted", inf.HardwareCorrupted),
+			kb("anon_huge_pages", inf.AnonHugePages),
+			{"huge_pages_total", int64(inf.HugePagesTotal)},
+			{"huge_pages_free", int64(inf.HugePagesFree)},
+			{"huge_pages_rsvd", int64(inf.HugePagesRsvd)},
+			{"huge_pages_surp", int64(inf.HugePagesSurp)},
+			kb("huge_page_size", inf.HugePagesSize),
+			kb("direct_map_4k", inf.DirectMap4K),
+			kb("direct_map_2m", inf.DirectMap2M),
+		},
+		Timestamp: inf.Timestamp,
+	}}
+}
+
+// DiskStats returns a diskstats Point per block device, tagged with the
+// device's name.
+func DiskStats(s *dstructs.DiskStats) []Point {
+	pts := make([]Point, 0, len(s.Device))
+	for _, d := range s.Device {
+		pts = append(pts, Point{
+			Measurement: "diskstats",
+			Tags:        []Tag{{"device", d.Name}},
+			Fields: []Field{
+				{"reads_completed", int64(d.ReadsCompleted)},
+				{"reads_merged", int64(d.ReadsMerged)},
+				{"read_sectors", int64(d.ReadSectors)},
+				{"reading_time", int64(d.ReadingTime)},
+				{"writes_completed", int64(d.WritesCompleted)},
+				{"writes_merged", int64(d.WritesMerged)},
+				{"written_sectors", int64(d.WrittenSectors)},
+				{"writing_time", int64(d.WritingTime)},
+				{"io_in_progress", int64(d.IOInProgress)},
+				{"io_time", int64(d.IOTime)},
+				{"weighted_io_time", int64(d.WeightedIOTime)},
+			},
+			Timestamp: s.Timestamp,
+		})
+	}
+	return pts
+}
+
+// DiskUsage returns a diskusage Point per block device, tagged with the
+// device's name, for the device's rates over the usage's time delta.
+func DiskUsage(u *dstructs.DiskUsage) []Point {
+	pts := make([]Point, 0, len(u.Rates))
+	for _, r := range u.Rates {
+		pts = append(pts, Point{
+			Measurement: "diskusage",
+			Tags:        []Tag{{"device", r.Name}},
+			Fields: []Field{
+				{"reads_per_sec", r.ReadsPerSec},
+				{"writes_per_sec", r.WritesPerSec},
+				{"reads_merged_per_sec", r.ReadsMergedPerSec},
+				{"writes_merged_per_sec", r.WritesMergedPerSec},
+				{"read_bytes_per_sec", r.ReadBytesPerSec},
+				{"write_bytes_per_sec", r.WriteBytesPerSec},
+				{"read_await", r.ReadAwait},
+				{"write_await", r.WriteAwait},
+				{"await", r.Await},
+				{"avg_queue_size", r.AvgQueueSize},
+				{"util", r.Util},
+			},
+			Timestamp: u.Timestamp,
+		})
+	}
+	return pts
+}
+
+// NetDev returns a netdev Point per network interface, tagged with the
+// interface's name.
+func NetDev(inf *nstructs.DevInfo) []Point {
+	pts := make([]Point, 0, len(inf.Device))
+	for _, d := range inf.Device {
+		pts = append(pts, Point{
+			Measurement: "netdev",
+			Tags:        []Tag{{"interface", d.Name}},
+			Fields: []Field{
+				{"receive_bytes", d.RBytes},
+				{"receive_packets", d.RPackets},
+				{"receive_errs", d.RErrs},
+				{"receive_drop", d.RDrop},
+				{"receive_fifo", d.RFIFO},
+				{"receive_frame", d.RFrame},
+				{"receive_compressed", d.RCompressed},
+				{"receive_multicast", d.RMulticast},
+				{"transmit_bytes", d.TBytes},
+				{"transmit_packets", d.TPackets},
+				{"transmit_errs", d.TErrs},
+				{"transmit_drop", d.TDrop},
+				{"transmit_fifo", d.TFIFO},
+				{"transmit_colls", d.TColls},
+				{"transmit_carrier", d.TCarrier},
+				{"transmit_compressed", d.TCompressed},
+			},
+			Timestamp: inf.Timestamp,
+		})
+	}
+	return pts
+}
+
+// NetUsage returns a netusage Point per network interface, tagged with the
+// interface's name, for the interface's rates over the usage's time delta.
+func NetUsage(u *nstructs.DevUsage) []Point {
+	pts := make([]Point, 0, len(u.Rates))
+	for _, r := range u.Rates {
+		pts = append(pts, Point{
+			Measurement: "netusage",
+			Tags:        []Tag{{"interface", r.Name}},
+			Fields: []Field{
+				{"speed", r.Speed},
+				{"receive_bytes_per_sec", r.RBytesPerSec},
+				{"receive_packets_per_sec", r.RPacketsPerSec},
+				{"receive_drop_ratio", r.RDropRatio},
+				{"receive_err_ratio", r.RErrRatio},
+				{"receive_util", r.RUtil},
+				{"transmit_bytes_per_sec", r.TBytesPerSec},
+				{"transmit_packets_per_sec", r.TPacketsPerSec},
+				{"transmit_drop_ratio", r.TDropRatio},
+				{"transmit_err_ratio", r.TErrRatio},
+				{"transmit_util", r.TUtil},
+			},
+			Timestamp: u.Timestamp,
+		})
+	}
+	return pts
+}
+
+// LoadAvg returns the LoadAvg as a loadavg Point.
+func LoadAvg(l loadavg.LoadAvg) []Point {
+	return []Point{{
+		Measurement: "loadavg",
+		Fields: []Field{
+			{"load1", Float64(l.Minute)},
+			{"load5", Float64(l.Five)},
+			{"load15", Float64(l.Fifteen)},
+			{"running", int64(l.Running)},
+			{"total", int64(l.Total)},
+			{"last_pid", int64(l.PID)},
+		},
+		Timestamp: l.Timestamp,
+	}}
+}
+
+// Uptime returns the Uptime as an uptime Point, in seconds.
+func Uptime(u uptime.Uptime) []Point {
+	return []Point{{
+		Measurement: "uptime",
+		Fields: []Field{
+			{"total", u.Total},
+			{"idle", u.Idle},
+		},
+		Timestamp: u.Timestamp,
+	}}
+}
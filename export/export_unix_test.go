@@ -0,0 +1,109 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package export
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hmmftg/joefriday/cpu/cpuutil"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+)
+
+func TestCPUUtil(t *testing.T) {
+	u := &cpuutil.CPUUtil{
+		Timestamp: 1500000000000000000, TimeDelta: 1000000000, CtxtDelta: 42, Processes: 3,
+		CPU: []cpuutil.Utilization{
+			{ID: "cpu", Usage: 12.5, User: 10, System: 2.5, Idle: 87.5},
+			{ID: "cpu0", Usage: 0.1, Idle: 99.9},
+		},
+	}
+	pts := CPUUtil(u)
+	if len(pts) != 3 {
+		t.Fatalf("got %d points; want 3", len(pts))
+	}
+	expected := Point{
+		Measurement: "cpuutil",
+		Fields:      []Field{{"time_delta", int64(1000000000)}, {"ctxt_delta", int64(42)}, {"processes", int64(3)}},
+		Timestamp:   1500000000000000000,
+	}
+	if !reflect.DeepEqual(pts[0], expected) {
+		t.Errorf("got %#v; want %#v", pts[0], expected)
+	}
+	expected = Point{
+		Measurement: "cpuutil",
+		Tags:        []Tag{{"cpu", "cpu0"}},
+		Fields: []Field{
+			{"usage", 0.1}, {"user", 0.0}, {"nice", 0.0}, {"system", 0.0}, {"idle", 99.9}, {"iowait", 0.0},
+			{"irq", 0.0}, {"softirq", 0.0}, {"steal", 0.0}, {"guest", 0.0}, {"guest_nice", 0.0},
+		},
+		Timestamp: 1500000000000000000,
+	}
+	if !reflect.DeepEqual(pts[2], expected) {
+		t.Errorf("got %#v; want %#v", pts[2], expected)
+	}
+}
+
+func TestMemInfo(t *testing.T) {
+	pts := MemInfo(&meminfo.Info{Timestamp: 1, MemTotal: 2048, HugePagesTotal: 4})
+	if len(pts) != 1 {
+		t.Fatalf("got %d points; want 1", len(pts))
+	}
+	fields := map[string]interface{}{}
+	for _, f := range pts[0].Fields {
+		fields[f.Key] = f.Value
+	}
+	if fields["mem_total"] != int64(2097152) {
+		t.Errorf("mem_total: got %v; want 2097152", fields["mem_total"])
+	}
+	// huge page counts aren't in kB.
+	if fields["huge_pages_total"] != int64(4) {
+		t.Errorf("huge_pages_total: got %v; want 4", fields["huge_pages_total"])
+	}
+}
+
+func TestNetUsage(t *testing.T) {
+	u := &nstructs.DevUsage{
+		Timestamp: 1,
+		Rates: []nstructs.DeviceRates{
+			{Name: "eth0", Speed: 1000, RBytesPerSec: 1.5},
+			{Name: "lo", TBytesPerSec: 2},
+		},
+	}
+	pts := NetUsage(u)
+	if len(pts) != 2 {
+		t.Fatalf("got %d points; want 2", len(pts))
+	}
+	for i, name := range []string{"eth0", "lo"} {
+		if !reflect.DeepEqual(pts[i].Tags, []Tag{{"interface", name}}) {
+			t.Errorf("%d: got tags %v; want interface=%s", i, pts[i].Tags, name)
+		}
+	}
+	if pts[0].Fields[0] != (Field{"speed", int64(1000)}) || pts[0].Fields[1] != (Field{"receive_bytes_per_sec", 1.5}) {
+		t.Errorf("got fields %v", pts[0].Fields[:2])
+	}
+}
+
+func TestLoadAvg(t *testing.T) {
+	pts := LoadAvg(loadavg.LoadAvg{Timestamp: 1, Minute: 0.1, Five: 0.2, Fifteen: 0.3, Running: 1, Total: 2, PID: 3})
+	expected := []Field{
+		{"load1", 0.1}, {"load5", 0.2}, {"load15", 0.3},
+		{"running", int64(1)}, {"total", int64(2)}, {"last_pid", int64(3)},
+	}
+	if !reflect.DeepEqual(pts[0].Fields, expected) {
+		t.Errorf("got %v; want %v", pts[0].Fields, expected)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package graphite encodes export.Points using Graphite's plaintext
+// protocol:
+//
+//	prefix.measurement.tag_value.field value timestamp
+//
+// A Point's path is made of the Encoder's prefix, the Point's measurement,
+// the values of its tags, in order, and the field's key; e.g. the user field
+// of the cpuutil Point for cpu0 is cpuutil.cpu0.user. Characters other than
+// letters, digits, hyphens, and underscores are replaced with underscores in
+// each of the path's nodes. Timestamps are in seconds. NaN and infinite
+// values are skipped.
+package graphite
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hmmftg/joefriday/export"
+)
+
+// Encoder writes Points to an io.Writer as plaintext protocol lines.
+type Encoder struct {
+	w      io.Writer
+	prefix string
+	buf    []byte
+}
+
+// NewEncoder returns an Encoder that writes to w. Prefix, if not empty, is
+// the start of each metric's path, e.g. "joefriday.host1"; a trailing dot is
+// removed.
+func NewEncoder(w io.Writer, prefix string) *Encoder {
+	return &Encoder{w: w, prefix: strings.TrimSuffix(prefix, ".")}
+}
+
+// Encode writes the Points, one line per field, with a single write, e.g.
+// one datagram when w is a UDP connection.
+func (e *Encoder) Encode(pts ...export.Point) error {
+	e.buf = e.buf[:0]
+	var err error
+	for _, p := range pts {
+		e.buf, err = AppendPoint(e.buf, e.prefix, p)
+		if err != nil {
+			return err
+		}
+	}
+	if len(e.buf) == 0 {
+		return nil
+	}
+	_, err = e.w.Write(e.buf)
+	return err
+}
+
+// AppendPoint appends a line, including its line feed, for each of the
+// Point's fields to b. The prefix, if not empty, starts each line's path.
+func AppendPoint(b []byte, prefix string, p export.Point) ([]byte, error) {
+	start := len(b)
+	for _, f := range p.Fields {
+		if v, ok := f.Value.(float64); ok && (math.IsNaN(v) || math.IsInf(v, 0)) {
+			continue
+		}
+		if prefix != "" {
+			b = append(b, prefix...)
+			b = append(b, '.')
+		}
+		b = appendNode(b, p.Measurement)
+		for _, t := range p.Tags {
+			b = append(b, '.')
+			b = appendNode(b, t.Value)
+		}
+		b = append(b, '.')
+		b = appendNode(b, f.Key)
+		b = append(b, ' ')
+		switch v := f.Value.(type) {
+		case int64:
+			b = strconv.AppendInt(b, v, 10)
+		case float64:
+			b = strconv.AppendFloat(b, v, 'f', -1, 64)
+		default:
+			return b[:start], fmt.Errorf("%s: field %s: unsupported value type %T", p.Measurement, f.Key, f.Value)
+		}
+		b = append(b, ' ')
+		b = strconv.AppendInt(b, p.Timestamp/1000000000, 10)
+		b = append(b, '\n')
+	}
+	return b, nil
+}
+
+// appendNode appends s, with the characters that aren't letters, digits,
+// hyphens, or underscores replaced with underscores. An empty s is appended
+// as an underscore so that the path's nodes stay in place.
+func appendNode(b []byte, s string) []byte {
+	if s == "" {
+		return append(b, '_')
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') || c == '-' || c == '_' {
+			b = append(b, c)
+			continue
+		}
+		b = append(b, '_')
+	}
+	return b
+}
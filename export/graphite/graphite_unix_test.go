@@ -0,0 +1,81 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphite
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/export"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		prefix   string
+		pt       export.Point
+		expected string
+	}{
+		{"no tags", "", export.Point{Measurement: "uptime", Fields: []export.Field{{Key: "total", Value: 1234.5}, {Key: "idle", Value: 4321.25}}, Timestamp: 1500000000999999999}, "uptime.total 1234.5 1500000000\nuptime.idle 4321.25 1500000000\n"},
+		{"prefix", "joefriday.host1.", export.Point{Measurement: "loadavg", Fields: []export.Field{{Key: "running", Value: int64(2)}}, Timestamp: 2000000000}, "joefriday.host1.loadavg.running 2 2\n"},
+		{"tags", "jf", export.Point{Measurement: "netdev", Tags: []export.Tag{{Key: "interface", Value: "eth0.100"}, {Key: "k", Value: ""}}, Fields: []export.Field{{Key: "receive_bytes", Value: int64(42)}}, Timestamp: 0}, "jf.netdev.eth0_100._.receive_bytes 42 0\n"},
+		{"float", "", export.Point{Measurement: "m", Fields: []export.Field{{Key: "a", Value: 1e21}, {Key: "b", Value: math.NaN()}, {Key: "c", Value: math.Inf(-1)}}}, "m.a 1000000000000000000000 0\n"},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := NewEncoder(&buf, test.prefix).Encode(test.pt)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if buf.String() != test.expected {
+			t.Errorf("%s: got %q; want %q", test.name, buf.String(), test.expected)
+		}
+	}
+}
+
+func TestEncodeUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewEncoder(&buf, "").Encode(export.Point{Measurement: "m", Fields: []export.Field{{Key: "a", Value: int64(1)}, {Key: "f", Value: "string"}}})
+	if err == nil {
+		t.Error("expected an error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.String())
+	}
+}
+
+func TestEncodeDiskUsage(t *testing.T) {
+	u := &dstructs.DiskUsage{
+		Timestamp: 1500000000000000000,
+		Rates:     []dstructs.DeviceRates{{Name: "sda", ReadsPerSec: 1.5, Util: 0.25}},
+	}
+	var buf bytes.Buffer
+	err := NewEncoder(&buf, "host").Encode(export.DiskUsage(u)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := bytes.Split(bytes.TrimSuffix(buf.Bytes(), []byte("\n")), []byte("\n"))
+	if len(lines) != 11 {
+		t.Fatalf("got %d lines; want 11", len(lines))
+	}
+	if string(lines[0]) != "host.diskusage.sda.reads_per_sec 1.5 1500000000" {
+		t.Errorf("got %q", lines[0])
+	}
+	if string(lines[10]) != "host.diskusage.sda.util 0.25 1500000000" {
+		t.Errorf("got %q", lines[10])
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package influx encodes export.Points using InfluxDB's line protocol:
+//
+//	measurement,tag=value field=1.5,count=2i timestamp
+//
+// Integer fields are written with the i suffix and timestamps are in
+// nanoseconds. Line protocol can't represent NaN and infinite floats; those
+// fields are skipped.
+package influx
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/hmmftg/joefriday/export"
+)
+
+// Encoder writes Points to an io.Writer as line protocol.
+type Encoder struct {
+	w   io.Writer
+	buf []byte
+}
+
+// NewEncoder returns an Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the Points, one line per Point, with a single write, e.g.
+// one datagram when w is a UDP connection. A Point without any fields that
+// can be written is skipped.
+func (e *Encoder) Encode(pts ...export.Point) error {
+	e.buf = e.buf[:0]
+	var err error
+	for _, p := range pts {
+		e.buf, err = AppendPoint(e.buf, p)
+		if err != nil {
+			return err
+		}
+	}
+	if len(e.buf) == 0 {
+		return nil
+	}
+	_, err = e.w.Write(e.buf)
+	return err
+}
+
+// AppendPoint appends the Point's line, including its line feed, to b. If
+// none of the Point's fields can be written, b is returned unchanged.
+func AppendPoint(b []byte, p export.Point) ([]byte, error) {
+	start := len(b)
+	b = appendEscaped(b, p.Measurement, false)
+	for _, t := range p.Tags {
+		if t.Value == "" {
+			// line protocol doesn't allow empty tag values.
+			continue
+		}
+		b = append(b, ',')
+		b = appendEscaped(b, t.Key, true)
+		b = append(b, '=')
+		b = appendEscaped(b, t.Value, true)
+	}
+	n := 0
+	for _, f := range p.Fields {
+		if v, ok := f.Value.(float64); ok && (math.IsNaN(v) || math.IsInf(v, 0)) {
+			continue
+		}
+		if n == 0 {
+			b = append(b, ' ')
+		} else {
+			b = append(b, ',')
+		}
+		b = appendEscaped(b, f.Key, true)
+		b = append(b, '=')
+		switch v := f.Value.(type) {
+		case int64:
+			b = strconv.AppendInt(b, v, 10)
+			b = append(b, 'i')
+		case float64:
+			b = strconv.AppendFloat(b, v, 'g', -1, 64)
+		default:
+			return b[:start], fmt.Errorf("%s: field %s: unsupported value type %T", p.Measurement, f.Key, f.Value)
+		}
+		n++
+	}
+	if n == 0 {
+		return b[:start], nil
+	}
+	b = append(b, ' ')
+	b = strconv.AppendInt(b, p.Timestamp, 10)
+	return append(b, '\n'), nil
+}
+
+// appendEscaped appends s with its commas and spaces escaped; when equals is
+// true, which is the case for tag keys, tag values, and field keys, its
+// equal signs are also escaped.
+func appendEscaped(b []byte, s string, equals bool) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == ',' || c == ' ' || (c == '=' && equals):
+			b = append(b, '\\', c)
+		case c == '\n':
+			// a line feed ends the line and can't be escaped; it's written as
+			// an escaped space instead.
+			b = append(b, '\\', ' ')
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
@@ -0,0 +1,110 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influx
+
+import (
+	"bytes"
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/cpu/cpuutil"
+	"github.com/hmmftg/joefriday/export"
+)
+
+func TestEncode(t *testing.T) {
+	tests := []struct {
+		name     string
+		pt       export.Point
+		expected string
+	}{
+		{"no tags", export.Point{Measurement: "uptime", Fields: []export.Field{{Key: "total", Value: 1234.5}, {Key: "idle", Value: 4321.25}}, Timestamp: 1}, "uptime total=1234.5,idle=4321.25 1\n"},
+		{"tags", export.Point{Measurement: "cpu", Tags: []export.Tag{{Key: "cpu", Value: "cpu0"}, {Key: "host", Value: "a"}}, Fields: []export.Field{{Key: "n", Value: int64(42)}}, Timestamp: 2}, "cpu,cpu=cpu0,host=a n=42i 2\n"},
+		{"escaped", export.Point{Measurement: "a b,c", Tags: []export.Tag{{Key: "k=1", Value: "v 1,=\n"}}, Fields: []export.Field{{Key: "f ,=", Value: 1.0}}, Timestamp: 3}, `a\ b\,c,k\=1=v\ 1\,\=\  f\ \,\==1 3` + "\n"},
+		{"empty tag", export.Point{Measurement: "m", Tags: []export.Tag{{Key: "k", Value: ""}}, Fields: []export.Field{{Key: "f", Value: int64(1)}}, Timestamp: 4}, "m f=1i 4\n"},
+		{"nan", export.Point{Measurement: "m", Fields: []export.Field{{Key: "a", Value: math.NaN()}, {Key: "b", Value: math.Inf(1)}, {Key: "c", Value: 0.5}}, Timestamp: 5}, "m c=0.5 5\n"},
+		{"no fields", export.Point{Measurement: "m", Fields: []export.Field{{Key: "a", Value: math.NaN()}}, Timestamp: 6}, ""},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		err := NewEncoder(&buf).Encode(test.pt)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %s", test.name, err)
+			continue
+		}
+		if buf.String() != test.expected {
+			t.Errorf("%s: got %q; want %q", test.name, buf.String(), test.expected)
+		}
+	}
+}
+
+func TestEncodeUnsupported(t *testing.T) {
+	var buf bytes.Buffer
+	err := NewEncoder(&buf).Encode(export.Point{Measurement: "m", Fields: []export.Field{{Key: "f", Value: "string"}}})
+	if err == nil {
+		t.Error("expected an error")
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected nothing to be written, got %q", buf.String())
+	}
+}
+
+func TestEncodeCPUUtil(t *testing.T) {
+	u := &cpuutil.CPUUtil{
+		Timestamp: 1500000000000000000, TimeDelta: 1000000000, CtxtDelta: 42, Processes: 3,
+		CPU: []cpuutil.Utilization{{ID: "cpu0", Usage: 12.5, User: 10, System: 2.5, Idle: 87.5}},
+	}
+	var buf bytes.Buffer
+	err := NewEncoder(&buf).Encode(export.CPUUtil(u)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "cpuutil time_delta=1000000000i,ctxt_delta=42i,processes=3i 1500000000000000000\n" +
+		"cpuutil,cpu=cpu0 usage=12.5,user=10,nice=0,system=2.5,idle=87.5,iowait=0,irq=0,softirq=0,steal=0,guest=0,guest_nice=0 1500000000000000000\n"
+	if buf.String() != expected {
+		t.Errorf("got %q; want %q", buf.String(), expected)
+	}
+}
+
+func TestEncodeUDP(t *testing.T) {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip(err)
+	}
+	defer pc.Close()
+	conn, err := net.Dial("udp", pc.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	pts := []export.Point{
+		{Measurement: "a", Fields: []export.Field{{Key: "f", Value: int64(1)}}, Timestamp: 1},
+		{Measurement: "b", Fields: []export.Field{{Key: "f", Value: int64(2)}}, Timestamp: 2},
+	}
+	err = NewEncoder(conn).Encode(pts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// both points are sent in a single datagram.
+	p := make([]byte, 1024)
+	pc.SetReadDeadline(time.Now().Add(time.Second))
+	n, _, err := pc.ReadFrom(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(p[:n]) != "a f=1i 1\nb f=2i 2\n" {
+		t.Errorf("got %q", p[:n])
+	}
+}
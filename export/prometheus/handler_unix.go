@@ -0,0 +1,200 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"net/http"
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/disk/diskstats"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	"github.com/hmmftg/joefriday/net/netdev"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/pressure"
+	"github.com/hmmftg/joefriday/system/uptime"
+)
+
+// Module is the name of a package whose information can be collected; the
+// modules are the collector package's.
+type Module = collector.Module
+
+// Modules is the list of the modules that the Handler can collect, in the
+// order that they are collected.
+var Modules = []Module{
+	collector.CPUStats, collector.MemInfo, collector.DiskStats, collector.NetDev,
+	collector.LoadAvg, collector.Uptime, collector.Pressure,
+}
+
+// DefaultModules is the list of modules that are collected when none are
+// specified. Pressure isn't included; not all kernels provide it.
+var DefaultModules = []Module{
+	collector.CPUStats, collector.MemInfo, collector.DiskStats, collector.NetDev,
+	collector.LoadAvg, collector.Uptime,
+}
+
+// ErrUnknownModule is returned when a module is not one of the Modules.
+var ErrUnknownModule = collector.ErrUnknownModule
+
+// Handler is an http.Handler that collects the enabled modules' information
+// on each request and writes it in the text exposition format. Whether each
+// module was successfully collected is written as
+// joefriday_scrape_module_success.
+type Handler struct {
+	modules   []Module
+	opts      []joe.Option
+	mu        sync.Mutex
+	buf       bytes.Buffer
+	cpuStats  *cpustats.Profiler
+	memInfo   *meminfo.Profiler
+	diskStats *diskstats.Profiler
+	netDev    *netdev.Profiler
+	loadAvg   *loadavg.Profiler
+	uptime    *uptime.Profiler
+	pressure  *pressure.Profiler
+}
+
+// NewHandler returns a Handler for the modules; if no modules are provided,
+// the DefaultModules are collected; a repeated module is collected once. The
+// opts are used by the profilers of each module. An ErrUnknownModule is
+// returned if any of the modules is not one of the Modules. A module whose
+// profiler can't be created is retried on each request.
+func NewHandler(modules []Module, opts ...joe.Option) (*Handler, error) {
+	modules, err := collector.Select(modules, Modules, DefaultModules)
+	if err != nil {
+		return nil, err
+	}
+	h := &Handler{modules: modules, opts: opts}
+	for _, m := range modules {
+		h.init(m)
+	}
+	return h, nil
+}
+
+// Modules returns the Handler's enabled modules.
+func (h *Handler) Modules() []Module {
+	return h.modules
+}
+
+// init creates the module's profiler, if it hasn't already been created.
+func (h *Handler) init(m Module) (err error) {
+	switch m {
+	case collector.CPUStats:
+		if h.cpuStats == nil {
+			h.cpuStats, err = cpustats.NewProfiler(h.opts...)
+		}
+	case collector.MemInfo:
+		if h.memInfo == nil {
+			h.memInfo, err = meminfo.NewProfiler(h.opts...)
+		}
+	case collector.DiskStats:
+		if h.diskStats == nil {
+			h.diskStats, err = diskstats.NewProfiler(h.opts...)
+		}
+	case collector.NetDev:
+		if h.netDev == nil {
+			h.netDev, err = netdev.NewProfiler(h.opts...)
+		}
+	case collector.LoadAvg:
+		if h.loadAvg == nil {
+			h.loadAvg, err = loadavg.NewProfiler(h.opts...)
+		}
+	case collector.Uptime:
+		if h.uptime == nil {
+			h.uptime, err = uptime.NewProfiler(h.opts...)
+		}
+	case collector.Pressure:
+		if h.pressure == nil {
+			h.pressure, err = pressure.NewProfiler(h.opts...)
+		}
+	}
+	return err
+}
+
+// collect gets the module's information and writes it. Nothing is written if
+// the information can't be gotten.
+func (h *Handler) collect(m Module, e *encoder) error {
+	err := h.init(m)
+	if err != nil {
+		return err
+	}
+	switch m {
+	case collector.CPUStats:
+		s, err := h.cpuStats.Get()
+		if err != nil {
+			return err
+		}
+		writeCPUStats(e, s)
+	case collector.MemInfo:
+		inf, err := h.memInfo.Get()
+		if err != nil {
+			return err
+		}
+		writeMemInfo(e, inf)
+	case collector.DiskStats:
+		s, err := h.diskStats.Get()
+		if err != nil {
+			return err
+		}
+		writeDiskStats(e, s)
+	case collector.NetDev:
+		inf, err := h.netDev.Get()
+		if err != nil {
+			return err
+		}
+		writeNetDev(e, inf)
+	case collector.LoadAvg:
+		l, err := h.loadAvg.Get()
+		if err != nil {
+			return err
+		}
+		writeLoadAvg(e, l)
+	case collector.Uptime:
+		u, err := h.uptime.Get()
+		if err != nil {
+			return err
+		}
+		writeUptime(e, u)
+	case collector.Pressure:
+		p, err := h.pressure.Get()
+		if err != nil {
+			return err
+		}
+		writePressure(e, p)
+	}
+	return nil
+}
+
+// ServeHTTP collects the enabled modules' information and writes it.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.buf.Reset()
+	e := &encoder{w: &h.buf}
+	success := make([]float64, len(h.modules))
+	for i, m := range h.modules {
+		if h.collect(m, e) == nil {
+			success[i] = 1
+		}
+	}
+	e.family("joefriday_scrape_module_success", gauge, "Whether the module's information was collected.")
+	for i, m := range h.modules {
+		e.sample("joefriday_scrape_module_success", success[i], "module", string(m))
+	}
+	w.Header().Set("Content-Type", ContentType)
+	w.Write(h.buf.Bytes())
+}
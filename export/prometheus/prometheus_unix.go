@@ -0,0 +1,405 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prometheus writes JoeFriday's information in the Prometheus text
+// exposition format. Every metric is prefixed with "joefriday_"; counters,
+// e.g. CPU time, use the "_total" suffix and all values are in base units:
+// seconds and bytes. Per CPU, device, and interface information is labeled
+// with cpu, device, and interface, respectively.
+//
+// The Write funcs write already collected information; the Handler collects
+// the information on each scrape.
+package prometheus
+
+import (
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/export"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/pressure"
+	"github.com/hmmftg/joefriday/system/uptime"
+)
+
+// ContentType is the content type of the text exposition format.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// The metric types.
+const (
+	counter = "counter"
+	gauge   = "gauge"
+)
+
+// sectorSize is the size of a sector in /proc/diskstats, regardless of the
+// device's actual sector size.
+const sectorSize = 512
+
+// userHZ is the number of clock ticks per second that is used when a
+// CPUStats' ClkTck isn't set.
+const userHZ = 100
+
+// An encoder writes metric families in the text exposition format. Once a
+// write fails, nothing else is written; the error is kept in err.
+type encoder struct {
+	w   io.Writer
+	buf []byte
+	err error
+}
+
+// family writes the HELP and TYPE lines of a metric family. All of the
+// family's samples must be written before the next family is started.
+func (e *encoder) family(name, typ, help string) {
+	e.buf = append(e.buf[:0], "# HELP "...)
+	e.buf = append(e.buf, name...)
+	e.buf = append(e.buf, ' ')
+	e.buf = appendEscaped(e.buf, help, false)
+	e.buf = append(e.buf, "\n# TYPE "...)
+	e.buf = append(e.buf, name...)
+	e.buf = append(e.buf, ' ')
+	e.buf = append(e.buf, typ...)
+	e.buf = append(e.buf, '\n')
+	e.write()
+}
+
+// sample writes a sample; labels holds label name and value pairs.
+func (e *encoder) sample(name string, v float64, labels ...string) {
+	e.buf = append(e.buf[:0], name...)
+	if len(labels) > 0 {
+		e.buf = append(e.buf, '{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				e.buf = append(e.buf, ',')
+			}
+			e.buf = append(e.buf, labels[i]...)
+			e.buf = append(e.buf, `="`...)
+			e.buf = appendEscaped(e.buf, labels[i+1], true)
+			e.buf = append(e.buf, '"')
+		}
+		e.buf = append(e.buf, '}')
+	}
+	e.buf = append(e.buf, ' ')
+	e.buf = appendValue(e.buf, v)
+	e.buf = append(e.buf, '\n')
+	e.write()
+}
+
+func (e *encoder) write() {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(e.buf)
+}
+
+// appendEscaped appends s with its backslashes and line feeds escaped; when
+// quoted is true, its double quotes are also escaped.
+func appendEscaped(b []byte, s string, quoted bool) []byte {
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\':
+			b = append(b, `\\`...)
+		case c == '\n':
+			b = append(b, `\n`...)
+		case c == '"' && quoted:
+			b = append(b, `\"`...)
+		default:
+			b = append(b, c)
+		}
+	}
+	return b
+}
+
+func appendValue(b []byte, v float64) []byte {
+	switch {
+	case math.IsNaN(v):
+		return append(b, "NaN"...)
+	case math.IsInf(v, 1):
+		return append(b, "+Inf"...)
+	case math.IsInf(v, -1):
+		return append(b, "-Inf"...)
+	}
+	return strconv.AppendFloat(b, v, 'g', -1, 64)
+}
+
+// cpuLabel returns the value of the cpu label for a CPU ID: the CPU's
+// number, or "all" for the aggregate of all CPUs.
+func cpuLabel(id string) string {
+	id = strings.TrimPrefix(id, "cpu")
+	if id == "" {
+		return "all"
+	}
+	return id
+}
+
+// WriteCPUStats writes the CPUStats. CPU time is converted from clock ticks
+// to seconds; guest time, which is also part of user and nice time, is
+// written separately.
+func WriteCPUStats(w io.Writer, s *cpustats.CPUStats) error {
+	e := &encoder{w: w}
+	writeCPUStats(e, s)
+	return e.err
+}
+
+func writeCPUStats(e *encoder, s *cpustats.CPUStats) {
+	tck := float64(s.ClkTck)
+	if tck <= 0 {
+		tck = userHZ
+	}
+	e.family("joefriday_cpu_seconds_total", counter, "Seconds the CPUs spent in each mode.")
+	for _, c := range s.CPU {
+		cpu := cpuLabel(c.ID)
+		for _, m := range []struct {
+			mode string
+			v    int64
+		}{
+			{"user", c.User}, {"nice", c.Nice}, {"system", c.System}, {"idle", c.Idle},
+			{"iowait", c.IOWait}, {"irq", c.IRQ}, {"softirq", c.SoftIRQ}, {"steal", c.Steal},
+		} {
+			e.sample("joefriday_cpu_seconds_total", float64(m.v)/tck, "cpu", cpu, "mode", m.mode)
+		}
+	}
+	e.family("joefriday_cpu_guest_seconds_total", counter, "Seconds the CPUs spent running guests, in each mode.")
+	for _, c := range s.CPU {
+		cpu := cpuLabel(c.ID)
+		e.sample("joefriday_cpu_guest_seconds_total", float64(c.Quest)/tck, "cpu", cpu, "mode", "user")
+		e.sample("joefriday_cpu_guest_seconds_total", float64(c.QuestNice)/tck, "cpu", cpu, "mode", "nice")
+	}
+	e.family("joefriday_context_switches_total", counter, "Number of context switches.")
+	e.sample("joefriday_context_switches_total", float64(s.Ctxt))
+	e.family("joefriday_boot_time_seconds", gauge, "Boot time, in seconds since the epoch.")
+	e.sample("joefriday_boot_time_seconds", float64(s.BTime))
+	e.family("joefriday_forks_total", counter, "Number of processes and threads created.")
+	e.sample("joefriday_forks_total", float64(s.Processes))
+}
+
+// memMetrics are the meminfo.Info fields that are in kB, in the order that
+// they are written.
+var memMetrics = []struct {
+	name  string
+	field string
+	v     func(*meminfo.Info) uint64
+}{
+	{"mem_total", "MemTotal", func(i *meminfo.Info) uint64 { return i.MemTotal }},
+	{"mem_free", "MemFree", func(i *meminfo.Info) uint64 { return i.MemFree }},
+	{"mem_available", "MemAvailable", func(i *meminfo.Info) uint64 { return i.MemAvailable }},
+	{"buffers", "Buffers", func(i *meminfo.Info) uint64 { return i.Buffers }},
+	{"cached", "Cached", func(i *meminfo.Info) uint64 { return i.Cached }},
+	{"swap_cached", "SwapCached", func(i *meminfo.Info) uint64 { return i.SwapCached }},
+	{"active", "Active", func(i *meminfo.Info) uint64 { return i.Active }},
+	{"inactive", "Inactive", func(i *meminfo.Info) uint64 { return i.Inactive }},
+	{"active_anon", "Active(anon)", func(i *meminfo.Info) uint64 { return i.ActiveAnon }},
+	{"inactive_anon", "Inactive(anon)", func(i *meminfo.Info) uint64 { return i.InactiveAnon }},
+	{"active_file", "Active(file)", func(i *meminfo.Info) uint64 { return i.ActiveFile }},
+	{"inactive_file", "Inactive(file)", func(i *meminfo.Info) uint64 { return i.InactiveFile }},
+	{"unevictable", "Unevictable", func(i *meminfo.Info) uint64 { return i.Unevictable }},
+	{"mlocked", "Mlocked", func(i *meminfo.Info) uint64 { return i.Mlocked }},
+	{"swap_total", "SwapTotal", func(i *meminfo.Info) uint64 { return i.SwapTotal }},
+	{"swap_free", "SwapFree", func(i *meminfo.Info) uint64 { return i.SwapFree }},
+	{"dirty", "Dirty", func(i *meminfo.Info) uint64 { return i.Dirty }},
+	{"writeback", "Writeback", func(i *meminfo.Info) uint64 { return i.Writeback }},
+	{"anon_pages", "AnonPages", func(i *meminfo.Info) uint64 { return i.AnonPages }},
+	{"mapped", "Mapped", func(i *meminfo.Info) uint64 { return i.Mapped }},
+	{"shmem", "Shmem", func(i *meminfo.Info) uint64 { return i.Shmem }},
+	{"slab", "Slab", func(i *meminfo.Info) uint64 { return i.Slab }},
+	{"s_reclaimable", "SReclaimable", func(i *meminfo.Info) uint64 { return i.SReclaimable }},
+	{"s_unreclaim", "SUnreclaim", func(i *meminfo.Info) uint64 { return i.SUnreclaim }},
+	{"kernel_stack", "KernelStack", func(i *meminfo.Info) uint64 { return i.KernelStack }},
+	{"page_tables", "PageTables", func(i *meminfo.Info) uint64 { return i.PageTables }},
+	{"nfs_unstable", "NFS_Unstable", func(i *meminfo.Info) uint64 { return i.NFSUnstable }},
+	{"bounce", "Bounce", func(i *meminfo.Info) uint64 { return i.Bounce }},
+	{"writeback_tmp", "WritebackTmp", func(i *meminfo.Info) uint64 { return i.WritebackTmp }},
+	{"commit_limit", "CommitLimit", func(i *meminfo.Info) uint64 { return i.CommitLimit }},
+	{"committed_as", "Committed_AS", func(i *meminfo.Info) uint64 { return i.CommittedAS }},
+	{"vmalloc_total", "VmallocTotal", func(i *meminfo.Info) uint64 { return i.VmallocTotal }},
+	{"vmalloc_used", "VmallocUsed", func(i *meminfo.Info) uint64 { return i.VmallocUsed }},
+	{"vmalloc_chunk", "VmallocChunk", func(i *meminfo.Info) uint64 { return i.VmallocChunk }},
+	{"hardware_corrupted", "HardwareCorrupted", func(i *meminfo.Info) uint64 { return i.HardwareCorrupted }},
+	{"anon_huge_pages", "AnonHugePages", func(i *meminfo.Info) uint64 { return i.AnonHugePages }},
+	{"huge_page_size", "Hugepagesize", func(i *meminfo.Info) uint64 { return i.HugePagesSize }},
+	{"direct_map_4k", "DirectMap4k", func(i *meminfo.Info) uint64 { return i.DirectMap4K }},
+	{"direct_map_2m", "DirectMap2M", func(i *meminfo.Info) uint64 { return i.DirectMap2M }},
+}
+
+// WriteMemInfo writes the meminfo.Info. The values that /proc/meminfo
+// reports in kB are converted to bytes; the huge page counts are written as
+// joefriday_memory_huge_pages, labeled with their state.
+func WriteMemInfo(w io.Writer, inf *meminfo.Info) error {
+	e := &encoder{w: w}
+	writeMemInfo(e, inf)
+	return e.err
+}
+
+func writeMemInfo(e *encoder, inf *meminfo.Info) {
+	for _, m := range memMetrics {
+		name := "joefriday_memory_" + m.name + "_bytes"
+		e.family(name, gauge, "Memory information field "+m.field+", in bytes.")
+		e.sample(name, float64(m.v(inf))*1024)
+	}
+	e.family("joefriday_memory_huge_pages", gauge, "Number of huge pages, by state.")
+	e.sample("joefriday_memory_huge_pages", float64(inf.HugePagesTotal), "state", "total")
+	e.sample("joefriday_memory_huge_pages", float64(inf.HugePagesFree), "state", "free")
+	e.sample("joefriday_memory_huge_pages", float64(inf.HugePagesRsvd), "state", "reserved")
+	e.sample("joefriday_memory_huge_pages", float64(inf.HugePagesSurp), "state", "surplus")
+}
+
+// diskMetrics are the per device metrics, in the order that they are
+// written.
+var diskMetrics = []struct {
+	name string
+	typ  string
+	help string
+	v    func(*dstructs.Device) float64
+}{
+	{"reads_completed_total", counter, "Number of reads completed.", func(d *dstructs.Device) float64 { return float64(d.ReadsCompleted) }},
+	{"reads_merged_total", counter, "Number of adjacent reads merged.", func(d *dstructs.Device) float64 { return float64(d.ReadsMerged) }},
+	{"read_bytes_total", counter, "Number of bytes read.", func(d *dstructs.Device) float64 { return float64(d.ReadSectors) * sectorSize }},
+	{"read_time_seconds_total", counter, "Seconds spent reading.", func(d *dstructs.Device) float64 { return float64(d.ReadingTime) / 1000 }},
+	{"writes_completed_total", counter, "Number of writes completed.", func(d *dstructs.Device) float64 { return float64(d.WritesCompleted) }},
+	{"writes_merged_total", counter, "Number of adjacent writes merged.", func(d *dstructs.Device) float64 { return float64(d.WritesMerged) }},
+	{"written_bytes_total", counter, "Number of bytes written.", func(d *dstructs.Device) float64 { return float64(d.WrittenSectors) * sectorSize }},
+	{"write_time_seconds_total", counter, "Seconds spent writing.", func(d *dstructs.Device) float64 { return float64(d.WritingTime) / 1000 }},
+	{"io_now", gauge, "Number of I/Os in progress.", func(d *dstructs.Device) float64 { return float64(d.IOInProgress) }},
+	{"io_time_seconds_total", counter, "Seconds spent doing I/Os.", func(d *dstructs.Device) float64 { return float64(d.IOTime) / 1000 }},
+	{"io_time_weighted_seconds_total", counter, "Weighted seconds spent doing I/Os.", func(d *dstructs.Device) float64 { return float64(d.WeightedIOTime) / 1000 }},
+}
+
+// WriteDiskStats writes the DiskStats, labeled by device. Sectors are
+// converted to bytes and milliseconds to seconds.
+func WriteDiskStats(w io.Writer, s *dstructs.DiskStats) error {
+	e := &encoder{w: w}
+	writeDiskStats(e, s)
+	return e.err
+}
+
+func writeDiskStats(e *encoder, s *dstructs.DiskStats) {
+	for _, m := range diskMetrics {
+		name := "joefriday_disk_" + m.name
+		e.family(name, m.typ, m.help)
+		for i := range s.Device {
+			e.sample(name, m.v(&s.Device[i]), "device", s.Device[i].Name)
+		}
+	}
+}
+
+// netMetrics are the per interface metrics, in the order that they are
+// written. All of them are counters.
+var netMetrics = []struct {
+	name string
+	help string
+	v    func(*nstructs.Device) int64
+}{
+	{"receive_bytes_total", "Number of bytes received.", func(d *nstructs.Device) int64 { return d.RBytes }},
+	{"receive_packets_total", "Number of packets received.", func(d *nstructs.Device) int64 { return d.RPackets }},
+	{"receive_errs_total", "Number of receive errors.", func(d *nstructs.Device) int64 { return d.RErrs }},
+	{"receive_drop_total", "Number of received packets dropped.", func(d *nstructs.Device) int64 { return d.RDrop }},
+	{"receive_fifo_total", "Number of receive FIFO buffer errors.", func(d *nstructs.Device) int64 { return d.RFIFO }},
+	{"receive_frame_total", "Number of receive framing errors.", func(d *nstructs.Device) int64 { return d.RFrame }},
+	{"receive_compressed_total", "Number of compressed packets received.", func(d *nstructs.Device) int64 { return d.RCompressed }},
+	{"receive_multicast_total", "Number of multicast frames received.", func(d *nstructs.Device) int64 { return d.RMulticast }},
+	{"transmit_bytes_total", "Number of bytes transmitted.", func(d *nstructs.Device) int64 { return d.TBytes }},
+	{"transmit_packets_total", "Number of packets transmitted.", func(d *nstructs.Device) int64 { return d.TPackets }},
+	{"transmit_errs_total", "Number of transmit errors.", func(d *nstructs.Device) int64 { return d.TErrs }},
+	{"transmit_drop_total", "Number of transmitted packets dropped.", func(d *nstructs.Device) int64 { return d.TDrop }},
+	{"transmit_fifo_total", "Number of transmit FIFO buffer errors.", func(d *nstructs.Device) int64 { return d.TFIFO }},
+	{"transmit_colls_total", "Number of collisions detected.", func(d *nstructs.Device) int64 { return d.TColls }},
+	{"transmit_carrier_total", "Number of carrier losses detected.", func(d *nstructs.Device) int64 { return d.TCarrier }},
+	{"transmit_compressed_total", "Number of compressed packets transmitted.", func(d *nstructs.Device) int64 { return d.TCompressed }},
+}
+
+// WriteNetDev writes the DevInfo, labeled by interface.
+func WriteNetDev(w io.Writer, inf *nstructs.DevInfo) error {
+	e := &encoder{w: w}
+	writeNetDev(e, inf)
+	return e.err
+}
+
+func writeNetDev(e *encoder, inf *nstructs.DevInfo) {
+	for _, m := range netMetrics {
+		name := "joefriday_network_" + m.name
+		e.family(name, counter, m.help)
+		for i := range inf.Device {
+			e.sample(name, float64(m.v(&inf.Device[i])), "interface", inf.Device[i].Name)
+		}
+	}
+}
+
+// WriteLoadAvg writes the LoadAvg.
+func WriteLoadAvg(w io.Writer, l loadavg.LoadAvg) error {
+	e := &encoder{w: w}
+	writeLoadAvg(e, l)
+	return e.err
+}
+
+func writeLoadAvg(e *encoder, l loadavg.LoadAvg) {
+	e.family("joefriday_load1", gauge, "1 minute load average.")
+	e.sample("joefriday_load1", export.Float64(l.Minute))
+	e.family("joefriday_load5", gauge, "5 minute load average.")
+	e.sample("joefriday_load5", export.Float64(l.Five))
+	e.family("joefriday_load15", gauge, "15 minute load average.")
+	e.sample("joefriday_load15", export.Float64(l.Fifteen))
+	e.family("joefriday_procs_running", gauge, "Number of runnable kernel scheduling entities.")
+	e.sample("joefriday_procs_running", float64(l.Running))
+	e.family("joefriday_scheduling_entities", gauge, "Number of kernel scheduling entities.")
+	e.sample("joefriday_scheduling_entities", float64(l.Total))
+	e.family("joefriday_last_pid", gauge, "PID of the most recently created process.")
+	e.sample("joefriday_last_pid", float64(l.PID))
+}
+
+// WriteUptime writes the Uptime.
+func WriteUptime(w io.Writer, u uptime.Uptime) error {
+	e := &encoder{w: w}
+	writeUptime(e, u)
+	return e.err
+}
+
+func writeUptime(e *encoder, u uptime.Uptime) {
+	e.family("joefriday_uptime_seconds", gauge, "Seconds since boot.")
+	e.sample("joefriday_uptime_seconds", u.Total)
+	e.family("joefriday_idle_seconds_total", counter, "Sum of the seconds each CPU has spent idle.")
+	e.sample("joefriday_idle_seconds_total", u.Idle)
+}
+
+// WritePressure writes the Pressure, labeled by resource and by kind: some
+// or full. The stall averages are written as ratios, labeled by their
+// window, and the total stall time is converted to seconds.
+func WritePressure(w io.Writer, p pressure.Pressure) error {
+	e := &encoder{w: w}
+	writePressure(e, p)
+	return e.err
+}
+
+func writePressure(e *encoder, p pressure.Pressure) {
+	stalls := []struct {
+		resource, kind string
+		s              pressure.Stall
+	}{
+		{"cpu", "some", p.CPU.Some}, {"cpu", "full", p.CPU.Full},
+		{"memory", "some", p.Memory.Some}, {"memory", "full", p.Memory.Full},
+		{"io", "some", p.IO.Some}, {"io", "full", p.IO.Full},
+	}
+	e.family("joefriday_pressure_stall_ratio", gauge, "Average ratio of time that tasks were stalled on a resource, over the window.")
+	for _, s := range stalls {
+		e.sample("joefriday_pressure_stall_ratio", export.Float64(s.s.Avg10)/100, "resource", s.resource, "kind", s.kind, "window", "10s")
+		e.sample("joefriday_pressure_stall_ratio", export.Float64(s.s.Avg60)/100, "resource", s.resource, "kind", s.kind, "window", "60s")
+		e.sample("joefriday_pressure_stall_ratio", export.Float64(s.s.Avg300)/100, "resource", s.resource, "kind", s.kind, "window", "300s")
+	}
+	e.family("joefriday_pressure_stalled_seconds_total", counter, "Seconds that tasks were stalled on a resource.")
+	for _, s := range stalls {
+		e.sample("joefriday_pressure_stalled_seconds_total", float64(s.s.Total)/1e6, "resource", s.resource, "kind", s.kind)
+	}
+}
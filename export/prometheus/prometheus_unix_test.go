@@ -0,0 +1,348 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package prometheus
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/collector"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	dstructs "github.com/hmmftg/joefriday/disk/structs"
+	"github.com/hmmftg/joefriday/mem/meminfo"
+	nstructs "github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/pressure"
+	"github.com/hmmftg/joefriday/system/uptime"
+)
+
+// contains checks that all of the lines are in s.
+func contains(t *testing.T, s string, lines ...string) {
+	t.Helper()
+	for _, l := range lines {
+		if !strings.Contains(s, l+"\n") {
+			t.Errorf("expected output to contain %q", l)
+		}
+	}
+}
+
+func TestEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	e := &encoder{w: &buf}
+	e.family("test_metric", gauge, "Help with a \\ and a\nnewline.")
+	e.sample("test_metric", 1.5, "a", `quote " backslash \ newline`+"\n", "b", "c")
+	e.sample("test_metric", math.NaN())
+	e.sample("test_metric", math.Inf(1))
+	e.sample("test_metric", math.Inf(-1))
+	e.sample("test_metric", 1e21)
+	expected := `# HELP test_metric Help with a \\ and a\nnewline.
+# TYPE test_metric gauge
+test_metric{a="quote \" backslash \\ newline\n",b="c"} 1.5
+test_metric NaN
+test_metric +Inf
+test_metric -Inf
+test_metric 1e+21
+`
+	if buf.String() != expected {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+type errWriter struct {
+	n int
+}
+
+var errWrite = errors.New("write error")
+
+func (w *errWriter) Write(p []byte) (int, error) {
+	w.n++
+	return 0, errWrite
+}
+
+func TestWriteError(t *testing.T) {
+	w := &errWriter{}
+	err := WriteLoadAvg(w, loadavg.LoadAvg{})
+	if err != errWrite {
+		t.Errorf("got %v; want %v", err, errWrite)
+	}
+	if w.n != 1 {
+		t.Errorf("got %d writes; want 1", w.n)
+	}
+}
+
+func TestWriteCPUStats(t *testing.T) {
+	s := &cpustats.CPUStats{
+		ClkTck: 100, Ctxt: 67890, BTime: 1500000000, Processes: 4242,
+		CPU: []cpustats.CPU{
+			{ID: "cpu", User: 150, Nice: 2, System: 30, Idle: 4000, IOWait: 5, IRQ: 6, SoftIRQ: 7, Steal: 8, Quest: 9, QuestNice: 10},
+			{ID: "cpu0", User: 50, Nice: 1, System: 15, Idle: 2000, IOWait: 2, IRQ: 3, SoftIRQ: 3, Steal: 4, Quest: 4, QuestNice: 5},
+		},
+	}
+	var buf bytes.Buffer
+	err := WriteCPUStats(&buf, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_cpu_seconds_total counter",
+		`joefriday_cpu_seconds_total{cpu="all",mode="user"} 1.5`,
+		`joefriday_cpu_seconds_total{cpu="all",mode="idle"} 40`,
+		`joefriday_cpu_seconds_total{cpu="0",mode="steal"} 0.04`,
+		`joefriday_cpu_guest_seconds_total{cpu="0",mode="nice"} 0.05`,
+		"joefriday_context_switches_total 67890",
+		"# TYPE joefriday_boot_time_seconds gauge",
+		"joefriday_boot_time_seconds 1.5e+09",
+		"joefriday_forks_total 4242",
+	)
+	// each family's samples are written together.
+	if n := strings.Count(buf.String(), "# TYPE joefriday_cpu_seconds_total"); n != 1 {
+		t.Errorf("got %d joefriday_cpu_seconds_total families; want 1", n)
+	}
+}
+
+func TestWriteMemInfo(t *testing.T) {
+	inf := &meminfo.Info{MemTotal: 2048, MemFree: 1024, HugePagesTotal: 4, HugePagesSize: 2048}
+	var buf bytes.Buffer
+	err := WriteMemInfo(&buf, inf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_memory_mem_total_bytes gauge",
+		"joefriday_memory_mem_total_bytes 2.097152e+06",
+		"joefriday_memory_mem_free_bytes 1.048576e+06",
+		"joefriday_memory_huge_page_size_bytes 2.097152e+06",
+		`joefriday_memory_huge_pages{state="total"} 4`,
+		`joefriday_memory_huge_pages{state="free"} 0`,
+	)
+}
+
+func TestWriteDiskStats(t *testing.T) {
+	s := &dstructs.DiskStats{
+		Device: []dstructs.Device{
+			{Name: "sda", ReadsCompleted: 10, ReadSectors: 4, ReadingTime: 1500, IOInProgress: 2, WeightedIOTime: 250},
+			{Name: "sdb", WritesCompleted: 3, WrittenSectors: 2, WritingTime: 20},
+		},
+	}
+	var buf bytes.Buffer
+	err := WriteDiskStats(&buf, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_disk_reads_completed_total counter",
+		`joefriday_disk_reads_completed_total{device="sda"} 10`,
+		`joefriday_disk_reads_completed_total{device="sdb"} 0`,
+		`joefriday_disk_read_bytes_total{device="sda"} 2048`,
+		`joefriday_disk_read_time_seconds_total{device="sda"} 1.5`,
+		`joefriday_disk_writes_completed_total{device="sdb"} 3`,
+		`joefriday_disk_written_bytes_total{device="sdb"} 1024`,
+		`joefriday_disk_write_time_seconds_total{device="sdb"} 0.02`,
+		"# TYPE joefriday_disk_io_now gauge",
+		`joefriday_disk_io_now{device="sda"} 2`,
+		`joefriday_disk_io_time_weighted_seconds_total{device="sda"} 0.25`,
+	)
+}
+
+func TestWriteNetDev(t *testing.T) {
+	inf := &nstructs.DevInfo{
+		Device: []nstructs.Device{
+			{Name: "eth0", RBytes: 1000, RPackets: 10, TBytes: 500, TColls: 1},
+			{Name: "lo", RBytes: 42},
+		},
+	}
+	var buf bytes.Buffer
+	err := WriteNetDev(&buf, inf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_network_receive_bytes_total counter",
+		`joefriday_network_receive_bytes_total{interface="eth0"} 1000`,
+		`joefriday_network_receive_bytes_total{interface="lo"} 42`,
+		`joefriday_network_receive_packets_total{interface="eth0"} 10`,
+		`joefriday_network_transmit_bytes_total{interface="eth0"} 500`,
+		`joefriday_network_transmit_colls_total{interface="eth0"} 1`,
+	)
+}
+
+func TestWriteLoadAvg(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteLoadAvg(&buf, loadavg.LoadAvg{Minute: 0.1, Five: 0.25, Fifteen: 1.5, Running: 2, Total: 300, PID: 4242})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP joefriday_load1 1 minute load average.
+# TYPE joefriday_load1 gauge
+joefriday_load1 0.1
+# HELP joefriday_load5 5 minute load average.
+# TYPE joefriday_load5 gauge
+joefriday_load5 0.25
+# HELP joefriday_load15 15 minute load average.
+# TYPE joefriday_load15 gauge
+joefriday_load15 1.5
+# HELP joefriday_procs_running Number of runnable kernel scheduling entities.
+# TYPE joefriday_procs_running gauge
+joefriday_procs_running 2
+# HELP joefriday_scheduling_entities Number of kernel scheduling entities.
+# TYPE joefriday_scheduling_entities gauge
+joefriday_scheduling_entities 300
+# HELP joefriday_last_pid PID of the most recently created process.
+# TYPE joefriday_last_pid gauge
+joefriday_last_pid 4242
+`
+	if buf.String() != expected {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteUptime(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteUptime(&buf, uptime.Uptime{Total: 1234.5, Idle: 4321.25})
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_uptime_seconds gauge",
+		"joefriday_uptime_seconds 1234.5",
+		"# TYPE joefriday_idle_seconds_total counter",
+		"joefriday_idle_seconds_total 4321.25",
+	)
+}
+
+func TestWritePressure(t *testing.T) {
+	p := pressure.Pressure{
+		CPU:    pressure.Resource{Some: pressure.Stall{Avg10: 1.5, Avg60: 0.5, Avg300: 0.1, Total: 2500000}},
+		Memory: pressure.Resource{Full: pressure.Stall{Avg10: 10, Total: 1000}},
+	}
+	var buf bytes.Buffer
+	err := WritePressure(&buf, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contains(t, buf.String(),
+		"# TYPE joefriday_pressure_stall_ratio gauge",
+		`joefriday_pressure_stall_ratio{resource="cpu",kind="some",window="10s"} 0.015`,
+		`joefriday_pressure_stall_ratio{resource="cpu",kind="some",window="300s"} 0.001`,
+		`joefriday_pressure_stall_ratio{resource="memory",kind="full",window="10s"} 0.1`,
+		"# TYPE joefriday_pressure_stalled_seconds_total counter",
+		`joefriday_pressure_stalled_seconds_total{resource="cpu",kind="some"} 2.5`,
+		`joefriday_pressure_stalled_seconds_total{resource="memory",kind="full"} 0.001`,
+	)
+}
+
+var procFS = fstest.MapFS{
+	"proc/stat": &fstest.MapFile{Data: []byte(`cpu  100 2 30 4000 5 6 7 8 9 10
+cpu0 100 2 30 4000 5 6 7 8 9 10
+intr 12345 0 0
+ctxt 67890
+btime 1500000000
+processes 4242
+procs_running 2
+procs_blocked 0
+`)},
+	"proc/meminfo": &fstest.MapFile{Data: []byte(`MemTotal:        2048 kB
+MemFree:         1024 kB
+MemAvailable:    1536 kB
+`)},
+	"proc/diskstats": &fstest.MapFile{Data: []byte(`   8       0 sda 10 2 4 1500 3 1 2 20 0 100 250
+`)},
+	"proc/net/dev": &fstest.MapFile{Data: []byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:      42       1    0    0    0     0          0         0       42       1    0    0    0     0       0          0
+`)},
+	"proc/loadavg": &fstest.MapFile{Data: []byte("0.10 0.25 1.50 2/300 4242\n")},
+	"proc/uptime":  &fstest.MapFile{Data: []byte("1234.50 4321.25\n")},
+}
+
+func TestHandler(t *testing.T) {
+	h, err := NewHandler(nil, joe.WithFS(procFS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+		if ct := w.Header().Get("Content-Type"); ct != ContentType {
+			t.Errorf("Content-Type: got %q; want %q", ct, ContentType)
+		}
+		contains(t, w.Body.String(),
+			`joefriday_cpu_seconds_total{cpu="0",mode="user"} 1`,
+			"joefriday_memory_mem_total_bytes 2.097152e+06",
+			`joefriday_disk_reads_completed_total{device="sda"} 10`,
+			`joefriday_network_receive_bytes_total{interface="lo"} 42`,
+			"joefriday_load15 1.5",
+			"joefriday_uptime_seconds 1234.5",
+			`joefriday_scrape_module_success{module="cpustats"} 1`,
+			`joefriday_scrape_module_success{module="meminfo"} 1`,
+			`joefriday_scrape_module_success{module="diskstats"} 1`,
+			`joefriday_scrape_module_success{module="netdev"} 1`,
+			`joefriday_scrape_module_success{module="loadavg"} 1`,
+			`joefriday_scrape_module_success{module="uptime"} 1`,
+		)
+	}
+}
+
+func TestHandlerModuleError(t *testing.T) {
+	// there is no pressure information in procFS.
+	h, err := NewHandler([]Module{collector.LoadAvg, collector.Pressure}, joe.WithFS(procFS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	contains(t, w.Body.String(),
+		"joefriday_load1 0.1",
+		`joefriday_scrape_module_success{module="loadavg"} 1`,
+		`joefriday_scrape_module_success{module="pressure"} 0`,
+	)
+	if strings.Contains(w.Body.String(), "joefriday_pressure_") {
+		t.Error("expected no pressure metrics")
+	}
+}
+
+func TestNewHandlerUnknownModule(t *testing.T) {
+	_, err := NewHandler([]Module{collector.LoadAvg, "bogus"})
+	if !errors.Is(err, ErrUnknownModule) {
+		t.Errorf("got %v; want %v", err, ErrUnknownModule)
+	}
+	// the collector's modules that the Handler doesn't collect are unknown.
+	_, err = NewHandler([]Module{collector.CPUUtil})
+	if !errors.Is(err, ErrUnknownModule) {
+		t.Errorf("cpuutil: got %v; want %v", err, ErrUnknownModule)
+	}
+}
+
+func TestNewHandlerRepeatedModule(t *testing.T) {
+	h, err := NewHandler([]Module{collector.LoadAvg, collector.Uptime, collector.LoadAvg}, joe.WithFS(procFS))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Module{collector.LoadAvg, collector.Uptime}
+	if !reflect.DeepEqual(h.Modules(), want) {
+		t.Errorf("got %v; want %v", h.Modules(), want)
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if n := strings.Count(w.Body.String(), "\njoefriday_load1 "); n != 1 {
+		t.Errorf("got %d joefriday_load1 samples; want 1", n)
+	}
+}
@@ -6,6 +6,8 @@ require (
 	github.com/google/flatbuffers v23.5.26+incompatible
 	github.com/mohae/benchutil v0.0.0-20170714194401-3392ed7c9b5a
 	github.com/mohae/randchars v0.0.0-20170727203217-89ffe2e7dfda
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+	google.golang.org/protobuf v1.36.5
 )
 
 require (
@@ -17,4 +19,5 @@ require (
 	github.com/lazybeaver/xorshift v0.0.0-20170702203709-ce511d4823dd // indirect
 	github.com/mohae/csv2md v0.0.0-20160526184001-56415a97bb30 // indirect
 	github.com/mohae/joefriday v0.0.0-20170926205446-2d83fc975dd8 // indirect
+	github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
 )
@@ -26,6 +26,8 @@ package joefriday
 import (
 	"bufio"
 	"fmt"
+	"io"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -145,12 +147,62 @@ func (p *Proc) Reset() error {
 	return nil
 }
 
+// An FSProc holds everything related to a file in a fs.FS and some processing
+// vars.
+type FSProc struct {
+	fs.File
+	Buf  *bufio.Reader
+	fsys fs.FS
+	name string
+}
+
+// NewFSProc creates a FSProc using the named file in fsys.
+func NewFSProc(fsys fs.FS, name string) (*FSProc, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &FSProc{File: f, Buf: bufio.NewReader(f), fsys: fsys, name: name}, nil
+}
+
+// ReadSlice is a wrapper for bufio.Reader.ReadSlice.
+func (p *FSProc) ReadSlice(delim byte) (line []byte, err error) {
+	return p.Buf.ReadSlice(delim)
+}
+
+// Reset reset's the profiler's resources. Files that can't seek are reopened.
+func (p *FSProc) Reset() error {
+	if s, ok := p.File.(io.Seeker); ok {
+		_, err := s.Seek(0, io.SeekStart)
+		if err != nil {
+			return &ResetError{err}
+		}
+		p.Buf.Reset(p.File)
+		return nil
+	}
+	f, err := p.fsys.Open(p.name)
+	if err != nil {
+		return &ResetError{err}
+	}
+	p.File.Close()
+	p.File = f
+	p.Buf.Reset(p.File)
+	return nil
+}
+
+// Tocker is implemented by all of the packages' tickers.
 type Tocker interface {
 	Close() // Close the Tocker's resources
 	Run()   // Run some code on an interval.
 	Stop()  // Stop the Tocker.
 }
 
+// Ticker signals a Tocker's Run loop to run at intervals.
+//
+// Deprecated: Stop blocks until Run receives the signal and a consumer that
+// stops reading Errs blocks Run. Use the generic Ticker in
+// github.com/hmmftg/joefriday/ticker, which is stopped by canceling a
+// context.Context and has configurable buffering.
 type Ticker struct {
 	*time.Ticker
 	Done chan struct{} // done channel
@@ -15,10 +15,12 @@ package joefriday
 import (
 	"bytes"
 	"errors"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"testing/fstest"
 )
 
 func TestErrorCheck(t *testing.T) {
@@ -355,3 +357,56 @@ func TestNewTempFileProc(t *testing.T) {
 		}
 	}
 }
+
+// noSeekFS wraps a fs.FS; its files don't implement io.Seeker.
+type noSeekFS struct {
+	fs.FS
+}
+
+type noSeekFile struct {
+	fs.File
+}
+
+func (n noSeekFS) Open(name string) (fs.File, error) {
+	f, err := n.FS.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return noSeekFile{f}, nil
+}
+
+func TestFSProc(t *testing.T) {
+	fsys := fstest.MapFS{"proc/test": &fstest.MapFile{Data: []byte("line 1\nline 2\n")}}
+	for _, test := range []struct {
+		name string
+		fsys fs.FS
+	}{
+		{"seeker", fsys},
+		{"no seeker", noSeekFS{fsys}},
+	} {
+		p, err := NewFSProc(test.fsys, "proc/test")
+		if err != nil {
+			t.Errorf("%s: %s", test.name, err)
+			continue
+		}
+		for i := 0; i < 2; i++ {
+			err = p.Reset()
+			if err != nil {
+				t.Errorf("%s: reset: %s", test.name, err)
+				break
+			}
+			line, err := p.ReadSlice('\n')
+			if err != nil {
+				t.Errorf("%s: read: %s", test.name, err)
+				break
+			}
+			if string(line) != "line 1\n" {
+				t.Errorf("%s: %d: got %q; want %q", test.name, i, line, "line 1\n")
+			}
+		}
+	}
+	_, err := NewFSProc(fsys, "proc/missing")
+	if !os.IsNotExist(err) {
+		t.Errorf("missing file: got %v; want ErrNotExist", err)
+	}
+}
@@ -22,7 +22,7 @@
 package membasic
 
 import (
-	"io"
+	"context"
 	"sync"
 	"time"
 
@@ -30,7 +30,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	basic "github.com/hmmftg/joefriday/mem/membasic"
 	"github.com/hmmftg/joefriday/mem/membasic/flat/structs"
-	"github.com/hmmftg/joefriday/tools"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to get the basic memory information as Flatbuffer
@@ -41,8 +41,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler that utilizes FlatBuffers; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := basic.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := basic.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -133,8 +133,7 @@ func Deserialize(p []byte) *basic.Info {
 
 // Ticker delivers the system's basic memory information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -143,136 +142,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// predeclare some vars
-	var (
-		i, pos, nameLen int
-		v               byte
-		n               uint64
-		err             error
-	)
-	// ticker
-Tick:
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			t.Builder.Reset()
-			err = t.Profiler.Profiler.Reset()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			structs.InfoStart(t.Builder)
-			structs.InfoAddTimestamp(t.Builder, time.Now().UTC().UnixNano())
-			for {
-				t.Val = t.Val[:0]
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					// An error results in sending error message and stop processing of this tick.
-					t.Errs <- &joe.ReadError{Err: err}
-					continue Tick
-				}
-				// first grab the key name (everything up to the ':')
-				for i, v = range t.Line {
-					if v == 0x3A {
-						t.Val = t.Line[:i]
-						pos = i + 1 // skip the :
-						break
-					}
-				}
-				nameLen = len(t.Val)
-				// skip all spaces
-				for i, v = range t.Line[pos:] {
-					if v != 0x20 {
-						pos += i
-						break
-					}
-				}
-
-				// grab the numbers
-				for _, v = range t.Line[pos:] {
-					if v == 0x20 || v == '\n' {
-						break
-					}
-					t.Val = append(t.Val, v)
-				}
-				// any conversion error results in 0
-				n, err = tools.ParseUint(t.Val[nameLen:])
-				if err != nil {
-					t.Errs <- &joe.ParseError{Info: string(t.Val[:nameLen]), Err: err}
-					continue
-				}
-				v = t.Val[0]
-				if v == 'A' {
-					if t.Val[5] == 'e' && nameLen == 6 {
-						structs.InfoAddActive(t.Builder, n)
-					}
-					continue
-				}
-				if v == 'I' {
-					if nameLen == 8 {
-						structs.InfoAddInactive(t.Builder, n)
-					}
-					continue
-				}
-				if v == 'M' {
-					v = t.Val[3]
-					if nameLen < 8 {
-						if v == 'p' {
-							structs.InfoAddMapped(t.Builder, n)
-							continue
-						}
-						if v == 'F' {
-							structs.InfoAddMemFree(t.Builder, n)
-						}
-						continue
-					}
-					if v == 'A' {
-						structs.InfoAddMemAvailable(t.Builder, n)
-						continue
-					}
-					structs.InfoAddMemTotal(t.Builder, n)
-					continue
-				}
-				if v == 'S' {
-					v = t.Val[1]
-					if v == 'w' {
-						if t.Val[4] == 'C' {
-							structs.InfoAddSwapCached(t.Builder, n)
-							continue
-						}
-						if t.Val[4] == 'F' {
-							structs.InfoAddSwapFree(t.Builder, n)
-							continue
-						}
-						structs.InfoAddSwapTotal(t.Builder, n)
-					}
-				}
-			}
-			t.Builder.Finish(structs.InfoEnd(t.Builder))
-			t.Data <- t.Profiler.Builder.Bytes[t.Builder.Head():]
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
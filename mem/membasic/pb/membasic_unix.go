@@ -0,0 +1,183 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package membasic processes a subset of the /proc/meminfo file. Instead of
+// returning a Go struct, it returns protobuf serialized bytes. A function to
+// deserialize the protobuf serialized bytes into a membasic.Info struct is
+// provided. For more detailed information about a system's memory, use the
+// meminfo package.
+//
+// Note: the package name is membasic and not the final element of the import
+// path (pb).
+package membasic
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	basic "github.com/hmmftg/joefriday/mem/membasic"
+	"github.com/hmmftg/joefriday/mem/membasic/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to get the basic memory information, as protobuf, by
+// processing the /proc/meminfo file.
+type Profiler struct {
+	*basic.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := basic.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current basic memory information as protobuf serialized
+// bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current basic memory information as protobuf serialized bytes
+// using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize the basic memory information using protobuf.
+func (prof *Profiler) Serialize(inf *basic.Info) ([]byte, error) {
+	return proto.Marshal(toProto(inf))
+}
+
+// Serialize the basic memory information using protobuf with the package's
+// global Profiler.
+func Serialize(inf *basic.Info) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(inf *basic.Info) ([]byte, error) {
+	return prof.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize that uses the package's global profiler.
+func Marshal(inf *basic.Info) ([]byte, error) {
+	return Serialize(inf)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// membasic.Info.
+func Deserialize(p []byte) (*basic.Info, error) {
+	m := &structs.Info{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*basic.Info, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a membasic.Info into a new structs.Info message.
+func toProto(inf *basic.Info) *structs.Info {
+	return &structs.Info{
+		Timestamp:    inf.Timestamp,
+		Active:       inf.Active,
+		Inactive:     inf.Inactive,
+		Mapped:       inf.Mapped,
+		MemAvailable: inf.MemAvailable,
+		MemFree:      inf.MemFree,
+		MemTotal:     inf.MemTotal,
+		SwapCached:   inf.SwapCached,
+		SwapFree:     inf.SwapFree,
+		SwapTotal:    inf.SwapTotal,
+	}
+}
+
+// fromProto copies a structs.Info message into a membasic.Info.
+func fromProto(m *structs.Info) *basic.Info {
+	return &basic.Info{
+		Timestamp:    m.Timestamp,
+		Active:       m.Active,
+		Inactive:     m.Inactive,
+		Mapped:       m.Mapped,
+		MemAvailable: m.MemAvailable,
+		MemFree:      m.MemFree,
+		MemTotal:     m.MemTotal,
+		SwapCached:   m.SwapCached,
+		SwapFree:     m.SwapFree,
+		SwapTotal:    m.SwapTotal,
+	}
+}
+
+// Ticker delivers the system's basic memory information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -21,7 +21,7 @@
 package meminfo
 
 import (
-	"io"
+	"context"
 	"sync"
 	"time"
 
@@ -29,7 +29,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	mem "github.com/hmmftg/joefriday/mem/meminfo"
 	"github.com/hmmftg/joefriday/mem/meminfo/flat/structs"
-	"github.com/hmmftg/joefriday/tools"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to get the memory information as Flatbuffer serialized
@@ -40,8 +40,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := mem.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := mem.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -199,8 +199,7 @@ func Deserialize(p []byte) *mem.Info {
 
 // Ticker delivers the system's memory information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -209,259 +208,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// predeclare some vars
-	var (
-		i, pos, nameLen int
-		v               byte
-		n               uint64
-		err             error
-	)
-	// ticker
-Tick:
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			t.Builder.Reset()
-			err = t.Profiler.Profiler.Reset()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			structs.InfoStart(t.Builder)
-			structs.InfoAddTimestamp(t.Builder, time.Now().UTC().UnixNano())
-			for {
-				t.Val = t.Val[:0]
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					// An error results in sending error message and stop processing of this tick.
-					t.Errs <- &joe.ReadError{Err: err}
-					continue Tick
-				}
-				// first grab the key name (everything up to the ':')
-				for i, v = range t.Line {
-					if v == 0x3A {
-						t.Val = t.Line[:i]
-						pos = i + 1 // skip the :
-						break
-					}
-				}
-				nameLen = len(t.Val)
-				// skip all spaces
-				for i, v = range t.Line[pos:] {
-					if v != 0x20 {
-						pos += i
-						break
-					}
-				}
-
-				// grab the numbers
-				for _, v = range t.Line[pos:] {
-					if v == 0x20 || v == '\n' {
-						break
-					}
-					t.Val = append(t.Val, v)
-				}
-				// any conversion error results in 0
-				n, err = tools.ParseUint(t.Val[nameLen:])
-				if err != nil {
-					t.Errs <- &joe.ParseError{Info: string(t.Val[:nameLen]), Err: err}
-					continue
-				}
-				v = t.Val[0]
-				if v == 'A' {
-					if t.Val[5] == 'e' {
-						if nameLen == 6 {
-							structs.InfoAddActive(t.Builder, n)
-							continue
-						}
-						if t.Val[7] == 'a' {
-							structs.InfoAddActiveAnon(t.Builder, n)
-							continue
-						}
-						structs.InfoAddActiveFile(t.Builder, n)
-						continue
-					}
-					if nameLen == 9 {
-						structs.InfoAddAnonPages(t.Builder, n)
-						continue
-					}
-					structs.InfoAddAnonHugePages(t.Builder, n)
-					continue
-				}
-				if v == 'C' {
-					if nameLen == 6 {
-						structs.InfoAddCached(t.Builder, n)
-						continue
-					}
-					if nameLen == 11 {
-						structs.InfoAddCommitLimit(t.Builder, n)
-						continue
-					}
-					structs.InfoAddCommittedAS(t.Builder, n)
-					continue
-				}
-				if v == 'D' {
-					if nameLen == 5 {
-						structs.InfoAddDirty(t.Builder, n)
-						continue
-					}
-					if t.Val[10] == 'k' {
-						structs.InfoAddDirectMap4K(t.Builder, n)
-						continue
-					}
-					structs.InfoAddDirectMap2M(t.Builder, n)
-					continue
-				}
-				if v == 'H' {
-					if nameLen == 14 {
-						if t.Val[10] == 'F' {
-							structs.InfoAddHugePagesFree(t.Builder, n)
-							continue
-						}
-						if t.Val[10] == 'R' {
-							structs.InfoAddHugePagesRsvd(t.Builder, n)
-							continue
-						}
-						structs.InfoAddHugePagesSurp(t.Builder, n)
-					}
-					if t.Val[1] == 'a' {
-						structs.InfoAddHardwareCorrupted(t.Builder, n)
-						continue
-					}
-					if t.Val[9] == 'i' {
-						structs.InfoAddHugePagesSize(t.Builder, n)
-						continue
-					}
-					structs.InfoAddHugePagesTotal(t.Builder, n)
-					continue
-				}
-				if v == 'I' {
-					if nameLen == 8 {
-						structs.InfoAddInactive(t.Builder, n)
-						continue
-					}
-					if t.Val[9] == 'a' {
-						structs.InfoAddInactiveAnon(t.Builder, n)
-						continue
-					}
-					structs.InfoAddInactiveFile(t.Builder, n)
-				}
-				if v == 'M' {
-					v = t.Val[3]
-					if nameLen < 8 {
-						if v == 'p' {
-							structs.InfoAddMapped(t.Builder, n)
-							continue
-						}
-						if v == 'F' {
-							structs.InfoAddMemFree(t.Builder, n)
-							continue
-						}
-						structs.InfoAddMlocked(t.Builder, n)
-						continue
-					}
-					if v == 'A' {
-						structs.InfoAddMemAvailable(t.Builder, n)
-						continue
-					}
-					structs.InfoAddMemTotal(t.Builder, n)
-					continue
-				}
-				if v == 'S' {
-					v = t.Val[1]
-					if v == 'w' {
-						if t.Val[4] == 'C' {
-							structs.InfoAddSwapCached(t.Builder, n)
-							continue
-						}
-						if t.Val[4] == 'F' {
-							structs.InfoAddSwapFree(t.Builder, n)
-							continue
-						}
-						structs.InfoAddSwapTotal(t.Builder, n)
-						continue
-					}
-					if v == 'h' {
-						structs.InfoAddShmem(t.Builder, n)
-						continue
-					}
-					if v == 'l' {
-						structs.InfoAddSlab(t.Builder, n)
-						continue
-					}
-					if v == 'R' {
-						structs.InfoAddSReclaimable(t.Builder, n)
-						continue
-					}
-					structs.InfoAddSUnreclaim(t.Builder, n)
-					continue
-				}
-				if v == 'V' {
-					if t.Val[8] == 'C' {
-						structs.InfoAddVmallocChunk(t.Builder, n)
-						continue
-					}
-					if t.Val[8] == 'T' {
-						structs.InfoAddVmallocTotal(t.Builder, n)
-						continue
-					}
-					structs.InfoAddVmallocUsed(t.Builder, n)
-					continue
-				}
-				if v == 'W' {
-					if nameLen == 9 {
-						structs.InfoAddWriteback(t.Builder, n)
-						continue
-					}
-					structs.InfoAddWritebackTmp(t.Builder, n)
-					continue
-				}
-				if v == 'B' {
-					if nameLen == 6 {
-						structs.InfoAddBounce(t.Builder, n)
-						continue
-					}
-					structs.InfoAddBuffers(t.Builder, n)
-					continue
-				}
-				if v == 'K' {
-					structs.InfoAddKernelStack(t.Builder, n)
-					continue
-				}
-				if v == 'N' {
-					structs.InfoAddNFSUnstable(t.Builder, n)
-					continue
-				}
-				if v == 'P' {
-					structs.InfoAddPageTables(t.Builder, n)
-				}
-				structs.InfoAddUnevictable(t.Builder, n)
-			}
-			t.Builder.Finish(structs.InfoEnd(t.Builder))
-			t.Data <- t.Profiler.Builder.Bytes[t.Builder.Head():]
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
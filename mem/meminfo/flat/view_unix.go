@@ -0,0 +1,255 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meminfo
+
+import (
+	fb "github.com/google/flatbuffers/go"
+	"github.com/hmmftg/joefriday/mem/meminfo/flat/structs"
+)
+
+// View provides read-only access to Flatbuffer serialized meminfo.Info without
+// deserializing it. Values are read from the serialized bytes as they are
+// requested, so reading a few fields does not allocate.
+type View struct {
+	t structs.Info
+}
+
+// NewView returns a View of the Flatbuffer serialized meminfo.Info in p.
+// p is not copied; it must not be modified while the View, or anything
+// returned by it, is in use.
+func NewView(p []byte) View {
+	var v View
+	v.t.Init(p, fb.GetUOffsetT(p))
+	return v
+}
+
+// Timestamp returns the Timestamp field.
+func (v View) Timestamp() int64 {
+	return v.t.Timestamp()
+}
+
+// Active returns the Active field.
+func (v View) Active() uint64 {
+	return v.t.Active()
+}
+
+// ActiveAnon returns the ActiveAnon field.
+func (v View) ActiveAnon() uint64 {
+	return v.t.ActiveAnon()
+}
+
+// ActiveFile returns the ActiveFile field.
+func (v View) ActiveFile() uint64 {
+	return v.t.ActiveFile()
+}
+
+// AnonHugePages returns the AnonHugePages field.
+func (v View) AnonHugePages() uint64 {
+	return v.t.AnonHugePages()
+}
+
+// AnonPages returns the AnonPages field.
+func (v View) AnonPages() uint64 {
+	return v.t.AnonPages()
+}
+
+// Bounce returns the Bounce field.
+func (v View) Bounce() uint64 {
+	return v.t.Bounce()
+}
+
+// Buffers returns the Buffers field.
+func (v View) Buffers() uint64 {
+	return v.t.Buffers()
+}
+
+// Cached returns the Cached field.
+func (v View) Cached() uint64 {
+	return v.t.Cached()
+}
+
+// CommitLimit returns the CommitLimit field.
+func (v View) CommitLimit() uint64 {
+	return v.t.CommitLimit()
+}
+
+// CommittedAS returns the CommittedAS field.
+func (v View) CommittedAS() uint64 {
+	return v.t.CommittedAS()
+}
+
+// DirectMap4K returns the DirectMap4K field.
+func (v View) DirectMap4K() uint64 {
+	return v.t.DirectMap4K()
+}
+
+// DirectMap2M returns the DirectMap2M field.
+func (v View) DirectMap2M() uint64 {
+	return v.t.DirectMap2M()
+}
+
+// Dirty returns the Dirty field.
+func (v View) Dirty() uint64 {
+	return v.t.Dirty()
+}
+
+// HardwareCorrupted returns the HardwareCorrupted field.
+func (v View) HardwareCorrupted() uint64 {
+	return v.t.HardwareCorrupted()
+}
+
+// HugePagesFree returns the HugePagesFree field.
+func (v View) HugePagesFree() uint64 {
+	return v.t.HugePagesFree()
+}
+
+// HugePagesRsvd returns the HugePagesRsvd field.
+func (v View) HugePagesRsvd() uint64 {
+	return v.t.HugePagesRsvd()
+}
+
+// HugePagesSize returns the HugePagesSize field.
+func (v View) HugePagesSize() uint64 {
+	return v.t.HugePagesSize()
+}
+
+// HugePagesSurp returns the HugePagesSurp field.
+func (v View) HugePagesSurp() uint64 {
+	return v.t.HugePagesSurp()
+}
+
+// HugePagesTotal returns the HugePagesTotal field.
+func (v View) HugePagesTotal() uint64 {
+	return v.t.HugePagesTotal()
+}
+
+// Inactive returns the Inactive field.
+func (v View) Inactive() uint64 {
+	return v.t.Inactive()
+}
+
+// InactiveAnon returns the InactiveAnon field.
+func (v View) InactiveAnon() uint64 {
+	return v.t.InactiveAnon()
+}
+
+// InactiveFile returns the InactiveFile field.
+func (v View) InactiveFile() uint64 {
+	return v.t.InactiveFile()
+}
+
+// KernelStack returns the KernelStack field.
+func (v View) KernelStack() uint64 {
+	return v.t.KernelStack()
+}
+
+// Mapped returns the Mapped field.
+func (v View) Mapped() uint64 {
+	return v.t.Mapped()
+}
+
+// MemAvailable returns the MemAvailable field.
+func (v View) MemAvailable() uint64 {
+	return v.t.MemAvailable()
+}
+
+// MemFree returns the MemFree field.
+func (v View) MemFree() uint64 {
+	return v.t.MemFree()
+}
+
+// MemTotal returns the MemTotal field.
+func (v View) MemTotal() uint64 {
+	return v.t.MemTotal()
+}
+
+// Mlocked returns the Mlocked field.
+func (v View) Mlocked() uint64 {
+	return v.t.Mlocked()
+}
+
+// NFSUnstable returns the NFSUnstable field.
+func (v View) NFSUnstable() uint64 {
+	return v.t.NFSUnstable()
+}
+
+// PageTables returns the PageTables field.
+func (v View) PageTables() uint64 {
+	return v.t.PageTables()
+}
+
+// Shmem returns the Shmem field.
+func (v View) Shmem() uint64 {
+	return v.t.Shmem()
+}
+
+// Slab returns the Slab field.
+func (v View) Slab() uint64 {
+	return v.t.Slab()
+}
+
+// SReclaimable returns the SReclaimable field.
+func (v View) SReclaimable() uint64 {
+	return v.t.SReclaimable()
+}
+
+// SUnreclaim returns the SUnreclaim field.
+func (v View) SUnreclaim() uint64 {
+	return v.t.SUnreclaim()
+}
+
+// SwapCached returns the SwapCached field.
+func (v View) SwapCached() uint64 {
+	return v.t.SwapCached()
+}
+
+// SwapFree returns the SwapFree field.
+func (v View) SwapFree() uint64 {
+	return v.t.SwapFree()
+}
+
+// SwapTotal returns the SwapTotal field.
+func (v View) SwapTotal() uint64 {
+	return v.t.SwapTotal()
+}
+
+// Unevictable returns the Unevictable field.
+func (v View) Unevictable() uint64 {
+	return v.t.Unevictable()
+}
+
+// VmallocChunk returns the VmallocChunk field.
+func (v View) VmallocChunk() uint64 {
+	return v.t.VmallocChunk()
+}
+
+// VmallocTotal returns the VmallocTotal field.
+func (v View) VmallocTotal() uint64 {
+	return v.t.VmallocTotal()
+}
+
+// VmallocUsed returns the VmallocUsed field.
+func (v View) VmallocUsed() uint64 {
+	return v.t.VmallocUsed()
+}
+
+// Writeback returns the Writeback field.
+func (v View) Writeback() uint64 {
+	return v.t.Writeback()
+}
+
+// WritebackTmp returns the WritebackTmp field.
+func (v View) WritebackTmp() uint64 {
+	return v.t.WritebackTmp()
+}
@@ -0,0 +1,236 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package meminfo
+
+import (
+	"testing"
+
+	mem "github.com/hmmftg/joefriday/mem/meminfo"
+)
+
+func viewFixture() *mem.Info {
+	return &mem.Info{
+		Timestamp:         1,
+		Active:            2,
+		ActiveAnon:        3,
+		ActiveFile:        4,
+		AnonHugePages:     5,
+		AnonPages:         6,
+		Bounce:            7,
+		Buffers:           8,
+		Cached:            9,
+		CommitLimit:       10,
+		CommittedAS:       11,
+		DirectMap4K:       12,
+		DirectMap2M:       13,
+		Dirty:             14,
+		HardwareCorrupted: 15,
+		HugePagesFree:     16,
+		HugePagesRsvd:     17,
+		HugePagesSize:     18,
+		HugePagesSurp:     19,
+		HugePagesTotal:    20,
+		Inactive:          21,
+		InactiveAnon:      22,
+		InactiveFile:      23,
+		KernelStack:       24,
+		Mapped:            25,
+		MemAvailable:      26,
+		MemFree:           27,
+		MemTotal:          28,
+		Mlocked:           29,
+		NFSUnstable:       30,
+		PageTables:        31,
+		Shmem:             32,
+		Slab:              33,
+		SReclaimable:      34,
+		SUnreclaim:        35,
+		SwapCached:        36,
+		SwapFree:          37,
+		SwapTotal:         38,
+		Unevictable:       39,
+		VmallocChunk:      40,
+		VmallocTotal:      41,
+		VmallocUsed:       42,
+		Writeback:         43,
+		WritebackTmp:      44,
+	}
+}
+
+func TestView(t *testing.T) {
+	s := viewFixture()
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v := NewView(p)
+	if v.Timestamp() != s.Timestamp {
+		t.Errorf("s.Timestamp: got %v; want %v", v.Timestamp(), s.Timestamp)
+	}
+	if v.Active() != s.Active {
+		t.Errorf("s.Active: got %v; want %v", v.Active(), s.Active)
+	}
+	if v.ActiveAnon() != s.ActiveAnon {
+		t.Errorf("s.ActiveAnon: got %v; want %v", v.ActiveAnon(), s.ActiveAnon)
+	}
+	if v.ActiveFile() != s.ActiveFile {
+		t.Errorf("s.ActiveFile: got %v; want %v", v.ActiveFile(), s.ActiveFile)
+	}
+	if v.AnonHugePages() != s.AnonHugePages {
+		t.Errorf("s.AnonHugePages: got %v; want %v", v.AnonHugePages(), s.AnonHugePages)
+	}
+	if v.AnonPages() != s.AnonPages {
+		t.Errorf("s.AnonPages: got %v; want %v", v.AnonPages(), s.AnonPages)
+	}
+	if v.Bounce() != s.Bounce {
+		t.Errorf("s.Bounce: got %v; want %v", v.Bounce(), s.Bounce)
+	}
+	if v.Buffers() != s.Buffers {
+		t.Errorf("s.Buffers: got %v; want %v", v.Buffers(), s.Buffers)
+	}
+	if v.Cached() != s.Cached {
+		t.Errorf("s.Cached: got %v; want %v", v.Cached(), s.Cached)
+	}
+	if v.CommitLimit() != s.CommitLimit {
+		t.Errorf("s.CommitLimit: got %v; want %v", v.CommitLimit(), s.CommitLimit)
+	}
+	if v.CommittedAS() != s.CommittedAS {
+		t.Errorf("s.CommittedAS: got %v; want %v", v.CommittedAS(), s.CommittedAS)
+	}
+	if v.DirectMap4K() != s.DirectMap4K {
+		t.Errorf("s.DirectMap4K: got %v; want %v", v.DirectMap4K(), s.DirectMap4K)
+	}
+	if v.DirectMap2M() != s.DirectMap2M {
+		t.Errorf("s.DirectMap2M: got %v; want %v", v.DirectMap2M(), s.DirectMap2M)
+	}
+	if v.Dirty() != s.Dirty {
+		t.Errorf("s.Dirty: got %v; want %v", v.Dirty(), s.Dirty)
+	}
+	if v.HardwareCorrupted() != s.HardwareCorrupted {
+		t.Errorf("s.HardwareCorrupted: got %v; want %v", v.HardwareCorrupted(), s.HardwareCorrupted)
+	}
+	if v.HugePagesFree() != s.HugePagesFree {
+		t.Errorf("s.HugePagesFree: got %v; want %v", v.HugePagesFree(), s.HugePagesFree)
+	}
+	if v.HugePagesRsvd() != s.HugePagesRsvd {
+		t.Errorf("s.HugePagesRsvd: got %v; want %v", v.HugePagesRsvd(), s.HugePagesRsvd)
+	}
+	if v.HugePagesSize() != s.HugePagesSize {
+		t.Errorf("s.HugePagesSize: got %v; want %v", v.HugePagesSize(), s.HugePagesSize)
+	}
+	if v.HugePagesSurp() != s.HugePagesSurp {
+		t.Errorf("s.HugePagesSurp: got %v; want %v", v.HugePagesSurp(), s.HugePagesSurp)
+	}
+	if v.HugePagesTotal() != s.HugePagesTotal {
+		t.Errorf("s.HugePagesTotal: got %v; want %v", v.HugePagesTotal(), s.HugePagesTotal)
+	}
+	if v.Inactive() != s.Inactive {
+		t.Errorf("s.Inactive: got %v; want %v", v.Inactive(), s.Inactive)
+	}
+	if v.InactiveAnon() != s.InactiveAnon {
+		t.Errorf("s.InactiveAnon: got %v; want %v", v.InactiveAnon(), s.InactiveAnon)
+	}
+	if v.InactiveFile() != s.InactiveFile {
+		t.Errorf("s.InactiveFile: got %v; want %v", v.InactiveFile(), s.InactiveFile)
+	}
+	if v.KernelStack() != s.KernelStack {
+		t.Errorf("s.KernelStack: got %v; want %v", v.KernelStack(), s.KernelStack)
+	}
+	if v.Mapped() != s.Mapped {
+		t.Errorf("s.Mapped: got %v; want %v", v.Mapped(), s.Mapped)
+	}
+	if v.MemAvailable() != s.MemAvailable {
+		t.Errorf("s.MemAvailable: got %v; want %v", v.MemAvailable(), s.MemAvailable)
+	}
+	if v.MemFree() != s.MemFree {
+		t.Errorf("s.MemFree: got %v; want %v", v.MemFree(), s.MemFree)
+	}
+	if v.MemTotal() != s.MemTotal {
+		t.Errorf("s.MemTotal: got %v; want %v", v.MemTotal(), s.MemTotal)
+	}
+	if v.Mlocked() != s.Mlocked {
+		t.Errorf("s.Mlocked: got %v; want %v", v.Mlocked(), s.Mlocked)
+	}
+	if v.NFSUnstable() != s.NFSUnstable {
+		t.Errorf("s.NFSUnstable: got %v; want %v", v.NFSUnstable(), s.NFSUnstable)
+	}
+	if v.PageTables() != s.PageTables {
+		t.Errorf("s.PageTables: got %v; want %v", v.PageTables(), s.PageTables)
+	}
+	if v.Shmem() != s.Shmem {
+		t.Errorf("s.Shmem: got %v; want %v", v.Shmem(), s.Shmem)
+	}
+	if v.Slab() != s.Slab {
+		t.Errorf("s.Slab: got %v; want %v", v.Slab(), s.Slab)
+	}
+	if v.SReclaimable() != s.SReclaimable {
+		t.Errorf("s.SReclaimable: got %v; want %v", v.SReclaimable(), s.SReclaimable)
+	}
+	if v.SUnreclaim() != s.SUnreclaim {
+		t.Errorf("s.SUnreclaim: got %v; want %v", v.SUnreclaim(), s.SUnreclaim)
+	}
+	if v.SwapCached() != s.SwapCached {
+		t.Errorf("s.SwapCached: got %v; want %v", v.SwapCached(), s.SwapCached)
+	}
+	if v.SwapFree() != s.SwapFree {
+		t.Errorf("s.SwapFree: got %v; want %v", v.SwapFree(), s.SwapFree)
+	}
+	if v.SwapTotal() != s.SwapTotal {
+		t.Errorf("s.SwapTotal: got %v; want %v", v.SwapTotal(), s.SwapTotal)
+	}
+	if v.Unevictable() != s.Unevictable {
+		t.Errorf("s.Unevictable: got %v; want %v", v.Unevictable(), s.Unevictable)
+	}
+	if v.VmallocChunk() != s.VmallocChunk {
+		t.Errorf("s.VmallocChunk: got %v; want %v", v.VmallocChunk(), s.VmallocChunk)
+	}
+	if v.VmallocTotal() != s.VmallocTotal {
+		t.Errorf("s.VmallocTotal: got %v; want %v", v.VmallocTotal(), s.VmallocTotal)
+	}
+	if v.VmallocUsed() != s.VmallocUsed {
+		t.Errorf("s.VmallocUsed: got %v; want %v", v.VmallocUsed(), s.VmallocUsed)
+	}
+	if v.Writeback() != s.Writeback {
+		t.Errorf("s.Writeback: got %v; want %v", v.Writeback(), s.Writeback)
+	}
+	if v.WritebackTmp() != s.WritebackTmp {
+		t.Errorf("s.WritebackTmp: got %v; want %v", v.WritebackTmp(), s.WritebackTmp)
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	var n uint64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := NewView(p)
+		n = v.MemTotal() - v.MemAvailable()
+	}
+	_ = n
+}
+
+// BenchmarkViewDeserialize reads the same values as BenchmarkView using
+// Deserialize.
+func BenchmarkViewDeserialize(b *testing.B) {
+	var n uint64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := Deserialize(p)
+		n = s.MemTotal - s.MemAvailable
+	}
+	_ = n
+}
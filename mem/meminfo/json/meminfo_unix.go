@@ -20,12 +20,14 @@
 package meminfo
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
 	mem "github.com/hmmftg/joefriday/mem/meminfo"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to get the memory information, as JSON, by processing the
@@ -35,8 +37,8 @@ type Profiler struct {
 }
 
 // Returns an Initialized profiler that uses JSON; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := mem.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := mem.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -115,8 +117,7 @@ func Unmarshal(p []byte) (*mem.Info, error) {
 
 // Ticker delivers the system's memory information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -125,35 +126,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -15,11 +15,13 @@
 package meminfo
 
 import (
+	"context"
 	"io"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -81,8 +83,9 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
@@ -341,8 +344,7 @@ func Get() (inf *Info, err error) {
 
 // Ticker delivers the system's memory information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan Info
+	*ticker.Ticker[Info]
 	*Profiler
 }
 
@@ -351,256 +353,26 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan Info), Profiler: p}
-	go t.Run()
-	return &t, nil
-}
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// predeclare some vars
-	var (
-		i, pos, nameLen int
-		v               byte
-		n               uint64
-		err             error
-		inf             Info
-	)
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			err = t.Profiler.Reset()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			inf.Timestamp = time.Now().UTC().UnixNano()
-			for {
-				t.Val = t.Val[:0]
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					t.Errs <- &joe.ReadError{Err: err}
-				}
-				// first grab the key name (everything up to the ':')
-				for i, v = range t.Line {
-					if v == ':' {
-						pos = i + 1
-						break
-					}
-					t.Val = append(t.Val, v)
-				}
-				nameLen = len(t.Val)
-
-				// skip all spaces
-				for i, v = range t.Line[pos:] {
-					if v != ' ' {
-						pos += i
-						break
-					}
-				}
-
-				// grab the numbers
-				for _, v = range t.Line[pos:] {
-					if v == ' ' || v == '\n' {
-						break
-					}
-					t.Val = append(t.Val, v)
-				}
-				// any conversion error results in 0
-				n, err = tools.ParseUint(t.Val[nameLen:])
-				if err != nil {
-					t.Errs <- &joe.ParseError{Info: string(t.Val[:nameLen]), Err: err}
-				}
-
-				v = t.Val[0]
-				// evaluate the key
-				if v == 'A' {
-					if t.Val[5] == 'e' {
-						if nameLen == 6 {
-							inf.Active = n
-							continue
-						}
-						if t.Val[7] == 'a' {
-							inf.ActiveAnon = n
-							continue
-						}
-						inf.ActiveFile = n
-						continue
-					}
-					if nameLen == 9 {
-						inf.AnonPages = n
-						continue
-					}
-					inf.AnonHugePages = n
-					continue
-				}
-				if v == 'C' {
-					if nameLen == 6 {
-						inf.Cached = n
-						continue
-					}
-					if nameLen == 11 {
-						inf.CommitLimit = n
-						continue
-					}
-					inf.CommittedAS = n
-					continue
-				}
-				if v == 'D' {
-					if nameLen == 5 {
-						inf.Dirty = n
-						continue
-					}
-					if t.Val[10] == 'k' {
-						inf.DirectMap4K = n
-						continue
-					}
-					inf.DirectMap2M = n
-					continue
-				}
-				if v == 'H' {
-					if nameLen == 14 {
-						if t.Val[10] == 'F' {
-							inf.HugePagesFree = n
-							continue
-						}
-						if t.Val[10] == 'R' {
-							inf.HugePagesRsvd = n
-							continue
-						}
-						inf.HugePagesSurp = n
-					}
-					if t.Val[1] == 'a' {
-						inf.HardwareCorrupted = n
-						continue
-					}
-					if t.Val[9] == 'i' {
-						inf.HugePagesSize = n
-						continue
-					}
-					inf.HugePagesTotal = n
-					continue
-				}
-				if v == 'I' {
-					if nameLen == 8 {
-						inf.Inactive = n
-						continue
-					}
-					if t.Val[9] == 'a' {
-						inf.InactiveAnon = n
-						continue
-					}
-					inf.InactiveFile = n
-				}
-				if v == 'M' {
-					v = t.Val[3]
-					if nameLen < 8 {
-						if v == 'p' {
-							inf.Mapped = n
-							continue
-						}
-						if v == 'F' {
-							inf.MemFree = n
-							continue
-						}
-						inf.Mlocked = n
-						continue
-					}
-					if v == 'A' {
-						inf.MemAvailable = n
-						continue
-					}
-					inf.MemTotal = n
-					continue
-				}
-				if v == 'S' {
-					v = t.Val[1]
-					if v == 'w' {
-						if t.Val[4] == 'C' {
-							inf.SwapCached = n
-							continue
-						}
-						if t.Val[4] == 'F' {
-							inf.SwapFree = n
-							continue
-						}
-						inf.SwapTotal = n
-						continue
-					}
-					if v == 'h' {
-						inf.Shmem = n
-						continue
-					}
-					if v == 'l' {
-						inf.Slab = n
-						continue
-					}
-					if v == 'R' {
-						inf.SReclaimable = n
-						continue
-					}
-					inf.SUnreclaim = n
-					continue
-				}
-				if v == 'V' {
-					if t.Val[8] == 'C' {
-						inf.VmallocChunk = n
-						continue
-					}
-					if t.Val[8] == 'T' {
-						inf.VmallocTotal = n
-						continue
-					}
-					inf.VmallocUsed = n
-					continue
-				}
-				if v == 'W' {
-					if nameLen == 9 {
-						inf.Writeback = n
-						continue
-					}
-					inf.WritebackTmp = n
-					continue
-				}
-				if v == 'B' {
-					if nameLen == 6 {
-						inf.Bounce = n
-						continue
-					}
-					inf.Buffers = n
-					continue
-				}
-				if v == 'K' {
-					inf.KernelStack = n
-					continue
-				}
-				if v == 'N' {
-					inf.NFSUnstable = n
-					continue
-				}
-				if v == 'P' {
-					inf.PageTables = n
-				}
-				inf.Unevictable = n
-			}
-			t.Data <- inf
+	t := Ticker{Profiler: p}
+	// The Ticker delivers Info values, not pointers.
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (Info, error) {
+		inf, err := t.Get()
+		if err != nil {
+			return Info{}, err
 		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+		return *inf, nil
+	})
+	return &t, nil
 }
@@ -15,9 +15,41 @@ package meminfo
 
 import (
 	"testing"
+	"testing/fstest"
 	"time"
+
+	joe "github.com/hmmftg/joefriday"
 )
 
+func TestGetFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/meminfo": &fstest.MapFile{Data: []byte(`MemTotal:       16312560 kB
+MemFree:         8113436 kB
+MemAvailable:   12340456 kB
+Buffers:          212392 kB
+Cached:          4036364 kB
+SwapCached:            0 kB
+Active:          4561288 kB
+Inactive:        2843636 kB
+SwapTotal:       2097148 kB
+SwapFree:        2097148 kB
+`)},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inf, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if inf.MemTotal != 16312560 || inf.MemFree != 8113436 || inf.MemAvailable != 12340456 || inf.Buffers != 212392 ||
+		inf.Cached != 4036364 || inf.Active != 4561288 || inf.Inactive != 2843636 || inf.SwapTotal != 2097148 ||
+		inf.SwapFree != 2097148 {
+		t.Errorf("got %#v; want the values from proc/meminfo", inf)
+	}
+}
+
 func TestGet(t *testing.T) {
 	inf, err := Get()
 	if err != nil {
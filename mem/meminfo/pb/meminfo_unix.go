@@ -0,0 +1,248 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package meminfo processes the memory information, /proc/meminfo. Instead of
+// returning a Go struct, it returns protobuf serialized bytes. A function to
+// deserialize the protobuf serialized bytes into a mem.Info struct is provided.
+//
+// Note: the package name is meminfo and not the final element of the import
+// path (pb).
+package meminfo
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	mem "github.com/hmmftg/joefriday/mem/meminfo"
+	"github.com/hmmftg/joefriday/mem/meminfo/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to get the memory information, as protobuf, by processing
+// the /proc/meminfo file.
+type Profiler struct {
+	*mem.Profiler
+}
+
+// Returns an Initialized profiler that uses protobuf; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := mem.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current memory information as protobuf serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current memory information as protobuf serialized bytes using
+// the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize memory information using protobuf
+func (prof *Profiler) Serialize(inf *mem.Info) ([]byte, error) {
+	return proto.Marshal(toProto(inf))
+}
+
+// Serialize memory information using protobuf with the package's global
+// Profiler.
+func Serialize(inf *mem.Info) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(inf *mem.Info) ([]byte, error) {
+	return prof.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize that uses the package's global profiler.
+func Marshal(inf *mem.Info) ([]byte, error) {
+	return Serialize(inf)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// meminfo.Info.
+func Deserialize(p []byte) (*mem.Info, error) {
+	m := &structs.Info{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*mem.Info, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a meminfo.Info into a new structs.Info message.
+func toProto(inf *mem.Info) *structs.Info {
+	return &structs.Info{
+		Timestamp:         inf.Timestamp,
+		Active:            inf.Active,
+		ActiveAnon:        inf.ActiveAnon,
+		ActiveFile:        inf.ActiveFile,
+		AnonHugePages:     inf.AnonHugePages,
+		AnonPages:         inf.AnonPages,
+		Bounce:            inf.Bounce,
+		Buffers:           inf.Buffers,
+		Cached:            inf.Cached,
+		CommitLimit:       inf.CommitLimit,
+		CommittedAs:       inf.CommittedAS,
+		DirectMap4K:       inf.DirectMap4K,
+		DirectMap2M:       inf.DirectMap2M,
+		Dirty:             inf.Dirty,
+		HardwareCorrupted: inf.HardwareCorrupted,
+		HugePagesFree:     inf.HugePagesFree,
+		HugePagesRsvd:     inf.HugePagesRsvd,
+		HugePagesSize:     inf.HugePagesSize,
+		HugePagesSurp:     inf.HugePagesSurp,
+		HugePagesTotal:    inf.HugePagesTotal,
+		Inactive:          inf.Inactive,
+		InactiveAnon:      inf.InactiveAnon,
+		InactiveFile:      inf.InactiveFile,
+		KernelStack:       inf.KernelStack,
+		Mapped:            inf.Mapped,
+		MemAvailable:      inf.MemAvailable,
+		MemFree:           inf.MemFree,
+		MemTotal:          inf.MemTotal,
+		Mlocked:           inf.Mlocked,
+		NfsUnstable:       inf.NFSUnstable,
+		PageTables:        inf.PageTables,
+		Shmem:             inf.Shmem,
+		Slab:              inf.Slab,
+		SReclaimable:      inf.SReclaimable,
+		SUnreclaim:        inf.SUnreclaim,
+		SwapCached:        inf.SwapCached,
+		SwapFree:          inf.SwapFree,
+		SwapTotal:         inf.SwapTotal,
+		Unevictable:       inf.Unevictable,
+		VmallocChunk:      inf.VmallocChunk,
+		VmallocTotal:      inf.VmallocTotal,
+		VmallocUsed:       inf.VmallocUsed,
+		Writeback:         inf.Writeback,
+		WritebackTmp:      inf.WritebackTmp,
+	}
+}
+
+// fromProto copies a structs.Info message into a meminfo.Info.
+func fromProto(m *structs.Info) *mem.Info {
+	return &mem.Info{
+		Timestamp:         m.Timestamp,
+		Active:            m.Active,
+		ActiveAnon:        m.ActiveAnon,
+		ActiveFile:        m.ActiveFile,
+		AnonHugePages:     m.AnonHugePages,
+		AnonPages:         m.AnonPages,
+		Bounce:            m.Bounce,
+		Buffers:           m.Buffers,
+		Cached:            m.Cached,
+		CommitLimit:       m.CommitLimit,
+		CommittedAS:       m.CommittedAs,
+		DirectMap4K:       m.DirectMap4K,
+		DirectMap2M:       m.DirectMap2M,
+		Dirty:             m.Dirty,
+		HardwareCorrupted: m.HardwareCorrupted,
+		HugePagesFree:     m.HugePagesFree,
+		HugePagesRsvd:     m.HugePagesRsvd,
+		HugePagesSize:     m.HugePagesSize,
+		HugePagesSurp:     m.HugePagesSurp,
+		HugePagesTotal:    m.HugePagesTotal,
+		Inactive:          m.Inactive,
+		InactiveAnon:      m.InactiveAnon,
+		InactiveFile:      m.InactiveFile,
+		KernelStack:       m.KernelStack,
+		Mapped:            m.Mapped,
+		MemAvailable:      m.MemAvailable,
+		MemFree:           m.MemFree,
+		MemTotal:          m.MemTotal,
+		Mlocked:           m.Mlocked,
+		NFSUnstable:       m.NfsUnstable,
+		PageTables:        m.PageTables,
+		Shmem:             m.Shmem,
+		Slab:              m.Slab,
+		SReclaimable:      m.SReclaimable,
+		SUnreclaim:        m.SUnreclaim,
+		SwapCached:        m.SwapCached,
+		SwapFree:          m.SwapFree,
+		SwapTotal:         m.SwapTotal,
+		Unevictable:       m.Unevictable,
+		VmallocChunk:      m.VmallocChunk,
+		VmallocTotal:      m.VmallocTotal,
+		VmallocUsed:       m.VmallocUsed,
+		Writeback:         m.Writeback,
+		WritebackTmp:      m.WritebackTmp,
+	}
+}
+
+// Ticker delivers the system's memory information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,563 @@
+// info.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: mem/meminfo/pb/info.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Info struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp         int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Active            uint64                 `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+	ActiveAnon        uint64                 `protobuf:"varint,3,opt,name=active_anon,json=activeAnon,proto3" json:"active_anon,omitempty"`
+	ActiveFile        uint64                 `protobuf:"varint,4,opt,name=active_file,json=activeFile,proto3" json:"active_file,omitempty"`
+	AnonHugePages     uint64                 `protobuf:"varint,5,opt,name=anon_huge_pages,json=anonHugePages,proto3" json:"anon_huge_pages,omitempty"`
+	AnonPages         uint64                 `protobuf:"varint,6,opt,name=anon_pages,json=anonPages,proto3" json:"anon_pages,omitempty"`
+	Bounce            uint64                 `protobuf:"varint,7,opt,name=bounce,proto3" json:"bounce,omitempty"`
+	Buffers           uint64                 `protobuf:"varint,8,opt,name=buffers,proto3" json:"buffers,omitempty"`
+	Cached            uint64                 `protobuf:"varint,9,opt,name=cached,proto3" json:"cached,omitempty"`
+	CommitLimit       uint64                 `protobuf:"varint,10,opt,name=commit_limit,json=commitLimit,proto3" json:"commit_limit,omitempty"`
+	CommittedAs       uint64                 `protobuf:"varint,11,opt,name=committed_as,json=committedAs,proto3" json:"committed_as,omitempty"`
+	DirectMap4K       uint64                 `protobuf:"varint,12,opt,name=direct_map4k,json=directMap4k,proto3" json:"direct_map4k,omitempty"`
+	DirectMap2M       uint64                 `protobuf:"varint,13,opt,name=direct_map2m,json=directMap2m,proto3" json:"direct_map2m,omitempty"`
+	Dirty             uint64                 `protobuf:"varint,14,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	HardwareCorrupted uint64                 `protobuf:"varint,15,opt,name=hardware_corrupted,json=hardwareCorrupted,proto3" json:"hardware_corrupted,omitempty"`
+	HugePagesFree     uint64                 `protobuf:"varint,16,opt,name=huge_pages_free,json=hugePagesFree,proto3" json:"huge_pages_free,omitempty"`
+	HugePagesRsvd     uint64                 `protobuf:"varint,17,opt,name=huge_pages_rsvd,json=hugePagesRsvd,proto3" json:"huge_pages_rsvd,omitempty"`
+	HugePagesSize     uint64                 `protobuf:"varint,18,opt,name=huge_pages_size,json=hugePagesSize,proto3" json:"huge_pages_size,omitempty"`
+	HugePagesSurp     uint64                 `protobuf:"varint,19,opt,name=huge_pages_surp,json=hugePagesSurp,proto3" json:"huge_pages_surp,omitempty"`
+	HugePagesTotal    uint64                 `protobuf:"varint,20,opt,name=huge_pages_total,json=hugePagesTotal,proto3" json:"huge_pages_total,omitempty"`
+	Inactive          uint64                 `protobuf:"varint,21,opt,name=inactive,proto3" json:"inactive,omitempty"`
+	InactiveAnon      uint64                 `protobuf:"varint,22,opt,name=inactive_anon,json=inactiveAnon,proto3" json:"inactive_anon,omitempty"`
+	InactiveFile      uint64                 `protobuf:"varint,23,opt,name=inactive_file,json=inactiveFile,proto3" json:"inactive_file,omitempty"`
+	KernelStack       uint64                 `protobuf:"varint,24,opt,name=kernel_stack,json=kernelStack,proto3" json:"kernel_stack,omitempty"`
+	Mapped            uint64                 `protobuf:"varint,25,opt,name=mapped,proto3" json:"mapped,omitempty"`
+	MemAvailable      uint64                 `protobuf:"varint,26,opt,name=mem_available,json=memAvailable,proto3" json:"mem_available,omitempty"`
+	MemFree           uint64                 `protobuf:"varint,27,opt,name=mem_free,json=memFree,proto3" json:"mem_free,omitempty"`
+	MemTotal          uint64                 `protobuf:"varint,28,opt,name=mem_total,json=memTotal,proto3" json:"mem_total,omitempty"`
+	Mlocked           uint64                 `protobuf:"varint,29,opt,name=mlocked,proto3" json:"mlocked,omitempty"`
+	NfsUnstable       uint64                 `protobuf:"varint,30,opt,name=nfs_unstable,json=nfsUnstable,proto3" json:"nfs_unstable,omitempty"`
+	PageTables        uint64                 `protobuf:"varint,31,opt,name=page_tables,json=pageTables,proto3" json:"page_tables,omitempty"`
+	Shmem             uint64                 `protobuf:"varint,32,opt,name=shmem,proto3" json:"shmem,omitempty"`
+	Slab              uint64                 `protobuf:"varint,33,opt,name=slab,proto3" json:"slab,omitempty"`
+	SReclaimable      uint64                 `protobuf:"varint,34,opt,name=s_reclaimable,json=sReclaimable,proto3" json:"s_reclaimable,omitempty"`
+	SUnreclaim        uint64                 `protobuf:"varint,35,opt,name=s_unreclaim,json=sUnreclaim,proto3" json:"s_unreclaim,omitempty"`
+	SwapCached        uint64                 `protobuf:"varint,36,opt,name=swap_cached,json=swapCached,proto3" json:"swap_cached,omitempty"`
+	SwapFree          uint64                 `protobuf:"varint,37,opt,name=swap_free,json=swapFree,proto3" json:"swap_free,omitempty"`
+	SwapTotal         uint64                 `protobuf:"varint,38,opt,name=swap_total,json=swapTotal,proto3" json:"swap_total,omitempty"`
+	Unevictable       uint64                 `protobuf:"varint,39,opt,name=unevictable,proto3" json:"unevictable,omitempty"`
+	VmallocChunk      uint64                 `protobuf:"varint,40,opt,name=vmalloc_chunk,json=vmallocChunk,proto3" json:"vmalloc_chunk,omitempty"`
+	VmallocTotal      uint64                 `protobuf:"varint,41,opt,name=vmalloc_total,json=vmallocTotal,proto3" json:"vmalloc_total,omitempty"`
+	VmallocUsed       uint64                 `protobuf:"varint,42,opt,name=vmalloc_used,json=vmallocUsed,proto3" json:"vmalloc_used,omitempty"`
+	Writeback         uint64                 `protobuf:"varint,43,opt,name=writeback,proto3" json:"writeback,omitempty"`
+	WritebackTmp      uint64                 `protobuf:"varint,44,opt,name=writeback_tmp,json=writebackTmp,proto3" json:"writeback_tmp,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Info) Reset() {
+	*x = Info{}
+	mi := &file_mem_meminfo_pb_info_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Info) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Info) ProtoMessage() {}
+
+func (x *Info) ProtoReflect() protoreflect.Message {
+	mi := &file_mem_meminfo_pb_info_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Info.ProtoReflect.Descriptor instead.
+func (*Info) Descriptor() ([]byte, []int) {
+	return file_mem_meminfo_pb_info_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Info) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Info) GetActive() uint64 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *Info) GetActiveAnon() uint64 {
+	if x != nil {
+		return x.ActiveAnon
+	}
+	return 0
+}
+
+func (x *Info) GetActiveFile() uint64 {
+	if x != nil {
+		return x.ActiveFile
+	}
+	return 0
+}
+
+func (x *Info) GetAnonHugePages() uint64 {
+	if x != nil {
+		return x.AnonHugePages
+	}
+	return 0
+}
+
+func (x *Info) GetAnonPages() uint64 {
+	if x != nil {
+		return x.AnonPages
+	}
+	return 0
+}
+
+func (x *Info) GetBounce() uint64 {
+	if x != nil {
+		return x.Bounce
+	}
+	return 0
+}
+
+func (x *Info) GetBuffers() uint64 {
+	if x != nil {
+		return x.Buffers
+	}
+	return 0
+}
+
+func (x *Info) GetCached() uint64 {
+	if x != nil {
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *Info) GetCommitLimit() uint64 {
+	if x != nil {
+		return x.CommitLimit
+	}
+	return 0
+}
+
+func (x *Info) GetCommittedAs() uint64 {
+	if x != nil {
+		return x.CommittedAs
+	}
+	return 0
+}
+
+func (x *Info) GetDirectMap4K() uint64 {
+	if x != nil {
+		return x.DirectMap4K
+	}
+	return 0
+}
+
+func (x *Info) GetDirectMap2M() uint64 {
+	if x != nil {
+		return x.DirectMap2M
+	}
+	return 0
+}
+
+func (x *Info) GetDirty() uint64 {
+	if x != nil {
+		return x.Dirty
+	}
+	return 0
+}
+
+func (x *Info) GetHardwareCorrupted() uint64 {
+	if x != nil {
+		return x.HardwareCorrupted
+	}
+	return 0
+}
+
+func (x *Info) GetHugePagesFree() uint64 {
+	if x != nil {
+		return x.HugePagesFree
+	}
+	return 0
+}
+
+func (x *Info) GetHugePagesRsvd() uint64 {
+	if x != nil {
+		return x.HugePagesRsvd
+	}
+	return 0
+}
+
+func (x *Info) GetHugePagesSize() uint64 {
+	if x != nil {
+		return x.HugePagesSize
+	}
+	return 0
+}
+
+func (x *Info) GetHugePagesSurp() uint64 {
+	if x != nil {
+		return x.HugePagesSurp
+	}
+	return 0
+}
+
+func (x *Info) GetHugePagesTotal() uint64 {
+	if x != nil {
+		return x.HugePagesTotal
+	}
+	return 0
+}
+
+func (x *Info) GetInactive() uint64 {
+	if x != nil {
+		return x.Inactive
+	}
+	return 0
+}
+
+func (x *Info) GetInactiveAnon() uint64 {
+	if x != nil {
+		return x.InactiveAnon
+	}
+	return 0
+}
+
+func (x *Info) GetInactiveFile() uint64 {
+	if x != nil {
+		return x.InactiveFile
+	}
+	return 0
+}
+
+func (x *Info) GetKernelStack() uint64 {
+	if x != nil {
+		return x.KernelStack
+	}
+	return 0
+}
+
+func (x *Info) GetMapped() uint64 {
+	if x != nil {
+		return x.Mapped
+	}
+	return 0
+}
+
+func (x *Info) GetMemAvailable() uint64 {
+	if x != nil {
+		return x.MemAvailable
+	}
+	return 0
+}
+
+func (x *Info) GetMemFree() uint64 {
+	if x != nil {
+		return x.MemFree
+	}
+	return 0
+}
+
+func (x *Info) GetMemTotal() uint64 {
+	if x != nil {
+		return x.MemTotal
+	}
+	return 0
+}
+
+func (x *Info) GetMlocked() uint64 {
+	if x != nil {
+		return x.Mlocked
+	}
+	return 0
+}
+
+func (x *Info) GetNfsUnstable() uint64 {
+	if x != nil {
+		return x.NfsUnstable
+	}
+	return 0
+}
+
+func (x *Info) GetPageTables() uint64 {
+	if x != nil {
+		return x.PageTables
+	}
+	return 0
+}
+
+func (x *Info) GetShmem() uint64 {
+	if x != nil {
+		return x.Shmem
+	}
+	return 0
+}
+
+func (x *Info) GetSlab() uint64 {
+	if x != nil {
+		return x.Slab
+	}
+	return 0
+}
+
+func (x *Info) GetSReclaimable() uint64 {
+	if x != nil {
+		return x.SReclaimable
+	}
+	return 0
+}
+
+func (x *Info) GetSUnreclaim() uint64 {
+	if x != nil {
+		return x.SUnreclaim
+	}
+	return 0
+}
+
+func (x *Info) GetSwapCached() uint64 {
+	if x != nil {
+		return x.SwapCached
+	}
+	return 0
+}
+
+func (x *Info) GetSwapFree() uint64 {
+	if x != nil {
+		return x.SwapFree
+	}
+	return 0
+}
+
+func (x *Info) GetSwapTotal() uint64 {
+	if x != nil {
+		return x.SwapTotal
+	}
+	return 0
+}
+
+func (x *Info) GetUnevictable() uint64 {
+	if x != nil {
+		return x.Unevictable
+	}
+	return 0
+}
+
+func (x *Info) GetVmallocChunk() uint64 {
+	if x != nil {
+		return x.VmallocChunk
+	}
+	return 0
+}
+
+func (x *Info) GetVmallocTotal() uint64 {
+	if x != nil {
+		return x.VmallocTotal
+	}
+	return 0
+}
+
+func (x *Info) GetVmallocUsed() uint64 {
+	if x != nil {
+		return x.VmallocUsed
+	}
+	return 0
+}
+
+func (x *Info) GetWriteback() uint64 {
+	if x != nil {
+		return x.Writeback
+	}
+	return 0
+}
+
+func (x *Info) GetWritebackTmp() uint64 {
+	if x != nil {
+		return x.WritebackTmp
+	}
+	return 0
+}
+
+var File_mem_meminfo_pb_info_proto protoreflect.FileDescriptor
+
+var file_mem_meminfo_pb_info_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x6d, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x6d, 0x69, 0x6e, 0x66, 0x6f, 0x2f, 0x70, 0x62,
+	0x2f, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x6a, 0x6f, 0x65,
+	0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6d, 0x65, 0x6d, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xa5,
+	0x0b, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x26, 0x0a, 0x0f, 0x61, 0x6e, 0x6f, 0x6e, 0x5f, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x61, 0x6e, 0x6f, 0x6e, 0x48, 0x75,
+	0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6e, 0x6f, 0x6e, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x50, 0x61, 0x67, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x62, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x64, 0x41, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x5f, 0x6d, 0x61, 0x70, 0x34, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x34, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x5f, 0x6d, 0x61, 0x70, 0x32, 0x6d, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x70, 0x32, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x69, 0x72, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x69, 0x72,
+	0x74, 0x79, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
+	0x68, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x75, 0x70, 0x74, 0x65,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x66, 0x72, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x75, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x72, 0x73, 0x76, 0x64, 0x18, 0x11, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x73, 0x76,
+	0x64, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x68, 0x75, 0x67,
+	0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x75, 0x72, 0x70, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72,
+	0x70, 0x12, 0x28, 0x0a, 0x10, 0x68, 0x75, 0x67, 0x65, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x68, 0x75, 0x67,
+	0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69,
+	0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x5f, 0x61, 0x6e, 0x6f, 0x6e, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x41, 0x6e, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
+	0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x17, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x63,
+	0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x53,
+	0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x18, 0x19,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x65, 0x6d, 0x5f, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x6d, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x46, 0x72, 0x65, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x65, 0x6d, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x65, 0x6d, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6d, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x66, 0x73, 0x5f, 0x75, 0x6e, 0x73, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x6e, 0x66, 0x73, 0x55, 0x6e,
+	0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68, 0x6d, 0x65, 0x6d,
+	0x18, 0x20, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x68, 0x6d, 0x65, 0x6d, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x61, 0x62, 0x18, 0x21, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x61,
+	0x62, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x22, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x52, 0x65, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x5f, 0x75, 0x6e, 0x72, 0x65,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x18, 0x23, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x55, 0x6e,
+	0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x77,
+	0x61, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x66, 0x72, 0x65, 0x65, 0x18, 0x25, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x77, 0x61,
+	0x70, 0x46, 0x72, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x26, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x6e, 0x65, 0x76, 0x69, 0x63, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e, 0x65, 0x76, 0x69,
+	0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x5f, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x28, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x76,
+	0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x76,
+	0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x29, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x5f, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x2a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x55,
+	0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b,
+	0x18, 0x2b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x74,
+	0x6d, 0x70, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x72, 0x69, 0x74, 0x65, 0x62,
+	0x61, 0x63, 0x6b, 0x54, 0x6d, 0x70, 0x42, 0x3c, 0x5a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66,
+	0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x6d, 0x65, 0x6d, 0x2f, 0x6d, 0x65, 0x6d, 0x69, 0x6e, 0x66,
+	0x6f, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_mem_meminfo_pb_info_proto_rawDescOnce sync.Once
+	file_mem_meminfo_pb_info_proto_rawDescData []byte
+)
+
+func file_mem_meminfo_pb_info_proto_rawDescGZIP() []byte {
+	file_mem_meminfo_pb_info_proto_rawDescOnce.Do(func() {
+		file_mem_meminfo_pb_info_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_mem_meminfo_pb_info_proto_rawDesc), len(file_mem_meminfo_pb_info_proto_rawDesc)))
+	})
+	return file_mem_meminfo_pb_info_proto_rawDescData
+}
+
+var file_mem_meminfo_pb_info_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mem_meminfo_pb_info_proto_goTypes = []any{
+	(*Info)(nil), // 0: joefriday.meminfo.Info
+}
+var file_mem_meminfo_pb_info_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_mem_meminfo_pb_info_proto_init() }
+func file_mem_meminfo_pb_info_proto_init() {
+	if File_mem_meminfo_pb_info_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mem_meminfo_pb_info_proto_rawDesc), len(file_mem_meminfo_pb_info_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_mem_meminfo_pb_info_proto_goTypes,
+		DependencyIndexes: file_mem_meminfo_pb_info_proto_depIdxs,
+		MessageInfos:      file_mem_meminfo_pb_info_proto_msgTypes,
+	}.Build()
+	File_mem_meminfo_pb_info_proto = out.File
+	file_mem_meminfo_pb_info_proto_goTypes = nil
+	file_mem_meminfo_pb_info_proto_depIdxs = nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vmstat gets the system's virtual memory statistics, /proc/vmstat.
+// Instead of returning a Go struct, it returns Flatbuffer serialized bytes. A
+// function to deserialize the Flatbuffer serialized bytes into a vmstat.Info
+// struct is provided.
+//
+// Note: the package name is vmstat and not the final element of the import
+// path (flat).
+package vmstat
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	vm "github.com/hmmftg/joefriday/mem/vmstat"
+	"github.com/hmmftg/joefriday/mem/vmstat/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to get the virtual memory statistics as Flatbuffer
+// serialized bytes by processing the /proc/vmstat file.
+type Profiler struct {
+	*vm.Profiler
+	*fb.Builder
+	names []string // the sorted names of the other counters
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := vm.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current virtual memory statistics as Flatbuffer serialized
+// bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current virtual memory statistics as Flatbuffer serialized
+// bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize serializes the virtual memory statistics using Flatbuffers. The
+// other counters are serialized in name order.
+func (prof *Profiler) Serialize(inf *vm.Info) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	prof.names = prof.names[:0]
+	for k := range inf.Other {
+		prof.names = append(prof.names, k)
+	}
+	sort.Strings(prof.names)
+	names := make([]fb.UOffsetT, len(prof.names))
+	counters := make([]fb.UOffsetT, len(prof.names))
+	for i, k := range prof.names {
+		names[i] = prof.Builder.CreateString(k)
+	}
+	for i, k := range prof.names {
+		structs.CounterStart(prof.Builder)
+		structs.CounterAddName(prof.Builder, names[i])
+		structs.CounterAddValue(prof.Builder, inf.Other[k])
+		counters[i] = structs.CounterEnd(prof.Builder)
+	}
+	structs.InfoStartOtherVector(prof.Builder, len(counters))
+	for i := len(counters) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(counters[i])
+	}
+	otherV := prof.Builder.EndVector(len(counters))
+	structs.InfoStart(prof.Builder)
+	structs.InfoAddTimestamp(prof.Builder, inf.Timestamp)
+	structs.InfoAddNrFreePages(prof.Builder, inf.NrFreePages)
+	structs.InfoAddNrInactiveAnon(prof.Builder, inf.NrInactiveAnon)
+	structs.InfoAddNrActiveAnon(prof.Builder, inf.NrActiveAnon)
+	structs.InfoAddNrInactiveFile(prof.Builder, inf.NrInactiveFile)
+	structs.InfoAddNrActiveFile(prof.Builder, inf.NrActiveFile)
+	structs.InfoAddNrUnevictable(prof.Builder, inf.NrUnevictable)
+	structs.InfoAddNrMlock(prof.Builder, inf.NrMlock)
+	structs.InfoAddNrAnonPages(prof.Builder, inf.NrAnonPages)
+	structs.InfoAddNrMapped(prof.Builder, inf.NrMapped)
+	structs.InfoAddNrFilePages(prof.Builder, inf.NrFilePages)
+	structs.InfoAddNrDirty(prof.Builder, inf.NrDirty)
+	structs.InfoAddNrWriteback(prof.Builder, inf.NrWriteback)
+	structs.InfoAddNrShmem(prof.Builder, inf.NrShmem)
+	structs.InfoAddNrSlabReclaimable(prof.Builder, inf.NrSlabReclaimable)
+	structs.InfoAddNrSlabUnreclaimable(prof.Builder, inf.NrSlabUnreclaimable)
+	structs.InfoAddNrKernelStack(prof.Builder, inf.NrKernelStack)
+	structs.InfoAddNrPageTablePages(prof.Builder, inf.NrPageTablePages)
+	structs.InfoAddNrDirtied(prof.Builder, inf.NrDirtied)
+	structs.InfoAddNrWritten(prof.Builder, inf.NrWritten)
+	structs.InfoAddPgPgIn(prof.Builder, inf.PgPgIn)
+	structs.InfoAddPgPgOut(prof.Builder, inf.PgPgOut)
+	structs.InfoAddPSwpIn(prof.Builder, inf.PSwpIn)
+	structs.InfoAddPSwpOut(prof.Builder, inf.PSwpOut)
+	structs.InfoAddPgFree(prof.Builder, inf.PgFree)
+	structs.InfoAddPgActivate(prof.Builder, inf.PgActivate)
+	structs.InfoAddPgDeactivate(prof.Builder, inf.PgDeactivate)
+	structs.InfoAddPgFault(prof.Builder, inf.PgFault)
+	structs.InfoAddPgMajFault(prof.Builder, inf.PgMajFault)
+	structs.InfoAddPgRefill(prof.Builder, inf.PgRefill)
+	structs.InfoAddPgStealKswapd(prof.Builder, inf.PgStealKswapd)
+	structs.InfoAddPgStealDirect(prof.Builder, inf.PgStealDirect)
+	structs.InfoAddPgStealKhugepaged(prof.Builder, inf.PgStealKhugepaged)
+	structs.InfoAddPgScanKswapd(prof.Builder, inf.PgScanKswapd)
+	structs.InfoAddPgScanDirect(prof.Builder, inf.PgScanDirect)
+	structs.InfoAddPgScanKhugepaged(prof.Builder, inf.PgScanKhugepaged)
+	structs.InfoAddOOMKill(prof.Builder, inf.OOMKill)
+	structs.InfoAddCompactStall(prof.Builder, inf.CompactStall)
+	structs.InfoAddWorkingsetRefaultAnon(prof.Builder, inf.WorkingsetRefaultAnon)
+	structs.InfoAddWorkingsetRefaultFile(prof.Builder, inf.WorkingsetRefaultFile)
+	structs.InfoAddTHPFaultAlloc(prof.Builder, inf.THPFaultAlloc)
+	structs.InfoAddTHPFaultFallback(prof.Builder, inf.THPFaultFallback)
+	structs.InfoAddOther(prof.Builder, otherV)
+	prof.Builder.Finish(structs.InfoEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize serializes the virtual memory statistics using Flatbuffers with
+// the package's global Profiler.
+func Serialize(inf *vm.Info) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them
+// as vmstat.Info.
+func Deserialize(p []byte) *vm.Info {
+	flatInf := structs.GetRootAsInfo(p, 0)
+	inf := &vm.Info{}
+	inf.Timestamp = flatInf.Timestamp()
+	inf.NrFreePages = flatInf.NrFreePages()
+	inf.NrInactiveAnon = flatInf.NrInactiveAnon()
+	inf.NrActiveAnon = flatInf.NrActiveAnon()
+	inf.NrInactiveFile = flatInf.NrInactiveFile()
+	inf.NrActiveFile = flatInf.NrActiveFile()
+	inf.NrUnevictable = flatInf.NrUnevictable()
+	inf.NrMlock = flatInf.NrMlock()
+	inf.NrAnonPages = flatInf.NrAnonPages()
+	inf.NrMapped = flatInf.NrMapped()
+	inf.NrFilePages = flatInf.NrFilePages()
+	inf.NrDirty = flatInf.NrDirty()
+	inf.NrWriteback = flatInf.NrWriteback()
+	inf.NrShmem = flatInf.NrShmem()
+	inf.NrSlabReclaimable = flatInf.NrSlabReclaimable()
+	inf.NrSlabUnreclaimable = flatInf.NrSlabUnreclaimable()
+	inf.NrKernelStack = flatInf.NrKernelStack()
+	inf.NrPageTablePages = flatInf.NrPageTablePages()
+	inf.NrDirtied = flatInf.NrDirtied()
+	inf.NrWritten = flatInf.NrWritten()
+	inf.PgPgIn = flatInf.PgPgIn()
+	inf.PgPgOut = flatInf.PgPgOut()
+	inf.PSwpIn = flatInf.PSwpIn()
+	inf.PSwpOut = flatInf.PSwpOut()
+	inf.PgFree = flatInf.PgFree()
+	inf.PgActivate = flatInf.PgActivate()
+	inf.PgDeactivate = flatInf.PgDeactivate()
+	inf.PgFault = flatInf.PgFault()
+	inf.PgMajFault = flatInf.PgMajFault()
+	inf.PgRefill = flatInf.PgRefill()
+	inf.PgStealKswapd = flatInf.PgStealKswapd()
+	inf.PgStealDirect = flatInf.PgStealDirect()
+	inf.PgStealKhugepaged = flatInf.PgStealKhugepaged()
+	inf.PgScanKswapd = flatInf.PgScanKswapd()
+	inf.PgScanDirect = flatInf.PgScanDirect()
+	inf.PgScanKhugepaged = flatInf.PgScanKhugepaged()
+	inf.OOMKill = flatInf.OOMKill()
+	inf.CompactStall = flatInf.CompactStall()
+	inf.WorkingsetRefaultAnon = flatInf.WorkingsetRefaultAnon()
+	inf.WorkingsetRefaultFile = flatInf.WorkingsetRefaultFile()
+	inf.THPFaultAlloc = flatInf.THPFaultAlloc()
+	inf.THPFaultFallback = flatInf.THPFaultFallback()
+	inf.Other = make(map[string]uint64, flatInf.OtherLength())
+	c := &structs.Counter{}
+	for i := 0; i < flatInf.OtherLength(); i++ {
+		if flatInf.Other(c, i) {
+			inf.Other[string(c.Name())] = c.Value()
+		}
+	}
+	return inf
+}
+
+// Ticker delivers the system's virtual memory statistics at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
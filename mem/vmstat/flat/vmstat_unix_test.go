@@ -0,0 +1,106 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmstat
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	vm "github.com/hmmftg/joefriday/mem/vmstat"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	inf, err := vm.Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	p, err := Serialize(inf)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	infD := Deserialize(p)
+	if !reflect.DeepEqual(inf, infD) {
+		t.Errorf("got %#v; want %#v", infD, inf)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkInfo("get", Deserialize(p), t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkInfo("ticker", Deserialize(v), t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkInfo(n string, i *vm.Info, t *testing.T) {
+	if i.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value, got 0", n)
+	}
+	if i.NrFreePages == 0 {
+		t.Errorf("%s: expected NrFreePages to be a non-zero value, got 0", n)
+	}
+	if i.PgFault == 0 {
+		t.Errorf("%s: expected PgFault to be a non-zero value, got 0", n)
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	inf, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = p.Serialize(inf)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var inf *vm.Info
+	p, _ := NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inf = Deserialize(tmp)
+	}
+	_ = inf
+}
@@ -0,0 +1,148 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vmstat processes the virtual memory statistics, /proc/vmstat.
+// Instead of returning a Go struct, it returns JSON serialized bytes. A
+// function to deserialize the JSON serialized bytes into a vmstat.Info struct
+// is provided.
+//
+// Note: the package name is vmstat and not the final element of the import
+// path (json).
+package vmstat
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	vm "github.com/hmmftg/joefriday/mem/vmstat"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to get the virtual memory statistics, as JSON, by
+// processing the /proc/vmstat file.
+type Profiler struct {
+	*vm.Profiler
+}
+
+// Returns an Initialized profiler that uses JSON; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := vm.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current virtual memory statistics as JSON serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current virtual memory statistics as JSON serialized bytes
+// using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize virtual memory statistics using JSON.
+func (prof *Profiler) Serialize(inf *vm.Info) ([]byte, error) {
+	return json.Marshal(inf)
+}
+
+// Serialize virtual memory statistics using JSON with the package's global
+// Profiler.
+func Serialize(inf *vm.Info) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(inf *vm.Info) ([]byte, error) {
+	return prof.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize that uses the package's global profiler.
+func Marshal(inf *vm.Info) ([]byte, error) {
+	return Serialize(inf)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// vmstat.Info.
+func Deserialize(p []byte) (*vm.Info, error) {
+	info := &vm.Info{}
+	err := json.Unmarshal(p, info)
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*vm.Info, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the system's virtual memory statistics at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
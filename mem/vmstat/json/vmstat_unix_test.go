@@ -0,0 +1,86 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmstat
+
+import (
+	"testing"
+	"time"
+
+	vm "github.com/hmmftg/joefriday/mem/vmstat"
+)
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	inf, err := Deserialize(p)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkInfo("get", inf, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			inf, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkInfo("ticker", inf, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkInfo(n string, i *vm.Info, t *testing.T) {
+	if i.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value, got 0", n)
+	}
+	if i.NrFreePages == 0 {
+		t.Errorf("%s: expected NrFreePages to be a non-zero value, got 0", n)
+	}
+	if len(i.Other) == 0 {
+		t.Errorf("%s: expected Other to have counters; it was empty", n)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
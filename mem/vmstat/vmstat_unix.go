@@ -0,0 +1,277 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vmstat gets the system's virtual memory statistics, /proc/vmstat.
+// The commonly used counters are fields of Info; all other counters are in
+// Info.Other, keyed by name, so that counters added by newer kernels are
+// still available.
+package vmstat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const procFile = "/proc/vmstat"
+
+// Info holds the virtual memory statistics. The nr_ values are the current
+// number of pages; the rest are counters that are accumulated since boot.
+// Counters that aren't reported by the kernel, e.g. pgsteal_khugepaged on
+// older kernels, are 0.
+type Info struct {
+	Timestamp             int64             `json:"timestamp"`
+	NrFreePages           uint64            `json:"nr_free_pages"`
+	NrInactiveAnon        uint64            `json:"nr_inactive_anon"`
+	NrActiveAnon          uint64            `json:"nr_active_anon"`
+	NrInactiveFile        uint64            `json:"nr_inactive_file"`
+	NrActiveFile          uint64            `json:"nr_active_file"`
+	NrUnevictable         uint64            `json:"nr_unevictable"`
+	NrMlock               uint64            `json:"nr_mlock"`
+	NrAnonPages           uint64            `json:"nr_anon_pages"`
+	NrMapped              uint64            `json:"nr_mapped"`
+	NrFilePages           uint64            `json:"nr_file_pages"`
+	NrDirty               uint64            `json:"nr_dirty"`
+	NrWriteback           uint64            `json:"nr_writeback"`
+	NrShmem               uint64            `json:"nr_shmem"`
+	NrSlabReclaimable     uint64            `json:"nr_slab_reclaimable"`
+	NrSlabUnreclaimable   uint64            `json:"nr_slab_unreclaimable"`
+	NrKernelStack         uint64            `json:"nr_kernel_stack"`
+	NrPageTablePages      uint64            `json:"nr_page_table_pages"`
+	NrDirtied             uint64            `json:"nr_dirtied"`
+	NrWritten             uint64            `json:"nr_written"`
+	PgPgIn                uint64            `json:"pgpgin"`
+	PgPgOut               uint64            `json:"pgpgout"`
+	PSwpIn                uint64            `json:"pswpin"`
+	PSwpOut               uint64            `json:"pswpout"`
+	PgFree                uint64            `json:"pgfree"`
+	PgActivate            uint64            `json:"pgactivate"`
+	PgDeactivate          uint64            `json:"pgdeactivate"`
+	PgFault               uint64            `json:"pgfault"`
+	PgMajFault            uint64            `json:"pgmajfault"`
+	PgRefill              uint64            `json:"pgrefill"`
+	PgStealKswapd         uint64            `json:"pgsteal_kswapd"`
+	PgStealDirect         uint64            `json:"pgsteal_direct"`
+	PgStealKhugepaged     uint64            `json:"pgsteal_khugepaged"`
+	PgScanKswapd          uint64            `json:"pgscan_kswapd"`
+	PgScanDirect          uint64            `json:"pgscan_direct"`
+	PgScanKhugepaged      uint64            `json:"pgscan_khugepaged"`
+	OOMKill               uint64            `json:"oom_kill"`
+	CompactStall          uint64            `json:"compact_stall"`
+	WorkingsetRefaultAnon uint64            `json:"workingset_refault_anon"`
+	WorkingsetRefaultFile uint64            `json:"workingset_refault_file"`
+	THPFaultAlloc         uint64            `json:"thp_fault_alloc"`
+	THPFaultFallback      uint64            `json:"thp_fault_fallback"`
+	Other                 map[string]uint64 `json:"other"`
+}
+
+// Profiler is used to get the virtual memory statistics by processing the
+// /proc/vmstat file.
+type Profiler struct {
+	joe.Procer
+	*joe.Buffer
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Procer: proc, Buffer: joe.NewBuffer()}, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	return prof.Procer.Reset()
+}
+
+// Get returns the current virtual memory statistics.
+func (prof *Profiler) Get() (inf *Info, err error) {
+	var (
+		i, line int
+		n       uint64
+	)
+	err = prof.Reset()
+	if err != nil {
+		return nil, err
+	}
+	inf = &Info{Other: make(map[string]uint64, 160)}
+	inf.Timestamp = time.Now().UTC().UnixNano()
+	for {
+		prof.Line, err = prof.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, &joe.ReadError{Err: err}
+		}
+		line++
+		// each line is the name and the value separated by a space
+		for i = 0; i < len(prof.Line); i++ {
+			if prof.Line[i] == 0x20 {
+				break
+			}
+		}
+		if i == len(prof.Line) {
+			return nil, &joe.ParseError{Info: fmt.Sprintf("line %d", line), Err: fmt.Errorf("%q: no value", joe.TrimTrailingSpaces(prof.Line))}
+		}
+		prof.Val = joe.TrimTrailingSpaces(prof.Line[i+1:])
+		n, err = tools.ParseUint(prof.Val)
+		if err != nil {
+			return nil, &joe.ParseError{Info: string(prof.Line[:i]), Err: err}
+		}
+		switch string(prof.Line[:i]) {
+		case "nr_free_pages":
+			inf.NrFreePages = n
+		case "nr_inactive_anon":
+			inf.NrInactiveAnon = n
+		case "nr_active_anon":
+			inf.NrActiveAnon = n
+		case "nr_inactive_file":
+			inf.NrInactiveFile = n
+		case "nr_active_file":
+			inf.NrActiveFile = n
+		case "nr_unevictable":
+			inf.NrUnevictable = n
+		case "nr_mlock":
+			inf.NrMlock = n
+		case "nr_anon_pages":
+			inf.NrAnonPages = n
+		case "nr_mapped":
+			inf.NrMapped = n
+		case "nr_file_pages":
+			inf.NrFilePages = n
+		case "nr_dirty":
+			inf.NrDirty = n
+		case "nr_writeback":
+			inf.NrWriteback = n
+		case "nr_shmem":
+			inf.NrShmem = n
+		case "nr_slab_reclaimable":
+			inf.NrSlabReclaimable = n
+		case "nr_slab_unreclaimable":
+			inf.NrSlabUnreclaimable = n
+		case "nr_kernel_stack":
+			inf.NrKernelStack = n
+		case "nr_page_table_pages":
+			inf.NrPageTablePages = n
+		case "nr_dirtied":
+			inf.NrDirtied = n
+		case "nr_written":
+			inf.NrWritten = n
+		case "pgpgin":
+			inf.PgPgIn = n
+		case "pgpgout":
+			inf.PgPgOut = n
+		case "pswpin":
+			inf.PSwpIn = n
+		case "pswpout":
+			inf.PSwpOut = n
+		case "pgfree":
+			inf.PgFree = n
+		case "pgactivate":
+			inf.PgActivate = n
+		case "pgdeactivate":
+			inf.PgDeactivate = n
+		case "pgfault":
+			inf.PgFault = n
+		case "pgmajfault":
+			inf.PgMajFault = n
+		case "pgrefill":
+			inf.PgRefill = n
+		case "pgsteal_kswapd":
+			inf.PgStealKswapd = n
+		case "pgsteal_direct":
+			inf.PgStealDirect = n
+		case "pgsteal_khugepaged":
+			inf.PgStealKhugepaged = n
+		case "pgscan_kswapd":
+			inf.PgScanKswapd = n
+		case "pgscan_direct":
+			inf.PgScanDirect = n
+		case "pgscan_khugepaged":
+			inf.PgScanKhugepaged = n
+		case "oom_kill":
+			inf.OOMKill = n
+		case "compact_stall":
+			inf.CompactStall = n
+		case "workingset_refault_anon":
+			inf.WorkingsetRefaultAnon = n
+		case "workingset_refault_file":
+			inf.WorkingsetRefaultFile = n
+		case "thp_fault_alloc":
+			inf.THPFaultAlloc = n
+		case "thp_fault_fallback":
+			inf.THPFaultFallback = n
+		default:
+			inf.Other[string(prof.Line[:i])] = n
+		}
+	}
+	return inf, nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current virtual memory statistics using the package's
+// global Profiler.
+func Get() (inf *Info, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Ticker delivers the system's virtual memory statistics at intervals.
+type Ticker struct {
+	*ticker.Ticker[*Info]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*Info, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
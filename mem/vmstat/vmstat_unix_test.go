@@ -0,0 +1,169 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmstat
+
+import (
+	"reflect"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+const vmstat = `nr_free_pages 123456
+nr_zone_inactive_anon 10
+nr_dirty 42
+pgpgin 1000
+pswpin 3
+pswpout 4
+pgfault 987654321
+pgmajfault 1234
+pgsteal_kswapd 500
+pgsteal_direct 60
+oom_kill 1
+nr_unstable 0
+some_new_counter 18446744073709551615
+`
+
+func TestGetFixture(t *testing.T) {
+	proc, err := joe.NewTempFileProc("vmstat", "vmstat", []byte(vmstat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer proc.Remove()
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.Procer = proc
+	inf, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := Info{
+		Timestamp: inf.Timestamp, NrFreePages: 123456, NrDirty: 42, PgPgIn: 1000, PSwpIn: 3, PSwpOut: 4,
+		PgFault: 987654321, PgMajFault: 1234, PgStealKswapd: 500, PgStealDirect: 60, OOMKill: 1,
+		Other: map[string]uint64{
+			"nr_zone_inactive_anon": 10,
+			"nr_unstable":           0,
+			"some_new_counter":      18446744073709551615,
+		},
+	}
+	if !reflect.DeepEqual(*inf, expected) {
+		t.Errorf("got %#v; want %#v", *inf, expected)
+	}
+}
+
+func TestGetErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"no value", "pgfault\n"},
+		{"bad value", "pgfault x\n"},
+		{"negative value", "pgfault -1\n"},
+	}
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range tests {
+		proc, err := joe.NewTempFileProc("vmstat", "vmstat", []byte(test.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		prof.Procer = proc
+		_, err = prof.Get()
+		if err == nil {
+			t.Errorf("%s: expected an error; got none", test.name)
+		} else if _, ok := err.(*joe.ParseError); !ok {
+			t.Errorf("%s: expected a ParseError; got %#v", test.name, err)
+		}
+		proc.Remove()
+	}
+}
+
+func TestNewProfilerProcFS(t *testing.T) {
+	fsys := fstest.MapFS{"host/proc/vmstat": &fstest.MapFile{Data: []byte(vmstat)}}
+	prof, err := NewProfiler(joe.WithFS(fsys), joe.WithProcFS("/host/proc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inf, err := prof.Get()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inf.NrFreePages != 123456 || inf.PgFault != 987654321 {
+		t.Errorf("got %#v; want the counters from host/proc/vmstat", inf)
+	}
+}
+
+func TestGet(t *testing.T) {
+	inf, err := Get()
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	checkInfo("get", inf, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkInfo("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkInfo(n string, i *Info, t *testing.T) {
+	if i.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value, got 0", n)
+	}
+	if i.NrFreePages == 0 {
+		t.Errorf("%s: expected NrFreePages to be a non-zero value, got 0", n)
+	}
+	if i.PgFault == 0 {
+		t.Errorf("%s: expected PgFault to be a non-zero value, got 0", n)
+	}
+	if i.Other == nil {
+		t.Errorf("%s: expected Other to be non-nil", n)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var inf *Info
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		inf, _ = p.Get()
+	}
+	_ = inf
+}
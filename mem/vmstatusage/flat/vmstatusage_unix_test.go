@@ -0,0 +1,63 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmstatusage
+
+import (
+	"testing"
+	"time"
+
+	usage "github.com/hmmftg/joefriday/mem/vmstatusage"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	u := &usage.Usage{
+		Timestamp: 2000, TimeDelta: 1000, PgFaultPerSec: 1234.5, PgMajFaultPerSec: 1.5,
+		PSwpInPerSec: 2, PSwpOutPerSec: 3, PgStealPerSec: 42.25,
+	}
+	p, err := Serialize(u)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	uD := Deserialize(p)
+	if *uD != *u {
+		t.Errorf("got %#v; want %#v", *uD, *u)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u := Deserialize(v)
+			if u.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
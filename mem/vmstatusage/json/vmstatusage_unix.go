@@ -0,0 +1,161 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vmstatusage gets the paging, swapping, and reclaim activity of the
+// system as per second rates. The rates are calculated from the difference
+// between two /proc/vmstat snapshots and the time elapsed between them, which
+// is stored in the TimeDelta field. Instead of returning a Go struct, it
+// returns JSON serialized bytes. A function to deserialize the JSON
+// serialized bytes into a vmstatusage.Usage struct is provided.
+//
+// Note: the package name is vmstatusage and not the final element of the
+// import path (json).
+package vmstatusage
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/mem/vmstatusage"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the virtual memory activity as JSON serialized
+// bytes.
+type Profiler struct {
+	*usage.Profiler
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a
+// /proc/vmstat snapshot is taken so that any Get() will return valid
+// information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current virtual memory activity as JSON serialized bytes.
+// Calculating the rates requires two snapshots. This func gets the current
+// snapshot of /proc/vmstat and calculates the rates using the difference
+// between that and the prior snapshot. The current snapshot is stored for use
+// as the prior snapshot on the next Get call. If ongoing usage information is
+// desired, the Ticker should be used; it's better suited for ongoing usage
+// information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current virtual memory activity as JSON serialized bytes
+// using the package's global Profiler. The profiler is lazily instantiated.
+// If the profiler doesn't already exist, the first usage information will not
+// be useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize vmstatusage.Usage using JSON.
+func (prof *Profiler) Serialize(u *usage.Usage) ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// Serialize vmstatusage.Usage using JSON with the package's global Profiler.
+func Serialize(u *usage.Usage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(u *usage.Usage) ([]byte, error) {
+	return prof.Serialize(u)
+}
+
+// Marshal is an alias for Serialize using the package's global Profiler.
+func Marshal(u *usage.Usage) ([]byte, error) {
+	return Serialize(u)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// vmstatusage.Usage.
+func Deserialize(p []byte) (*usage.Usage, error) {
+	u := &usage.Usage{}
+	err := json.Unmarshal(p, u)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*usage.Usage, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the system's virtual memory activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
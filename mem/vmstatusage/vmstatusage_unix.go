@@ -0,0 +1,151 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vmstatusage gets the paging, swapping, and reclaim activity of the
+// system as per second rates. The rates are calculated from the difference
+// between two /proc/vmstat snapshots and the time elapsed between them, which
+// is stored in the TimeDelta field.
+package vmstatusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/mem/vmstat"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+// Usage holds the per second rates of the virtual memory activity. PgFault
+// is the rate of page faults, both minor and major, and PgMajFault the rate
+// of major page faults, which required I/O. PSwpIn and PSwpOut are the rates
+// of pages swapped in and out. PgSteal is the rate of pages reclaimed, by
+// kswapd, direct reclaim, and khugepaged.
+type Usage struct {
+	Timestamp        int64   `json:"timestamp"`
+	TimeDelta        int64   `json:"time_delta"`
+	PgFaultPerSec    float64 `json:"pgfault_per_sec"`
+	PgMajFaultPerSec float64 `json:"pgmajfault_per_sec"`
+	PSwpInPerSec     float64 `json:"pswpin_per_sec"`
+	PSwpOutPerSec    float64 `json:"pswpout_per_sec"`
+	PgStealPerSec    float64 `json:"pgsteal_per_sec"`
+}
+
+// Profiler is used to process the virtual memory activity.
+type Profiler struct {
+	*vmstat.Profiler
+	prior vmstat.Info
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a
+// /proc/vmstat snapshot is taken so that any Get() will return valid
+// information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := vmstat.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	prior, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, prior: *prior}, nil
+}
+
+// Get returns the current virtual memory activity. Calculating the rates
+// requires two snapshots. This func gets the current snapshot of /proc/vmstat
+// and calculates the rates using the difference between that and the prior
+// snapshot. The current snapshot is stored for use as the prior snapshot on
+// the next Get call. If ongoing usage information is desired, the Ticker
+// should be used; it's better suited for ongoing usage information.
+func (prof *Profiler) Get() (u *Usage, err error) {
+	cur, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	u = prof.CalculateUsage(cur)
+	prof.prior = *cur
+	return u, nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current virtual memory activity using the package's global
+// Profiler. The profiler is lazily instantiated. If the profiler doesn't
+// already exist, the first usage information will not be useful due to
+// minimal time elapsing between the initial and second snapshots used for
+// usage calculations; the results of the first call should be discarded.
+func Get() (u *Usage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// CalculateUsage returns the per second rates using the difference between
+// the current /proc/vmstat snapshot and the prior one. If no time has elapsed
+// between the snapshots, the rates are 0.
+func (prof *Profiler) CalculateUsage(cur *vmstat.Info) *Usage {
+	u := &Usage{Timestamp: cur.Timestamp, TimeDelta: cur.Timestamp - prof.prior.Timestamp}
+	if u.TimeDelta <= 0 {
+		return u
+	}
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	u.PgFaultPerSec = float64(tools.CounterDelta(cur.PgFault, prof.prior.PgFault)) / secs
+	u.PgMajFaultPerSec = float64(tools.CounterDelta(cur.PgMajFault, prof.prior.PgMajFault)) / secs
+	u.PSwpInPerSec = float64(tools.CounterDelta(cur.PSwpIn, prof.prior.PSwpIn)) / secs
+	u.PSwpOutPerSec = float64(tools.CounterDelta(cur.PSwpOut, prof.prior.PSwpOut)) / secs
+	steal := tools.CounterDelta(cur.PgStealKswapd, prof.prior.PgStealKswapd) +
+		tools.CounterDelta(cur.PgStealDirect, prof.prior.PgStealDirect) +
+		tools.CounterDelta(cur.PgStealKhugepaged, prof.prior.PgStealKhugepaged)
+	u.PgStealPerSec = float64(steal) / secs
+	return u
+}
+
+// Ticker delivers the system's virtual memory activity at intervals.
+type Ticker struct {
+	*ticker.Ticker[*Usage]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*Usage, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vmstatusage
+
+import (
+	"testing"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/mem/vmstat"
+)
+
+func TestCalculateUsage(t *testing.T) {
+	sec := int64(time.Second)
+	tests := []struct {
+		name     string
+		prior    vmstat.Info
+		cur      vmstat.Info
+		expected Usage
+	}{
+		{
+			"rates",
+			vmstat.Info{Timestamp: sec, PgFault: 1000, PgMajFault: 10, PSwpIn: 5, PSwpOut: 6, PgStealKswapd: 100, PgStealDirect: 20, PgStealKhugepaged: 1},
+			vmstat.Info{Timestamp: 3 * sec, PgFault: 3000, PgMajFault: 30, PSwpIn: 9, PSwpOut: 6, PgStealKswapd: 300, PgStealDirect: 60, PgStealKhugepaged: 3},
+			Usage{Timestamp: 3 * sec, TimeDelta: 2 * sec, PgFaultPerSec: 1000, PgMajFaultPerSec: 10, PSwpInPerSec: 2, PgStealPerSec: 121},
+		},
+		{
+			"reset",
+			vmstat.Info{Timestamp: sec, PgFault: 1 << 40},
+			vmstat.Info{Timestamp: 2 * sec, PgFault: 500},
+			Usage{Timestamp: 2 * sec, TimeDelta: sec, PgFaultPerSec: 500},
+		},
+		{
+			"no time delta",
+			vmstat.Info{Timestamp: sec, PgFault: 1000},
+			vmstat.Info{Timestamp: sec, PgFault: 2000},
+			Usage{Timestamp: sec},
+		},
+	}
+	for _, test := range tests {
+		prof := &Profiler{prior: test.prior}
+		u := prof.CalculateUsage(&test.cur)
+		if *u != test.expected {
+			t.Errorf("%s: got %#v; want %#v", test.name, *u, test.expected)
+		}
+	}
+}
+
+func TestGetFixture(t *testing.T) {
+	prior, err := joe.NewTempFileProc("vmstatusage", "vmstat", []byte("pgfault 1000\npgmajfault 10\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prior.Remove()
+	cur, err := joe.NewTempFileProc("vmstatusage", "vmstat", []byte("pgfault 2000\npgmajfault 20\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cur.Remove()
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.Procer = prior
+	_, err = prof.Get()
+	if err != nil {
+		t.Fatalf("prior: unexpected error: %s", err)
+	}
+	prof.Procer = cur
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("cur: unexpected error: %s", err)
+	}
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	if u.TimeDelta <= 0 {
+		t.Fatalf("TimeDelta: got %d; want a value > 0", u.TimeDelta)
+	}
+	if u.PgFaultPerSec != 1000/secs {
+		t.Errorf("PgFaultPerSec: got %v; want %v", u.PgFaultPerSec, 1000/secs)
+	}
+	if u.PgMajFaultPerSec != 10/secs {
+		t.Errorf("PgMajFaultPerSec: got %v; want %v", u.PgMajFaultPerSec, 10/secs)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *Usage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	if u.PgFaultPerSec < 0 {
+		t.Errorf("%s: PgFaultPerSec: got %v; want a value >= 0", n, u.PgFaultPerSec)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netdev
+
+import (
+	fb "github.com/google/flatbuffers/go"
+	"github.com/hmmftg/joefriday/net/structs/flat"
+)
+
+// View provides read-only access to Flatbuffer serialized structs.DevInfo
+// without deserializing it. Values are read from the serialized bytes as they
+// are requested, so reading a few fields, or iterating the devices, does not
+// allocate.
+type View struct {
+	t flat.DevInfo
+}
+
+// NewView returns a View of the Flatbuffer serialized structs.DevInfo in p.
+// p is not copied; it must not be modified while the View, or anything
+// returned by it, is in use.
+func NewView(p []byte) View {
+	var v View
+	v.t.Init(p, fb.GetUOffsetT(p))
+	return v
+}
+
+// Timestamp returns the Timestamp field.
+func (v View) Timestamp() int64 {
+	return v.t.Timestamp()
+}
+
+// DeviceLen returns the number of Device entries.
+func (v View) DeviceLen() int {
+	return v.t.DeviceLength()
+}
+
+// Device returns a view of the i-th Device entry. It panics if i is out of
+// range.
+func (v View) Device(i int) DeviceView {
+	if i < 0 || i >= v.t.DeviceLength() {
+		panic("netdev: Device index out of range")
+	}
+	var e DeviceView
+	v.t.Device(&e.t, i)
+	return e
+}
+
+// DeviceView is a read-only view of a single Device entry of a View.
+type DeviceView struct {
+	t flat.Device
+}
+
+// Name returns the Name field. The returned slice refers to the
+// serialized bytes.
+func (v DeviceView) Name() []byte {
+	return v.t.Name()
+}
+
+// RBytes returns the RBytes field.
+func (v DeviceView) RBytes() int64 {
+	return v.t.RBytes()
+}
+
+// RPackets returns the RPackets field.
+func (v DeviceView) RPackets() int64 {
+	return v.t.RPackets()
+}
+
+// RErrs returns the RErrs field.
+func (v DeviceView) RErrs() int64 {
+	return v.t.RErrs()
+}
+
+// RDrop returns the RDrop field.
+func (v DeviceView) RDrop() int64 {
+	return v.t.RDrop()
+}
+
+// RFIFO returns the RFIFO field.
+func (v DeviceView) RFIFO() int64 {
+	return v.t.RFIFO()
+}
+
+// RFrame returns the RFrame field.
+func (v DeviceView) RFrame() int64 {
+	return v.t.RFrame()
+}
+
+// RCompressed returns the RCompressed field.
+func (v DeviceView) RCompressed() int64 {
+	return v.t.RCompressed()
+}
+
+// RMulticast returns the RMulticast field.
+func (v DeviceView) RMulticast() int64 {
+	return v.t.RMulticast()
+}
+
+// TBytes returns the TBytes field.
+func (v DeviceView) TBytes() int64 {
+	return v.t.TBytes()
+}
+
+// TPackets returns the TPackets field.
+func (v DeviceView) TPackets() int64 {
+	return v.t.TPackets()
+}
+
+// TErrs returns the TErrs field.
+func (v DeviceView) TErrs() int64 {
+	return v.t.TErrs()
+}
+
+// TDrop returns the TDrop field.
+func (v DeviceView) TDrop() int64 {
+	return v.t.TDrop()
+}
+
+// TFIFO returns the TFIFO field.
+func (v DeviceView) TFIFO() int64 {
+	return v.t.TFIFO()
+}
+
+// TColls returns the TColls field.
+func (v DeviceView) TColls() int64 {
+	return v.t.TColls()
+}
+
+// TCarrier returns the TCarrier field.
+func (v DeviceView) TCarrier() int64 {
+	return v.t.TCarrier()
+}
+
+// TCompressed returns the TCompressed field.
+func (v DeviceView) TCompressed() int64 {
+	return v.t.TCompressed()
+}
@@ -0,0 +1,168 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netdev
+
+import (
+	"testing"
+
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func viewFixture() *structs.DevInfo {
+	return &structs.DevInfo{
+		Timestamp: 1,
+		Device: []structs.Device{
+			{
+				Name:        "name-3",
+				RBytes:      4,
+				RPackets:    5,
+				RErrs:       6,
+				RDrop:       7,
+				RFIFO:       8,
+				RFrame:      9,
+				RCompressed: 10,
+				RMulticast:  11,
+				TBytes:      12,
+				TPackets:    13,
+				TErrs:       14,
+				TDrop:       15,
+				TFIFO:       16,
+				TColls:      17,
+				TCarrier:    18,
+				TCompressed: 19,
+			},
+			{
+				Name:        "name-20",
+				RBytes:      21,
+				RPackets:    22,
+				RErrs:       23,
+				RDrop:       24,
+				RFIFO:       25,
+				RFrame:      26,
+				RCompressed: 27,
+				RMulticast:  28,
+				TBytes:      29,
+				TPackets:    30,
+				TErrs:       31,
+				TDrop:       32,
+				TFIFO:       33,
+				TColls:      34,
+				TCarrier:    35,
+				TCompressed: 36,
+			},
+		},
+	}
+}
+
+func TestView(t *testing.T) {
+	s := viewFixture()
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v := NewView(p)
+	if v.Timestamp() != s.Timestamp {
+		t.Errorf("s.Timestamp: got %v; want %v", v.Timestamp(), s.Timestamp)
+	}
+	if v.DeviceLen() != len(s.Device) {
+		t.Fatalf("DeviceLen: got %d; want %d", v.DeviceLen(), len(s.Device))
+	}
+	for i := 0; i < v.DeviceLen(); i++ {
+		e, w := v.Device(i), s.Device[i]
+		if string(e.Name()) != w.Name {
+			t.Errorf("w.Name: got %s; want %s", e.Name(), w.Name)
+		}
+		if e.RBytes() != w.RBytes {
+			t.Errorf("w.RBytes: got %v; want %v", e.RBytes(), w.RBytes)
+		}
+		if e.RPackets() != w.RPackets {
+			t.Errorf("w.RPackets: got %v; want %v", e.RPackets(), w.RPackets)
+		}
+		if e.RErrs() != w.RErrs {
+			t.Errorf("w.RErrs: got %v; want %v", e.RErrs(), w.RErrs)
+		}
+		if e.RDrop() != w.RDrop {
+			t.Errorf("w.RDrop: got %v; want %v", e.RDrop(), w.RDrop)
+		}
+		if e.RFIFO() != w.RFIFO {
+			t.Errorf("w.RFIFO: got %v; want %v", e.RFIFO(), w.RFIFO)
+		}
+		if e.RFrame() != w.RFrame {
+			t.Errorf("w.RFrame: got %v; want %v", e.RFrame(), w.RFrame)
+		}
+		if e.RCompressed() != w.RCompressed {
+			t.Errorf("w.RCompressed: got %v; want %v", e.RCompressed(), w.RCompressed)
+		}
+		if e.RMulticast() != w.RMulticast {
+			t.Errorf("w.RMulticast: got %v; want %v", e.RMulticast(), w.RMulticast)
+		}
+		if e.TBytes() != w.TBytes {
+			t.Errorf("w.TBytes: got %v; want %v", e.TBytes(), w.TBytes)
+		}
+		if e.TPackets() != w.TPackets {
+			t.Errorf("w.TPackets: got %v; want %v", e.TPackets(), w.TPackets)
+		}
+		if e.TErrs() != w.TErrs {
+			t.Errorf("w.TErrs: got %v; want %v", e.TErrs(), w.TErrs)
+		}
+		if e.TDrop() != w.TDrop {
+			t.Errorf("w.TDrop: got %v; want %v", e.TDrop(), w.TDrop)
+		}
+		if e.TFIFO() != w.TFIFO {
+			t.Errorf("w.TFIFO: got %v; want %v", e.TFIFO(), w.TFIFO)
+		}
+		if e.TColls() != w.TColls {
+			t.Errorf("w.TColls: got %v; want %v", e.TColls(), w.TColls)
+		}
+		if e.TCarrier() != w.TCarrier {
+			t.Errorf("w.TCarrier: got %v; want %v", e.TCarrier(), w.TCarrier)
+		}
+		if e.TCompressed() != w.TCompressed {
+			t.Errorf("w.TCompressed: got %v; want %v", e.TCompressed(), w.TCompressed)
+		}
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	var n int64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := NewView(p)
+		n = 0
+		for j := 0; j < v.DeviceLen(); j++ {
+			d := v.Device(j)
+			n += d.RBytes() + d.TBytes()
+		}
+	}
+	_ = n
+}
+
+// BenchmarkViewDeserialize reads the same values as BenchmarkView using
+// Deserialize.
+func BenchmarkViewDeserialize(b *testing.B) {
+	var n int64
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := Deserialize(p)
+		n = 0
+		for _, d := range s.Device {
+			n += d.RBytes + d.TBytes
+		}
+	}
+	_ = n
+}
@@ -14,12 +14,43 @@
 package netdev
 
 import (
+	"reflect"
 	"testing"
+	"testing/fstest"
 	"time"
 
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/net/structs"
 )
 
+func TestGetFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"proc/net/dev": &fstest.MapFile{Data: []byte(`Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+    lo:    1000      10    0    0    0     0          0         0     1000      10    0    0    0     0       0          0
+  eth0: 5000000    4000    1    2    3     4          5         6  2000000    3000    7    8    9    10      11         12
+`)},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inf, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []structs.Device{
+		{Name: "lo", RBytes: 1000, RPackets: 10, TBytes: 1000, TPackets: 10},
+		{
+			Name: "eth0", RBytes: 5000000, RPackets: 4000, RErrs: 1, RDrop: 2, RFIFO: 3, RFrame: 4, RCompressed: 5, RMulticast: 6,
+			TBytes: 2000000, TPackets: 3000, TErrs: 7, TDrop: 8, TFIFO: 9, TColls: 10, TCarrier: 11, TCompressed: 12,
+		},
+	}
+	if !reflect.DeepEqual(inf.Device, expected) {
+		t.Errorf("got %#v; want %#v", inf.Device, expected)
+	}
+}
+
 func TestGet(t *testing.T) {
 	inf, err := Get()
 	if err != nil {
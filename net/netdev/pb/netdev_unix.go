@@ -0,0 +1,221 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netdev gets the system's network device information: /proc/net/dev.
+// Instead of returning a Go struct, it returns protobuf serialized bytes. A
+// function to deserialize the protobuf serialized bytes into a structs.DevInfo
+// struct is provided.
+//
+// Note: the package name is netdev and not the final element of the import
+// path (pb).
+package netdev
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	dev "github.com/hmmftg/joefriday/net/netdev"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/net/structs/pb"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the network device information as protobuf using
+// the /proc/net/dev file.
+type Profiler struct {
+	*dev.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := dev.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current network device information as protobuf serialized
+// bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current network device information as protobuf serialized
+// bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize network device information as protobuf.
+func (prof *Profiler) Serialize(inf *structs.DevInfo) ([]byte, error) {
+	return proto.Marshal(toProto(inf))
+}
+
+// Serialize network device information as protobuf using the package's global
+// Profiler.
+func Serialize(inf *structs.DevInfo) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(inf *structs.DevInfo) ([]byte, error) {
+	return prof.Serialize(inf)
+}
+
+// Marshal is an alias for Serialize; uses the package's global Profiler.
+func Marshal(inf *structs.DevInfo) ([]byte, error) {
+	return Serialize(inf)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// structs.DevInfo
+func Deserialize(p []byte) (*structs.DevInfo, error) {
+	m := &pb.DevInfo{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.DevInfo, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a structs.DevInfo into a new pb.DevInfo message.
+func toProto(inf *structs.DevInfo) *pb.DevInfo {
+	m := &pb.DevInfo{
+		Timestamp: inf.Timestamp,
+		Device:    make([]*pb.Device, len(inf.Device)),
+	}
+	for i := range inf.Device {
+		m.Device[i] = deviceToProto(&inf.Device[i])
+	}
+	return m
+}
+
+// fromProto copies a pb.DevInfo message into a structs.DevInfo.
+func fromProto(m *pb.DevInfo) *structs.DevInfo {
+	inf := &structs.DevInfo{
+		Timestamp: m.Timestamp,
+		Device:    make([]structs.Device, len(m.Device)),
+	}
+	for i, d := range m.Device {
+		inf.Device[i] = deviceFromProto(d)
+	}
+	return inf
+}
+
+// deviceToProto copies a structs.Device into a new pb.Device message.
+func deviceToProto(d *structs.Device) *pb.Device {
+	return &pb.Device{
+		Name:        d.Name,
+		RBytes:      d.RBytes,
+		RPackets:    d.RPackets,
+		RErrs:       d.RErrs,
+		RDrop:       d.RDrop,
+		RFifo:       d.RFIFO,
+		RFrame:      d.RFrame,
+		RCompressed: d.RCompressed,
+		RMulticast:  d.RMulticast,
+		TBytes:      d.TBytes,
+		TPackets:    d.TPackets,
+		TErrs:       d.TErrs,
+		TDrop:       d.TDrop,
+		TFifo:       d.TFIFO,
+		TColls:      d.TColls,
+		TCarrier:    d.TCarrier,
+		TCompressed: d.TCompressed,
+	}
+}
+
+// deviceFromProto copies a pb.Device message into a structs.Device.
+func deviceFromProto(m *pb.Device) structs.Device {
+	return structs.Device{
+		Name:        m.Name,
+		RBytes:      m.RBytes,
+		RPackets:    m.RPackets,
+		RErrs:       m.RErrs,
+		RDrop:       m.RDrop,
+		RFIFO:       m.RFifo,
+		RFrame:      m.RFrame,
+		RCompressed: m.RCompressed,
+		RMulticast:  m.RMulticast,
+		TBytes:      m.TBytes,
+		TPackets:    m.TPackets,
+		TErrs:       m.TErrs,
+		TDrop:       m.TDrop,
+		TFIFO:       m.TFifo,
+		TColls:      m.TColls,
+		TCarrier:    m.TCarrier,
+		TCompressed: m.TCompressed,
+	}
+}
+
+// Ticker delivers the system's network device information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	ns "github.com/hmmftg/joefriday/net/netstat"
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	// every field gets a unique value so misplaced fields are caught.
+	n := &structs.Netstat{Timestamp: 1000}
+	for i, f := range ns.Fields {
+		*f.Value(n) = int64(i + 1)
+	}
+	n.TCP.MaxConn = -1
+	p, err := Serialize(n)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	nD := Deserialize(p)
+	if !reflect.DeepEqual(n, nD) {
+		t.Errorf("got %#v; want %#v", nD, n)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkNetstat("get", Deserialize(p), t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkNetstat("ticker", Deserialize(v), t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkNetstat(n string, v *structs.Netstat, t *testing.T) {
+	if v.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if v.IP.DefaultTTL == 0 {
+		t.Errorf("%s: IP.DefaultTTL: wanted non-zero value; got 0", n)
+	}
+	if v.TCP.RtoMin == 0 {
+		t.Errorf("%s: TCP.RtoMin: wanted non-zero value; got 0", n)
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	n, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = p.Serialize(n)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var n *structs.Netstat
+	p, _ := NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		n = Deserialize(tmp)
+	}
+	_ = n
+}
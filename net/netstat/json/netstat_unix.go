@@ -0,0 +1,149 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netstat handles JSON based processing of the kernel's network
+// protocol counters: /proc/net/snmp, /proc/net/netstat, and /proc/net/snmp6.
+// Instead of returning a Go struct, it returns JSON serialized bytes. A
+// function to deserialize the JSON serialized bytes into a structs.Netstat
+// struct is provided.
+//
+// Note: the package name is netstat and not the final element of the import
+// path (json).
+package netstat
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	ns "github.com/hmmftg/joefriday/net/netstat"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the network protocol counters as JSON
+// serialized bytes.
+type Profiler struct {
+	*ns.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := ns.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current network protocol counters as JSON serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	n, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(n)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current network protocol counters as JSON serialized bytes
+// using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize structs.Netstat using JSON.
+func (prof *Profiler) Serialize(n *structs.Netstat) ([]byte, error) {
+	return json.Marshal(n)
+}
+
+// Serialize structs.Netstat using JSON with the package's global Profiler.
+func Serialize(n *structs.Netstat) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(n)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(n *structs.Netstat) ([]byte, error) {
+	return prof.Serialize(n)
+}
+
+// Marshal is an alias for Serialize; uses the package's global Profiler.
+func Marshal(n *structs.Netstat) ([]byte, error) {
+	return Serialize(n)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// structs.Netstat.
+func Deserialize(p []byte) (*structs.Netstat, error) {
+	n := &structs.Netstat{}
+	err := json.Unmarshal(p, n)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.Netstat, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the system's network protocol counters at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
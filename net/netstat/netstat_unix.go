@@ -0,0 +1,635 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netstat processes the kernel's network protocol counters:
+// /proc/net/snmp, /proc/net/netstat, and /proc/net/snmp6. The Ip, Icmp, Tcp,
+// Udp, and TcpExt sections of /proc/net/snmp and /proc/net/netstat, and the
+// Ip6, Icmp6, and Udp6 counters of /proc/net/snmp6, are parsed; everything
+// else, including counters that aren't known to this package, is ignored.
+package netstat
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const (
+	SNMPFile    = "/proc/net/snmp"
+	SNMP6File   = "/proc/net/snmp6"
+	NetstatFile = "/proc/net/netstat"
+)
+
+// Field describes a field of structs.Netstat.
+type Field struct {
+	// Name is the field's name as used by the kernel, prefixed with the name
+	// of its section, e.g. TcpActiveOpens or Ip6InReceives.
+	Name string
+	// Gauge is true if the field is not a counter: its value doesn't only
+	// increase, e.g. TcpCurrEstab, or it is a configuration value, e.g.
+	// TcpRtoMin.
+	Gauge bool
+	// Value returns a pointer to the field in n.
+	Value func(n *structs.Netstat) *int64
+}
+
+// Fields holds all of the fields of structs.Netstat.
+var Fields = []Field{
+	// Ip
+	{"IpForwarding", true, func(n *structs.Netstat) *int64 { return &n.IP.Forwarding }},
+	{"IpDefaultTTL", true, func(n *structs.Netstat) *int64 { return &n.IP.DefaultTTL }},
+	{"IpInReceives", false, func(n *structs.Netstat) *int64 { return &n.IP.InReceives }},
+	{"IpInHdrErrors", false, func(n *structs.Netstat) *int64 { return &n.IP.InHdrErrors }},
+	{"IpInAddrErrors", false, func(n *structs.Netstat) *int64 { return &n.IP.InAddrErrors }},
+	{"IpForwDatagrams", false, func(n *structs.Netstat) *int64 { return &n.IP.ForwDatagrams }},
+	{"IpInUnknownProtos", false, func(n *structs.Netstat) *int64 { return &n.IP.InUnknownProtos }},
+	{"IpInDiscards", false, func(n *structs.Netstat) *int64 { return &n.IP.InDiscards }},
+	{"IpInDelivers", false, func(n *structs.Netstat) *int64 { return &n.IP.InDelivers }},
+	{"IpOutRequests", false, func(n *structs.Netstat) *int64 { return &n.IP.OutRequests }},
+	{"IpOutDiscards", false, func(n *structs.Netstat) *int64 { return &n.IP.OutDiscards }},
+	{"IpOutNoRoutes", false, func(n *structs.Netstat) *int64 { return &n.IP.OutNoRoutes }},
+	{"IpReasmTimeout", true, func(n *structs.Netstat) *int64 { return &n.IP.ReasmTimeout }},
+	{"IpReasmReqds", false, func(n *structs.Netstat) *int64 { return &n.IP.ReasmReqds }},
+	{"IpReasmOKs", false, func(n *structs.Netstat) *int64 { return &n.IP.ReasmOKs }},
+	{"IpReasmFails", false, func(n *structs.Netstat) *int64 { return &n.IP.ReasmFails }},
+	{"IpFragOKs", false, func(n *structs.Netstat) *int64 { return &n.IP.FragOKs }},
+	{"IpFragFails", false, func(n *structs.Netstat) *int64 { return &n.IP.FragFails }},
+	{"IpFragCreates", false, func(n *structs.Netstat) *int64 { return &n.IP.FragCreates }},
+	{"IpOutTransmits", false, func(n *structs.Netstat) *int64 { return &n.IP.OutTransmits }},
+	// Icmp
+	{"IcmpInMsgs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InMsgs }},
+	{"IcmpInErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InErrors }},
+	{"IcmpInCsumErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InCsumErrors }},
+	{"IcmpInDestUnreachs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InDestUnreachs }},
+	{"IcmpInTimeExcds", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InTimeExcds }},
+	{"IcmpInParmProbs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InParmProbs }},
+	{"IcmpInSrcQuenchs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InSrcQuenchs }},
+	{"IcmpInRedirects", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InRedirects }},
+	{"IcmpInEchos", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InEchos }},
+	{"IcmpInEchoReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InEchoReps }},
+	{"IcmpInTimestamps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InTimestamps }},
+	{"IcmpInTimestampReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InTimestampReps }},
+	{"IcmpInAddrMasks", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InAddrMasks }},
+	{"IcmpInAddrMaskReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.InAddrMaskReps }},
+	{"IcmpOutMsgs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutMsgs }},
+	{"IcmpOutErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutErrors }},
+	{"IcmpOutRateLimitGlobal", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutRateLimitGlobal }},
+	{"IcmpOutRateLimitHost", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutRateLimitHost }},
+	{"IcmpOutDestUnreachs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutDestUnreachs }},
+	{"IcmpOutTimeExcds", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutTimeExcds }},
+	{"IcmpOutParmProbs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutParmProbs }},
+	{"IcmpOutSrcQuenchs", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutSrcQuenchs }},
+	{"IcmpOutRedirects", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutRedirects }},
+	{"IcmpOutEchos", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutEchos }},
+	{"IcmpOutEchoReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutEchoReps }},
+	{"IcmpOutTimestamps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutTimestamps }},
+	{"IcmpOutTimestampReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutTimestampReps }},
+	{"IcmpOutAddrMasks", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutAddrMasks }},
+	{"IcmpOutAddrMaskReps", false, func(n *structs.Netstat) *int64 { return &n.ICMP.OutAddrMaskReps }},
+	// Tcp
+	{"TcpRtoAlgorithm", true, func(n *structs.Netstat) *int64 { return &n.TCP.RtoAlgorithm }},
+	{"TcpRtoMin", true, func(n *structs.Netstat) *int64 { return &n.TCP.RtoMin }},
+	{"TcpRtoMax", true, func(n *structs.Netstat) *int64 { return &n.TCP.RtoMax }},
+	{"TcpMaxConn", true, func(n *structs.Netstat) *int64 { return &n.TCP.MaxConn }},
+	{"TcpActiveOpens", false, func(n *structs.Netstat) *int64 { return &n.TCP.ActiveOpens }},
+	{"TcpPassiveOpens", false, func(n *structs.Netstat) *int64 { return &n.TCP.PassiveOpens }},
+	{"TcpAttemptFails", false, func(n *structs.Netstat) *int64 { return &n.TCP.AttemptFails }},
+	{"TcpEstabResets", false, func(n *structs.Netstat) *int64 { return &n.TCP.EstabResets }},
+	{"TcpCurrEstab", true, func(n *structs.Netstat) *int64 { return &n.TCP.CurrEstab }},
+	{"TcpInSegs", false, func(n *structs.Netstat) *int64 { return &n.TCP.InSegs }},
+	{"TcpOutSegs", false, func(n *structs.Netstat) *int64 { return &n.TCP.OutSegs }},
+	{"TcpRetransSegs", false, func(n *structs.Netstat) *int64 { return &n.TCP.RetransSegs }},
+	{"TcpInErrs", false, func(n *structs.Netstat) *int64 { return &n.TCP.InErrs }},
+	{"TcpOutRsts", false, func(n *structs.Netstat) *int64 { return &n.TCP.OutRsts }},
+	{"TcpInCsumErrors", false, func(n *structs.Netstat) *int64 { return &n.TCP.InCsumErrors }},
+	// Udp
+	{"UdpInDatagrams", false, func(n *structs.Netstat) *int64 { return &n.UDP.InDatagrams }},
+	{"UdpNoPorts", false, func(n *structs.Netstat) *int64 { return &n.UDP.NoPorts }},
+	{"UdpInErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP.InErrors }},
+	{"UdpOutDatagrams", false, func(n *structs.Netstat) *int64 { return &n.UDP.OutDatagrams }},
+	{"UdpRcvbufErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP.RcvbufErrors }},
+	{"UdpSndbufErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP.SndbufErrors }},
+	{"UdpInCsumErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP.InCsumErrors }},
+	{"UdpIgnoredMulti", false, func(n *structs.Netstat) *int64 { return &n.UDP.IgnoredMulti }},
+	{"UdpMemErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP.MemErrors }},
+	// TcpExt
+	{"TcpExtSyncookiesSent", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.SyncookiesSent }},
+	{"TcpExtSyncookiesRecv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.SyncookiesRecv }},
+	{"TcpExtSyncookiesFailed", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.SyncookiesFailed }},
+	{"TcpExtEmbryonicRsts", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.EmbryonicRsts }},
+	{"TcpExtPruneCalled", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PruneCalled }},
+	{"TcpExtRcvPruned", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.RcvPruned }},
+	{"TcpExtOfoPruned", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.OfoPruned }},
+	{"TcpExtOutOfWindowIcmps", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.OutOfWindowIcmps }},
+	{"TcpExtLockDroppedIcmps", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.LockDroppedIcmps }},
+	{"TcpExtArpFilter", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.ArpFilter }},
+	{"TcpExtTW", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TW }},
+	{"TcpExtTWRecycled", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TWRecycled }},
+	{"TcpExtTWKilled", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TWKilled }},
+	{"TcpExtPAWSActive", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PAWSActive }},
+	{"TcpExtPAWSEstab", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PAWSEstab }},
+	{"TcpExtBeyondWindow", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.BeyondWindow }},
+	{"TcpExtTSEcrRejected", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TSEcrRejected }},
+	{"TcpExtPAWSOldAck", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PAWSOldAck }},
+	{"TcpExtPAWSTimewait", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PAWSTimewait }},
+	{"TcpExtDelayedACKs", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.DelayedACKs }},
+	{"TcpExtDelayedACKLocked", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.DelayedACKLocked }},
+	{"TcpExtDelayedACKLost", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.DelayedACKLost }},
+	{"TcpExtListenOverflows", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.ListenOverflows }},
+	{"TcpExtListenDrops", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.ListenDrops }},
+	{"TcpExtTCPHPHits", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHPHits }},
+	{"TcpExtTCPPureAcks", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPPureAcks }},
+	{"TcpExtTCPHPAcks", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHPAcks }},
+	{"TcpExtTCPRenoRecovery", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRenoRecovery }},
+	{"TcpExtTCPSackRecovery", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackRecovery }},
+	{"TcpExtTCPSACKReneging", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSACKReneging }},
+	{"TcpExtTCPSACKReorder", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSACKReorder }},
+	{"TcpExtTCPRenoReorder", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRenoReorder }},
+	{"TcpExtTCPTSReorder", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPTSReorder }},
+	{"TcpExtTCPFullUndo", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFullUndo }},
+	{"TcpExtTCPPartialUndo", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPPartialUndo }},
+	{"TcpExtTCPDSACKUndo", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKUndo }},
+	{"TcpExtTCPLossUndo", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPLossUndo }},
+	{"TcpExtTCPLostRetransmit", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPLostRetransmit }},
+	{"TcpExtTCPRenoFailures", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRenoFailures }},
+	{"TcpExtTCPSackFailures", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackFailures }},
+	{"TcpExtTCPLossFailures", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPLossFailures }},
+	{"TcpExtTCPFastRetrans", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastRetrans }},
+	{"TcpExtTCPSlowStartRetrans", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSlowStartRetrans }},
+	{"TcpExtTCPTimeouts", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPTimeouts }},
+	{"TcpExtTCPLossProbes", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPLossProbes }},
+	{"TcpExtTCPLossProbeRecovery", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPLossProbeRecovery }},
+	{"TcpExtTCPRenoRecoveryFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRenoRecoveryFail }},
+	{"TcpExtTCPSackRecoveryFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackRecoveryFail }},
+	{"TcpExtTCPRcvCollapsed", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRcvCollapsed }},
+	{"TcpExtTCPBacklogCoalesce", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPBacklogCoalesce }},
+	{"TcpExtTCPDSACKOldSent", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKOldSent }},
+	{"TcpExtTCPDSACKOfoSent", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKOfoSent }},
+	{"TcpExtTCPDSACKRecv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKRecv }},
+	{"TcpExtTCPDSACKOfoRecv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKOfoRecv }},
+	{"TcpExtTCPAbortOnData", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortOnData }},
+	{"TcpExtTCPAbortOnClose", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortOnClose }},
+	{"TcpExtTCPAbortOnMemory", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortOnMemory }},
+	{"TcpExtTCPAbortOnTimeout", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortOnTimeout }},
+	{"TcpExtTCPAbortOnLinger", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortOnLinger }},
+	{"TcpExtTCPAbortFailed", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAbortFailed }},
+	{"TcpExtTCPMemoryPressures", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMemoryPressures }},
+	{"TcpExtTCPMemoryPressuresChrono", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMemoryPressuresChrono }},
+	{"TcpExtTCPSACKDiscard", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSACKDiscard }},
+	{"TcpExtTCPDSACKIgnoredOld", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKIgnoredOld }},
+	{"TcpExtTCPDSACKIgnoredNoUndo", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKIgnoredNoUndo }},
+	{"TcpExtTCPSpuriousRTOs", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSpuriousRTOs }},
+	{"TcpExtTCPMD5NotFound", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMD5NotFound }},
+	{"TcpExtTCPMD5Unexpected", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMD5Unexpected }},
+	{"TcpExtTCPMD5Failure", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMD5Failure }},
+	{"TcpExtTCPSackShifted", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackShifted }},
+	{"TcpExtTCPSackMerged", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackMerged }},
+	{"TcpExtTCPSackShiftFallback", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSackShiftFallback }},
+	{"TcpExtTCPBacklogDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPBacklogDrop }},
+	{"TcpExtPFMemallocDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.PFMemallocDrop }},
+	{"TcpExtTCPMinTTLDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMinTTLDrop }},
+	{"TcpExtTCPDeferAcceptDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDeferAcceptDrop }},
+	{"TcpExtIPReversePathFilter", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.IPReversePathFilter }},
+	{"TcpExtTCPTimeWaitOverflow", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPTimeWaitOverflow }},
+	{"TcpExtTCPReqQFullDoCookies", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPReqQFullDoCookies }},
+	{"TcpExtTCPReqQFullDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPReqQFullDrop }},
+	{"TcpExtTCPRetransFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRetransFail }},
+	{"TcpExtTCPRcvCoalesce", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRcvCoalesce }},
+	{"TcpExtTCPOFOQueue", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPOFOQueue }},
+	{"TcpExtTCPOFODrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPOFODrop }},
+	{"TcpExtTCPOFOMerge", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPOFOMerge }},
+	{"TcpExtTCPChallengeACK", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPChallengeACK }},
+	{"TcpExtTCPSYNChallenge", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSYNChallenge }},
+	{"TcpExtTCPFastOpenActive", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenActive }},
+	{"TcpExtTCPFastOpenActiveFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenActiveFail }},
+	{"TcpExtTCPFastOpenPassive", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenPassive }},
+	{"TcpExtTCPFastOpenPassiveFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenPassiveFail }},
+	{"TcpExtTCPFastOpenListenOverflow", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenListenOverflow }},
+	{"TcpExtTCPFastOpenCookieReqd", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenCookieReqd }},
+	{"TcpExtTCPFastOpenBlackhole", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenBlackhole }},
+	{"TcpExtTCPSpuriousRtxHostQueues", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSpuriousRtxHostQueues }},
+	{"TcpExtBusyPollRxPackets", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.BusyPollRxPackets }},
+	{"TcpExtTCPAutoCorking", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAutoCorking }},
+	{"TcpExtTCPFromZeroWindowAdv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFromZeroWindowAdv }},
+	{"TcpExtTCPToZeroWindowAdv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPToZeroWindowAdv }},
+	{"TcpExtTCPWantZeroWindowAdv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPWantZeroWindowAdv }},
+	{"TcpExtTCPSynRetrans", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPSynRetrans }},
+	{"TcpExtTCPOrigDataSent", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPOrigDataSent }},
+	{"TcpExtTCPHystartTrainDetect", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHystartTrainDetect }},
+	{"TcpExtTCPHystartTrainCwnd", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHystartTrainCwnd }},
+	{"TcpExtTCPHystartDelayDetect", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHystartDelayDetect }},
+	{"TcpExtTCPHystartDelayCwnd", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPHystartDelayCwnd }},
+	{"TcpExtTCPACKSkippedSynRecv", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedSynRecv }},
+	{"TcpExtTCPACKSkippedPAWS", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedPAWS }},
+	{"TcpExtTCPACKSkippedSeq", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedSeq }},
+	{"TcpExtTCPACKSkippedFinWait2", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedFinWait2 }},
+	{"TcpExtTCPACKSkippedTimeWait", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedTimeWait }},
+	{"TcpExtTCPACKSkippedChallenge", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPACKSkippedChallenge }},
+	{"TcpExtTCPWinProbe", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPWinProbe }},
+	{"TcpExtTCPKeepAlive", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPKeepAlive }},
+	{"TcpExtTCPMTUPFail", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMTUPFail }},
+	{"TcpExtTCPMTUPSuccess", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMTUPSuccess }},
+	{"TcpExtTCPDelivered", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDelivered }},
+	{"TcpExtTCPDeliveredCE", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDeliveredCE }},
+	{"TcpExtTCPAckCompressed", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAckCompressed }},
+	{"TcpExtTCPZeroWindowDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPZeroWindowDrop }},
+	{"TcpExtTCPRcvQDrop", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPRcvQDrop }},
+	{"TcpExtTCPWqueueTooBig", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPWqueueTooBig }},
+	{"TcpExtTCPFastOpenPassiveAltKey", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPFastOpenPassiveAltKey }},
+	{"TcpExtTcpTimeoutRehash", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TcpTimeoutRehash }},
+	{"TcpExtTcpDuplicateDataRehash", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TcpDuplicateDataRehash }},
+	{"TcpExtTCPDSACKRecvSegs", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKRecvSegs }},
+	{"TcpExtTCPDSACKIgnoredDubious", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPDSACKIgnoredDubious }},
+	{"TcpExtTCPMigrateReqSuccess", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMigrateReqSuccess }},
+	{"TcpExtTCPMigrateReqFailure", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPMigrateReqFailure }},
+	{"TcpExtTCPPLBRehash", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPPLBRehash }},
+	{"TcpExtTCPAORequired", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAORequired }},
+	{"TcpExtTCPAOBad", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAOBad }},
+	{"TcpExtTCPAOKeyNotFound", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAOKeyNotFound }},
+	{"TcpExtTCPAOGood", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAOGood }},
+	{"TcpExtTCPAODroppedIcmps", false, func(n *structs.Netstat) *int64 { return &n.TCPExt.TCPAODroppedIcmps }},
+	// Ip6
+	{"Ip6InReceives", false, func(n *structs.Netstat) *int64 { return &n.IP6.InReceives }},
+	{"Ip6InHdrErrors", false, func(n *structs.Netstat) *int64 { return &n.IP6.InHdrErrors }},
+	{"Ip6InTooBigErrors", false, func(n *structs.Netstat) *int64 { return &n.IP6.InTooBigErrors }},
+	{"Ip6InNoRoutes", false, func(n *structs.Netstat) *int64 { return &n.IP6.InNoRoutes }},
+	{"Ip6InAddrErrors", false, func(n *structs.Netstat) *int64 { return &n.IP6.InAddrErrors }},
+	{"Ip6InUnknownProtos", false, func(n *structs.Netstat) *int64 { return &n.IP6.InUnknownProtos }},
+	{"Ip6InTruncatedPkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InTruncatedPkts }},
+	{"Ip6InDiscards", false, func(n *structs.Netstat) *int64 { return &n.IP6.InDiscards }},
+	{"Ip6InDelivers", false, func(n *structs.Netstat) *int64 { return &n.IP6.InDelivers }},
+	{"Ip6OutForwDatagrams", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutForwDatagrams }},
+	{"Ip6OutRequests", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutRequests }},
+	{"Ip6OutDiscards", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutDiscards }},
+	{"Ip6OutNoRoutes", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutNoRoutes }},
+	{"Ip6ReasmTimeout", true, func(n *structs.Netstat) *int64 { return &n.IP6.ReasmTimeout }},
+	{"Ip6ReasmReqds", false, func(n *structs.Netstat) *int64 { return &n.IP6.ReasmReqds }},
+	{"Ip6ReasmOKs", false, func(n *structs.Netstat) *int64 { return &n.IP6.ReasmOKs }},
+	{"Ip6ReasmFails", false, func(n *structs.Netstat) *int64 { return &n.IP6.ReasmFails }},
+	{"Ip6FragOKs", false, func(n *structs.Netstat) *int64 { return &n.IP6.FragOKs }},
+	{"Ip6FragFails", false, func(n *structs.Netstat) *int64 { return &n.IP6.FragFails }},
+	{"Ip6FragCreates", false, func(n *structs.Netstat) *int64 { return &n.IP6.FragCreates }},
+	{"Ip6InMcastPkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InMcastPkts }},
+	{"Ip6OutMcastPkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutMcastPkts }},
+	{"Ip6InOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.InOctets }},
+	{"Ip6OutOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutOctets }},
+	{"Ip6InMcastOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.InMcastOctets }},
+	{"Ip6OutMcastOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutMcastOctets }},
+	{"Ip6InBcastOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.InBcastOctets }},
+	{"Ip6OutBcastOctets", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutBcastOctets }},
+	{"Ip6InNoECTPkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InNoECTPkts }},
+	{"Ip6InECT1Pkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InECT1Pkts }},
+	{"Ip6InECT0Pkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InECT0Pkts }},
+	{"Ip6InCEPkts", false, func(n *structs.Netstat) *int64 { return &n.IP6.InCEPkts }},
+	{"Ip6OutTransmits", false, func(n *structs.Netstat) *int64 { return &n.IP6.OutTransmits }},
+	// Icmp6
+	{"Icmp6InMsgs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InMsgs }},
+	{"Icmp6InErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InErrors }},
+	{"Icmp6OutMsgs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutMsgs }},
+	{"Icmp6OutErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutErrors }},
+	{"Icmp6InCsumErrors", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InCsumErrors }},
+	{"Icmp6OutRateLimitHost", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutRateLimitHost }},
+	{"Icmp6InDestUnreachs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InDestUnreachs }},
+	{"Icmp6InPktTooBigs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InPktTooBigs }},
+	{"Icmp6InTimeExcds", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InTimeExcds }},
+	{"Icmp6InParmProblems", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InParmProblems }},
+	{"Icmp6InEchos", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InEchos }},
+	{"Icmp6InEchoReplies", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InEchoReplies }},
+	{"Icmp6InGroupMembQueries", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InGroupMembQueries }},
+	{"Icmp6InGroupMembResponses", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InGroupMembResponses }},
+	{"Icmp6InGroupMembReductions", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InGroupMembReductions }},
+	{"Icmp6InRouterSolicits", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InRouterSolicits }},
+	{"Icmp6InRouterAdvertisements", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InRouterAdvertisements }},
+	{"Icmp6InNeighborSolicits", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InNeighborSolicits }},
+	{"Icmp6InNeighborAdvertisements", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InNeighborAdvertisements }},
+	{"Icmp6InRedirects", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InRedirects }},
+	{"Icmp6InMLDv2Reports", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.InMLDv2Reports }},
+	{"Icmp6OutDestUnreachs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutDestUnreachs }},
+	{"Icmp6OutPktTooBigs", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutPktTooBigs }},
+	{"Icmp6OutTimeExcds", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutTimeExcds }},
+	{"Icmp6OutParmProblems", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutParmProblems }},
+	{"Icmp6OutEchos", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutEchos }},
+	{"Icmp6OutEchoReplies", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutEchoReplies }},
+	{"Icmp6OutGroupMembQueries", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutGroupMembQueries }},
+	{"Icmp6OutGroupMembResponses", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutGroupMembResponses }},
+	{"Icmp6OutGroupMembReductions", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutGroupMembReductions }},
+	{"Icmp6OutRouterSolicits", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutRouterSolicits }},
+	{"Icmp6OutRouterAdvertisements", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutRouterAdvertisements }},
+	{"Icmp6OutNeighborSolicits", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutNeighborSolicits }},
+	{"Icmp6OutNeighborAdvertisements", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutNeighborAdvertisements }},
+	{"Icmp6OutRedirects", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutRedirects }},
+	{"Icmp6OutMLDv2Reports", false, func(n *structs.Netstat) *int64 { return &n.ICMP6.OutMLDv2Reports }},
+	// Udp6
+	{"Udp6InDatagrams", false, func(n *structs.Netstat) *int64 { return &n.UDP6.InDatagrams }},
+	{"Udp6NoPorts", false, func(n *structs.Netstat) *int64 { return &n.UDP6.NoPorts }},
+	{"Udp6InErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP6.InErrors }},
+	{"Udp6OutDatagrams", false, func(n *structs.Netstat) *int64 { return &n.UDP6.OutDatagrams }},
+	{"Udp6RcvbufErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP6.RcvbufErrors }},
+	{"Udp6SndbufErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP6.SndbufErrors }},
+	{"Udp6InCsumErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP6.InCsumErrors }},
+	{"Udp6IgnoredMulti", false, func(n *structs.Netstat) *int64 { return &n.UDP6.IgnoredMulti }},
+	{"Udp6MemErrors", false, func(n *structs.Netstat) *int64 { return &n.UDP6.MemErrors }},
+}
+
+// fieldIndex is the index of each field in Fields by name.
+var fieldIndex = make(map[string]int, len(Fields))
+
+func init() {
+	for i := 0; i < len(Fields); i++ {
+		fieldIndex[Fields[i].Name] = i
+	}
+}
+
+// Profiler is used to process the network protocol counters.
+type Profiler struct {
+	*joe.Buffer
+	// The counter files. A nil file, e.g. snmp6 on a system without IPv6
+	// support, is skipped.
+	SNMP    joe.Procer
+	SNMP6   joe.Procer
+	Netstat joe.Procer
+	header  []byte // the current header line of a header/value pair of lines
+	name    []byte // the current field's name: its section and its name
+}
+
+// Returns an initialized Profiler; ready to use. Counter files that don't
+// exist are skipped.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	prof = &Profiler{Buffer: joe.NewBuffer()}
+	prof.SNMP, err = newProc(o, SNMPFile)
+	if err != nil {
+		return nil, err
+	}
+	prof.SNMP6, err = newProc(o, SNMP6File)
+	if err != nil {
+		return nil, err
+	}
+	prof.Netstat, err = newProc(o, NetstatFile)
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// newProc returns a Procer for the file, using the Options; if the file
+// doesn't exist, nil is returned.
+func newProc(o joe.Options, fname string) (joe.Procer, error) {
+	proc, err := o.NewProc(o.Path(fname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return proc, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	for _, p := range []joe.Procer{prof.SNMP, prof.SNMP6, prof.Netstat} {
+		if p == nil {
+			continue
+		}
+		err := p.Reset()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the current network protocol counters.
+func (prof *Profiler) Get() (n *structs.Netstat, err error) {
+	err = prof.Reset()
+	if err != nil {
+		return nil, err
+	}
+	n = &structs.Netstat{Timestamp: time.Now().UTC().UnixNano()}
+	if prof.SNMP != nil {
+		err = prof.parsePairs("snmp", prof.SNMP, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if prof.Netstat != nil {
+		err = prof.parsePairs("netstat", prof.Netstat, n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if prof.SNMP6 != nil {
+		err = prof.parseSNMP6(n)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return n, nil
+}
+
+// parsePairs processes a file made up of pairs of lines: a header line with
+// the section name followed by the names of its fields and a line with the
+// section name followed by the values of those fields, e.g.:
+//
+//	Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens ...
+//	Tcp: 1 200 120000 -1 4242 ...
+func (prof *Profiler) parsePairs(fname string, proc joe.Procer, n *structs.Netstat) error {
+	var (
+		i, j, hPos, vPos, sect, line int
+		err                          error
+	)
+	for {
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return &joe.ReadError{Info: fname, Err: err}
+		}
+		line++
+		// the line buffer is reused by the next read so the header is copied.
+		prof.header = append(prof.header[:0], prof.Line...)
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return &joe.ParseError{Info: fmt.Sprintf("%s: line %d", fname, line), Err: fmt.Errorf("header has no values line")}
+			}
+			return &joe.ReadError{Info: fname, Err: err}
+		}
+		line++
+		// the section names of the header and values lines must match.
+		for sect = 0; sect < len(prof.header); sect++ {
+			if prof.header[sect] == ':' {
+				break
+			}
+		}
+		if sect == len(prof.header) || len(prof.Line) <= sect || prof.Line[sect] != ':' || string(prof.Line[:sect]) != string(prof.header[:sect]) {
+			return &joe.ParseError{Info: fmt.Sprintf("%s: line %d", fname, line), Err: fmt.Errorf("values line doesn't match header %q", prof.header[:sect])}
+		}
+		hPos = sect + 1
+		vPos = sect + 1
+		for {
+			// the next header field
+			for ; hPos < len(prof.header); hPos++ {
+				if prof.header[hPos] != 0x20 {
+					break
+				}
+			}
+			for i = hPos; i < len(prof.header); i++ {
+				if prof.header[i] == 0x20 || prof.header[i] == '\n' {
+					break
+				}
+			}
+			// the next value
+			for ; vPos < len(prof.Line); vPos++ {
+				if prof.Line[vPos] != 0x20 {
+					break
+				}
+			}
+			for j = vPos; j < len(prof.Line); j++ {
+				if prof.Line[j] == 0x20 || prof.Line[j] == '\n' {
+					break
+				}
+			}
+			if i == hPos || j == vPos {
+				if i != hPos || j != vPos {
+					return &joe.ParseError{Info: fmt.Sprintf("%s: line %d", fname, line), Err: fmt.Errorf("%s: number of values doesn't match the number of fields", prof.header[:sect])}
+				}
+				break
+			}
+			prof.name = append(append(prof.name[:0], prof.header[:sect]...), prof.header[hPos:i]...)
+			err = prof.set(n, prof.Line[vPos:j])
+			if err != nil {
+				return &joe.ParseError{Info: fmt.Sprintf("%s: line %d: %s", fname, line, prof.name), Err: err}
+			}
+			hPos = i
+			vPos = j
+		}
+	}
+}
+
+// parseSNMP6 processes /proc/net/snmp6, which has a field name and its value
+// on each line, e.g.:
+//
+//	Ip6InReceives                   	4242
+func (prof *Profiler) parseSNMP6(n *structs.Netstat) error {
+	var (
+		i, pos, line int
+		err          error
+	)
+	for {
+		prof.Line, err = prof.SNMP6.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			return &joe.ReadError{Info: "snmp6", Err: err}
+		}
+		line++
+		for i = 0; i < len(prof.Line); i++ {
+			if prof.Line[i] == 0x20 || prof.Line[i] == '\t' {
+				break
+			}
+		}
+		prof.name = append(prof.name[:0], prof.Line[:i]...)
+		for pos = i; pos < len(prof.Line); pos++ {
+			if prof.Line[pos] != 0x20 && prof.Line[pos] != '\t' {
+				break
+			}
+		}
+		err = prof.set(n, joe.TrimTrailingSpaces(prof.Line[pos:]))
+		if err != nil {
+			return &joe.ParseError{Info: fmt.Sprintf("snmp6: line %d: %s", line, prof.name), Err: err}
+		}
+	}
+}
+
+// set sets the field named prof.name to the value v. Unknown fields are
+// ignored.
+func (prof *Profiler) set(n *structs.Netstat, v []byte) error {
+	i, ok := fieldIndex[string(prof.name)]
+	if !ok {
+		return nil
+	}
+	// most values are unsigned counters but some, e.g. TcpMaxConn, can be -1.
+	if len(v) > 0 && v[0] == '-' {
+		x, err := tools.ParseInt(v)
+		if err != nil {
+			return err
+		}
+		*Fields[i].Value(n) = x
+		return nil
+	}
+	x, err := tools.ParseUint(v)
+	if err != nil {
+		return err
+	}
+	*Fields[i].Value(n) = int64(x)
+	return nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current network protocol counters using the package's
+// global Profiler.
+func Get() (n *structs.Netstat, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Ticker delivers the system's network protocol counters at intervals.
+type Ticker struct {
+	*ticker.Ticker[*structs.Netstat]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.Netstat, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstat
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+const snmp = `Ip: Forwarding DefaultTTL InReceives InHdrErrors
+Ip: 2 64 123456 3
+Icmp: InMsgs InErrors
+Icmp: 42 1
+Tcp: RtoAlgorithm RtoMin RtoMax MaxConn ActiveOpens CurrEstab InSegs
+Tcp: 1 200 120000 -1 4242 7 18446744073709551
+Udp: InDatagrams NoPorts InErrors
+Udp: 900 8 2
+UdpLite: InDatagrams NoPorts InErrors
+UdpLite: 11 12 13
+`
+
+const netstat = `TcpExt: SyncookiesSent ListenOverflows ListenDrops TCPNotYetAField TCPTimeouts
+TcpExt: 1 5 6 99 314
+IpExt: InNoRoutes InTruncatedPkts
+IpExt: 3 4
+`
+
+const snmp6 = `Ip6InReceives                   	5150
+Ip6OutRequests                  	4242
+Icmp6InMsgs                     	12
+Icmp6InType133                  	4
+Udp6InDatagrams                 	77
+UdpLite6InDatagrams             	88
+`
+
+func TestGet(t *testing.T) {
+	snmpProc, err := joe.NewTempFileProc("netstat", "snmp", []byte(snmp))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snmpProc.Remove()
+	netstatProc, err := joe.NewTempFileProc("netstat", "netstat", []byte(netstat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer netstatProc.Remove()
+	snmp6Proc, err := joe.NewTempFileProc("netstat", "snmp6", []byte(snmp6))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer snmp6Proc.Remove()
+
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.SNMP = snmpProc
+	prof.Netstat = netstatProc
+	prof.SNMP6 = snmp6Proc
+	expected := structs.Netstat{
+		IP:     structs.IP{Forwarding: 2, DefaultTTL: 64, InReceives: 123456, InHdrErrors: 3},
+		ICMP:   structs.ICMP{InMsgs: 42, InErrors: 1},
+		TCP:    structs.TCP{RtoAlgorithm: 1, RtoMin: 200, RtoMax: 120000, MaxConn: -1, ActiveOpens: 4242, CurrEstab: 7, InSegs: 18446744073709551},
+		UDP:    structs.UDP{InDatagrams: 900, NoPorts: 8, InErrors: 2},
+		TCPExt: structs.TCPExt{SyncookiesSent: 1, ListenOverflows: 5, ListenDrops: 6, TCPTimeouts: 314},
+		IP6:    structs.IP6{InReceives: 5150, OutRequests: 4242},
+		ICMP6:  structs.ICMP6{InMsgs: 12},
+		UDP6:   structs.UDP6{InDatagrams: 77},
+	}
+	// the files are re-read on each Get.
+	for i := 0; i < 2; i++ {
+		n, err := prof.Get()
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if n.Timestamp == 0 {
+			t.Errorf("%d: Timestamp: wanted non-zero value; got 0", i)
+		}
+		expected.Timestamp = n.Timestamp
+		if !reflect.DeepEqual(*n, expected) {
+			t.Errorf("%d: got %#v; want %#v", i, *n, expected)
+		}
+	}
+
+	// a missing file is skipped.
+	prof.SNMP6 = nil
+	n, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if n.IP6 != (structs.IP6{}) {
+		t.Errorf("no snmp6: IP6: got %#v; want zero value", n.IP6)
+	}
+	if n.TCP != expected.TCP {
+		t.Errorf("no snmp6: TCP: got %#v; want %#v", n.TCP, expected.TCP)
+	}
+}
+
+func TestGetErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"no values", "Tcp: ActiveOpens PassiveOpens\n"},
+		{"mismatched section", "Tcp: ActiveOpens PassiveOpens\nUdp: 1 2\n"},
+		{"too few values", "Tcp: ActiveOpens PassiveOpens\nTcp: 1\n"},
+		{"too many values", "Tcp: ActiveOpens PassiveOpens\nTcp: 1 2 3\n"},
+		{"bad value", "Tcp: ActiveOpens PassiveOpens\nTcp: 1 x\n"},
+	}
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.SNMP6 = nil
+	prof.Netstat = nil
+	for _, test := range tests {
+		proc, err := joe.NewTempFileProc("netstat", "snmp", []byte(test.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		prof.SNMP = proc
+		_, err = prof.Get()
+		if err == nil {
+			t.Errorf("%s: expected an error; got none", test.name)
+		} else if _, ok := err.(*joe.ParseError); !ok {
+			t.Errorf("%s: expected a ParseError; got %#v", test.name, err)
+		}
+		proc.Remove()
+	}
+}
+
+// Each field in Fields must be unique and all of the fields of
+// structs.Netstat, other than Timestamp, must be in Fields.
+func TestFields(t *testing.T) {
+	var n structs.Netstat
+	seen := make(map[*int64]string, len(Fields))
+	for _, f := range Fields {
+		p := f.Value(&n)
+		if name, ok := seen[p]; ok {
+			t.Errorf("%s: same field as %s", f.Name, name)
+		}
+		seen[p] = f.Name
+	}
+	var cnt int
+	v := reflect.ValueOf(n)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Kind() == reflect.Struct {
+			cnt += v.Field(i).NumField()
+		}
+	}
+	if cnt != len(Fields) {
+		t.Errorf("got %d fields; structs.Netstat has %d", len(Fields), cnt)
+	}
+}
+
+func TestGetLive(t *testing.T) {
+	n, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkNetstat("get", n, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkNetstat("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkNetstat(n string, v *structs.Netstat, t *testing.T) {
+	if v.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if v.IP.DefaultTTL == 0 {
+		t.Errorf("%s: IP.DefaultTTL: wanted non-zero value; got 0", n)
+	}
+	if v.TCP.RtoMin == 0 {
+		t.Errorf("%s: TCP.RtoMin: wanted non-zero value; got 0", n)
+	}
+}
+
+var ns *structs.Netstat
+
+func BenchmarkGet(b *testing.B) {
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ns, _ = p.Get()
+	}
+	_ = ns
+}
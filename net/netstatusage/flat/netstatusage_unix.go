@@ -0,0 +1,867 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netstatusage gets the change in the kernel's network protocol
+// counters. Usage is calculated by taking the difference between two
+// snapshots of /proc/net/snmp, /proc/net/netstat, and /proc/net/snmp6. The
+// time elapsed between the two snapshots is stored in the TimeDelta field.
+// Instead of returning a Go struct, it returns Flatbuffer serialized bytes. A
+// function to deserialize the Flatbuffer serialized bytes into a
+// structs.NetstatUsage struct is provided.
+//
+// Note: the package name is netstatusage and not the final element of the
+// import path (flat).
+package netstatusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/net/netstatusage"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/net/structs/flat"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the network protocol counter usage.
+type Profiler struct {
+	*usage.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a snapshot of
+// the counters is taken so that any Get() will return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current network protocol counter usage as Flatbuffer
+// serialized bytes. Calculating usage requires two snapshots. This func gets
+// the current snapshot of the counters and calculates the difference between
+// that and the prior snapshot. The current snapshot is stored for use as the
+// prior snapshot on the next Get call. If ongoing usage information is
+// desired, the Ticker should be used; it's better suited for ongoing usage
+// information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current network protocol counter usage as Flatbuffer
+// serialized bytes using the package's global Profiler. The profiler is
+// lazily instantiated. If the profiler doesn't already exist, the first usage
+// information will not be useful due to the minimal time elapsing between the
+// initial and second snapshots used for usage calculations; the results of
+// the first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		std.Builder.Reset()
+	}
+
+	return std.Get()
+}
+
+// Serialize serializes structs.NetstatUsage using Flatbuffers.
+func (prof *Profiler) Serialize(u *structs.NetstatUsage) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	ip := serializeIP(prof.Builder, &u.IP)
+	icmp := serializeICMP(prof.Builder, &u.ICMP)
+	tcp := serializeTCP(prof.Builder, &u.TCP)
+	udp := serializeUDP(prof.Builder, &u.UDP)
+	tcpExt := serializeTCPExt(prof.Builder, &u.TCPExt)
+	ip6 := serializeIP6(prof.Builder, &u.IP6)
+	icmp6 := serializeICMP6(prof.Builder, &u.ICMP6)
+	udp6 := serializeUDP6(prof.Builder, &u.UDP6)
+	flat.NetstatUsageStart(prof.Builder)
+	flat.NetstatUsageAddTimestamp(prof.Builder, u.Timestamp)
+	flat.NetstatUsageAddTimeDelta(prof.Builder, u.TimeDelta)
+	flat.NetstatUsageAddIP(prof.Builder, ip)
+	flat.NetstatUsageAddICMP(prof.Builder, icmp)
+	flat.NetstatUsageAddTCP(prof.Builder, tcp)
+	flat.NetstatUsageAddUDP(prof.Builder, udp)
+	flat.NetstatUsageAddTCPExt(prof.Builder, tcpExt)
+	flat.NetstatUsageAddIP6(prof.Builder, ip6)
+	flat.NetstatUsageAddICMP6(prof.Builder, icmp6)
+	flat.NetstatUsageAddUDP6(prof.Builder, udp6)
+	prof.Builder.Finish(flat.NetstatUsageEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize serializes structs.NetstatUsage using Flatbuffers with the
+// package's global Profiler.
+func Serialize(u *structs.NetstatUsage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them
+// as structs.NetstatUsage.
+func Deserialize(p []byte) *structs.NetstatUsage {
+	uF := flat.GetRootAsNetstatUsage(p, 0)
+	u := &structs.NetstatUsage{Timestamp: uF.Timestamp(), TimeDelta: uF.TimeDelta()}
+	if f := uF.IP(nil); f != nil {
+		u.IP = deserializeIP(f)
+	}
+	if f := uF.ICMP(nil); f != nil {
+		u.ICMP = deserializeICMP(f)
+	}
+	if f := uF.TCP(nil); f != nil {
+		u.TCP = deserializeTCP(f)
+	}
+	if f := uF.UDP(nil); f != nil {
+		u.UDP = deserializeUDP(f)
+	}
+	if f := uF.TCPExt(nil); f != nil {
+		u.TCPExt = deserializeTCPExt(f)
+	}
+	if f := uF.IP6(nil); f != nil {
+		u.IP6 = deserializeIP6(f)
+	}
+	if f := uF.ICMP6(nil); f != nil {
+		u.ICMP6 = deserializeICMP6(f)
+	}
+	if f := uF.UDP6(nil); f != nil {
+		u.UDP6 = deserializeUDP6(f)
+	}
+	return u
+}
+
+// serializeIP serializes structs.IP using Flatbuffers.
+func serializeIP(b *fb.Builder, v *structs.IP) fb.UOffsetT {
+	flat.IPStart(b)
+	flat.IPAddForwarding(b, v.Forwarding)
+	flat.IPAddDefaultTTL(b, v.DefaultTTL)
+	flat.IPAddInReceives(b, v.InReceives)
+	flat.IPAddInHdrErrors(b, v.InHdrErrors)
+	flat.IPAddInAddrErrors(b, v.InAddrErrors)
+	flat.IPAddForwDatagrams(b, v.ForwDatagrams)
+	flat.IPAddInUnknownProtos(b, v.InUnknownProtos)
+	flat.IPAddInDiscards(b, v.InDiscards)
+	flat.IPAddInDelivers(b, v.InDelivers)
+	flat.IPAddOutRequests(b, v.OutRequests)
+	flat.IPAddOutDiscards(b, v.OutDiscards)
+	flat.IPAddOutNoRoutes(b, v.OutNoRoutes)
+	flat.IPAddReasmTimeout(b, v.ReasmTimeout)
+	flat.IPAddReasmReqds(b, v.ReasmReqds)
+	flat.IPAddReasmOKs(b, v.ReasmOKs)
+	flat.IPAddReasmFails(b, v.ReasmFails)
+	flat.IPAddFragOKs(b, v.FragOKs)
+	flat.IPAddFragFails(b, v.FragFails)
+	flat.IPAddFragCreates(b, v.FragCreates)
+	flat.IPAddOutTransmits(b, v.OutTransmits)
+	return flat.IPEnd(b)
+}
+
+// serializeICMP serializes structs.ICMP using Flatbuffers.
+func serializeICMP(b *fb.Builder, v *structs.ICMP) fb.UOffsetT {
+	flat.ICMPStart(b)
+	flat.ICMPAddInMsgs(b, v.InMsgs)
+	flat.ICMPAddInErrors(b, v.InErrors)
+	flat.ICMPAddInCsumErrors(b, v.InCsumErrors)
+	flat.ICMPAddInDestUnreachs(b, v.InDestUnreachs)
+	flat.ICMPAddInTimeExcds(b, v.InTimeExcds)
+	flat.ICMPAddInParmProbs(b, v.InParmProbs)
+	flat.ICMPAddInSrcQuenchs(b, v.InSrcQuenchs)
+	flat.ICMPAddInRedirects(b, v.InRedirects)
+	flat.ICMPAddInEchos(b, v.InEchos)
+	flat.ICMPAddInEchoReps(b, v.InEchoReps)
+	flat.ICMPAddInTimestamps(b, v.InTimestamps)
+	flat.ICMPAddInTimestampReps(b, v.InTimestampReps)
+	flat.ICMPAddInAddrMasks(b, v.InAddrMasks)
+	flat.ICMPAddInAddrMaskReps(b, v.InAddrMaskReps)
+	flat.ICMPAddOutMsgs(b, v.OutMsgs)
+	flat.ICMPAddOutErrors(b, v.OutErrors)
+	flat.ICMPAddOutRateLimitGlobal(b, v.OutRateLimitGlobal)
+	flat.ICMPAddOutRateLimitHost(b, v.OutRateLimitHost)
+	flat.ICMPAddOutDestUnreachs(b, v.OutDestUnreachs)
+	flat.ICMPAddOutTimeExcds(b, v.OutTimeExcds)
+	flat.ICMPAddOutParmProbs(b, v.OutParmProbs)
+	flat.ICMPAddOutSrcQuenchs(b, v.OutSrcQuenchs)
+	flat.ICMPAddOutRedirects(b, v.OutRedirects)
+	flat.ICMPAddOutEchos(b, v.OutEchos)
+	flat.ICMPAddOutEchoReps(b, v.OutEchoReps)
+	flat.ICMPAddOutTimestamps(b, v.OutTimestamps)
+	flat.ICMPAddOutTimestampReps(b, v.OutTimestampReps)
+	flat.ICMPAddOutAddrMasks(b, v.OutAddrMasks)
+	flat.ICMPAddOutAddrMaskReps(b, v.OutAddrMaskReps)
+	return flat.ICMPEnd(b)
+}
+
+// serializeTCP serializes structs.TCP using Flatbuffers.
+func serializeTCP(b *fb.Builder, v *structs.TCP) fb.UOffsetT {
+	flat.TCPStart(b)
+	flat.TCPAddRtoAlgorithm(b, v.RtoAlgorithm)
+	flat.TCPAddRtoMin(b, v.RtoMin)
+	flat.TCPAddRtoMax(b, v.RtoMax)
+	flat.TCPAddMaxConn(b, v.MaxConn)
+	flat.TCPAddActiveOpens(b, v.ActiveOpens)
+	flat.TCPAddPassiveOpens(b, v.PassiveOpens)
+	flat.TCPAddAttemptFails(b, v.AttemptFails)
+	flat.TCPAddEstabResets(b, v.EstabResets)
+	flat.TCPAddCurrEstab(b, v.CurrEstab)
+	flat.TCPAddInSegs(b, v.InSegs)
+	flat.TCPAddOutSegs(b, v.OutSegs)
+	flat.TCPAddRetransSegs(b, v.RetransSegs)
+	flat.TCPAddInErrs(b, v.InErrs)
+	flat.TCPAddOutRsts(b, v.OutRsts)
+	flat.TCPAddInCsumErrors(b, v.InCsumErrors)
+	return flat.TCPEnd(b)
+}
+
+// serializeUDP serializes structs.UDP using Flatbuffers.
+func serializeUDP(b *fb.Builder, v *structs.UDP) fb.UOffsetT {
+	flat.UDPStart(b)
+	flat.UDPAddInDatagrams(b, v.InDatagrams)
+	flat.UDPAddNoPorts(b, v.NoPorts)
+	flat.UDPAddInErrors(b, v.InErrors)
+	flat.UDPAddOutDatagrams(b, v.OutDatagrams)
+	flat.UDPAddRcvbufErrors(b, v.RcvbufErrors)
+	flat.UDPAddSndbufErrors(b, v.SndbufErrors)
+	flat.UDPAddInCsumErrors(b, v.InCsumErrors)
+	flat.UDPAddIgnoredMulti(b, v.IgnoredMulti)
+	flat.UDPAddMemErrors(b, v.MemErrors)
+	return flat.UDPEnd(b)
+}
+
+// serializeTCPExt serializes structs.TCPExt using Flatbuffers.
+func serializeTCPExt(b *fb.Builder, v *structs.TCPExt) fb.UOffsetT {
+	flat.TCPExtStart(b)
+	flat.TCPExtAddSyncookiesSent(b, v.SyncookiesSent)
+	flat.TCPExtAddSyncookiesRecv(b, v.SyncookiesRecv)
+	flat.TCPExtAddSyncookiesFailed(b, v.SyncookiesFailed)
+	flat.TCPExtAddEmbryonicRsts(b, v.EmbryonicRsts)
+	flat.TCPExtAddPruneCalled(b, v.PruneCalled)
+	flat.TCPExtAddRcvPruned(b, v.RcvPruned)
+	flat.TCPExtAddOfoPruned(b, v.OfoPruned)
+	flat.TCPExtAddOutOfWindowIcmps(b, v.OutOfWindowIcmps)
+	flat.TCPExtAddLockDroppedIcmps(b, v.LockDroppedIcmps)
+	flat.TCPExtAddArpFilter(b, v.ArpFilter)
+	flat.TCPExtAddTW(b, v.TW)
+	flat.TCPExtAddTWRecycled(b, v.TWRecycled)
+	flat.TCPExtAddTWKilled(b, v.TWKilled)
+	flat.TCPExtAddPAWSActive(b, v.PAWSActive)
+	flat.TCPExtAddPAWSEstab(b, v.PAWSEstab)
+	flat.TCPExtAddBeyondWindow(b, v.BeyondWindow)
+	flat.TCPExtAddTSEcrRejected(b, v.TSEcrRejected)
+	flat.TCPExtAddPAWSOldAck(b, v.PAWSOldAck)
+	flat.TCPExtAddPAWSTimewait(b, v.PAWSTimewait)
+	flat.TCPExtAddDelayedACKs(b, v.DelayedACKs)
+	flat.TCPExtAddDelayedACKLocked(b, v.DelayedACKLocked)
+	flat.TCPExtAddDelayedACKLost(b, v.DelayedACKLost)
+	flat.TCPExtAddListenOverflows(b, v.ListenOverflows)
+	flat.TCPExtAddListenDrops(b, v.ListenDrops)
+	flat.TCPExtAddTCPHPHits(b, v.TCPHPHits)
+	flat.TCPExtAddTCPPureAcks(b, v.TCPPureAcks)
+	flat.TCPExtAddTCPHPAcks(b, v.TCPHPAcks)
+	flat.TCPExtAddTCPRenoRecovery(b, v.TCPRenoRecovery)
+	flat.TCPExtAddTCPSackRecovery(b, v.TCPSackRecovery)
+	flat.TCPExtAddTCPSACKReneging(b, v.TCPSACKReneging)
+	flat.TCPExtAddTCPSACKReorder(b, v.TCPSACKReorder)
+	flat.TCPExtAddTCPRenoReorder(b, v.TCPRenoReorder)
+	flat.TCPExtAddTCPTSReorder(b, v.TCPTSReorder)
+	flat.TCPExtAddTCPFullUndo(b, v.TCPFullUndo)
+	flat.TCPExtAddTCPPartialUndo(b, v.TCPPartialUndo)
+	flat.TCPExtAddTCPDSACKUndo(b, v.TCPDSACKUndo)
+	flat.TCPExtAddTCPLossUndo(b, v.TCPLossUndo)
+	flat.TCPExtAddTCPLostRetransmit(b, v.TCPLostRetransmit)
+	flat.TCPExtAddTCPRenoFailures(b, v.TCPRenoFailures)
+	flat.TCPExtAddTCPSackFailures(b, v.TCPSackFailures)
+	flat.TCPExtAddTCPLossFailures(b, v.TCPLossFailures)
+	flat.TCPExtAddTCPFastRetrans(b, v.TCPFastRetrans)
+	flat.TCPExtAddTCPSlowStartRetrans(b, v.TCPSlowStartRetrans)
+	flat.TCPExtAddTCPTimeouts(b, v.TCPTimeouts)
+	flat.TCPExtAddTCPLossProbes(b, v.TCPLossProbes)
+	flat.TCPExtAddTCPLossProbeRecovery(b, v.TCPLossProbeRecovery)
+	flat.TCPExtAddTCPRenoRecoveryFail(b, v.TCPRenoRecoveryFail)
+	flat.TCPExtAddTCPSackRecoveryFail(b, v.TCPSackRecoveryFail)
+	flat.TCPExtAddTCPRcvCollapsed(b, v.TCPRcvCollapsed)
+	flat.TCPExtAddTCPBacklogCoalesce(b, v.TCPBacklogCoalesce)
+	flat.TCPExtAddTCPDSACKOldSent(b, v.TCPDSACKOldSent)
+	flat.TCPExtAddTCPDSACKOfoSent(b, v.TCPDSACKOfoSent)
+	flat.TCPExtAddTCPDSACKRecv(b, v.TCPDSACKRecv)
+	flat.TCPExtAddTCPDSACKOfoRecv(b, v.TCPDSACKOfoRecv)
+	flat.TCPExtAddTCPAbortOnData(b, v.TCPAbortOnData)
+	flat.TCPExtAddTCPAbortOnClose(b, v.TCPAbortOnClose)
+	flat.TCPExtAddTCPAbortOnMemory(b, v.TCPAbortOnMemory)
+	flat.TCPExtAddTCPAbortOnTimeout(b, v.TCPAbortOnTimeout)
+	flat.TCPExtAddTCPAbortOnLinger(b, v.TCPAbortOnLinger)
+	flat.TCPExtAddTCPAbortFailed(b, v.TCPAbortFailed)
+	flat.TCPExtAddTCPMemoryPressures(b, v.TCPMemoryPressures)
+	flat.TCPExtAddTCPMemoryPressuresChrono(b, v.TCPMemoryPressuresChrono)
+	flat.TCPExtAddTCPSACKDiscard(b, v.TCPSACKDiscard)
+	flat.TCPExtAddTCPDSACKIgnoredOld(b, v.TCPDSACKIgnoredOld)
+	flat.TCPExtAddTCPDSACKIgnoredNoUndo(b, v.TCPDSACKIgnoredNoUndo)
+	flat.TCPExtAddTCPSpuriousRTOs(b, v.TCPSpuriousRTOs)
+	flat.TCPExtAddTCPMD5NotFound(b, v.TCPMD5NotFound)
+	flat.TCPExtAddTCPMD5Unexpected(b, v.TCPMD5Unexpected)
+	flat.TCPExtAddTCPMD5Failure(b, v.TCPMD5Failure)
+	flat.TCPExtAddTCPSackShifted(b, v.TCPSackShifted)
+	flat.TCPExtAddTCPSackMerged(b, v.TCPSackMerged)
+	flat.TCPExtAddTCPSackShiftFallback(b, v.TCPSackShiftFallback)
+	flat.TCPExtAddTCPBacklogDrop(b, v.TCPBacklogDrop)
+	flat.TCPExtAddPFMemallocDrop(b, v.PFMemallocDrop)
+	flat.TCPExtAddTCPMinTTLDrop(b, v.TCPMinTTLDrop)
+	flat.TCPExtAddTCPDeferAcceptDrop(b, v.TCPDeferAcceptDrop)
+	flat.TCPExtAddIPReversePathFilter(b, v.IPReversePathFilter)
+	flat.TCPExtAddTCPTimeWaitOverflow(b, v.TCPTimeWaitOverflow)
+	flat.TCPExtAddTCPReqQFullDoCookies(b, v.TCPReqQFullDoCookies)
+	flat.TCPExtAddTCPReqQFullDrop(b, v.TCPReqQFullDrop)
+	flat.TCPExtAddTCPRetransFail(b, v.TCPRetransFail)
+	flat.TCPExtAddTCPRcvCoalesce(b, v.TCPRcvCoalesce)
+	flat.TCPExtAddTCPOFOQueue(b, v.TCPOFOQueue)
+	flat.TCPExtAddTCPOFODrop(b, v.TCPOFODrop)
+	flat.TCPExtAddTCPOFOMerge(b, v.TCPOFOMerge)
+	flat.TCPExtAddTCPChallengeACK(b, v.TCPChallengeACK)
+	flat.TCPExtAddTCPSYNChallenge(b, v.TCPSYNChallenge)
+	flat.TCPExtAddTCPFastOpenActive(b, v.TCPFastOpenActive)
+	flat.TCPExtAddTCPFastOpenActiveFail(b, v.TCPFastOpenActiveFail)
+	flat.TCPExtAddTCPFastOpenPassive(b, v.TCPFastOpenPassive)
+	flat.TCPExtAddTCPFastOpenPassiveFail(b, v.TCPFastOpenPassiveFail)
+	flat.TCPExtAddTCPFastOpenListenOverflow(b, v.TCPFastOpenListenOverflow)
+	flat.TCPExtAddTCPFastOpenCookieReqd(b, v.TCPFastOpenCookieReqd)
+	flat.TCPExtAddTCPFastOpenBlackhole(b, v.TCPFastOpenBlackhole)
+	flat.TCPExtAddTCPSpuriousRtxHostQueues(b, v.TCPSpuriousRtxHostQueues)
+	flat.TCPExtAddBusyPollRxPackets(b, v.BusyPollRxPackets)
+	flat.TCPExtAddTCPAutoCorking(b, v.TCPAutoCorking)
+	flat.TCPExtAddTCPFromZeroWindowAdv(b, v.TCPFromZeroWindowAdv)
+	flat.TCPExtAddTCPToZeroWindowAdv(b, v.TCPToZeroWindowAdv)
+	flat.TCPExtAddTCPWantZeroWindowAdv(b, v.TCPWantZeroWindowAdv)
+	flat.TCPExtAddTCPSynRetrans(b, v.TCPSynRetrans)
+	flat.TCPExtAddTCPOrigDataSent(b, v.TCPOrigDataSent)
+	flat.TCPExtAddTCPHystartTrainDetect(b, v.TCPHystartTrainDetect)
+	flat.TCPExtAddTCPHystartTrainCwnd(b, v.TCPHystartTrainCwnd)
+	flat.TCPExtAddTCPHystartDelayDetect(b, v.TCPHystartDelayDetect)
+	flat.TCPExtAddTCPHystartDelayCwnd(b, v.TCPHystartDelayCwnd)
+	flat.TCPExtAddTCPACKSkippedSynRecv(b, v.TCPACKSkippedSynRecv)
+	flat.TCPExtAddTCPACKSkippedPAWS(b, v.TCPACKSkippedPAWS)
+	flat.TCPExtAddTCPACKSkippedSeq(b, v.TCPACKSkippedSeq)
+	flat.TCPExtAddTCPACKSkippedFinWait2(b, v.TCPACKSkippedFinWait2)
+	flat.TCPExtAddTCPACKSkippedTimeWait(b, v.TCPACKSkippedTimeWait)
+	flat.TCPExtAddTCPACKSkippedChallenge(b, v.TCPACKSkippedChallenge)
+	flat.TCPExtAddTCPWinProbe(b, v.TCPWinProbe)
+	flat.TCPExtAddTCPKeepAlive(b, v.TCPKeepAlive)
+	flat.TCPExtAddTCPMTUPFail(b, v.TCPMTUPFail)
+	flat.TCPExtAddTCPMTUPSuccess(b, v.TCPMTUPSuccess)
+	flat.TCPExtAddTCPDelivered(b, v.TCPDelivered)
+	flat.TCPExtAddTCPDeliveredCE(b, v.TCPDeliveredCE)
+	flat.TCPExtAddTCPAckCompressed(b, v.TCPAckCompressed)
+	flat.TCPExtAddTCPZeroWindowDrop(b, v.TCPZeroWindowDrop)
+	flat.TCPExtAddTCPRcvQDrop(b, v.TCPRcvQDrop)
+	flat.TCPExtAddTCPWqueueTooBig(b, v.TCPWqueueTooBig)
+	flat.TCPExtAddTCPFastOpenPassiveAltKey(b, v.TCPFastOpenPassiveAltKey)
+	flat.TCPExtAddTcpTimeoutRehash(b, v.TcpTimeoutRehash)
+	flat.TCPExtAddTcpDuplicateDataRehash(b, v.TcpDuplicateDataRehash)
+	flat.TCPExtAddTCPDSACKRecvSegs(b, v.TCPDSACKRecvSegs)
+	flat.TCPExtAddTCPDSACKIgnoredDubious(b, v.TCPDSACKIgnoredDubious)
+	flat.TCPExtAddTCPMigrateReqSuccess(b, v.TCPMigrateReqSuccess)
+	flat.TCPExtAddTCPMigrateReqFailure(b, v.TCPMigrateReqFailure)
+	flat.TCPExtAddTCPPLBRehash(b, v.TCPPLBRehash)
+	flat.TCPExtAddTCPAORequired(b, v.TCPAORequired)
+	flat.TCPExtAddTCPAOBad(b, v.TCPAOBad)
+	flat.TCPExtAddTCPAOKeyNotFound(b, v.TCPAOKeyNotFound)
+	flat.TCPExtAddTCPAOGood(b, v.TCPAOGood)
+	flat.TCPExtAddTCPAODroppedIcmps(b, v.TCPAODroppedIcmps)
+	return flat.TCPExtEnd(b)
+}
+
+// serializeIP6 serializes structs.IP6 using Flatbuffers.
+func serializeIP6(b *fb.Builder, v *structs.IP6) fb.UOffsetT {
+	flat.IP6Start(b)
+	flat.IP6AddInReceives(b, v.InReceives)
+	flat.IP6AddInHdrErrors(b, v.InHdrErrors)
+	flat.IP6AddInTooBigErrors(b, v.InTooBigErrors)
+	flat.IP6AddInNoRoutes(b, v.InNoRoutes)
+	flat.IP6AddInAddrErrors(b, v.InAddrErrors)
+	flat.IP6AddInUnknownProtos(b, v.InUnknownProtos)
+	flat.IP6AddInTruncatedPkts(b, v.InTruncatedPkts)
+	flat.IP6AddInDiscards(b, v.InDiscards)
+	flat.IP6AddInDelivers(b, v.InDelivers)
+	flat.IP6AddOutForwDatagrams(b, v.OutForwDatagrams)
+	flat.IP6AddOutRequests(b, v.OutRequests)
+	flat.IP6AddOutDiscards(b, v.OutDiscards)
+	flat.IP6AddOutNoRoutes(b, v.OutNoRoutes)
+	flat.IP6AddReasmTimeout(b, v.ReasmTimeout)
+	flat.IP6AddReasmReqds(b, v.ReasmReqds)
+	flat.IP6AddReasmOKs(b, v.ReasmOKs)
+	flat.IP6AddReasmFails(b, v.ReasmFails)
+	flat.IP6AddFragOKs(b, v.FragOKs)
+	flat.IP6AddFragFails(b, v.FragFails)
+	flat.IP6AddFragCreates(b, v.FragCreates)
+	flat.IP6AddInMcastPkts(b, v.InMcastPkts)
+	flat.IP6AddOutMcastPkts(b, v.OutMcastPkts)
+	flat.IP6AddInOctets(b, v.InOctets)
+	flat.IP6AddOutOctets(b, v.OutOctets)
+	flat.IP6AddInMcastOctets(b, v.InMcastOctets)
+	flat.IP6AddOutMcastOctets(b, v.OutMcastOctets)
+	flat.IP6AddInBcastOctets(b, v.InBcastOctets)
+	flat.IP6AddOutBcastOctets(b, v.OutBcastOctets)
+	flat.IP6AddInNoECTPkts(b, v.InNoECTPkts)
+	flat.IP6AddInECT1Pkts(b, v.InECT1Pkts)
+	flat.IP6AddInECT0Pkts(b, v.InECT0Pkts)
+	flat.IP6AddInCEPkts(b, v.InCEPkts)
+	flat.IP6AddOutTransmits(b, v.OutTransmits)
+	return flat.IP6End(b)
+}
+
+// serializeICMP6 serializes structs.ICMP6 using Flatbuffers.
+func serializeICMP6(b *fb.Builder, v *structs.ICMP6) fb.UOffsetT {
+	flat.ICMP6Start(b)
+	flat.ICMP6AddInMsgs(b, v.InMsgs)
+	flat.ICMP6AddInErrors(b, v.InErrors)
+	flat.ICMP6AddOutMsgs(b, v.OutMsgs)
+	flat.ICMP6AddOutErrors(b, v.OutErrors)
+	flat.ICMP6AddInCsumErrors(b, v.InCsumErrors)
+	flat.ICMP6AddOutRateLimitHost(b, v.OutRateLimitHost)
+	flat.ICMP6AddInDestUnreachs(b, v.InDestUnreachs)
+	flat.ICMP6AddInPktTooBigs(b, v.InPktTooBigs)
+	flat.ICMP6AddInTimeExcds(b, v.InTimeExcds)
+	flat.ICMP6AddInParmProblems(b, v.InParmProblems)
+	flat.ICMP6AddInEchos(b, v.InEchos)
+	flat.ICMP6AddInEchoReplies(b, v.InEchoReplies)
+	flat.ICMP6AddInGroupMembQueries(b, v.InGroupMembQueries)
+	flat.ICMP6AddInGroupMembResponses(b, v.InGroupMembResponses)
+	flat.ICMP6AddInGroupMembReductions(b, v.InGroupMembReductions)
+	flat.ICMP6AddInRouterSolicits(b, v.InRouterSolicits)
+	flat.ICMP6AddInRouterAdvertisements(b, v.InRouterAdvertisements)
+	flat.ICMP6AddInNeighborSolicits(b, v.InNeighborSolicits)
+	flat.ICMP6AddInNeighborAdvertisements(b, v.InNeighborAdvertisements)
+	flat.ICMP6AddInRedirects(b, v.InRedirects)
+	flat.ICMP6AddInMLDv2Reports(b, v.InMLDv2Reports)
+	flat.ICMP6AddOutDestUnreachs(b, v.OutDestUnreachs)
+	flat.ICMP6AddOutPktTooBigs(b, v.OutPktTooBigs)
+	flat.ICMP6AddOutTimeExcds(b, v.OutTimeExcds)
+	flat.ICMP6AddOutParmProblems(b, v.OutParmProblems)
+	flat.ICMP6AddOutEchos(b, v.OutEchos)
+	flat.ICMP6AddOutEchoReplies(b, v.OutEchoReplies)
+	flat.ICMP6AddOutGroupMembQueries(b, v.OutGroupMembQueries)
+	flat.ICMP6AddOutGroupMembResponses(b, v.OutGroupMembResponses)
+	flat.ICMP6AddOutGroupMembReductions(b, v.OutGroupMembReductions)
+	flat.ICMP6AddOutRouterSolicits(b, v.OutRouterSolicits)
+	flat.ICMP6AddOutRouterAdvertisements(b, v.OutRouterAdvertisements)
+	flat.ICMP6AddOutNeighborSolicits(b, v.OutNeighborSolicits)
+	flat.ICMP6AddOutNeighborAdvertisements(b, v.OutNeighborAdvertisements)
+	flat.ICMP6AddOutRedirects(b, v.OutRedirects)
+	flat.ICMP6AddOutMLDv2Reports(b, v.OutMLDv2Reports)
+	return flat.ICMP6End(b)
+}
+
+// serializeUDP6 serializes structs.UDP6 using Flatbuffers.
+func serializeUDP6(b *fb.Builder, v *structs.UDP6) fb.UOffsetT {
+	flat.UDP6Start(b)
+	flat.UDP6AddInDatagrams(b, v.InDatagrams)
+	flat.UDP6AddNoPorts(b, v.NoPorts)
+	flat.UDP6AddInErrors(b, v.InErrors)
+	flat.UDP6AddOutDatagrams(b, v.OutDatagrams)
+	flat.UDP6AddRcvbufErrors(b, v.RcvbufErrors)
+	flat.UDP6AddSndbufErrors(b, v.SndbufErrors)
+	flat.UDP6AddInCsumErrors(b, v.InCsumErrors)
+	flat.UDP6AddIgnoredMulti(b, v.IgnoredMulti)
+	flat.UDP6AddMemErrors(b, v.MemErrors)
+	return flat.UDP6End(b)
+}
+
+// deserializeIP deserializes a flat.IP as structs.IP.
+func deserializeIP(f *flat.IP) structs.IP {
+	return structs.IP{
+		Forwarding:      f.Forwarding(),
+		DefaultTTL:      f.DefaultTTL(),
+		InReceives:      f.InReceives(),
+		InHdrErrors:     f.InHdrErrors(),
+		InAddrErrors:    f.InAddrErrors(),
+		ForwDatagrams:   f.ForwDatagrams(),
+		InUnknownProtos: f.InUnknownProtos(),
+		InDiscards:      f.InDiscards(),
+		InDelivers:      f.InDelivers(),
+		OutRequests:     f.OutRequests(),
+		OutDiscards:     f.OutDiscards(),
+		OutNoRoutes:     f.OutNoRoutes(),
+		ReasmTimeout:    f.ReasmTimeout(),
+		ReasmReqds:      f.ReasmReqds(),
+		ReasmOKs:        f.ReasmOKs(),
+		ReasmFails:      f.ReasmFails(),
+		FragOKs:         f.FragOKs(),
+		FragFails:       f.FragFails(),
+		FragCreates:     f.FragCreates(),
+		OutTransmits:    f.OutTransmits(),
+	}
+}
+
+// deserializeICMP deserializes a flat.ICMP as structs.ICMP.
+func deserializeICMP(f *flat.ICMP) structs.ICMP {
+	return structs.ICMP{
+		InMsgs:             f.InMsgs(),
+		InErrors:           f.InErrors(),
+		InCsumErrors:       f.InCsumErrors(),
+		InDestUnreachs:     f.InDestUnreachs(),
+		InTimeExcds:        f.InTimeExcds(),
+		InParmProbs:        f.InParmProbs(),
+		InSrcQuenchs:       f.InSrcQuenchs(),
+		InRedirects:        f.InRedirects(),
+		InEchos:            f.InEchos(),
+		InEchoReps:         f.InEchoReps(),
+		InTimestamps:       f.InTimestamps(),
+		InTimestampReps:    f.InTimestampReps(),
+		InAddrMasks:        f.InAddrMasks(),
+		InAddrMaskReps:     f.InAddrMaskReps(),
+		OutMsgs:            f.OutMsgs(),
+		OutErrors:          f.OutErrors(),
+		OutRateLimitGlobal: f.OutRateLimitGlobal(),
+		OutRateLimitHost:   f.OutRateLimitHost(),
+		OutDestUnreachs:    f.OutDestUnreachs(),
+		OutTimeExcds:       f.OutTimeExcds(),
+		OutParmProbs:       f.OutParmProbs(),
+		OutSrcQuenchs:      f.OutSrcQuenchs(),
+		OutRedirects:       f.OutRedirects(),
+		OutEchos:           f.OutEchos(),
+		OutEchoReps:        f.OutEchoReps(),
+		OutTimestamps:      f.OutTimestamps(),
+		OutTimestampReps:   f.OutTimestampReps(),
+		OutAddrMasks:       f.OutAddrMasks(),
+		OutAddrMaskReps:    f.OutAddrMaskReps(),
+	}
+}
+
+// deserializeTCP deserializes a flat.TCP as structs.TCP.
+func deserializeTCP(f *flat.TCP) structs.TCP {
+	return structs.TCP{
+		RtoAlgorithm: f.RtoAlgorithm(),
+		RtoMin:       f.RtoMin(),
+		RtoMax:       f.RtoMax(),
+		MaxConn:      f.MaxConn(),
+		ActiveOpens:  f.ActiveOpens(),
+		PassiveOpens: f.PassiveOpens(),
+		AttemptFails: f.AttemptFails(),
+		EstabResets:  f.EstabResets(),
+		CurrEstab:    f.CurrEstab(),
+		InSegs:       f.InSegs(),
+		OutSegs:      f.OutSegs(),
+		RetransSegs:  f.RetransSegs(),
+		InErrs:       f.InErrs(),
+		OutRsts:      f.OutRsts(),
+		InCsumErrors: f.InCsumErrors(),
+	}
+}
+
+// deserializeUDP deserializes a flat.UDP as structs.UDP.
+func deserializeUDP(f *flat.UDP) structs.UDP {
+	return structs.UDP{
+		InDatagrams:  f.InDatagrams(),
+		NoPorts:      f.NoPorts(),
+		InErrors:     f.InErrors(),
+		OutDatagrams: f.OutDatagrams(),
+		RcvbufErrors: f.RcvbufErrors(),
+		SndbufErrors: f.SndbufErrors(),
+		InCsumErrors: f.InCsumErrors(),
+		IgnoredMulti: f.IgnoredMulti(),
+		MemErrors:    f.MemErrors(),
+	}
+}
+
+// deserializeTCPExt deserializes a flat.TCPExt as structs.TCPExt.
+func deserializeTCPExt(f *flat.TCPExt) structs.TCPExt {
+	return structs.TCPExt{
+		SyncookiesSent:            f.SyncookiesSent(),
+		SyncookiesRecv:            f.SyncookiesRecv(),
+		SyncookiesFailed:          f.SyncookiesFailed(),
+		EmbryonicRsts:             f.EmbryonicRsts(),
+		PruneCalled:               f.PruneCalled(),
+		RcvPruned:                 f.RcvPruned(),
+		OfoPruned:                 f.OfoPruned(),
+		OutOfWindowIcmps:          f.OutOfWindowIcmps(),
+		LockDroppedIcmps:          f.LockDroppedIcmps(),
+		ArpFilter:                 f.ArpFilter(),
+		TW:                        f.TW(),
+		TWRecycled:                f.TWRecycled(),
+		TWKilled:                  f.TWKilled(),
+		PAWSActive:                f.PAWSActive(),
+		PAWSEstab:                 f.PAWSEstab(),
+		BeyondWindow:              f.BeyondWindow(),
+		TSEcrRejected:             f.TSEcrRejected(),
+		PAWSOldAck:                f.PAWSOldAck(),
+		PAWSTimewait:              f.PAWSTimewait(),
+		DelayedACKs:               f.DelayedACKs(),
+		DelayedACKLocked:          f.DelayedACKLocked(),
+		DelayedACKLost:            f.DelayedACKLost(),
+		ListenOverflows:           f.ListenOverflows(),
+		ListenDrops:               f.ListenDrops(),
+		TCPHPHits:                 f.TCPHPHits(),
+		TCPPureAcks:               f.TCPPureAcks(),
+		TCPHPAcks:                 f.TCPHPAcks(),
+		TCPRenoRecovery:           f.TCPRenoRecovery(),
+		TCPSackRecovery:           f.TCPSackRecovery(),
+		TCPSACKReneging:           f.TCPSACKReneging(),
+		TCPSACKReorder:            f.TCPSACKReorder(),
+		TCPRenoReorder:            f.TCPRenoReorder(),
+		TCPTSReorder:              f.TCPTSReorder(),
+		TCPFullUndo:               f.TCPFullUndo(),
+		TCPPartialUndo:            f.TCPPartialUndo(),
+		TCPDSACKUndo:              f.TCPDSACKUndo(),
+		TCPLossUndo:               f.TCPLossUndo(),
+		TCPLostRetransmit:         f.TCPLostRetransmit(),
+		TCPRenoFailures:           f.TCPRenoFailures(),
+		TCPSackFailures:           f.TCPSackFailures(),
+		TCPLossFailures:           f.TCPLossFailures(),
+		TCPFastRetrans:            f.TCPFastRetrans(),
+		TCPSlowStartRetrans:       f.TCPSlowStartRetrans(),
+		TCPTimeouts:               f.TCPTimeouts(),
+		TCPLossProbes:             f.TCPLossProbes(),
+		TCPLossProbeRecovery:      f.TCPLossProbeRecovery(),
+		TCPRenoRecoveryFail:       f.TCPRenoRecoveryFail(),
+		TCPSackRecoveryFail:       f.TCPSackRecoveryFail(),
+		TCPRcvCollapsed:           f.TCPRcvCollapsed(),
+		TCPBacklogCoalesce:        f.TCPBacklogCoalesce(),
+		TCPDSACKOldSent:           f.TCPDSACKOldSent(),
+		TCPDSACKOfoSent:           f.TCPDSACKOfoSent(),
+		TCPDSACKRecv:              f.TCPDSACKRecv(),
+		TCPDSACKOfoRecv:           f.TCPDSACKOfoRecv(),
+		TCPAbortOnData:            f.TCPAbortOnData(),
+		TCPAbortOnClose:           f.TCPAbortOnClose(),
+		TCPAbortOnMemory:          f.TCPAbortOnMemory(),
+		TCPAbortOnTimeout:         f.TCPAbortOnTimeout(),
+		TCPAbortOnLinger:          f.TCPAbortOnLinger(),
+		TCPAbortFailed:            f.TCPAbortFailed(),
+		TCPMemoryPressures:        f.TCPMemoryPressures(),
+		TCPMemoryPressuresChrono:  f.TCPMemoryPressuresChrono(),
+		TCPSACKDiscard:            f.TCPSACKDiscard(),
+		TCPDSACKIgnoredOld:        f.TCPDSACKIgnoredOld(),
+		TCPDSACKIgnoredNoUndo:     f.TCPDSACKIgnoredNoUndo(),
+		TCPSpuriousRTOs:           f.TCPSpuriousRTOs(),
+		TCPMD5NotFound:            f.TCPMD5NotFound(),
+		TCPMD5Unexpected:          f.TCPMD5Unexpected(),
+		TCPMD5Failure:             f.TCPMD5Failure(),
+		TCPSackShifted:            f.TCPSackShifted(),
+		TCPSackMerged:             f.TCPSackMerged(),
+		TCPSackShiftFallback:      f.TCPSackShiftFallback(),
+		TCPBacklogDrop:            f.TCPBacklogDrop(),
+		PFMemallocDrop:            f.PFMemallocDrop(),
+		TCPMinTTLDrop:             f.TCPMinTTLDrop(),
+		TCPDeferAcceptDrop:        f.TCPDeferAcceptDrop(),
+		IPReversePathFilter:       f.IPReversePathFilter(),
+		TCPTimeWaitOverflow:       f.TCPTimeWaitOverflow(),
+		TCPReqQFullDoCookies:      f.TCPReqQFullDoCookies(),
+		TCPReqQFullDrop:           f.TCPReqQFullDrop(),
+		TCPRetransFail:            f.TCPRetransFail(),
+		TCPRcvCoalesce:            f.TCPRcvCoalesce(),
+		TCPOFOQueue:               f.TCPOFOQueue(),
+		TCPOFODrop:                f.TCPOFODrop(),
+		TCPOFOMerge:               f.TCPOFOMerge(),
+		TCPChallengeACK:           f.TCPChallengeACK(),
+		TCPSYNChallenge:           f.TCPSYNChallenge(),
+		TCPFastOpenActive:         f.TCPFastOpenActive(),
+		TCPFastOpenActiveFail:     f.TCPFastOpenActiveFail(),
+		TCPFastOpenPassive:        f.TCPFastOpenPassive(),
+		TCPFastOpenPassiveFail:    f.TCPFastOpenPassiveFail(),
+		TCPFastOpenListenOverflow: f.TCPFastOpenListenOverflow(),
+		TCPFastOpenCookieReqd:     f.TCPFastOpenCookieReqd(),
+		TCPFastOpenBlackhole:      f.TCPFastOpenBlackhole(),
+		TCPSpuriousRtxHostQueues:  f.TCPSpuriousRtxHostQueues(),
+		BusyPollRxPackets:         f.BusyPollRxPackets(),
+		TCPAutoCorking:            f.TCPAutoCorking(),
+		TCPFromZeroWindowAdv:      f.TCPFromZeroWindowAdv(),
+		TCPToZeroWindowAdv:        f.TCPToZeroWindowAdv(),
+		TCPWantZeroWindowAdv:      f.TCPWantZeroWindowAdv(),
+		TCPSynRetrans:             f.TCPSynRetrans(),
+		TCPOrigDataSent:           f.TCPOrigDataSent(),
+		TCPHystartTrainDetect:     f.TCPHystartTrainDetect(),
+		TCPHystartTrainCwnd:       f.TCPHystartTrainCwnd(),
+		TCPHystartDelayDetect:     f.TCPHystartDelayDetect(),
+		TCPHystartDelayCwnd:       f.TCPHystartDelayCwnd(),
+		TCPACKSkippedSynRecv:      f.TCPACKSkippedSynRecv(),
+		TCPACKSkippedPAWS:         f.TCPACKSkippedPAWS(),
+		TCPACKSkippedSeq:          f.TCPACKSkippedSeq(),
+		TCPACKSkippedFinWait2:     f.TCPACKSkippedFinWait2(),
+		TCPACKSkippedTimeWait:     f.TCPACKSkippedTimeWait(),
+		TCPACKSkippedChallenge:    f.TCPACKSkippedChallenge(),
+		TCPWinProbe:               f.TCPWinProbe(),
+		TCPKeepAlive:              f.TCPKeepAlive(),
+		TCPMTUPFail:               f.TCPMTUPFail(),
+		TCPMTUPSuccess:            f.TCPMTUPSuccess(),
+		TCPDelivered:              f.TCPDelivered(),
+		TCPDeliveredCE:            f.TCPDeliveredCE(),
+		TCPAckCompressed:          f.TCPAckCompressed(),
+		TCPZeroWindowDrop:         f.TCPZeroWindowDrop(),
+		TCPRcvQDrop:               f.TCPRcvQDrop(),
+		TCPWqueueTooBig:           f.TCPWqueueTooBig(),
+		TCPFastOpenPassiveAltKey:  f.TCPFastOpenPassiveAltKey(),
+		TcpTimeoutRehash:          f.TcpTimeoutRehash(),
+		TcpDuplicateDataRehash:    f.TcpDuplicateDataRehash(),
+		TCPDSACKRecvSegs:          f.TCPDSACKRecvSegs(),
+		TCPDSACKIgnoredDubious:    f.TCPDSACKIgnoredDubious(),
+		TCPMigrateReqSuccess:      f.TCPMigrateReqSuccess(),
+		TCPMigrateReqFailure:      f.TCPMigrateReqFailure(),
+		TCPPLBRehash:              f.TCPPLBRehash(),
+		TCPAORequired:             f.TCPAORequired(),
+		TCPAOBad:                  f.TCPAOBad(),
+		TCPAOKeyNotFound:          f.TCPAOKeyNotFound(),
+		TCPAOGood:                 f.TCPAOGood(),
+		TCPAODroppedIcmps:         f.TCPAODroppedIcmps(),
+	}
+}
+
+// deserializeIP6 deserializes a flat.IP6 as structs.IP6.
+func deserializeIP6(f *flat.IP6) structs.IP6 {
+	return structs.IP6{
+		InReceives:       f.InReceives(),
+		InHdrErrors:      f.InHdrErrors(),
+		InTooBigErrors:   f.InTooBigErrors(),
+		InNoRoutes:       f.InNoRoutes(),
+		InAddrErrors:     f.InAddrErrors(),
+		InUnknownProtos:  f.InUnknownProtos(),
+		InTruncatedPkts:  f.InTruncatedPkts(),
+		InDiscards:       f.InDiscards(),
+		InDelivers:       f.InDelivers(),
+		OutForwDatagrams: f.OutForwDatagrams(),
+		OutRequests:      f.OutRequests(),
+		OutDiscards:      f.OutDiscards(),
+		OutNoRoutes:      f.OutNoRoutes(),
+		ReasmTimeout:     f.ReasmTimeout(),
+		ReasmReqds:       f.ReasmReqds(),
+		ReasmOKs:         f.ReasmOKs(),
+		ReasmFails:       f.ReasmFails(),
+		FragOKs:          f.FragOKs(),
+		FragFails:        f.FragFails(),
+		FragCreates:      f.FragCreates(),
+		InMcastPkts:      f.InMcastPkts(),
+		OutMcastPkts:     f.OutMcastPkts(),
+		InOctets:         f.InOctets(),
+		OutOctets:        f.OutOctets(),
+		InMcastOctets:    f.InMcastOctets(),
+		OutMcastOctets:   f.OutMcastOctets(),
+		InBcastOctets:    f.InBcastOctets(),
+		OutBcastOctets:   f.OutBcastOctets(),
+		InNoECTPkts:      f.InNoECTPkts(),
+		InECT1Pkts:       f.InECT1Pkts(),
+		InECT0Pkts:       f.InECT0Pkts(),
+		InCEPkts:         f.InCEPkts(),
+		OutTransmits:     f.OutTransmits(),
+	}
+}
+
+// deserializeICMP6 deserializes a flat.ICMP6 as structs.ICMP6.
+func deserializeICMP6(f *flat.ICMP6) structs.ICMP6 {
+	return structs.ICMP6{
+		InMsgs:                    f.InMsgs(),
+		InErrors:                  f.InErrors(),
+		OutMsgs:                   f.OutMsgs(),
+		OutErrors:                 f.OutErrors(),
+		InCsumErrors:              f.InCsumErrors(),
+		OutRateLimitHost:          f.OutRateLimitHost(),
+		InDestUnreachs:            f.InDestUnreachs(),
+		InPktTooBigs:              f.InPktTooBigs(),
+		InTimeExcds:               f.InTimeExcds(),
+		InParmProblems:            f.InParmProblems(),
+		InEchos:                   f.InEchos(),
+		InEchoReplies:             f.InEchoReplies(),
+		InGroupMembQueries:        f.InGroupMembQueries(),
+		InGroupMembResponses:      f.InGroupMembResponses(),
+		InGroupMembReductions:     f.InGroupMembReductions(),
+		InRouterSolicits:          f.InRouterSolicits(),
+		InRouterAdvertisements:    f.InRouterAdvertisements(),
+		InNeighborSolicits:        f.InNeighborSolicits(),
+		InNeighborAdvertisements:  f.InNeighborAdvertisements(),
+		InRedirects:               f.InRedirects(),
+		InMLDv2Reports:            f.InMLDv2Reports(),
+		OutDestUnreachs:           f.OutDestUnreachs(),
+		OutPktTooBigs:             f.OutPktTooBigs(),
+		OutTimeExcds:              f.OutTimeExcds(),
+		OutParmProblems:           f.OutParmProblems(),
+		OutEchos:                  f.OutEchos(),
+		OutEchoReplies:            f.OutEchoReplies(),
+		OutGroupMembQueries:       f.OutGroupMembQueries(),
+		OutGroupMembResponses:     f.OutGroupMembResponses(),
+		OutGroupMembReductions:    f.OutGroupMembReductions(),
+		OutRouterSolicits:         f.OutRouterSolicits(),
+		OutRouterAdvertisements:   f.OutRouterAdvertisements(),
+		OutNeighborSolicits:       f.OutNeighborSolicits(),
+		OutNeighborAdvertisements: f.OutNeighborAdvertisements(),
+		OutRedirects:              f.OutRedirects(),
+		OutMLDv2Reports:           f.OutMLDv2Reports(),
+	}
+}
+
+// deserializeUDP6 deserializes a flat.UDP6 as structs.UDP6.
+func deserializeUDP6(f *flat.UDP6) structs.UDP6 {
+	return structs.UDP6{
+		InDatagrams:  f.InDatagrams(),
+		NoPorts:      f.NoPorts(),
+		InErrors:     f.InErrors(),
+		OutDatagrams: f.OutDatagrams(),
+		RcvbufErrors: f.RcvbufErrors(),
+		SndbufErrors: f.SndbufErrors(),
+		InCsumErrors: f.InCsumErrors(),
+		IgnoredMulti: f.IgnoredMulti(),
+		MemErrors:    f.MemErrors(),
+	}
+}
+
+// Ticker delivers the system's network protocol counter usage at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
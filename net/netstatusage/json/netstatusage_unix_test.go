@@ -0,0 +1,82 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstatusage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func TestGet(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	time.Sleep(time.Duration(200) * time.Millisecond)
+	b, err := p.Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	u, err := Deserialize(b)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkUsage("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkUsage("ticker", u, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *structs.NetstatUsage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	if u.TCP.RtoMin == 0 {
+		t.Errorf("%s: TCP.RtoMin: wanted non-zero value; got 0", n)
+	}
+}
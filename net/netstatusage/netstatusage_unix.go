@@ -0,0 +1,145 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netstatusage gets the change in the kernel's network protocol
+// counters. Usage is calculated by taking the difference between two
+// snapshots of /proc/net/snmp, /proc/net/netstat, and /proc/net/snmp6. The
+// time elapsed between the two snapshots is stored in the TimeDelta field.
+// Fields that aren't counters, e.g. TCP.CurrEstab or TCP.RtoMin, hold their
+// current value.
+package netstatusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/netstat"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+// Profiler is used to process the network protocol counter usage.
+type Profiler struct {
+	*netstat.Profiler
+	prior structs.Netstat
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a snapshot of
+// the counters is taken so that any Get() will return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := netstat.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	prior, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, prior: *prior}, nil
+}
+
+// Get returns the current network protocol counter usage. Calculating usage
+// requires two snapshots. This func gets the current snapshot of the counters
+// and calculates the difference between that and the prior snapshot. The
+// current snapshot is stored for use as the prior snapshot on the next Get
+// call. If ongoing usage information is desired, the Ticker should be used;
+// it's better suited for ongoing usage information.
+func (prof *Profiler) Get() (u *structs.NetstatUsage, err error) {
+	cur, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	u = prof.CalculateUsage(cur)
+	prof.prior = *cur
+	return u, nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current network protocol counter usage using the package's
+// global Profiler. The profiler is lazily instantiated. If the profiler
+// doesn't already exist, the first usage information will not be useful due
+// to minimal time elapsing between the initial and second snapshots used for
+// usage calculations; the results of the first call should be discarded.
+func Get() (u *structs.NetstatUsage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// CalculateUsage returns the difference, see tools.CounterDelta, between the
+// current snapshot of the counters and the prior one; fields that aren't
+// counters hold their current value.
+func (prof *Profiler) CalculateUsage(cur *structs.Netstat) *structs.NetstatUsage {
+	var d structs.Netstat
+	for i := 0; i < len(netstat.Fields); i++ {
+		f := &netstat.Fields[i]
+		if f.Gauge {
+			*f.Value(&d) = *f.Value(cur)
+			continue
+		}
+		*f.Value(&d) = tools.CounterDelta(*f.Value(cur), *f.Value(&prof.prior))
+	}
+	return &structs.NetstatUsage{
+		Timestamp: cur.Timestamp,
+		TimeDelta: cur.Timestamp - prof.prior.Timestamp,
+		IP:        d.IP,
+		ICMP:      d.ICMP,
+		TCP:       d.TCP,
+		UDP:       d.UDP,
+		TCPExt:    d.TCPExt,
+		IP6:       d.IP6,
+		ICMP6:     d.ICMP6,
+		UDP6:      d.UDP6,
+	}
+}
+
+// Ticker delivers the system's network protocol counter usage at intervals.
+type Ticker struct {
+	*ticker.Ticker[*structs.NetstatUsage]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.NetstatUsage, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
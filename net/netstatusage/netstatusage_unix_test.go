@@ -0,0 +1,133 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package netstatusage
+
+import (
+	"testing"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func TestCalculateUsage(t *testing.T) {
+	prior, err := joe.NewTempFileProc("netstatusage", "snmp", []byte(
+		"Tcp: RtoMin ActiveOpens CurrEstab InSegs\nTcp: 200 100 5 4294967000\n"+
+			"Udp: InDatagrams NoPorts\nUdp: 900 5000000000\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prior.Remove()
+	// ActiveOpens increased, CurrEstab, a gauge, decreased, InSegs wrapped at
+	// 32 bits, and NoPorts was reset.
+	cur, err := joe.NewTempFileProc("netstatusage", "snmp", []byte(
+		"Tcp: RtoMin ActiveOpens CurrEstab InSegs\nTcp: 200 150 3 100\n"+
+			"Udp: InDatagrams NoPorts\nUdp: 950 7\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cur.Remove()
+
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.SNMP = prior
+	prof.SNMP6 = nil
+	prof.Netstat = nil
+	_, err = prof.Get()
+	if err != nil {
+		t.Fatalf("prior: unexpected error: %s", err)
+	}
+	prof.SNMP = cur
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("cur: unexpected error: %s", err)
+	}
+	if u.TimeDelta <= 0 {
+		t.Errorf("TimeDelta: got %d; want a value > 0", u.TimeDelta)
+	}
+	expectedTCP := structs.TCP{RtoMin: 200, ActiveOpens: 50, CurrEstab: 3, InSegs: 396}
+	if u.TCP != expectedTCP {
+		t.Errorf("TCP: got %#v; want %#v", u.TCP, expectedTCP)
+	}
+	expectedUDP := structs.UDP{InDatagrams: 50, NoPorts: 7}
+	if u.UDP != expectedUDP {
+		t.Errorf("UDP: got %#v; want %#v", u.UDP, expectedUDP)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	time.Sleep(time.Duration(200) * time.Millisecond)
+	u, err := p.Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkUsage("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkUsage(n string, u *structs.NetstatUsage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	if u.IP.DefaultTTL == 0 {
+		t.Errorf("%s: IP.DefaultTTL: wanted non-zero value; got 0", n)
+	}
+	if u.TCP.RtoMin == 0 {
+		t.Errorf("%s: TCP.RtoMin: wanted non-zero value; got 0", n)
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var u *structs.NetstatUsage
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = p.Get()
+	}
+	_ = u
+}
@@ -23,6 +23,7 @@
 package netusage
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	usage "github.com/hmmftg/joefriday/net/netusage"
 	"github.com/hmmftg/joefriday/net/structs"
 	"github.com/hmmftg/joefriday/net/structs/flat"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Profiler is used to process the network device usage.
@@ -42,8 +44,8 @@ type Profiler struct {
 // Returns an initialized Profiler; ready to use. Upon creation, a
 // /proc/net/dev snapshot is taken so that any Get() will return valid
 // information.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := usage.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -120,10 +122,57 @@ func (prof *Profiler) Serialize(u *structs.DevUsage) []byte {
 		prof.Builder.PrependUOffsetT(devs[i])
 	}
 	devsV := prof.Builder.EndVector(len(devs))
+	added := make([]fb.UOffsetT, len(u.Added))
+	for i := 0; i < len(u.Added); i++ {
+		added[i] = prof.Builder.CreateString(u.Added[i])
+	}
+	flat.DevUsageStartAddedVector(prof.Builder, len(added))
+	for i := len(added) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(added[i])
+	}
+	addedV := prof.Builder.EndVector(len(added))
+	removed := make([]fb.UOffsetT, len(u.Removed))
+	for i := 0; i < len(u.Removed); i++ {
+		removed[i] = prof.Builder.CreateString(u.Removed[i])
+	}
+	flat.DevUsageStartRemovedVector(prof.Builder, len(removed))
+	for i := len(removed) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(removed[i])
+	}
+	removedV := prof.Builder.EndVector(len(removed))
+	rates := make([]fb.UOffsetT, len(u.Rates))
+	rateNames := make([]fb.UOffsetT, len(u.Rates))
+	for i := 0; i < len(u.Rates); i++ {
+		rateNames[i] = prof.Builder.CreateString(u.Rates[i].Name)
+	}
+	for i := 0; i < len(u.Rates); i++ {
+		flat.DeviceRatesStart(prof.Builder)
+		flat.DeviceRatesAddName(prof.Builder, rateNames[i])
+		flat.DeviceRatesAddSpeed(prof.Builder, u.Rates[i].Speed)
+		flat.DeviceRatesAddRBytesPerSec(prof.Builder, u.Rates[i].RBytesPerSec)
+		flat.DeviceRatesAddRPacketsPerSec(prof.Builder, u.Rates[i].RPacketsPerSec)
+		flat.DeviceRatesAddRDropRatio(prof.Builder, u.Rates[i].RDropRatio)
+		flat.DeviceRatesAddRErrRatio(prof.Builder, u.Rates[i].RErrRatio)
+		flat.DeviceRatesAddRUtil(prof.Builder, u.Rates[i].RUtil)
+		flat.DeviceRatesAddTBytesPerSec(prof.Builder, u.Rates[i].TBytesPerSec)
+		flat.DeviceRatesAddTPacketsPerSec(prof.Builder, u.Rates[i].TPacketsPerSec)
+		flat.DeviceRatesAddTDropRatio(prof.Builder, u.Rates[i].TDropRatio)
+		flat.DeviceRatesAddTErrRatio(prof.Builder, u.Rates[i].TErrRatio)
+		flat.DeviceRatesAddTUtil(prof.Builder, u.Rates[i].TUtil)
+		rates[i] = flat.DeviceRatesEnd(prof.Builder)
+	}
+	flat.DevUsageStartRatesVector(prof.Builder, len(rates))
+	for i := len(rates) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(rates[i])
+	}
+	ratesV := prof.Builder.EndVector(len(rates))
 	flat.DevUsageStart(prof.Builder)
 	flat.DevUsageAddTimestamp(prof.Builder, u.Timestamp)
 	flat.DevUsageAddTimeDelta(prof.Builder, u.TimeDelta)
 	flat.DevUsageAddDevice(prof.Builder, devsV)
+	flat.DevUsageAddAdded(prof.Builder, addedV)
+	flat.DevUsageAddRemoved(prof.Builder, removedV)
+	flat.DevUsageAddRates(prof.Builder, ratesV)
 	prof.Builder.Finish(flat.DevUsageEnd(prof.Builder))
 	p := prof.Builder.Bytes[prof.Builder.Head():]
 	// copy them (otherwise gets lost in reset)
@@ -180,13 +229,44 @@ func Deserialize(p []byte) *structs.DevUsage {
 		}
 		u.Device[i] = sDev
 	}
+	u.Rates = make([]structs.DeviceRates, uFlat.RatesLength())
+	fRate := &flat.DeviceRates{}
+	for i := 0; i < len(u.Rates); i++ {
+		var r structs.DeviceRates
+		if uFlat.Rates(fRate, i) {
+			r.Name = string(fRate.Name())
+			r.Speed = fRate.Speed()
+			r.RBytesPerSec = fRate.RBytesPerSec()
+			r.RPacketsPerSec = fRate.RPacketsPerSec()
+			r.RDropRatio = fRate.RDropRatio()
+			r.RErrRatio = fRate.RErrRatio()
+			r.RUtil = fRate.RUtil()
+			r.TBytesPerSec = fRate.TBytesPerSec()
+			r.TPacketsPerSec = fRate.TPacketsPerSec()
+			r.TDropRatio = fRate.TDropRatio()
+			r.TErrRatio = fRate.TErrRatio()
+			r.TUtil = fRate.TUtil()
+		}
+		u.Rates[i] = r
+	}
+	if uFlat.AddedLength() > 0 {
+		u.Added = make([]string, uFlat.AddedLength())
+		for i := 0; i < len(u.Added); i++ {
+			u.Added[i] = string(uFlat.Added(i))
+		}
+	}
+	if uFlat.RemovedLength() > 0 {
+		u.Removed = make([]string, uFlat.RemovedLength())
+		for i := 0; i < len(u.Removed); i++ {
+			u.Removed[i] = string(uFlat.Removed(i))
+		}
+	}
 	return u
 }
 
 // Ticker delivers the system's net devices usage at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 	*Profiler
 }
 
@@ -195,35 +275,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			p, err := t.Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -14,6 +14,7 @@
 package netusage
 
 import (
+	"reflect"
 	"testing"
 	"time"
 
@@ -36,6 +37,32 @@ func TestGet(t *testing.T) {
 	checkUsage("get", u, t)
 }
 
+func TestSerializeDeserialize(t *testing.T) {
+	u := &structs.DevUsage{
+		Timestamp: 2000, TimeDelta: 1000,
+		Device: []structs.Device{
+			{Name: "eth0", RBytes: 1, RPackets: 2, RErrs: 3, RDrop: 4, RFIFO: 5, RFrame: 6, RCompressed: 7, RMulticast: 8,
+				TBytes: 9, TPackets: 10, TErrs: 11, TDrop: 12, TFIFO: 13, TColls: 14, TCarrier: 15, TCompressed: 16},
+			{Name: "veth2", RBytes: 42},
+		},
+		Rates: []structs.DeviceRates{
+			{Name: "eth0", Speed: 1000, RBytesPerSec: 1, RPacketsPerSec: 2, RDropRatio: 0.5, RErrRatio: 0.25, RUtil: 0.1,
+				TBytesPerSec: 9, TPacketsPerSec: 10, TDropRatio: 0.75, TErrRatio: 0.125, TUtil: 0.2},
+			{Name: "veth2", RBytesPerSec: 42},
+		},
+		Added:   []string{"veth2"},
+		Removed: []string{"veth0", "veth1"},
+	}
+	p, err := Serialize(u)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	uD := Deserialize(p)
+	if !reflect.DeepEqual(u, uD) {
+		t.Errorf("got %#v; want %#v", uD, u)
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -69,9 +96,12 @@ func checkUsage(n string, u *structs.DevUsage, t *testing.T) {
 		t.Errorf("%s: expected TimeDelta to be a non-zero value; was 0", n)
 	}
 	if len(u.Device) == 0 {
-		t.Error("%s: expected devices; got none", n)
+		t.Errorf("%s: expected devices; got none", n)
 		return
 	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
 	// check name
 	for i, v := range u.Device {
 		if v.Name == "" {
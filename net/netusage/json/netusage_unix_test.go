@@ -80,6 +80,9 @@ func checkUsage(n string, u *structs.DevUsage, t *testing.T) {
 		t.Errorf("%s: expected devices; got none", n)
 		return
 	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
 	// check name
 	for i, v := range u.Device {
 		if v.Name == "" {
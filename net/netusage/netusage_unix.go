@@ -15,31 +15,56 @@
 // by taking the difference between two network device snapshots,
 // /proc/net/dev. The time elapsed between the two snapshots is stored in the
 // TimeDelta field.
+//
+// In addition to the raw counter deltas, the per second rates for each device
+// are derived: bytes and packets per second, drop and error ratios, and, if
+// the link speed is known, /sys/class/net/[device]/speed, the percentage of
+// the line rate used.
 package netusage
 
 import (
-	"fmt"
-	"io"
+	"context"
+	"path/filepath"
 	"sync"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/net/netdev"
 	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
+const (
+	sysFSNet = "/sys/class/net"
+	// speedRefresh is how long a device's link speed is cached before it's
+	// read again.
+	speedRefresh = 10 * time.Second
+)
+
 // Profiler is used to process the network device usage.
 type Profiler struct {
 	*netdev.Profiler
-	prior structs.DevInfo
+	prior        structs.DevInfo
+	index        map[string]int // prior device index by name
+	matched      []bool         // whether the prior device was matched to a current one
+	sysFSNetPath string
+	speeds       map[string]linkSpeed // link speed by device name
+	opts         joe.Options
+}
+
+// linkSpeed is a device's cached link speed.
+type linkSpeed struct {
+	speed int64
+	read  int64 // timestamp of the snapshot the speed was read for
 }
 
 // Returns an initialized Profiler; ready to use. Upon creation, a
 // /proc/net/dev snapshot is taken so that any Get() will return valid
 // information.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := netdev.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	p, err := netdev.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +72,14 @@ func NewProfiler() (prof *Profiler, err error) {
 	if err != nil {
 		return nil, err
 	}
-	return &Profiler{Profiler: p, prior: *prior}, nil
+	return &Profiler{Profiler: p, prior: *prior, sysFSNetPath: o.Path(sysFSNet), opts: o}, nil
+}
+
+// SysFSNetPath enables overriding the default value. This is for testing and
+// should not be used outside of tests.
+func (prof *Profiler) SysFSNetPath(s string) {
+	prof.sysFSNetPath = s
+	prof.speeds = nil
 }
 
 // Get returns the current network device usage. Calculating usage requires two
@@ -87,39 +119,146 @@ func Get() (u *structs.DevUsage, err error) {
 }
 
 // CalculateUsage returns the difference between the current /proc/net/dev
-// snapshot and the prior one.
+// snapshot and the prior one. Devices are matched by name, so devices that
+// are added or removed between the snapshots don't affect the usage of the
+// other devices. A device that wasn't in the prior snapshot has its counters
+// used as is, since they were accumulated since the prior snapshot, and is
+// added to Added. A device in the prior snapshot that is no longer present is
+// added to Removed.
 func (prof *Profiler) CalculateUsage(cur *structs.DevInfo) *structs.DevUsage {
 	u := &structs.DevUsage{
 		Timestamp: cur.Timestamp,
 		TimeDelta: cur.Timestamp - prof.prior.Timestamp,
 		Device:    make([]structs.Device, len(cur.Device)),
 	}
+	if prof.index == nil {
+		prof.index = make(map[string]int, len(prof.prior.Device))
+	}
+	for k := range prof.index {
+		delete(prof.index, k)
+	}
+	for i := 0; i < len(prof.prior.Device); i++ {
+		prof.index[prof.prior.Device[i].Name] = i
+	}
+	if cap(prof.matched) < len(prof.prior.Device) {
+		prof.matched = make([]bool, len(prof.prior.Device))
+	}
+	prof.matched = prof.matched[:len(prof.prior.Device)]
+	for i := range prof.matched {
+		prof.matched[i] = false
+	}
+	var prior structs.Device
 	for i := 0; i < len(cur.Device); i++ {
+		j, ok := prof.index[cur.Device[i].Name]
+		if ok {
+			prof.matched[j] = true
+			prior = prof.prior.Device[j]
+		} else {
+			prior = structs.Device{}
+			u.Added = append(u.Added, cur.Device[i].Name)
+		}
 		u.Device[i].Name = cur.Device[i].Name
-		u.Device[i].RBytes = cur.Device[i].RBytes - prof.prior.Device[i].RBytes
-		u.Device[i].RPackets = cur.Device[i].RPackets - prof.prior.Device[i].RPackets
-		u.Device[i].RErrs = cur.Device[i].RErrs - prof.prior.Device[i].RErrs
-		u.Device[i].RDrop = cur.Device[i].RDrop - prof.prior.Device[i].RDrop
-		u.Device[i].RFIFO = cur.Device[i].RFIFO - prof.prior.Device[i].RFIFO
-		u.Device[i].RFrame = cur.Device[i].RFrame - prof.prior.Device[i].RFrame
-		u.Device[i].RCompressed = cur.Device[i].RCompressed - prof.prior.Device[i].RCompressed
-		u.Device[i].RMulticast = cur.Device[i].RMulticast - prof.prior.Device[i].RMulticast
-		u.Device[i].TBytes = cur.Device[i].TBytes - prof.prior.Device[i].TBytes
-		u.Device[i].TPackets = cur.Device[i].TPackets - prof.prior.Device[i].TPackets
-		u.Device[i].TErrs = cur.Device[i].TErrs - prof.prior.Device[i].TErrs
-		u.Device[i].TDrop = cur.Device[i].TDrop - prof.prior.Device[i].TDrop
-		u.Device[i].TFIFO = cur.Device[i].TFIFO - prof.prior.Device[i].TFIFO
-		u.Device[i].TColls = cur.Device[i].TColls - prof.prior.Device[i].TColls
-		u.Device[i].TCarrier = cur.Device[i].TCarrier - prof.prior.Device[i].TCarrier
-		u.Device[i].TCompressed = cur.Device[i].TCompressed - prof.prior.Device[i].TCompressed
+		u.Device[i].RBytes = tools.CounterDelta(cur.Device[i].RBytes, prior.RBytes)
+		u.Device[i].RPackets = tools.CounterDelta(cur.Device[i].RPackets, prior.RPackets)
+		u.Device[i].RErrs = tools.CounterDelta(cur.Device[i].RErrs, prior.RErrs)
+		u.Device[i].RDrop = tools.CounterDelta(cur.Device[i].RDrop, prior.RDrop)
+		u.Device[i].RFIFO = tools.CounterDelta(cur.Device[i].RFIFO, prior.RFIFO)
+		u.Device[i].RFrame = tools.CounterDelta(cur.Device[i].RFrame, prior.RFrame)
+		u.Device[i].RCompressed = tools.CounterDelta(cur.Device[i].RCompressed, prior.RCompressed)
+		u.Device[i].RMulticast = tools.CounterDelta(cur.Device[i].RMulticast, prior.RMulticast)
+		u.Device[i].TBytes = tools.CounterDelta(cur.Device[i].TBytes, prior.TBytes)
+		u.Device[i].TPackets = tools.CounterDelta(cur.Device[i].TPackets, prior.TPackets)
+		u.Device[i].TErrs = tools.CounterDelta(cur.Device[i].TErrs, prior.TErrs)
+		u.Device[i].TDrop = tools.CounterDelta(cur.Device[i].TDrop, prior.TDrop)
+		u.Device[i].TFIFO = tools.CounterDelta(cur.Device[i].TFIFO, prior.TFIFO)
+		u.Device[i].TColls = tools.CounterDelta(cur.Device[i].TColls, prior.TColls)
+		u.Device[i].TCarrier = tools.CounterDelta(cur.Device[i].TCarrier, prior.TCarrier)
+		u.Device[i].TCompressed = tools.CounterDelta(cur.Device[i].TCompressed, prior.TCompressed)
+	}
+	for i, ok := range prof.matched {
+		if !ok {
+			u.Removed = append(u.Removed, prof.prior.Device[i].Name)
+			delete(prof.speeds, prof.prior.Device[i].Name)
+		}
 	}
+	u.Rates = prof.calculateRates(u)
 	return u
 }
 
+// calculateRates derives the per second rates of each device from the
+// usage's counter deltas.
+func (prof *Profiler) calculateRates(u *structs.DevUsage) []structs.DeviceRates {
+	rates := make([]structs.DeviceRates, len(u.Device))
+	secs := float64(u.TimeDelta) / float64(time.Second)
+	for i := 0; i < len(u.Device); i++ {
+		dev := &u.Device[i]
+		r := &rates[i]
+		r.Name = dev.Name
+		r.Speed = prof.speed(dev.Name, u.Timestamp)
+		r.RDropRatio = ratio(dev.RDrop, dev.RPackets)
+		r.RErrRatio = ratio(dev.RErrs, dev.RPackets)
+		r.TDropRatio = ratio(dev.TDrop, dev.TPackets)
+		r.TErrRatio = ratio(dev.TErrs, dev.TPackets)
+		if u.TimeDelta <= 0 {
+			continue
+		}
+		r.RBytesPerSec = float64(dev.RBytes) / secs
+		r.RPacketsPerSec = float64(dev.RPackets) / secs
+		r.TBytesPerSec = float64(dev.TBytes) / secs
+		r.TPacketsPerSec = float64(dev.TPackets) / secs
+		if r.Speed > 0 {
+			// speed is in Mbits/sec
+			r.RUtil = r.RBytesPerSec * 8 / (float64(r.Speed) * 1000000) * 100
+			r.TUtil = r.TBytesPerSec * 8 / (float64(r.Speed) * 1000000) * 100
+		}
+	}
+	return rates
+}
+
+// ratio returns n as a fraction of the total of n and packets.
+func ratio(n, packets int64) float64 {
+	if n+packets <= 0 {
+		return 0
+	}
+	return float64(n) / float64(n+packets)
+}
+
+// speed returns the device's link speed in Mbits/sec as of the snapshot taken
+// at ts. The speed can change, e.g. when the link is renegotiated, so it's
+// cached for speedRefresh and then read again. The speeds of removed devices
+// are evicted.
+func (prof *Profiler) speed(name string, ts int64) int64 {
+	s, ok := prof.speeds[name]
+	if ok && ts >= s.read && ts-s.read < int64(speedRefresh) {
+		return s.speed
+	}
+	if prof.speeds == nil {
+		prof.speeds = map[string]linkSpeed{}
+	}
+	s = linkSpeed{speed: prof.readSpeed(name), read: ts}
+	prof.speeds[name] = s
+	return s.speed
+}
+
+// readSpeed reads the device's link speed in Mbits/sec. If the speed can't be
+// determined, 0 is returned: reading the speed of a device whose link is down
+// or that doesn't have a speed, e.g. loopback, results in an error and
+// virtual devices may report -1.
+func (prof *Profiler) readSpeed(name string) int64 {
+	b, err := prof.opts.ReadFile(filepath.Join(prof.sysFSNetPath, name, "speed"))
+	if err != nil {
+		return 0
+	}
+	n, err := tools.ParseInt(joe.TrimTrailingSpaces(b))
+	if err != nil || n < 0 {
+		return 0
+	}
+	return n
+}
+
 // Ticker delivers the system's network device usage at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan *structs.DevUsage
+	*ticker.Ticker[*structs.DevUsage]
 	*Profiler
 }
 
@@ -128,171 +267,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan *structs.DevUsage), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.DevUsage, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	var (
-		i, pos, line, fieldNum int
-		n                      uint64
-		v                      byte
-		err                    error
-		cur                    structs.DevInfo
-		dev                    structs.Device
-	)
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			cur.Timestamp = time.Now().UTC().UnixNano()
-			err = t.Procer.Reset()
-			if err != nil {
-				t.Errs <- err
-				break
-			}
-			line = 0
-			cur.Device = cur.Device[:0]
-			// read each line until eof
-			for {
-				t.Val = t.Val[:0]
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					t.Errs <- &joe.ReadError{Err: err}
-					break
-				}
-				line++
-				if line < 3 {
-					continue
-				}
-				// first grab the interface name (everything up to the ':')
-				for i, v = range t.Line {
-					if v == 0x3A {
-						pos = i + 1
-						break
-					}
-					// skip spaces
-					if v != 0x20 {
-						continue
-					}
-					t.Val = append(t.Val, v)
-				}
-				dev.Name = string(t.Val[:])
-				fieldNum = 0
-				// process the rest of the line
-				for {
-					fieldNum++
-					// skip all spaces
-					for i, v = range t.Line[pos:] {
-						if v != 0x20 {
-							pos += i
-							break
-						}
-					}
-					// grab the numbers
-					for i, v = range t.Line[pos:] {
-						if v == 0x20 || v == '\n' {
-							break
-						}
-					}
-					// any conversion error results in 0
-					n, err = tools.ParseUint(t.Line[pos : pos+1])
-					pos += i
-					if err != nil {
-						t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-						continue
-					}
-					if fieldNum < 9 {
-						if fieldNum < 5 {
-							if fieldNum < 3 {
-								if fieldNum == 1 {
-									dev.RBytes = int64(n)
-									continue
-								}
-								dev.RPackets = int64(n) // must be 2
-								continue
-							}
-							if fieldNum == 3 {
-								dev.RErrs = int64(n)
-								continue
-							}
-							dev.RDrop = int64(n) // must be 4
-							continue
-						}
-						if fieldNum < 7 {
-							if fieldNum == 5 {
-								dev.RFIFO = int64(n)
-								continue
-							}
-							dev.RFrame = int64(n) // must be 6
-							continue
-						}
-						if fieldNum == 7 {
-							dev.RCompressed = int64(n)
-							continue
-						}
-						dev.RMulticast = int64(n) // must be 8
-						continue
-					}
-					if fieldNum < 13 {
-						if fieldNum < 11 {
-							if fieldNum == 9 {
-								dev.TBytes = int64(n)
-								continue
-							}
-							dev.TPackets = int64(n) // must be 10
-							continue
-						}
-						if fieldNum == 11 {
-							dev.TErrs = int64(n)
-							continue
-						}
-						dev.TDrop = int64(n) // must be 12
-						continue
-					}
-					if fieldNum < 15 {
-						if fieldNum == 13 {
-							dev.TFIFO = int64(n)
-							continue
-						}
-						dev.TColls = int64(n) // must be 14
-						continue
-					}
-					if fieldNum == 15 {
-						dev.TCarrier = int64(n)
-						continue
-					}
-					if fieldNum == 16 {
-						dev.TCompressed = int64(n)
-						break
-					}
-				}
-				cur.Device = append(cur.Device, dev)
-			}
-			t.Data <- t.CalculateUsage(&cur)
-			t.prior.Timestamp = cur.Timestamp
-			if len(t.prior.Device) != len(cur.Device) {
-				t.prior.Device = make([]structs.Device, len(cur.Device))
-			}
-			copy(t.prior.Device, cur.Device)
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
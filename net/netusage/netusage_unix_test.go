@@ -14,12 +14,108 @@
 package netusage
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
 	"time"
 
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/net/structs"
 )
 
+const devHeader = `Inter-|   Receive                                                |  Transmit
+ face |bytes    packets errs drop fifo frame compressed multicast|bytes    packets errs drop fifo colls carrier compressed
+`
+
+// devLine returns a /proc/net/dev line for the device with all of its
+// counters set to v.
+func devLine(name string, v int64) string {
+	s := fmt.Sprintf("%6s:", name)
+	for i := 0; i < 16; i++ {
+		s += fmt.Sprintf(" %d", v)
+	}
+	return s + "\n"
+}
+
+// device returns a device with all of its counters set to v.
+func device(name string, v int64) structs.Device {
+	return structs.Device{
+		Name: name, RBytes: v, RPackets: v, RErrs: v, RDrop: v, RFIFO: v, RFrame: v, RCompressed: v, RMulticast: v,
+		TBytes: v, TPackets: v, TErrs: v, TDrop: v, TFIFO: v, TColls: v, TCarrier: v, TCompressed: v,
+	}
+}
+
+func TestCalculateUsageChurn(t *testing.T) {
+	prior, err := joe.NewTempFileProc("netusage", "dev", []byte(devHeader+
+		devLine("lo", 1000)+
+		devLine("eth0", 4294967000)+
+		devLine("veth1", 500)+
+		devLine("eth1", 5000000000)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer prior.Remove()
+	// lo increased, veth1 was removed, veth2 was added, eth0's counters
+	// wrapped at 32 bits, and eth1's counters were reset. The order of the
+	// devices also changed.
+	cur, err := joe.NewTempFileProc("netusage", "dev", []byte(devHeader+
+		devLine("veth2", 42)+
+		devLine("eth1", 300)+
+		devLine("eth0", 100)+
+		devLine("lo", 1500)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cur.Remove()
+
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.Procer = prior
+	_, err = prof.Get()
+	if err != nil {
+		t.Fatalf("prior: unexpected error: %s", err)
+	}
+	prof.Procer = cur
+	u, err := prof.Get()
+	if err != nil {
+		t.Fatalf("cur: unexpected error: %s", err)
+	}
+	expected := []structs.Device{
+		device("veth2", 42),
+		device("eth1", 300),
+		device("eth0", 396),
+		device("lo", 500),
+	}
+	if !reflect.DeepEqual(u.Device, expected) {
+		t.Errorf("Device: got %#v; want %#v", u.Device, expected)
+	}
+	if !reflect.DeepEqual(u.Added, []string{"veth2"}) {
+		t.Errorf("Added: got %v; want [veth2]", u.Added)
+	}
+	if !reflect.DeepEqual(u.Removed, []string{"veth1"}) {
+		t.Errorf("Removed: got %v; want [veth1]", u.Removed)
+	}
+
+	// no churn: nothing is added or removed.
+	u, err = prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(u.Added) != 0 || len(u.Removed) != 0 {
+		t.Errorf("no churn: got added %v, removed %v; want none", u.Added, u.Removed)
+	}
+	for i, v := range u.Device {
+		if v != device(v.Name, 0) {
+			t.Errorf("no churn: %d: got %#v; want all counters to be 0", i, v)
+		}
+	}
+}
+
 func TestGet(t *testing.T) {
 	p, err := NewProfiler()
 	if err != nil {
@@ -35,6 +131,125 @@ func TestGet(t *testing.T) {
 	t.Logf("%#v\n", u)
 }
 
+func TestCalculateRates(t *testing.T) {
+	// sysfs net tree: eth0 is a 100 Mbit/s link, veth0 is virtual, and lo
+	// doesn't have a speed.
+	dir, err := ioutil.TempDir("", "netusage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for _, v := range []struct {
+		name  string
+		speed string
+	}{
+		{"eth0", "100\n"},
+		{"veth0", "-1\n"},
+	} {
+		err = os.MkdirAll(filepath.Join(dir, v.name), 0777)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, v.name, "speed"), []byte(v.speed), 0666)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	prof := &Profiler{
+		prior: structs.DevInfo{
+			Timestamp: 0,
+			Device: []structs.Device{
+				{Name: "lo", RBytes: 1000, RPackets: 10, TBytes: 1000, TPackets: 10},
+				{Name: "eth0", RBytes: 5000, RPackets: 50, RDrop: 1, TBytes: 2000, TPackets: 20},
+				{Name: "veth0"},
+			},
+		},
+	}
+	prof.SysFSNetPath(dir)
+	cur := &structs.DevInfo{
+		Timestamp: int64(2 * time.Second),
+		Device: []structs.Device{
+			{Name: "lo", RBytes: 3000, RPackets: 30, TBytes: 3000, TPackets: 30},
+			// 2.5 MB/s received: 20% of 100 Mbit/s.
+			{Name: "eth0", RBytes: 5005000, RPackets: 1050, RDrop: 11, RErrs: 30, TBytes: 1252000, TPackets: 520, TDrop: 0, TErrs: 0},
+			{Name: "veth0", RBytes: 200, RPackets: 4, TBytes: 100, TPackets: 2, TErrs: 2},
+		},
+	}
+	expected := []structs.DeviceRates{
+		{Name: "lo", RBytesPerSec: 1000, RPacketsPerSec: 10, TBytesPerSec: 1000, TPacketsPerSec: 10},
+		{
+			Name: "eth0", Speed: 100, RBytesPerSec: 2500000, RPacketsPerSec: 500, RDropRatio: 10.0 / 1010.0,
+			RErrRatio: 30.0 / 1030.0, RUtil: 20, TBytesPerSec: 625000, TPacketsPerSec: 250, TUtil: 5,
+		},
+		{Name: "veth0", RBytesPerSec: 100, RPacketsPerSec: 2, TBytesPerSec: 50, TPacketsPerSec: 1, TErrRatio: 0.5},
+	}
+	u := prof.CalculateUsage(cur)
+	if !reflect.DeepEqual(u.Rates, expected) {
+		t.Errorf("got %#v; want %#v", u.Rates, expected)
+	}
+
+	// a zero time delta results in no per second rates.
+	prof.prior = *cur
+	u = prof.CalculateUsage(cur)
+	for i, v := range u.Rates {
+		if v.RBytesPerSec != 0 || v.TPacketsPerSec != 0 || v.RUtil != 0 {
+			t.Errorf("zero delta: %d: expected rates to be 0; got %#v", i, v)
+		}
+	}
+
+	// the speed is cached until it's due to be refreshed.
+	err = ioutil.WriteFile(filepath.Join(dir, "eth0", "speed"), []byte("1000\n"), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := &structs.DevInfo{Timestamp: cur.Timestamp + int64(time.Second), Device: cur.Device}
+	u = prof.CalculateUsage(next)
+	if u.Rates[1].Speed != 100 {
+		t.Errorf("cached speed: got %d; want 100", u.Rates[1].Speed)
+	}
+	next = &structs.DevInfo{Timestamp: cur.Timestamp + int64(speedRefresh), Device: cur.Device[:2]}
+	u = prof.CalculateUsage(next)
+	if u.Rates[1].Speed != 1000 {
+		t.Errorf("refreshed speed: got %d; want 1000", u.Rates[1].Speed)
+	}
+
+	// the speed of a removed device is evicted.
+	prof.prior = *next
+	u = prof.CalculateUsage(&structs.DevInfo{Timestamp: next.Timestamp, Device: cur.Device[:1]})
+	if !reflect.DeepEqual(u.Removed, []string{"eth0"}) {
+		t.Errorf("Removed: got %v; want [eth0]", u.Removed)
+	}
+	if _, ok := prof.speeds["eth0"]; ok {
+		t.Error("eth0: expected the speed to be evicted")
+	}
+}
+
+func TestNewProfilerRoot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "netusage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.MkdirAll(filepath.Join(dir, "proc", "net"), 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "proc", "net", "dev"), []byte(devHeader+devLine("eth0", 1000)), 0666)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof, err := NewProfiler(joe.WithRoot(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prof.sysFSNetPath != filepath.Join(dir, "sys", "class", "net") {
+		t.Errorf("sysfs net path: got %q; want %q", prof.sysFSNetPath, filepath.Join(dir, "sys", "class", "net"))
+	}
+	if len(prof.prior.Device) != 1 || prof.prior.Device[0] != device("eth0", 1000) {
+		t.Errorf("prior: got %#v; want eth0 with all counters set to 1000", prof.prior.Device)
+	}
+}
+
 func TestTicker(t *testing.T) {
 	tkr, err := NewTicker(time.Millisecond)
 	if err != nil {
@@ -70,6 +285,9 @@ func checkUsage(n string, u *structs.DevUsage, t *testing.T) {
 		t.Errorf("%s: expected devices; got none", n)
 		return
 	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
 	// check name
 	for i, v := range u.Device {
 		if v.Name == "" {
@@ -0,0 +1,278 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package netusage gets the usage of the network devices. Usage is calculated
+// by taking the difference between two network device snapshots, /proc/net/dev.
+// The time elapsed between the two snapshots is stored in the TimeDelta field.
+// Instead of returning a Go struct, it returns protobuf serialized bytes. A
+// function to deserialize the protobuf serialized bytes into a structs.DevUsage
+// struct is provided.
+//
+// Note: the package name is netusage and not the final element of the import
+// path (pb)
+package netusage
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	usage "github.com/hmmftg/joefriday/net/netusage"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/net/structs/pb"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the network device usage.
+type Profiler struct {
+	*usage.Profiler
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a
+// /proc/net/dev snapshot is taken so that any Get() will return valid
+// information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := usage.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current network device usage as protobuf serialized bytes.
+// Calculating usage requires two snapshots. This func gets the current
+// snapshot of /proc/net/dev and calculates the difference between that and the
+// prior snapshot. The current snapshot is stored for use as the prior snapshot
+// on the next Get call. If ongoing usage information is desired, the Ticker
+// should be used; it's better suited for ongoing usage information..
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current network device usage as protobuf serialized bytes
+// using the package's global Profiler. The profiler is lazily instantiated. If
+// the profiler doesn't already exist, the first usage information will not be
+// useful due to the minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize network device usage using protobuf.
+func (prof *Profiler) Serialize(u *structs.DevUsage) ([]byte, error) {
+	return proto.Marshal(toProto(u))
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(u *structs.DevUsage) ([]byte, error) {
+	return prof.Serialize(u)
+}
+
+// Serialize network device usage using protobuf with the package's global
+// Profiler.
+func Serialize(inf *structs.DevUsage) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(inf)
+}
+
+// Deserialize deserializes protobuf serialized bytes as structs.DevUsage.
+func Deserialize(p []byte) (*structs.DevUsage, error) {
+	m := &pb.DevUsage{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.DevUsage, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a structs.DevUsage into a new pb.DevUsage message.
+func toProto(u *structs.DevUsage) *pb.DevUsage {
+	m := &pb.DevUsage{
+		Timestamp: u.Timestamp,
+		TimeDelta: u.TimeDelta,
+		Device:    make([]*pb.Device, len(u.Device)),
+		Rates:     make([]*pb.DeviceRates, len(u.Rates)),
+		Added:     u.Added,
+		Removed:   u.Removed,
+	}
+	for i := range u.Device {
+		m.Device[i] = deviceToProto(&u.Device[i])
+	}
+	for i := range u.Rates {
+		m.Rates[i] = deviceRatesToProto(&u.Rates[i])
+	}
+	return m
+}
+
+// fromProto copies a pb.DevUsage message into a structs.DevUsage.
+func fromProto(m *pb.DevUsage) *structs.DevUsage {
+	u := &structs.DevUsage{
+		Timestamp: m.Timestamp,
+		TimeDelta: m.TimeDelta,
+		Device:    make([]structs.Device, len(m.Device)),
+		Rates:     make([]structs.DeviceRates, len(m.Rates)),
+		Added:     m.Added,
+		Removed:   m.Removed,
+	}
+	for i, d := range m.Device {
+		u.Device[i] = deviceFromProto(d)
+	}
+	for i, r := range m.Rates {
+		u.Rates[i] = deviceRatesFromProto(r)
+	}
+	return u
+}
+
+// deviceToProto copies a structs.Device into a new pb.Device message.
+func deviceToProto(d *structs.Device) *pb.Device {
+	return &pb.Device{
+		Name:        d.Name,
+		RBytes:      d.RBytes,
+		RPackets:    d.RPackets,
+		RErrs:       d.RErrs,
+		RDrop:       d.RDrop,
+		RFifo:       d.RFIFO,
+		RFrame:      d.RFrame,
+		RCompressed: d.RCompressed,
+		RMulticast:  d.RMulticast,
+		TBytes:      d.TBytes,
+		TPackets:    d.TPackets,
+		TErrs:       d.TErrs,
+		TDrop:       d.TDrop,
+		TFifo:       d.TFIFO,
+		TColls:      d.TColls,
+		TCarrier:    d.TCarrier,
+		TCompressed: d.TCompressed,
+	}
+}
+
+// deviceFromProto copies a pb.Device message into a structs.Device.
+func deviceFromProto(m *pb.Device) structs.Device {
+	return structs.Device{
+		Name:        m.Name,
+		RBytes:      m.RBytes,
+		RPackets:    m.RPackets,
+		RErrs:       m.RErrs,
+		RDrop:       m.RDrop,
+		RFIFO:       m.RFifo,
+		RFrame:      m.RFrame,
+		RCompressed: m.RCompressed,
+		RMulticast:  m.RMulticast,
+		TBytes:      m.TBytes,
+		TPackets:    m.TPackets,
+		TErrs:       m.TErrs,
+		TDrop:       m.TDrop,
+		TFIFO:       m.TFifo,
+		TColls:      m.TColls,
+		TCarrier:    m.TCarrier,
+		TCompressed: m.TCompressed,
+	}
+}
+
+// deviceRatesToProto copies a structs.DeviceRates into a new pb.DeviceRates
+// message.
+func deviceRatesToProto(d *structs.DeviceRates) *pb.DeviceRates {
+	return &pb.DeviceRates{
+		Name:           d.Name,
+		Speed:          d.Speed,
+		RBytesPerSec:   d.RBytesPerSec,
+		RPacketsPerSec: d.RPacketsPerSec,
+		RDropRatio:     d.RDropRatio,
+		RErrRatio:      d.RErrRatio,
+		RUtil:          d.RUtil,
+		TBytesPerSec:   d.TBytesPerSec,
+		TPacketsPerSec: d.TPacketsPerSec,
+		TDropRatio:     d.TDropRatio,
+		TErrRatio:      d.TErrRatio,
+		TUtil:          d.TUtil,
+	}
+}
+
+// deviceRatesFromProto copies a pb.DeviceRates message into a
+// structs.DeviceRates.
+func deviceRatesFromProto(m *pb.DeviceRates) structs.DeviceRates {
+	return structs.DeviceRates{
+		Name:           m.Name,
+		Speed:          m.Speed,
+		RBytesPerSec:   m.RBytesPerSec,
+		RPacketsPerSec: m.RPacketsPerSec,
+		RDropRatio:     m.RDropRatio,
+		RErrRatio:      m.RErrRatio,
+		RUtil:          m.RUtil,
+		TBytesPerSec:   m.TBytesPerSec,
+		TPacketsPerSec: m.TPacketsPerSec,
+		TDropRatio:     m.TDropRatio,
+		TErrRatio:      m.TErrRatio,
+		TUtil:          m.TUtil,
+	}
+}
+
+// Ticker delivers the network device information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
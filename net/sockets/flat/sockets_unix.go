@@ -0,0 +1,290 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sockets handles Flatbuffer based processing of the TCP and UDP
+// socket tables: /proc/net/tcp, /proc/net/tcp6, /proc/net/udp, and
+// /proc/net/udp6. Instead of returning a Go struct, it returns Flatbuffer
+// serialized bytes. Functions to deserialize the Flatbuffer serialized bytes
+// into a structs.Sockets or structs.SocketSummary struct are provided.
+//
+// Note: the package name is sockets and not the final element of the import
+// path (flat).
+package sockets
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	socks "github.com/hmmftg/joefriday/net/sockets"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/net/structs/flat"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the socket tables as Flatbuffer serialized
+// bytes.
+type Profiler struct {
+	*socks.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := socks.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current sockets as Flatbuffer serialized bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	s, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(s), nil
+}
+
+// GetSummary returns a summary of the current sockets as Flatbuffer
+// serialized bytes.
+func (prof *Profiler) GetSummary() ([]byte, error) {
+	sum, err := prof.Profiler.GetSummary()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeSummary(sum), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current sockets as Flatbuffer serialized bytes using the
+// package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		std.Builder.Reset()
+	}
+
+	return std.Get()
+}
+
+// GetSummary returns a summary of the current sockets as Flatbuffer
+// serialized bytes using the package's global Profiler.
+func GetSummary() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		std.Builder.Reset()
+	}
+
+	return std.GetSummary()
+}
+
+// Serialize serializes structs.Sockets using Flatbuffers.
+func (prof *Profiler) Serialize(s *structs.Sockets) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	sockF := make([]fb.UOffsetT, len(s.Socket))
+	protos := make([]fb.UOffsetT, len(s.Socket))
+	localAddrs := make([]fb.UOffsetT, len(s.Socket))
+	remoteAddrs := make([]fb.UOffsetT, len(s.Socket))
+	states := make([]fb.UOffsetT, len(s.Socket))
+	for i := 0; i < len(s.Socket); i++ {
+		protos[i] = prof.Builder.CreateString(s.Socket[i].Protocol)
+		localAddrs[i] = prof.Builder.CreateString(s.Socket[i].LocalAddr)
+		remoteAddrs[i] = prof.Builder.CreateString(s.Socket[i].RemoteAddr)
+		states[i] = prof.Builder.CreateString(s.Socket[i].State)
+	}
+	for i := 0; i < len(s.Socket); i++ {
+		flat.SocketStart(prof.Builder)
+		flat.SocketAddProtocol(prof.Builder, protos[i])
+		flat.SocketAddLocalAddr(prof.Builder, localAddrs[i])
+		flat.SocketAddLocalPort(prof.Builder, s.Socket[i].LocalPort)
+		flat.SocketAddRemoteAddr(prof.Builder, remoteAddrs[i])
+		flat.SocketAddRemotePort(prof.Builder, s.Socket[i].RemotePort)
+		flat.SocketAddState(prof.Builder, states[i])
+		flat.SocketAddTxQueue(prof.Builder, s.Socket[i].TxQueue)
+		flat.SocketAddRxQueue(prof.Builder, s.Socket[i].RxQueue)
+		flat.SocketAddUID(prof.Builder, s.Socket[i].UID)
+		flat.SocketAddInode(prof.Builder, s.Socket[i].Inode)
+		sockF[i] = flat.SocketEnd(prof.Builder)
+	}
+	flat.SocketsStartSocketVector(prof.Builder, len(sockF))
+	for i := len(sockF) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(sockF[i])
+	}
+	sockV := prof.Builder.EndVector(len(sockF))
+	flat.SocketsStart(prof.Builder)
+	flat.SocketsAddTimestamp(prof.Builder, s.Timestamp)
+	flat.SocketsAddSocket(prof.Builder, sockV)
+	prof.Builder.Finish(flat.SocketsEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize serializes structs.Sockets using Flatbuffers with the package's
+// global Profiler.
+func Serialize(s *structs.Sockets) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(s), nil
+}
+
+// SerializeSummary serializes structs.SocketSummary using Flatbuffers.
+func (prof *Profiler) SerializeSummary(sum *structs.SocketSummary) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	flat.SocketSummaryStart(prof.Builder)
+	flat.SocketSummaryAddTimestamp(prof.Builder, sum.Timestamp)
+	flat.SocketSummaryAddTCP(prof.Builder, sum.TCP)
+	flat.SocketSummaryAddTCP6(prof.Builder, sum.TCP6)
+	flat.SocketSummaryAddUDP(prof.Builder, sum.UDP)
+	flat.SocketSummaryAddUDP6(prof.Builder, sum.UDP6)
+	flat.SocketSummaryAddEstablished(prof.Builder, sum.Established)
+	flat.SocketSummaryAddSynSent(prof.Builder, sum.SynSent)
+	flat.SocketSummaryAddSynRecv(prof.Builder, sum.SynRecv)
+	flat.SocketSummaryAddFinWait1(prof.Builder, sum.FinWait1)
+	flat.SocketSummaryAddFinWait2(prof.Builder, sum.FinWait2)
+	flat.SocketSummaryAddTimeWait(prof.Builder, sum.TimeWait)
+	flat.SocketSummaryAddClose(prof.Builder, sum.Close)
+	flat.SocketSummaryAddCloseWait(prof.Builder, sum.CloseWait)
+	flat.SocketSummaryAddLastAck(prof.Builder, sum.LastAck)
+	flat.SocketSummaryAddListen(prof.Builder, sum.Listen)
+	flat.SocketSummaryAddClosing(prof.Builder, sum.Closing)
+	flat.SocketSummaryAddNewSynRecv(prof.Builder, sum.NewSynRecv)
+	prof.Builder.Finish(flat.SocketSummaryEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// SerializeSummary serializes structs.SocketSummary using Flatbuffers with
+// the package's global Profiler.
+func SerializeSummary(sum *structs.SocketSummary) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeSummary(sum), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them
+// as structs.Sockets.
+func Deserialize(p []byte) *structs.Sockets {
+	sF := flat.GetRootAsSockets(p, 0)
+	s := &structs.Sockets{Timestamp: sF.Timestamp(), Socket: make([]structs.Socket, sF.SocketLength())}
+	sockF := &flat.Socket{}
+	for i := 0; i < len(s.Socket); i++ {
+		var sock structs.Socket
+		if sF.Socket(sockF, i) {
+			sock.Protocol = string(sockF.Protocol())
+			sock.LocalAddr = string(sockF.LocalAddr())
+			sock.LocalPort = sockF.LocalPort()
+			sock.RemoteAddr = string(sockF.RemoteAddr())
+			sock.RemotePort = sockF.RemotePort()
+			sock.State = string(sockF.State())
+			sock.TxQueue = sockF.TxQueue()
+			sock.RxQueue = sockF.RxQueue()
+			sock.UID = sockF.UID()
+			sock.Inode = sockF.Inode()
+		}
+		s.Socket[i] = sock
+	}
+	return s
+}
+
+// DeserializeSummary takes some Flatbuffer serialized bytes and deserializes
+// them as structs.SocketSummary.
+func DeserializeSummary(p []byte) *structs.SocketSummary {
+	sumF := flat.GetRootAsSocketSummary(p, 0)
+	return &structs.SocketSummary{
+		Timestamp:   sumF.Timestamp(),
+		TCP:         sumF.TCP(),
+		TCP6:        sumF.TCP6(),
+		UDP:         sumF.UDP(),
+		UDP6:        sumF.UDP6(),
+		Established: sumF.Established(),
+		SynSent:     sumF.SynSent(),
+		SynRecv:     sumF.SynRecv(),
+		FinWait1:    sumF.FinWait1(),
+		FinWait2:    sumF.FinWait2(),
+		TimeWait:    sumF.TimeWait(),
+		Close:       sumF.Close(),
+		CloseWait:   sumF.CloseWait(),
+		LastAck:     sumF.LastAck(),
+		Listen:      sumF.Listen(),
+		Closing:     sumF.Closing(),
+		NewSynRecv:  sumF.NewSynRecv(),
+	}
+}
+
+// Ticker delivers the system's sockets at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,131 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sockets
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	s := &structs.Sockets{
+		Timestamp: 1000,
+		Socket: []structs.Socket{
+			{Protocol: "tcp", LocalAddr: "127.0.0.1", LocalPort: 631, RemoteAddr: "0.0.0.0", State: "LISTEN", Inode: 1001},
+			{Protocol: "tcp6", LocalAddr: "::1", LocalPort: 80, RemoteAddr: "::1", RemotePort: 52000, State: "CLOSE_WAIT", TxQueue: 1, RxQueue: 512, UID: 33, Inode: 2002},
+		},
+	}
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	sD := Deserialize(p)
+	if !reflect.DeepEqual(s, sD) {
+		t.Errorf("got %#v; want %#v", sD, s)
+	}
+
+	sum := &structs.SocketSummary{
+		Timestamp: 1000, TCP: 1, TCP6: 2, UDP: 3, UDP6: 4, Established: 5, SynSent: 6, SynRecv: 7, FinWait1: 8,
+		FinWait2: 9, TimeWait: 10, Close: 11, CloseWait: 12, LastAck: 13, Listen: 14, Closing: 15, NewSynRecv: 16,
+	}
+	p, err = SerializeSummary(sum)
+	if err != nil {
+		t.Fatalf("summary: unexpected error: %s", err)
+	}
+	sumD := DeserializeSummary(p)
+	if *sumD != *sum {
+		t.Errorf("summary: got %#v; want %#v", *sumD, *sum)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkSockets("get", Deserialize(p), t)
+	p, err = GetSummary()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	sum := DeserializeSummary(p)
+	if sum.Timestamp == 0 {
+		t.Error("summary: Timestamp: wanted non-zero value; got 0")
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkSockets("ticker", Deserialize(v), t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkSockets(n string, s *structs.Sockets, t *testing.T) {
+	if s.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	for i, v := range s.Socket {
+		if v.Protocol == "" {
+			t.Errorf("%s: %d: Protocol: wanted a non-empty value; was empty", n, i)
+		}
+		if v.State == "" {
+			t.Errorf("%s: %d: State: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	s, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = p.Serialize(s)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var s *structs.Sockets
+	p, _ := NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s = Deserialize(tmp)
+	}
+	_ = s
+}
@@ -0,0 +1,202 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sockets handles JSON based processing of the TCP and UDP socket
+// tables: /proc/net/tcp, /proc/net/tcp6, /proc/net/udp, and /proc/net/udp6.
+// Instead of returning a Go struct, it returns JSON serialized bytes.
+// Functions to deserialize the JSON serialized bytes into a structs.Sockets
+// or structs.SocketSummary struct are provided.
+//
+// Note: the package name is sockets and not the final element of the import
+// path (json).
+package sockets
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	socks "github.com/hmmftg/joefriday/net/sockets"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the socket tables as JSON serialized bytes.
+type Profiler struct {
+	*socks.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := socks.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current sockets as JSON serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	s, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(s)
+}
+
+// GetSummary returns a summary of the current sockets as JSON serialized
+// bytes.
+func (prof *Profiler) GetSummary() (p []byte, err error) {
+	sum, err := prof.Profiler.GetSummary()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeSummary(sum)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current sockets as JSON serialized bytes using the
+// package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetSummary returns a summary of the current sockets as JSON serialized
+// bytes using the package's global Profiler.
+func GetSummary() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetSummary()
+}
+
+// Serialize structs.Sockets using JSON.
+func (prof *Profiler) Serialize(s *structs.Sockets) ([]byte, error) {
+	return json.Marshal(s)
+}
+
+// Serialize structs.Sockets using JSON with the package's global Profiler.
+func Serialize(s *structs.Sockets) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(s)
+}
+
+// SerializeSummary serializes structs.SocketSummary using JSON.
+func (prof *Profiler) SerializeSummary(sum *structs.SocketSummary) ([]byte, error) {
+	return json.Marshal(sum)
+}
+
+// SerializeSummary serializes structs.SocketSummary using JSON with the
+// package's global Profiler.
+func SerializeSummary(sum *structs.SocketSummary) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeSummary(sum)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(s *structs.Sockets) ([]byte, error) {
+	return prof.Serialize(s)
+}
+
+// Marshal is an alias for Serialize; uses the package's global Profiler.
+func Marshal(s *structs.Sockets) ([]byte, error) {
+	return Serialize(s)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// structs.Sockets.
+func Deserialize(p []byte) (*structs.Sockets, error) {
+	s := &structs.Sockets{}
+	err := json.Unmarshal(p, s)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*structs.Sockets, error) {
+	return Deserialize(p)
+}
+
+// DeserializeSummary takes some JSON serialized bytes and unmarshals them as
+// structs.SocketSummary.
+func DeserializeSummary(p []byte) (*structs.SocketSummary, error) {
+	sum := &structs.SocketSummary{}
+	err := json.Unmarshal(p, sum)
+	if err != nil {
+		return nil, err
+	}
+	return sum, nil
+}
+
+// Ticker delivers the system's sockets at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
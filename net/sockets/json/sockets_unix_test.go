@@ -0,0 +1,101 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sockets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	s, err := Deserialize(p)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkSockets("get", s, t)
+	p, err = GetSummary()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	sum, err := DeserializeSummary(p)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if sum.Timestamp == 0 {
+		t.Error("summary: Timestamp: wanted non-zero value; got 0")
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			s, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkSockets("ticker", s, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkSockets(n string, s *structs.Sockets, t *testing.T) {
+	if s.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	for i, v := range s.Socket {
+		if v.Protocol == "" {
+			t.Errorf("%s: %d: Protocol: wanted a non-empty value; was empty", n, i)
+		}
+		if v.State == "" {
+			t.Errorf("%s: %d: State: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
@@ -0,0 +1,398 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sockets processes the TCP and UDP socket tables: /proc/net/tcp,
+// /proc/net/tcp6, /proc/net/udp, and /proc/net/udp6. Either the sockets
+// themselves or a summary of the number of sockets in each table and the
+// number of TCP sockets in each state, like ss -s, can be returned.
+package sockets
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const (
+	TCPFile  = "/proc/net/tcp"
+	TCP6File = "/proc/net/tcp6"
+	UDPFile  = "/proc/net/udp"
+	UDP6File = "/proc/net/udp6"
+)
+
+// TCP socket states, as used by the kernel: include/net/tcp_states.h.
+const (
+	Established uint8 = iota + 1
+	SynSent
+	SynRecv
+	FinWait1
+	FinWait2
+	TimeWait
+	Close
+	CloseWait
+	LastAck
+	Listen
+	Closing
+	NewSynRecv
+)
+
+var states = [...]string{
+	"UNKNOWN",
+	"ESTABLISHED",
+	"SYN_SENT",
+	"SYN_RECV",
+	"FIN_WAIT1",
+	"FIN_WAIT2",
+	"TIME_WAIT",
+	"CLOSE",
+	"CLOSE_WAIT",
+	"LAST_ACK",
+	"LISTEN",
+	"CLOSING",
+	"NEW_SYN_RECV",
+}
+
+// StateName returns the name of the TCP state. Unknown states are returned
+// as UNKNOWN.
+func StateName(st uint8) string {
+	if int(st) >= len(states) {
+		return states[0]
+	}
+	return states[st]
+}
+
+// Profiler is used to process the socket tables.
+type Profiler struct {
+	*joe.Buffer
+	// The socket tables. A nil table, e.g. tcp6 on a system without IPv6
+	// support, is skipped.
+	TCP  joe.Procer
+	TCP6 joe.Procer
+	UDP  joe.Procer
+	UDP6 joe.Procer
+}
+
+// Returns an initialized Profiler; ready to use. Socket tables that don't
+// exist are skipped.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	prof = &Profiler{Buffer: joe.NewBuffer()}
+	prof.TCP, err = newProc(o, TCPFile)
+	if err != nil {
+		return nil, err
+	}
+	prof.TCP6, err = newProc(o, TCP6File)
+	if err != nil {
+		return nil, err
+	}
+	prof.UDP, err = newProc(o, UDPFile)
+	if err != nil {
+		return nil, err
+	}
+	prof.UDP6, err = newProc(o, UDP6File)
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// newProc returns a Procer for the file, using the Options; if the file
+// doesn't exist, nil is returned.
+func newProc(o joe.Options, fname string) (joe.Procer, error) {
+	proc, err := o.NewProc(o.Path(fname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return proc, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	for _, p := range []joe.Procer{prof.TCP, prof.TCP6, prof.UDP, prof.UDP6} {
+		if p == nil {
+			continue
+		}
+		err := p.Reset()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the current sockets.
+func (prof *Profiler) Get() (socks *structs.Sockets, err error) {
+	err = prof.Reset()
+	if err != nil {
+		return nil, err
+	}
+	socks = &structs.Sockets{Timestamp: time.Now().UTC().UnixNano(), Socket: make([]structs.Socket, 0, 16)}
+	for _, t := range []struct {
+		proto string
+		proc  joe.Procer
+	}{
+		{"tcp", prof.TCP},
+		{"tcp6", prof.TCP6},
+		{"udp", prof.UDP},
+		{"udp6", prof.UDP6},
+	} {
+		if t.proc == nil {
+			continue
+		}
+		socks.Socket, err = prof.parse(t.proto, t.proc, socks.Socket)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return socks, nil
+}
+
+// GetSummary returns a summary of the current sockets.
+func (prof *Profiler) GetSummary() (*structs.SocketSummary, error) {
+	socks, err := prof.Get()
+	if err != nil {
+		return nil, err
+	}
+	return Summarize(socks), nil
+}
+
+// parse processes a socket table, appending its sockets to socks.
+func (prof *Profiler) parse(proto string, proc joe.Procer, socks []structs.Socket) ([]structs.Socket, error) {
+	var (
+		i, pos, line, fieldNum int
+		n                      uint64
+		err                    error
+		sock                   structs.Socket
+	)
+	for {
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, &joe.ReadError{Info: proto, Err: err}
+		}
+		line++
+		// skip the header
+		if line == 1 {
+			continue
+		}
+		sock = structs.Socket{Protocol: proto}
+		pos = 0
+		fieldNum = 0
+		for fieldNum < 10 {
+			// skip the spaces
+			for ; pos < len(prof.Line); pos++ {
+				if prof.Line[pos] != 0x20 {
+					break
+				}
+			}
+			for i = pos; i < len(prof.Line); i++ {
+				if prof.Line[i] == 0x20 || prof.Line[i] == '\n' {
+					break
+				}
+			}
+			if i == pos {
+				return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d: field %d", proto, line, fieldNum+1), Err: fmt.Errorf("unexpected end of line")}
+			}
+			prof.Val = prof.Line[pos:i]
+			pos = i
+			fieldNum++
+			switch fieldNum {
+			case 2:
+				sock.LocalAddr, sock.LocalPort, err = parseAddr(prof.Val)
+			case 3:
+				sock.RemoteAddr, sock.RemotePort, err = parseAddr(prof.Val)
+			case 4:
+				n, err = tools.ParseHex(prof.Val)
+				sock.State = StateName(uint8(n))
+			case 5: // tx_queue:rx_queue
+				for i = 0; i < len(prof.Val); i++ {
+					if prof.Val[i] == ':' {
+						break
+					}
+				}
+				if i == len(prof.Val) {
+					err = fmt.Errorf("%q: missing ':'", prof.Val)
+					break
+				}
+				sock.TxQueue, err = tools.ParseHex(prof.Val[:i])
+				if err != nil {
+					break
+				}
+				sock.RxQueue, err = tools.ParseHex(prof.Val[i+1:])
+			case 8:
+				n, err = tools.ParseUint(prof.Val)
+				sock.UID = uint32(n)
+			case 10:
+				sock.Inode, err = tools.ParseUint(prof.Val)
+			}
+			if err != nil {
+				return nil, &joe.ParseError{Info: fmt.Sprintf("%s: line %d: field %d", proto, line, fieldNum), Err: err}
+			}
+		}
+		socks = append(socks, sock)
+	}
+	return socks, nil
+}
+
+// parseAddr parses an address:port pair from a socket table. The address is
+// the hex representation of the IPv4 or IPv6 address as one, or four, 32-bit
+// words in host byte order. The port is the hex representation of the port.
+func parseAddr(b []byte) (addr string, port uint16, err error) {
+	var i int
+	for i = 0; i < len(b); i++ {
+		if b[i] == ':' {
+			break
+		}
+	}
+	if i != 8 && i != 32 || i == len(b) {
+		return "", 0, fmt.Errorf("%q: not a valid address:port", b)
+	}
+	ip := make(net.IP, i/2)
+	for j := 0; j < i; j += 8 {
+		v, err := tools.ParseHex(b[j : j+8])
+		if err != nil {
+			return "", 0, err
+		}
+		binary.NativeEndian.PutUint32(ip[j/2:], uint32(v))
+	}
+	v, err := tools.ParseHex(b[i+1:])
+	if err != nil {
+		return "", 0, err
+	}
+	if v > 0xFFFF {
+		return "", 0, fmt.Errorf("%q: port out of range", b[i+1:])
+	}
+	return ip.String(), uint16(v), nil
+}
+
+// Summarize returns the summary of the sockets.
+func Summarize(socks *structs.Sockets) *structs.SocketSummary {
+	sum := &structs.SocketSummary{Timestamp: socks.Timestamp}
+	for i := 0; i < len(socks.Socket); i++ {
+		switch socks.Socket[i].Protocol {
+		case "tcp":
+			sum.TCP++
+		case "tcp6":
+			sum.TCP6++
+		case "udp":
+			sum.UDP++
+			continue
+		case "udp6":
+			sum.UDP6++
+			continue
+		}
+		switch socks.Socket[i].State {
+		case "ESTABLISHED":
+			sum.Established++
+		case "SYN_SENT":
+			sum.SynSent++
+		case "SYN_RECV":
+			sum.SynRecv++
+		case "FIN_WAIT1":
+			sum.FinWait1++
+		case "FIN_WAIT2":
+			sum.FinWait2++
+		case "TIME_WAIT":
+			sum.TimeWait++
+		case "CLOSE":
+			sum.Close++
+		case "CLOSE_WAIT":
+			sum.CloseWait++
+		case "LAST_ACK":
+			sum.LastAck++
+		case "LISTEN":
+			sum.Listen++
+		case "CLOSING":
+			sum.Closing++
+		case "NEW_SYN_RECV":
+			sum.NewSynRecv++
+		}
+	}
+	return sum
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current sockets using the package's global Profiler.
+func Get() (socks *structs.Sockets, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetSummary returns a summary of the current sockets using the package's
+// global Profiler.
+func GetSummary() (sum *structs.SocketSummary, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetSummary()
+}
+
+// Ticker delivers the system's sockets at intervals.
+type Ticker struct {
+	*ticker.Ticker[*structs.Sockets]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*structs.Sockets, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
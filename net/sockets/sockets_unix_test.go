@@ -0,0 +1,216 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sockets
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+// hexAddr returns the ip and port as they appear in the socket tables.
+func hexAddr(ip string, port uint16) string {
+	b := net.ParseIP(ip)
+	if v4 := b.To4(); v4 != nil {
+		b = v4
+	}
+	var s string
+	for i := 0; i < len(b); i += 4 {
+		s += fmt.Sprintf("%08X", binary.NativeEndian.Uint32(b[i:]))
+	}
+	return fmt.Sprintf("%s:%04X", s, port)
+}
+
+const tcpHeader = "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n"
+
+func tableLine(sl int, local, remote string, st uint8, tx, rx uint64, uid uint32, inode uint64) string {
+	return fmt.Sprintf("%4d: %s %s %02X %08X:%08X 00:00000000 00000000 %5d        0 %d 1 0000000000000000 100 0 0 10 0\n", sl, local, remote, st, tx, rx, uid, inode)
+}
+
+func TestGet(t *testing.T) {
+	tcp, err := joe.NewTempFileProc("sockets", "tcp", []byte(tcpHeader+
+		tableLine(0, hexAddr("127.0.0.1", 631), hexAddr("0.0.0.0", 0), Listen, 0, 0, 0, 1001)+
+		tableLine(1, hexAddr("10.0.0.2", 22), hexAddr("10.0.0.9", 51000), Established, 36, 0, 0, 1002)+
+		tableLine(2, hexAddr("10.0.0.2", 40000), hexAddr("93.184.216.34", 443), TimeWait, 0, 0, 0, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcp.Remove()
+	tcp6, err := joe.NewTempFileProc("sockets", "tcp6", []byte(tcpHeader+
+		tableLine(0, hexAddr("::", 80), hexAddr("::", 0), Listen, 0, 0, 33, 2001)+
+		tableLine(1, hexAddr("::1", 80), hexAddr("::1", 52000), CloseWait, 0, 512, 33, 2002)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tcp6.Remove()
+	udp, err := joe.NewTempFileProc("sockets", "udp", []byte(tcpHeader+
+		tableLine(0, hexAddr("0.0.0.0", 68), hexAddr("0.0.0.0", 0), Close, 0, 0, 101, 3001)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer udp.Remove()
+
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.TCP = tcp
+	prof.TCP6 = tcp6
+	prof.UDP = udp
+	prof.UDP6 = nil
+	socks, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := []structs.Socket{
+		{Protocol: "tcp", LocalAddr: "127.0.0.1", LocalPort: 631, RemoteAddr: "0.0.0.0", State: "LISTEN", Inode: 1001},
+		{Protocol: "tcp", LocalAddr: "10.0.0.2", LocalPort: 22, RemoteAddr: "10.0.0.9", RemotePort: 51000, State: "ESTABLISHED", TxQueue: 36, Inode: 1002},
+		{Protocol: "tcp", LocalAddr: "10.0.0.2", LocalPort: 40000, RemoteAddr: "93.184.216.34", RemotePort: 443, State: "TIME_WAIT"},
+		{Protocol: "tcp6", LocalAddr: "::", LocalPort: 80, RemoteAddr: "::", State: "LISTEN", UID: 33, Inode: 2001},
+		{Protocol: "tcp6", LocalAddr: "::1", LocalPort: 80, RemoteAddr: "::1", RemotePort: 52000, State: "CLOSE_WAIT", RxQueue: 512, UID: 33, Inode: 2002},
+		{Protocol: "udp", LocalAddr: "0.0.0.0", LocalPort: 68, RemoteAddr: "0.0.0.0", State: "CLOSE", UID: 101, Inode: 3001},
+	}
+	if !reflect.DeepEqual(socks.Socket, expected) {
+		t.Errorf("got %#v; want %#v", socks.Socket, expected)
+	}
+
+	sum := Summarize(socks)
+	expectedSum := structs.SocketSummary{
+		Timestamp: socks.Timestamp, TCP: 3, TCP6: 2, UDP: 1,
+		Established: 1, TimeWait: 1, CloseWait: 1, Listen: 2,
+	}
+	if *sum != expectedSum {
+		t.Errorf("summary: got %#v; want %#v", *sum, expectedSum)
+	}
+
+	// the tables are re-read on each Get.
+	socks, err = prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(socks.Socket) != len(expected) {
+		t.Errorf("second get: got %d sockets; want %d", len(socks.Socket), len(expected))
+	}
+}
+
+func TestParseAddr(t *testing.T) {
+	tests := []struct {
+		v    string
+		addr string
+		port uint16
+		err  bool
+	}{
+		{hexAddr("192.168.1.10", 8080), "192.168.1.10", 8080, false},
+		{hexAddr("fe80::1", 53), "fe80::1", 53, false},
+		{hexAddr("::ffff:10.1.2.3", 443), "10.1.2.3", 443, false},
+		{"0100007F", "", 0, true},
+		{"0100007:0050", "", 0, true},
+		{"0100007G:0050", "", 0, true},
+		{"0100007F:10000", "", 0, true},
+	}
+	for _, test := range tests {
+		addr, port, err := parseAddr([]byte(test.v))
+		if err != nil {
+			if !test.err {
+				t.Errorf("%s: unexpected error: %s", test.v, err)
+			}
+			continue
+		}
+		if test.err {
+			t.Errorf("%s: expected an error; got none", test.v)
+			continue
+		}
+		if addr != test.addr {
+			t.Errorf("%s: addr: got %s; want %s", test.v, addr, test.addr)
+		}
+		if port != test.port {
+			t.Errorf("%s: port: got %d; want %d", test.v, port, test.port)
+		}
+	}
+}
+
+func TestGetLive(t *testing.T) {
+	socks, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkSockets("get", socks, t)
+	sum, err := GetSummary()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if sum.Timestamp == 0 {
+		t.Error("summary: Timestamp: wanted non-zero value; got 0")
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkSockets("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkSockets(n string, socks *structs.Sockets, t *testing.T) {
+	if socks.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	for i, v := range socks.Socket {
+		if v.Protocol == "" {
+			t.Errorf("%s: %d: Protocol: wanted a non-empty value; was empty", n, i)
+		}
+		if v.LocalAddr == "" {
+			t.Errorf("%s: %d: LocalAddr: wanted a non-empty value; was empty", n, i)
+		}
+		if v.State == "" || v.State == "UNKNOWN" {
+			t.Errorf("%s: %d: State: got %q; wanted a known state", n, i, v.State)
+		}
+	}
+}
+
+var socks *structs.Sockets
+
+func BenchmarkGet(b *testing.B) {
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		socks, _ = p.Get()
+	}
+	_ = socks
+}
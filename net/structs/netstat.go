@@ -0,0 +1,370 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package structs
+
+// Netstat contains the kernel's network protocol counters from
+// /proc/net/snmp, /proc/net/netstat, and /proc/net/snmp6. The sections of a
+// file that doesn't exist, e.g. /proc/net/snmp6 on a system without IPv6
+// support, are zero.
+type Netstat struct {
+	Timestamp int64  `json:"timestamp"`
+	IP        IP     `json:"ip"`
+	ICMP      ICMP   `json:"icmp"`
+	TCP       TCP    `json:"tcp"`
+	UDP       UDP    `json:"udp"`
+	TCPExt    TCPExt `json:"tcp_ext"`
+	IP6       IP6    `json:"ip6"`
+	ICMP6     ICMP6  `json:"icmp6"`
+	UDP6      UDP6   `json:"udp6"`
+}
+
+// NetstatUsage contains the change in the kernel's network protocol counters.
+// Usage is calculated as the delta between two Netstat snapshots; the
+// TimeDelta field holds the time elapsed between the two snapshots used to
+// calculate the usage. Fields that aren't counters, e.g. TCP.CurrEstab, hold
+// their current value.
+type NetstatUsage struct {
+	Timestamp int64  `json:"timestamp"`
+	TimeDelta int64  `json:"time_delta"`
+	IP        IP     `json:"ip"`
+	ICMP      ICMP   `json:"icmp"`
+	TCP       TCP    `json:"tcp"`
+	UDP       UDP    `json:"udp"`
+	TCPExt    TCPExt `json:"tcp_ext"`
+	IP6       IP6    `json:"ip6"`
+	ICMP6     ICMP6  `json:"icmp6"`
+	UDP6      UDP6   `json:"udp6"`
+}
+
+// IP contains the IPv4 counters from /proc/net/snmp.
+type IP struct {
+	Forwarding      int64 `json:"forwarding"`
+	DefaultTTL      int64 `json:"default_ttl"`
+	InReceives      int64 `json:"in_receives"`
+	InHdrErrors     int64 `json:"in_hdr_errors"`
+	InAddrErrors    int64 `json:"in_addr_errors"`
+	ForwDatagrams   int64 `json:"forw_datagrams"`
+	InUnknownProtos int64 `json:"in_unknown_protos"`
+	InDiscards      int64 `json:"in_discards"`
+	InDelivers      int64 `json:"in_delivers"`
+	OutRequests     int64 `json:"out_requests"`
+	OutDiscards     int64 `json:"out_discards"`
+	OutNoRoutes     int64 `json:"out_no_routes"`
+	ReasmTimeout    int64 `json:"reasm_timeout"`
+	ReasmReqds      int64 `json:"reasm_reqds"`
+	ReasmOKs        int64 `json:"reasm_oks"`
+	ReasmFails      int64 `json:"reasm_fails"`
+	FragOKs         int64 `json:"frag_oks"`
+	FragFails       int64 `json:"frag_fails"`
+	FragCreates     int64 `json:"frag_creates"`
+	OutTransmits    int64 `json:"out_transmits"`
+}
+
+// ICMP contains the ICMP counters from /proc/net/snmp.
+type ICMP struct {
+	InMsgs             int64 `json:"in_msgs"`
+	InErrors           int64 `json:"in_errors"`
+	InCsumErrors       int64 `json:"in_csum_errors"`
+	InDestUnreachs     int64 `json:"in_dest_unreachs"`
+	InTimeExcds        int64 `json:"in_time_excds"`
+	InParmProbs        int64 `json:"in_parm_probs"`
+	InSrcQuenchs       int64 `json:"in_src_quenchs"`
+	InRedirects        int64 `json:"in_redirects"`
+	InEchos            int64 `json:"in_echos"`
+	InEchoReps         int64 `json:"in_echo_reps"`
+	InTimestamps       int64 `json:"in_timestamps"`
+	InTimestampReps    int64 `json:"in_timestamp_reps"`
+	InAddrMasks        int64 `json:"in_addr_masks"`
+	InAddrMaskReps     int64 `json:"in_addr_mask_reps"`
+	OutMsgs            int64 `json:"out_msgs"`
+	OutErrors          int64 `json:"out_errors"`
+	OutRateLimitGlobal int64 `json:"out_rate_limit_global"`
+	OutRateLimitHost   int64 `json:"out_rate_limit_host"`
+	OutDestUnreachs    int64 `json:"out_dest_unreachs"`
+	OutTimeExcds       int64 `json:"out_time_excds"`
+	OutParmProbs       int64 `json:"out_parm_probs"`
+	OutSrcQuenchs      int64 `json:"out_src_quenchs"`
+	OutRedirects       int64 `json:"out_redirects"`
+	OutEchos           int64 `json:"out_echos"`
+	OutEchoReps        int64 `json:"out_echo_reps"`
+	OutTimestamps      int64 `json:"out_timestamps"`
+	OutTimestampReps   int64 `json:"out_timestamp_reps"`
+	OutAddrMasks       int64 `json:"out_addr_masks"`
+	OutAddrMaskReps    int64 `json:"out_addr_mask_reps"`
+}
+
+// TCP contains the TCP counters from /proc/net/snmp. RtoAlgorithm, RtoMin,
+// RtoMax, and MaxConn are configuration values and CurrEstab is the number of
+// connections currently in the ESTABLISHED or CLOSE-WAIT state; the rest are
+// counters.
+type TCP struct {
+	RtoAlgorithm int64 `json:"rto_algorithm"`
+	RtoMin       int64 `json:"rto_min"`
+	RtoMax       int64 `json:"rto_max"`
+	MaxConn      int64 `json:"max_conn"`
+	ActiveOpens  int64 `json:"active_opens"`
+	PassiveOpens int64 `json:"passive_opens"`
+	AttemptFails int64 `json:"attempt_fails"`
+	EstabResets  int64 `json:"estab_resets"`
+	CurrEstab    int64 `json:"curr_estab"`
+	InSegs       int64 `json:"in_segs"`
+	OutSegs      int64 `json:"out_segs"`
+	RetransSegs  int64 `json:"retrans_segs"`
+	InErrs       int64 `json:"in_errs"`
+	OutRsts      int64 `json:"out_rsts"`
+	InCsumErrors int64 `json:"in_csum_errors"`
+}
+
+// UDP contains the UDP counters from /proc/net/snmp.
+type UDP struct {
+	InDatagrams  int64 `json:"in_datagrams"`
+	NoPorts      int64 `json:"no_ports"`
+	InErrors     int64 `json:"in_errors"`
+	OutDatagrams int64 `json:"out_datagrams"`
+	RcvbufErrors int64 `json:"rcvbuf_errors"`
+	SndbufErrors int64 `json:"sndbuf_errors"`
+	InCsumErrors int64 `json:"in_csum_errors"`
+	IgnoredMulti int64 `json:"ignored_multi"`
+	MemErrors    int64 `json:"mem_errors"`
+}
+
+// TCPExt contains the extended TCP counters from /proc/net/netstat.
+type TCPExt struct {
+	SyncookiesSent            int64 `json:"syncookies_sent"`
+	SyncookiesRecv            int64 `json:"syncookies_recv"`
+	SyncookiesFailed          int64 `json:"syncookies_failed"`
+	EmbryonicRsts             int64 `json:"embryonic_rsts"`
+	PruneCalled               int64 `json:"prune_called"`
+	RcvPruned                 int64 `json:"rcv_pruned"`
+	OfoPruned                 int64 `json:"ofo_pruned"`
+	OutOfWindowIcmps          int64 `json:"out_of_window_icmps"`
+	LockDroppedIcmps          int64 `json:"lock_dropped_icmps"`
+	ArpFilter                 int64 `json:"arp_filter"`
+	TW                        int64 `json:"tw"`
+	TWRecycled                int64 `json:"tw_recycled"`
+	TWKilled                  int64 `json:"tw_killed"`
+	PAWSActive                int64 `json:"paws_active"`
+	PAWSEstab                 int64 `json:"paws_estab"`
+	BeyondWindow              int64 `json:"beyond_window"`
+	TSEcrRejected             int64 `json:"ts_ecr_rejected"`
+	PAWSOldAck                int64 `json:"paws_old_ack"`
+	PAWSTimewait              int64 `json:"paws_timewait"`
+	DelayedACKs               int64 `json:"delayed_acks"`
+	DelayedACKLocked          int64 `json:"delayed_ack_locked"`
+	DelayedACKLost            int64 `json:"delayed_ack_lost"`
+	ListenOverflows           int64 `json:"listen_overflows"`
+	ListenDrops               int64 `json:"listen_drops"`
+	TCPHPHits                 int64 `json:"tcp_hp_hits"`
+	TCPPureAcks               int64 `json:"tcp_pure_acks"`
+	TCPHPAcks                 int64 `json:"tcp_hp_acks"`
+	TCPRenoRecovery           int64 `json:"tcp_reno_recovery"`
+	TCPSackRecovery           int64 `json:"tcp_sack_recovery"`
+	TCPSACKReneging           int64 `json:"tcp_sack_reneging"`
+	TCPSACKReorder            int64 `json:"tcp_sack_reorder"`
+	TCPRenoReorder            int64 `json:"tcp_reno_reorder"`
+	TCPTSReorder              int64 `json:"tcp_ts_reorder"`
+	TCPFullUndo               int64 `json:"tcp_full_undo"`
+	TCPPartialUndo            int64 `json:"tcp_partial_undo"`
+	TCPDSACKUndo              int64 `json:"tcp_dsack_undo"`
+	TCPLossUndo               int64 `json:"tcp_loss_undo"`
+	TCPLostRetransmit         int64 `json:"tcp_lost_retransmit"`
+	TCPRenoFailures           int64 `json:"tcp_reno_failures"`
+	TCPSackFailures           int64 `json:"tcp_sack_failures"`
+	TCPLossFailures           int64 `json:"tcp_loss_failures"`
+	TCPFastRetrans            int64 `json:"tcp_fast_retrans"`
+	TCPSlowStartRetrans       int64 `json:"tcp_slow_start_retrans"`
+	TCPTimeouts               int64 `json:"tcp_timeouts"`
+	TCPLossProbes             int64 `json:"tcp_loss_probes"`
+	TCPLossProbeRecovery      int64 `json:"tcp_loss_probe_recovery"`
+	TCPRenoRecoveryFail       int64 `json:"tcp_reno_recovery_fail"`
+	TCPSackRecoveryFail       int64 `json:"tcp_sack_recovery_fail"`
+	TCPRcvCollapsed           int64 `json:"tcp_rcv_collapsed"`
+	TCPBacklogCoalesce        int64 `json:"tcp_backlog_coalesce"`
+	TCPDSACKOldSent           int64 `json:"tcp_dsack_old_sent"`
+	TCPDSACKOfoSent           int64 `json:"tcp_dsack_ofo_sent"`
+	TCPDSACKRecv              int64 `json:"tcp_dsack_recv"`
+	TCPDSACKOfoRecv           int64 `json:"tcp_dsack_ofo_recv"`
+	TCPAbortOnData            int64 `json:"tcp_abort_on_data"`
+	TCPAbortOnClose           int64 `json:"tcp_abort_on_close"`
+	TCPAbortOnMemory          int64 `json:"tcp_abort_on_memory"`
+	TCPAbortOnTimeout         int64 `json:"tcp_abort_on_timeout"`
+	TCPAbortOnLinger          int64 `json:"tcp_abort_on_linger"`
+	TCPAbortFailed            int64 `json:"tcp_abort_failed"`
+	TCPMemoryPressures        int64 `json:"tcp_memory_pressures"`
+	TCPMemoryPressuresChrono  int64 `json:"tcp_memory_pressures_chrono"`
+	TCPSACKDiscard            int64 `json:"tcp_sack_discard"`
+	TCPDSACKIgnoredOld        int64 `json:"tcp_dsack_ignored_old"`
+	TCPDSACKIgnoredNoUndo     int64 `json:"tcp_dsack_ignored_no_undo"`
+	TCPSpuriousRTOs           int64 `json:"tcp_spurious_rtos"`
+	TCPMD5NotFound            int64 `json:"tcp_md5_not_found"`
+	TCPMD5Unexpected          int64 `json:"tcp_md5_unexpected"`
+	TCPMD5Failure             int64 `json:"tcp_md5_failure"`
+	TCPSackShifted            int64 `json:"tcp_sack_shifted"`
+	TCPSackMerged             int64 `json:"tcp_sack_merged"`
+	TCPSackShiftFallback      int64 `json:"tcp_sack_shift_fallback"`
+	TCPBacklogDrop            int64 `json:"tcp_backlog_drop"`
+	PFMemallocDrop            int64 `json:"pf_memalloc_drop"`
+	TCPMinTTLDrop             int64 `json:"tcp_min_ttl_drop"`
+	TCPDeferAcceptDrop        int64 `json:"tcp_defer_accept_drop"`
+	IPReversePathFilter       int64 `json:"ip_reverse_path_filter"`
+	TCPTimeWaitOverflow       int64 `json:"tcp_time_wait_overflow"`
+	TCPReqQFullDoCookies      int64 `json:"tcp_req_q_full_do_cookies"`
+	TCPReqQFullDrop           int64 `json:"tcp_req_q_full_drop"`
+	TCPRetransFail            int64 `json:"tcp_retrans_fail"`
+	TCPRcvCoalesce            int64 `json:"tcp_rcv_coalesce"`
+	TCPOFOQueue               int64 `json:"tcp_ofo_queue"`
+	TCPOFODrop                int64 `json:"tcp_ofo_drop"`
+	TCPOFOMerge               int64 `json:"tcp_ofo_merge"`
+	TCPChallengeACK           int64 `json:"tcp_challenge_ack"`
+	TCPSYNChallenge           int64 `json:"tcp_syn_challenge"`
+	TCPFastOpenActive         int64 `json:"tcp_fast_open_active"`
+	TCPFastOpenActiveFail     int64 `json:"tcp_fast_open_active_fail"`
+	TCPFastOpenPassive        int64 `json:"tcp_fast_open_passive"`
+	TCPFastOpenPassiveFail    int64 `json:"tcp_fast_open_passive_fail"`
+	TCPFastOpenListenOverflow int64 `json:"tcp_fast_open_listen_overflow"`
+	TCPFastOpenCookieReqd     int64 `json:"tcp_fast_open_cookie_reqd"`
+	TCPFastOpenBlackhole      int64 `json:"tcp_fast_open_blackhole"`
+	TCPSpuriousRtxHostQueues  int64 `json:"tcp_spurious_rtx_host_queues"`
+	BusyPollRxPackets         int64 `json:"busy_poll_rx_packets"`
+	TCPAutoCorking            int64 `json:"tcp_auto_corking"`
+	TCPFromZeroWindowAdv      int64 `json:"tcp_from_zero_window_adv"`
+	TCPToZeroWindowAdv        int64 `json:"tcp_to_zero_window_adv"`
+	TCPWantZeroWindowAdv      int64 `json:"tcp_want_zero_window_adv"`
+	TCPSynRetrans             int64 `json:"tcp_syn_retrans"`
+	TCPOrigDataSent           int64 `json:"tcp_orig_data_sent"`
+	TCPHystartTrainDetect     int64 `json:"tcp_hystart_train_detect"`
+	TCPHystartTrainCwnd       int64 `json:"tcp_hystart_train_cwnd"`
+	TCPHystartDelayDetect     int64 `json:"tcp_hystart_delay_detect"`
+	TCPHystartDelayCwnd       int64 `json:"tcp_hystart_delay_cwnd"`
+	TCPACKSkippedSynRecv      int64 `json:"tcp_ack_skipped_syn_recv"`
+	TCPACKSkippedPAWS         int64 `json:"tcp_ack_skipped_paws"`
+	TCPACKSkippedSeq          int64 `json:"tcp_ack_skipped_seq"`
+	TCPACKSkippedFinWait2     int64 `json:"tcp_ack_skipped_fin_wait2"`
+	TCPACKSkippedTimeWait     int64 `json:"tcp_ack_skipped_time_wait"`
+	TCPACKSkippedChallenge    int64 `json:"tcp_ack_skipped_challenge"`
+	TCPWinProbe               int64 `json:"tcp_win_probe"`
+	TCPKeepAlive              int64 `json:"tcp_keep_alive"`
+	TCPMTUPFail               int64 `json:"tcp_mtup_fail"`
+	TCPMTUPSuccess            int64 `json:"tcp_mtup_success"`
+	TCPDelivered              int64 `json:"tcp_delivered"`
+	TCPDeliveredCE            int64 `json:"tcp_delivered_ce"`
+	TCPAckCompressed          int64 `json:"tcp_ack_compressed"`
+	TCPZeroWindowDrop         int64 `json:"tcp_zero_window_drop"`
+	TCPRcvQDrop               int64 `json:"tcp_rcv_q_drop"`
+	TCPWqueueTooBig           int64 `json:"tcp_wqueue_too_big"`
+	TCPFastOpenPassiveAltKey  int64 `json:"tcp_fast_open_passive_alt_key"`
+	TcpTimeoutRehash          int64 `json:"tcp_timeout_rehash"`
+	TcpDuplicateDataRehash    int64 `json:"tcp_duplicate_data_rehash"`
+	TCPDSACKRecvSegs          int64 `json:"tcp_dsack_recv_segs"`
+	TCPDSACKIgnoredDubious    int64 `json:"tcp_dsack_ignored_dubious"`
+	TCPMigrateReqSuccess      int64 `json:"tcp_migrate_req_success"`
+	TCPMigrateReqFailure      int64 `json:"tcp_migrate_req_failure"`
+	TCPPLBRehash              int64 `json:"tcp_plb_rehash"`
+	TCPAORequired             int64 `json:"tcp_ao_required"`
+	TCPAOBad                  int64 `json:"tcp_ao_bad"`
+	TCPAOKeyNotFound          int64 `json:"tcp_ao_key_not_found"`
+	TCPAOGood                 int64 `json:"tcp_ao_good"`
+	TCPAODroppedIcmps         int64 `json:"tcp_ao_dropped_icmps"`
+}
+
+// IP6 contains the IPv6 counters from /proc/net/snmp6.
+type IP6 struct {
+	InReceives       int64 `json:"in_receives"`
+	InHdrErrors      int64 `json:"in_hdr_errors"`
+	InTooBigErrors   int64 `json:"in_too_big_errors"`
+	InNoRoutes       int64 `json:"in_no_routes"`
+	InAddrErrors     int64 `json:"in_addr_errors"`
+	InUnknownProtos  int64 `json:"in_unknown_protos"`
+	InTruncatedPkts  int64 `json:"in_truncated_pkts"`
+	InDiscards       int64 `json:"in_discards"`
+	InDelivers       int64 `json:"in_delivers"`
+	OutForwDatagrams int64 `json:"out_forw_datagrams"`
+	OutRequests      int64 `json:"out_requests"`
+	OutDiscards      int64 `json:"out_discards"`
+	OutNoRoutes      int64 `json:"out_no_routes"`
+	ReasmTimeout     int64 `json:"reasm_timeout"`
+	ReasmReqds       int64 `json:"reasm_reqds"`
+	ReasmOKs         int64 `json:"reasm_oks"`
+	ReasmFails       int64 `json:"reasm_fails"`
+	FragOKs          int64 `json:"frag_oks"`
+	FragFails        int64 `json:"frag_fails"`
+	FragCreates      int64 `json:"frag_creates"`
+	InMcastPkts      int64 `json:"in_mcast_pkts"`
+	OutMcastPkts     int64 `json:"out_mcast_pkts"`
+	InOctets         int64 `json:"in_octets"`
+	OutOctets        int64 `json:"out_octets"`
+	InMcastOctets    int64 `json:"in_mcast_octets"`
+	OutMcastOctets   int64 `json:"out_mcast_octets"`
+	InBcastOctets    int64 `json:"in_bcast_octets"`
+	OutBcastOctets   int64 `json:"out_bcast_octets"`
+	InNoECTPkts      int64 `json:"in_no_ect_pkts"`
+	InECT1Pkts       int64 `json:"in_ect1_pkts"`
+	InECT0Pkts       int64 `json:"in_ect0_pkts"`
+	InCEPkts         int64 `json:"in_ce_pkts"`
+	OutTransmits     int64 `json:"out_transmits"`
+}
+
+// ICMP6 contains the ICMPv6 counters from /proc/net/snmp6. The per message
+// type counters, Icmp6InTypeN and Icmp6OutTypeN, are not included.
+type ICMP6 struct {
+	InMsgs                    int64 `json:"in_msgs"`
+	InErrors                  int64 `json:"in_errors"`
+	OutMsgs                   int64 `json:"out_msgs"`
+	OutErrors                 int64 `json:"out_errors"`
+	InCsumErrors              int64 `json:"in_csum_errors"`
+	OutRateLimitHost          int64 `json:"out_rate_limit_host"`
+	InDestUnreachs            int64 `json:"in_dest_unreachs"`
+	InPktTooBigs              int64 `json:"in_pkt_too_bigs"`
+	InTimeExcds               int64 `json:"in_time_excds"`
+	InParmProblems            int64 `json:"in_parm_problems"`
+	InEchos                   int64 `json:"in_echos"`
+	InEchoReplies             int64 `json:"in_echo_replies"`
+	InGroupMembQueries        int64 `json:"in_group_memb_queries"`
+	InGroupMembResponses      int64 `json:"in_group_memb_responses"`
+	InGroupMembReductions     int64 `json:"in_group_memb_reductions"`
+	InRouterSolicits          int64 `json:"in_router_solicits"`
+	InRouterAdvertisements    int64 `json:"in_router_advertisements"`
+	InNeighborSolicits        int64 `json:"in_neighbor_solicits"`
+	InNeighborAdvertisements  int64 `json:"in_neighbor_advertisements"`
+	InRedirects               int64 `json:"in_redirects"`
+	InMLDv2Reports            int64 `json:"in_mldv2_reports"`
+	OutDestUnreachs           int64 `json:"out_dest_unreachs"`
+	OutPktTooBigs             int64 `json:"out_pkt_too_bigs"`
+	OutTimeExcds              int64 `json:"out_time_excds"`
+	OutParmProblems           int64 `json:"out_parm_problems"`
+	OutEchos                  int64 `json:"out_echos"`
+	OutEchoReplies            int64 `json:"out_echo_replies"`
+	OutGroupMembQueries       int64 `json:"out_group_memb_queries"`
+	OutGroupMembResponses     int64 `json:"out_group_memb_responses"`
+	OutGroupMembReductions    int64 `json:"out_group_memb_reductions"`
+	OutRouterSolicits         int64 `json:"out_router_solicits"`
+	OutRouterAdvertisements   int64 `json:"out_router_advertisements"`
+	OutNeighborSolicits       int64 `json:"out_neighbor_solicits"`
+	OutNeighborAdvertisements int64 `json:"out_neighbor_advertisements"`
+	OutRedirects              int64 `json:"out_redirects"`
+	OutMLDv2Reports           int64 `json:"out_mldv2_reports"`
+}
+
+// UDP6 contains the UDP over IPv6 counters from /proc/net/snmp6.
+type UDP6 struct {
+	InDatagrams  int64 `json:"in_datagrams"`
+	NoPorts      int64 `json:"no_ports"`
+	InErrors     int64 `json:"in_errors"`
+	OutDatagrams int64 `json:"out_datagrams"`
+	RcvbufErrors int64 `json:"rcvbuf_errors"`
+	SndbufErrors int64 `json:"sndbuf_errors"`
+	InCsumErrors int64 `json:"in_csum_errors"`
+	IgnoredMulti int64 `json:"ignored_multi"`
+	MemErrors    int64 `json:"mem_errors"`
+}
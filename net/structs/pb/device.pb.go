@@ -0,0 +1,284 @@
+// device.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: net/structs/device.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Device struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	RBytes        int64                  `protobuf:"varint,2,opt,name=r_bytes,json=rBytes,proto3" json:"r_bytes,omitempty"`
+	RPackets      int64                  `protobuf:"varint,3,opt,name=r_packets,json=rPackets,proto3" json:"r_packets,omitempty"`
+	RErrs         int64                  `protobuf:"varint,4,opt,name=r_errs,json=rErrs,proto3" json:"r_errs,omitempty"`
+	RDrop         int64                  `protobuf:"varint,5,opt,name=r_drop,json=rDrop,proto3" json:"r_drop,omitempty"`
+	RFifo         int64                  `protobuf:"varint,6,opt,name=r_fifo,json=rFifo,proto3" json:"r_fifo,omitempty"`
+	RFrame        int64                  `protobuf:"varint,7,opt,name=r_frame,json=rFrame,proto3" json:"r_frame,omitempty"`
+	RCompressed   int64                  `protobuf:"varint,8,opt,name=r_compressed,json=rCompressed,proto3" json:"r_compressed,omitempty"`
+	RMulticast    int64                  `protobuf:"varint,9,opt,name=r_multicast,json=rMulticast,proto3" json:"r_multicast,omitempty"`
+	TBytes        int64                  `protobuf:"varint,10,opt,name=t_bytes,json=tBytes,proto3" json:"t_bytes,omitempty"`
+	TPackets      int64                  `protobuf:"varint,11,opt,name=t_packets,json=tPackets,proto3" json:"t_packets,omitempty"`
+	TErrs         int64                  `protobuf:"varint,12,opt,name=t_errs,json=tErrs,proto3" json:"t_errs,omitempty"`
+	TDrop         int64                  `protobuf:"varint,13,opt,name=t_drop,json=tDrop,proto3" json:"t_drop,omitempty"`
+	TFifo         int64                  `protobuf:"varint,14,opt,name=t_fifo,json=tFifo,proto3" json:"t_fifo,omitempty"`
+	TColls        int64                  `protobuf:"varint,15,opt,name=t_colls,json=tColls,proto3" json:"t_colls,omitempty"`
+	TCarrier      int64                  `protobuf:"varint,16,opt,name=t_carrier,json=tCarrier,proto3" json:"t_carrier,omitempty"`
+	TCompressed   int64                  `protobuf:"varint,17,opt,name=t_compressed,json=tCompressed,proto3" json:"t_compressed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_net_structs_device_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_net_structs_device_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_net_structs_device_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Device) GetRBytes() int64 {
+	if x != nil {
+		return x.RBytes
+	}
+	return 0
+}
+
+func (x *Device) GetRPackets() int64 {
+	if x != nil {
+		return x.RPackets
+	}
+	return 0
+}
+
+func (x *Device) GetRErrs() int64 {
+	if x != nil {
+		return x.RErrs
+	}
+	return 0
+}
+
+func (x *Device) GetRDrop() int64 {
+	if x != nil {
+		return x.RDrop
+	}
+	return 0
+}
+
+func (x *Device) GetRFifo() int64 {
+	if x != nil {
+		return x.RFifo
+	}
+	return 0
+}
+
+func (x *Device) GetRFrame() int64 {
+	if x != nil {
+		return x.RFrame
+	}
+	return 0
+}
+
+func (x *Device) GetRCompressed() int64 {
+	if x != nil {
+		return x.RCompressed
+	}
+	return 0
+}
+
+func (x *Device) GetRMulticast() int64 {
+	if x != nil {
+		return x.RMulticast
+	}
+	return 0
+}
+
+func (x *Device) GetTBytes() int64 {
+	if x != nil {
+		return x.TBytes
+	}
+	return 0
+}
+
+func (x *Device) GetTPackets() int64 {
+	if x != nil {
+		return x.TPackets
+	}
+	return 0
+}
+
+func (x *Device) GetTErrs() int64 {
+	if x != nil {
+		return x.TErrs
+	}
+	return 0
+}
+
+func (x *Device) GetTDrop() int64 {
+	if x != nil {
+		return x.TDrop
+	}
+	return 0
+}
+
+func (x *Device) GetTFifo() int64 {
+	if x != nil {
+		return x.TFifo
+	}
+	return 0
+}
+
+func (x *Device) GetTColls() int64 {
+	if x != nil {
+		return x.TColls
+	}
+	return 0
+}
+
+func (x *Device) GetTCarrier() int64 {
+	if x != nil {
+		return x.TCarrier
+	}
+	return 0
+}
+
+func (x *Device) GetTCompressed() int64 {
+	if x != nil {
+		return x.TCompressed
+	}
+	return 0
+}
+
+var File_net_structs_device_proto protoreflect.FileDescriptor
+
+var file_net_structs_device_proto_rawDesc = string([]byte{
+	0x0a, 0x18, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6a, 0x6f, 0x65, 0x66,
+	0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6e, 0x65, 0x74, 0x22, 0xc8, 0x03, 0x0a, 0x06, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x72, 0x5f, 0x65, 0x72, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x72, 0x45, 0x72, 0x72, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x5f, 0x66, 0x69, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x46,
+	0x69, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x5f, 0x66, 0x72, 0x61, 0x6d, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x72, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x72, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x50,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x45, 0x72, 0x72, 0x73, 0x12, 0x15, 0x0a,
+	0x06, 0x74, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74,
+	0x44, 0x72, 0x6f, 0x70, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x5f, 0x66, 0x69, 0x66, 0x6f, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x46, 0x69, 0x66, 0x6f, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x5f, 0x63, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x74, 0x43, 0x61, 0x72, 0x72, 0x69, 0x65,
+	0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x64, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69,
+	0x64, 0x61, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f,
+	0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_net_structs_device_proto_rawDescOnce sync.Once
+	file_net_structs_device_proto_rawDescData []byte
+)
+
+func file_net_structs_device_proto_rawDescGZIP() []byte {
+	file_net_structs_device_proto_rawDescOnce.Do(func() {
+		file_net_structs_device_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_net_structs_device_proto_rawDesc), len(file_net_structs_device_proto_rawDesc)))
+	})
+	return file_net_structs_device_proto_rawDescData
+}
+
+var file_net_structs_device_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_net_structs_device_proto_goTypes = []any{
+	(*Device)(nil), // 0: joefriday.net.Device
+}
+var file_net_structs_device_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_net_structs_device_proto_init() }
+func file_net_structs_device_proto_init() {
+	if File_net_structs_device_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_net_structs_device_proto_rawDesc), len(file_net_structs_device_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_net_structs_device_proto_goTypes,
+		DependencyIndexes: file_net_structs_device_proto_depIdxs,
+		MessageInfos:      file_net_structs_device_proto_msgTypes,
+	}.Build()
+	File_net_structs_device_proto = out.File
+	file_net_structs_device_proto_goTypes = nil
+	file_net_structs_device_proto_depIdxs = nil
+}
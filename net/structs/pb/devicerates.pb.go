@@ -0,0 +1,241 @@
+// devicerates.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: net/structs/devicerates.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeviceRates struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Speed          int64                  `protobuf:"varint,2,opt,name=speed,proto3" json:"speed,omitempty"`
+	RBytesPerSec   float64                `protobuf:"fixed64,3,opt,name=r_bytes_per_sec,json=rBytesPerSec,proto3" json:"r_bytes_per_sec,omitempty"`
+	RPacketsPerSec float64                `protobuf:"fixed64,4,opt,name=r_packets_per_sec,json=rPacketsPerSec,proto3" json:"r_packets_per_sec,omitempty"`
+	RDropRatio     float64                `protobuf:"fixed64,5,opt,name=r_drop_ratio,json=rDropRatio,proto3" json:"r_drop_ratio,omitempty"`
+	RErrRatio      float64                `protobuf:"fixed64,6,opt,name=r_err_ratio,json=rErrRatio,proto3" json:"r_err_ratio,omitempty"`
+	RUtil          float64                `protobuf:"fixed64,7,opt,name=r_util,json=rUtil,proto3" json:"r_util,omitempty"`
+	TBytesPerSec   float64                `protobuf:"fixed64,8,opt,name=t_bytes_per_sec,json=tBytesPerSec,proto3" json:"t_bytes_per_sec,omitempty"`
+	TPacketsPerSec float64                `protobuf:"fixed64,9,opt,name=t_packets_per_sec,json=tPacketsPerSec,proto3" json:"t_packets_per_sec,omitempty"`
+	TDropRatio     float64                `protobuf:"fixed64,10,opt,name=t_drop_ratio,json=tDropRatio,proto3" json:"t_drop_ratio,omitempty"`
+	TErrRatio      float64                `protobuf:"fixed64,11,opt,name=t_err_ratio,json=tErrRatio,proto3" json:"t_err_ratio,omitempty"`
+	TUtil          float64                `protobuf:"fixed64,12,opt,name=t_util,json=tUtil,proto3" json:"t_util,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeviceRates) Reset() {
+	*x = DeviceRates{}
+	mi := &file_net_structs_devicerates_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeviceRates) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRates) ProtoMessage() {}
+
+func (x *DeviceRates) ProtoReflect() protoreflect.Message {
+	mi := &file_net_structs_devicerates_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRates.ProtoReflect.Descriptor instead.
+func (*DeviceRates) Descriptor() ([]byte, []int) {
+	return file_net_structs_devicerates_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceRates) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceRates) GetSpeed() int64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetRBytesPerSec() float64 {
+	if x != nil {
+		return x.RBytesPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetRPacketsPerSec() float64 {
+	if x != nil {
+		return x.RPacketsPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetRDropRatio() float64 {
+	if x != nil {
+		return x.RDropRatio
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetRErrRatio() float64 {
+	if x != nil {
+		return x.RErrRatio
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetRUtil() float64 {
+	if x != nil {
+		return x.RUtil
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetTBytesPerSec() float64 {
+	if x != nil {
+		return x.TBytesPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetTPacketsPerSec() float64 {
+	if x != nil {
+		return x.TPacketsPerSec
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetTDropRatio() float64 {
+	if x != nil {
+		return x.TDropRatio
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetTErrRatio() float64 {
+	if x != nil {
+		return x.TErrRatio
+	}
+	return 0
+}
+
+func (x *DeviceRates) GetTUtil() float64 {
+	if x != nil {
+		return x.TUtil
+	}
+	return 0
+}
+
+var File_net_structs_devicerates_proto protoreflect.FileDescriptor
+
+var file_net_structs_devicerates_proto_rawDesc = string([]byte{
+	0x0a, 0x1d, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x72, 0x61, 0x74, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0d, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6e, 0x65, 0x74, 0x22, 0x8d,
+	0x03, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x61, 0x74, 0x65, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0f, 0x72, 0x5f, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x72, 0x42, 0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12,
+	0x29, 0x0a, 0x11, 0x72, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x72, 0x50, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x72, 0x5f,
+	0x64, 0x72, 0x6f, 0x70, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x0a, 0x72, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0b,
+	0x72, 0x5f, 0x65, 0x72, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x72, 0x45, 0x72, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x15, 0x0a, 0x06,
+	0x72, 0x5f, 0x75, 0x74, 0x69, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x72, 0x55,
+	0x74, 0x69, 0x6c, 0x12, 0x25, 0x0a, 0x0f, 0x74, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x74, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x29, 0x0a, 0x11, 0x74, 0x5f,
+	0x70, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x74, 0x50, 0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x50,
+	0x65, 0x72, 0x53, 0x65, 0x63, 0x12, 0x20, 0x0a, 0x0c, 0x74, 0x5f, 0x64, 0x72, 0x6f, 0x70, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x74, 0x44, 0x72,
+	0x6f, 0x70, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x1e, 0x0a, 0x0b, 0x74, 0x5f, 0x65, 0x72, 0x72,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x45,
+	0x72, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x15, 0x0a, 0x06, 0x74, 0x5f, 0x75, 0x74, 0x69,
+	0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x55, 0x74, 0x69, 0x6c, 0x42, 0x2f,
+	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d,
+	0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x6e, 0x65,
+	0x74, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_net_structs_devicerates_proto_rawDescOnce sync.Once
+	file_net_structs_devicerates_proto_rawDescData []byte
+)
+
+func file_net_structs_devicerates_proto_rawDescGZIP() []byte {
+	file_net_structs_devicerates_proto_rawDescOnce.Do(func() {
+		file_net_structs_devicerates_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_net_structs_devicerates_proto_rawDesc), len(file_net_structs_devicerates_proto_rawDesc)))
+	})
+	return file_net_structs_devicerates_proto_rawDescData
+}
+
+var file_net_structs_devicerates_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_net_structs_devicerates_proto_goTypes = []any{
+	(*DeviceRates)(nil), // 0: joefriday.net.DeviceRates
+}
+var file_net_structs_devicerates_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_net_structs_devicerates_proto_init() }
+func file_net_structs_devicerates_proto_init() {
+	if File_net_structs_devicerates_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_net_structs_devicerates_proto_rawDesc), len(file_net_structs_devicerates_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_net_structs_devicerates_proto_goTypes,
+		DependencyIndexes: file_net_structs_devicerates_proto_depIdxs,
+		MessageInfos:      file_net_structs_devicerates_proto_msgTypes,
+	}.Build()
+	File_net_structs_devicerates_proto = out.File
+	file_net_structs_devicerates_proto_goTypes = nil
+	file_net_structs_devicerates_proto_depIdxs = nil
+}
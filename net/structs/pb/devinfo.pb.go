@@ -0,0 +1,146 @@
+// devinfo.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: net/structs/devinfo.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DevInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Device        []*Device              `protobuf:"bytes,2,rep,name=device,proto3" json:"device,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DevInfo) Reset() {
+	*x = DevInfo{}
+	mi := &file_net_structs_devinfo_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DevInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevInfo) ProtoMessage() {}
+
+func (x *DevInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_net_structs_devinfo_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevInfo.ProtoReflect.Descriptor instead.
+func (*DevInfo) Descriptor() ([]byte, []int) {
+	return file_net_structs_devinfo_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DevInfo) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *DevInfo) GetDevice() []*Device {
+	if x != nil {
+		return x.Device
+	}
+	return nil
+}
+
+var File_net_structs_devinfo_proto protoreflect.FileDescriptor
+
+var file_net_structs_devinfo_proto_rawDesc = string([]byte{
+	0x0a, 0x19, 0x6e, 0x65, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x65,
+	0x76, 0x69, 0x6e, 0x66, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x6a, 0x6f, 0x65,
+	0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6e, 0x65, 0x74, 0x1a, 0x18, 0x6e, 0x65, 0x74, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x56, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x2d, 0x0a,
+	0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6e, 0x65, 0x74, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x2f, 0x5a, 0x2d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74,
+	0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x2f,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x2f, 0x70, 0x62, 0x3b, 0x70, 0x62, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_net_structs_devinfo_proto_rawDescOnce sync.Once
+	file_net_structs_devinfo_proto_rawDescData []byte
+)
+
+func file_net_structs_devinfo_proto_rawDescGZIP() []byte {
+	file_net_structs_devinfo_proto_rawDescOnce.Do(func() {
+		file_net_structs_devinfo_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_net_structs_devinfo_proto_rawDesc), len(file_net_structs_devinfo_proto_rawDesc)))
+	})
+	return file_net_structs_devinfo_proto_rawDescData
+}
+
+var file_net_structs_devinfo_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_net_structs_devinfo_proto_goTypes = []any{
+	(*DevInfo)(nil), // 0: joefriday.net.DevInfo
+	(*Device)(nil),  // 1: joefriday.net.Device
+}
+var file_net_structs_devinfo_proto_depIdxs = []int32{
+	1, // 0: joefriday.net.DevInfo.device:type_name -> joefriday.net.Device
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_net_structs_devinfo_proto_init() }
+func file_net_structs_devinfo_proto_init() {
+	if File_net_structs_devinfo_proto != nil {
+		return
+	}
+	file_net_structs_device_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_net_structs_devinfo_proto_rawDesc), len(file_net_structs_devinfo_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_net_structs_devinfo_proto_goTypes,
+		DependencyIndexes: file_net_structs_devinfo_proto_depIdxs,
+		MessageInfos:      file_net_structs_devinfo_proto_msgTypes,
+	}.Build()
+	File_net_structs_devinfo_proto = out.File
+	file_net_structs_devinfo_proto_goTypes = nil
+	file_net_structs_devinfo_proto_depIdxs = nil
+}
@@ -44,9 +44,80 @@ type DevInfo struct {
 // DevUsage contains information about the usage of all current network
 // devices. Usage is calculated as the delta between two /proc/net/dev
 // snapshots; the TimeDelta field holds the time elapsed between the
-// two snapshots used to calculate the usage.
+// two snapshots used to calculate the usage. Devices are matched by name;
+// Added holds the names of the devices that weren't in the prior snapshot and
+// Removed holds the names of the devices that are no longer present.
 type DevUsage struct {
-	Timestamp  int64 `json:"timestamp"`
-	TimeDelta  int64 `json:"time_delta"`
-	Device []Device `json:"devices"`
+	Timestamp int64    `json:"timestamp"`
+	TimeDelta int64    `json:"time_delta"`
+	Device    []Device      `json:"devices"`
+	Rates     []DeviceRates `json:"rates"`
+	Added     []string      `json:"added"`
+	Removed   []string      `json:"removed"`
+}
+
+// DeviceRates contains the per second rates derived from a network device's
+// usage. The drop and error ratios are the fraction of the packets that were
+// dropped or had errors: drops / (packets + drops). Speed is the link speed,
+// in Mbits/sec, from /sys/class/net/[device]/speed; it is 0 if the speed is
+// unknown, e.g. the link is down or the device is virtual, in which case the
+// utilization of the line rate, RUtil and TUtil, is also 0.
+type DeviceRates struct {
+	Name           string  `json:"name"`
+	Speed          int64   `json:"speed"`
+	RBytesPerSec   float64 `json:"receive_bytes_per_sec"`
+	RPacketsPerSec float64 `json:"receive_packets_per_sec"`
+	RDropRatio     float64 `json:"receive_drop_ratio"`
+	RErrRatio      float64 `json:"receive_err_ratio"`
+	RUtil          float64 `json:"receive_util"`
+	TBytesPerSec   float64 `json:"transmit_bytes_per_sec"`
+	TPacketsPerSec float64 `json:"transmit_packets_per_sec"`
+	TDropRatio     float64 `json:"transmit_drop_ratio"`
+	TErrRatio      float64 `json:"transmit_err_ratio"`
+	TUtil          float64 `json:"transmit_util"`
+}
+
+// Sockets contains information about the sockets in the TCP and UDP socket
+// tables: /proc/net/tcp, /proc/net/tcp6, /proc/net/udp, and /proc/net/udp6.
+type Sockets struct {
+	Timestamp int64    `json:"timestamp"`
+	Socket    []Socket `json:"sockets"`
+}
+
+// Socket contains information about a socket. Protocol is one of tcp, tcp6,
+// udp, or udp6. State is the name of the socket's TCP state, e.g. LISTEN;
+// UDP sockets use the same states: an unconnected UDP socket is CLOSE.
+type Socket struct {
+	Protocol   string `json:"protocol"`
+	LocalAddr  string `json:"local_address"`
+	LocalPort  uint16 `json:"local_port"`
+	RemoteAddr string `json:"remote_address"`
+	RemotePort uint16 `json:"remote_port"`
+	State      string `json:"state"`
+	TxQueue    uint64 `json:"tx_queue"`
+	RxQueue    uint64 `json:"rx_queue"`
+	UID        uint32 `json:"uid"`
+	Inode      uint64 `json:"inode"`
+}
+
+// SocketSummary contains the number of sockets in each socket table and the
+// number of TCP sockets, both IPv4 and IPv6, in each state, like ss -s.
+type SocketSummary struct {
+	Timestamp   int64 `json:"timestamp"`
+	TCP         int32 `json:"tcp"`
+	TCP6        int32 `json:"tcp6"`
+	UDP         int32 `json:"udp"`
+	UDP6        int32 `json:"udp6"`
+	Established int32 `json:"established"`
+	SynSent     int32 `json:"syn_sent"`
+	SynRecv     int32 `json:"syn_recv"`
+	FinWait1    int32 `json:"fin_wait1"`
+	FinWait2    int32 `json:"fin_wait2"`
+	TimeWait    int32 `json:"time_wait"`
+	Close       int32 `json:"close"`
+	CloseWait   int32 `json:"close_wait"`
+	LastAck     int32 `json:"last_ack"`
+	Listen      int32 `json:"listen"`
+	Closing     int32 `json:"closing"`
+	NewSynRecv  int32 `json:"new_syn_recv"`
 }
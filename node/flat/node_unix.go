@@ -26,6 +26,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	numa "github.com/hmmftg/joefriday/node"
 	"github.com/hmmftg/joefriday/node/flat/structs"
 )
@@ -38,8 +39,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a node profiler.
-func NewProfiler() (p *Profiler) {
-	prof := numa.NewProfiler()
+func NewProfiler(opts ...joe.Option) (p *Profiler) {
+	prof := numa.NewProfiler(opts...)
 	return &Profiler{Profiler: prof, Builder: fb.NewBuilder(0)}
 }
 
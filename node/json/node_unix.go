@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	numa "github.com/hmmftg/joefriday/node"
 )
 
@@ -35,8 +36,8 @@ type Profiler struct {
 }
 
 // Initializes and returns a cpuinfo profiler.
-func NewProfiler() (prof *Profiler) {
-	p := numa.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler) {
+	p := numa.NewProfiler(opts...)
 	return &Profiler{Profiler: p}
 }
 
@@ -19,7 +19,6 @@ package node
 
 import (
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 
@@ -49,12 +48,13 @@ type Profiler struct {
 	// the path of th4e sysfs node tree; cached so it doesn't need to be
 	// generated for every use.
 	nodePath string
+	opts     joefriday.Options
 }
 
 // Returns an initialized Profiler.
-func NewProfiler() (prof *Profiler) {
-	prof = &Profiler{}
-	prof.SysFSSystemPath(joefriday.SysFSSystem)
+func NewProfiler(opts ...joefriday.Option) (prof *Profiler) {
+	prof = &Profiler{opts: joefriday.NewOptions(opts...)}
+	prof.SysFSSystemPath(prof.opts.Path(joefriday.SysFSSystem))
 	return prof
 }
 
@@ -71,7 +71,7 @@ func (prof *Profiler) Get() (nodes *Nodes, err error) {
 	var x int32 // index of nodeX currently being processed.
 
 	// First see if the node dir exists, return any error.
-	_, err = os.Stat(prof.nodePath)
+	_, err = prof.opts.Stat(prof.nodePath)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +103,7 @@ func (prof *Profiler) nodeXPath(x int32) string {
 // CPUList returns the string found in the CPUList file or any error that
 // occurs.
 func (prof *Profiler) CPUList(path string) (string, error) {
-	p, err := ioutil.ReadFile(filepath.Join(path, CPUList))
+	p, err := prof.opts.ReadFile(filepath.Join(path, CPUList))
 	if err != nil {
 		return "", err
 	}
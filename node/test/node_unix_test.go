@@ -15,8 +15,11 @@ package node
 
 import (
 	"os"
+	"reflect"
 	"testing"
+	"testing/fstest"
 
+	"github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/node"
 	"github.com/hmmftg/joefriday/testinfo"
 )
@@ -86,3 +89,26 @@ func TestNodeX(t *testing.T) {
 		tSysFS.CleanNode()
 	}
 }
+
+func TestNodeFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"sys/devices/system/node/node0/cpulist": &fstest.MapFile{Data: []byte("0-3\n")},
+		"sys/devices/system/node/node1/cpulist": &fstest.MapFile{Data: []byte("4-7\n")},
+	}
+	prof := node.NewProfiler(joefriday.WithFS(fsys))
+	n, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := &node.Nodes{Node: []node.Node{{ID: 0, CPUList: "0-3"}, {ID: 1, CPUList: "4-7"}}}
+	if !reflect.DeepEqual(n, expected) {
+		t.Errorf("got %#v; want %#v", n, expected)
+	}
+
+	// no node tree
+	prof = node.NewProfiler(joefriday.WithFS(fstest.MapFS{}))
+	_, err = prof.Get()
+	if !os.IsNotExist(err) {
+		t.Errorf("no node tree: got %v; want ErrNotExist", err)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2016 The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joefriday
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// The default locations of the filesystems the profilers read from.
+const (
+	ProcFS = "/proc"
+	SysFS  = "/sys"
+	Etc    = "/etc"
+)
+
+// The environment variables that, when set, override the default locations
+// of the filesystems. This is useful when JoeFriday is run inside of a
+// container that has the host's filesystems mounted elsewhere, e.g. the
+// host's /proc mounted at /host/proc.
+const (
+	ProcFSEnv = "JOEFRIDAY_PROCFS"
+	SysFSEnv  = "JOEFRIDAY_SYSFS"
+	EtcEnv    = "JOEFRIDAY_ETC"
+)
+
+// Options holds the locations of the filesystems that a Profiler reads from
+// and the file system that they are read from.
+type Options struct {
+	ProcFS string // the root of the proc filesystem
+	SysFS  string // the root of the sys filesystem
+	Etc    string // the location of the etc directory
+	// Root is the root that any other absolute path is within, e.g. the
+	// mount points of filesystems. If Root is empty, those paths are used
+	// as is.
+	Root string
+	// FS is the file system that the files are read from; the locations are
+	// relative to its root. If FS is nil, the files are read from the
+	// operating system's file system.
+	FS fs.FS
+}
+
+// Option sets an Options value.
+type Option func(*Options)
+
+// WithProcFS sets the root of the proc filesystem.
+func WithProcFS(s string) Option {
+	return func(o *Options) {
+		o.ProcFS = s
+	}
+}
+
+// WithSysFS sets the root of the sys filesystem.
+func WithSysFS(s string) Option {
+	return func(o *Options) {
+		o.SysFS = s
+	}
+}
+
+// WithEtc sets the location of the etc directory.
+func WithEtc(s string) Option {
+	return func(o *Options) {
+		o.Etc = s
+	}
+}
+
+// WithFS sets the file system that the files are read from, e.g. a
+// fstest.MapFS or an archived snapshot of a system's files. The locations of
+// the filesystems are relative to the root of fsys.
+func WithFS(fsys fs.FS) Option {
+	return func(o *Options) {
+		o.FS = fsys
+	}
+}
+
+// WithRoot sets the locations of the proc filesystem, the sys filesystem, and
+// the etc directory to proc, sys, and etc within the root s, e.g. a root of
+// /host results in /host/proc, /host/sys, and /host/etc. Any other absolute
+// path is also rebased onto s.
+func WithRoot(s string) Option {
+	return func(o *Options) {
+		o.Root = s
+		o.ProcFS = filepath.Join(s, ProcFS)
+		o.SysFS = filepath.Join(s, SysFS)
+		o.Etc = filepath.Join(s, Etc)
+	}
+}
+
+// NewOptions returns Options with the opts applied. Any location that isn't
+// set by the opts uses its environment variable, if it is set, or the
+// default location.
+func NewOptions(opts ...Option) Options {
+	o := Options{
+		ProcFS: getenv(ProcFSEnv, ProcFS),
+		SysFS:  getenv(SysFSEnv, SysFS),
+		Etc:    getenv(EtcEnv, Etc),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+func getenv(key, def string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	return v
+}
+
+// Path returns the location of p using the Options. The path, p, is a path
+// within the default location of one of the filesystems, e.g. /proc/meminfo;
+// its default location is replaced with the configured one. Any other
+// absolute path is rebased onto Root, if it is set; otherwise it is returned
+// as is.
+func (o Options) Path(p string) string {
+	if s, ok := rebase(p, ProcFS, o.ProcFS); ok {
+		return s
+	}
+	if s, ok := rebase(p, SysFS, o.SysFS); ok {
+		return s
+	}
+	if s, ok := rebase(p, Etc, o.Etc); ok {
+		return s
+	}
+	if o.Root != "" && filepath.IsAbs(p) {
+		return filepath.Join(o.Root, p)
+	}
+	return p
+}
+
+// rebase replaces the def prefix of p with root. False is returned if p is
+// not within def.
+func rebase(p, def, root string) (string, bool) {
+	if p == def {
+		return root, true
+	}
+	if !strings.HasPrefix(p, def+"/") {
+		return p, false
+	}
+	return root + p[len(def):], true
+}
+
+// The following methods access the named file, or directory, using the
+// Options' file system. The name is an absolute path, e.g. one returned by
+// Path.
+
+// NewProc returns a Procer for the named file.
+func (o Options) NewProc(name string) (Procer, error) {
+	if o.FS == nil {
+		p, err := NewProc(name)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	}
+	p, err := NewFSProc(o.FS, fsName(name))
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Open opens the named file.
+func (o Options) Open(name string) (fs.File, error) {
+	if o.FS == nil {
+		return os.Open(name)
+	}
+	return o.FS.Open(fsName(name))
+}
+
+// ReadFile reads the named file and returns its contents.
+func (o Options) ReadFile(name string) ([]byte, error) {
+	if o.FS == nil {
+		return os.ReadFile(name)
+	}
+	return fs.ReadFile(o.FS, fsName(name))
+}
+
+// ReadDir reads the named directory and returns its entries sorted by
+// filename.
+func (o Options) ReadDir(name string) ([]fs.DirEntry, error) {
+	if o.FS == nil {
+		return os.ReadDir(name)
+	}
+	return fs.ReadDir(o.FS, fsName(name))
+}
+
+// Stat returns a fs.FileInfo describing the named file.
+func (o Options) Stat(name string) (fs.FileInfo, error) {
+	if o.FS == nil {
+		return os.Stat(name)
+	}
+	return fs.Stat(o.FS, fsName(name))
+}
+
+// Glob returns the names of all files matching pattern; the names are
+// absolute paths.
+func (o Options) Glob(pattern string) ([]string, error) {
+	if o.FS == nil {
+		return filepath.Glob(pattern)
+	}
+	matches, err := fs.Glob(o.FS, fsName(pattern))
+	if err != nil {
+		return nil, err
+	}
+	for i := range matches {
+		matches[i] = "/" + matches[i]
+	}
+	return matches, nil
+}
+
+// fsName returns the fs.FS name of the absolute path p: fs.FS names are
+// unrooted.
+func fsName(p string) string {
+	p = strings.TrimLeft(filepath.ToSlash(filepath.Clean(p)), "/")
+	if p == "" {
+		return "."
+	}
+	return p
+}
@@ -0,0 +1,146 @@
+// Copyright 2016 The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package joefriday
+
+import (
+	"io/fs"
+	"os"
+	"reflect"
+	"testing"
+	"testing/fstest"
+)
+
+func TestNewOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		opts []Option
+		want Options
+	}{
+		{"defaults", nil, nil, Options{ProcFS: "/proc", SysFS: "/sys", Etc: "/etc"}},
+		{
+			"env", map[string]string{ProcFSEnv: "/host/proc", SysFSEnv: "/host/sys", EtcEnv: "/host/etc"}, nil,
+			Options{ProcFS: "/host/proc", SysFS: "/host/sys", Etc: "/host/etc"},
+		},
+		{
+			"opts", nil, []Option{WithProcFS("/a/proc"), WithSysFS("/b/sys"), WithEtc("/c/etc")},
+			Options{ProcFS: "/a/proc", SysFS: "/b/sys", Etc: "/c/etc"},
+		},
+		{
+			"opts override env", map[string]string{ProcFSEnv: "/host/proc"}, []Option{WithProcFS("/a/proc")},
+			Options{ProcFS: "/a/proc", SysFS: "/sys", Etc: "/etc"},
+		},
+		{"root", nil, []Option{WithRoot("/host")}, Options{ProcFS: "/host/proc", SysFS: "/host/sys", Etc: "/host/etc", Root: "/host"}},
+	}
+	for _, test := range tests {
+		for _, k := range []string{ProcFSEnv, SysFSEnv, EtcEnv} {
+			t.Setenv(k, test.env[k])
+		}
+		o := NewOptions(test.opts...)
+		if o != test.want {
+			t.Errorf("%s: got %#v; want %#v", test.name, o, test.want)
+		}
+	}
+}
+
+func TestOptionsPath(t *testing.T) {
+	o := Options{ProcFS: "/host/proc", SysFS: "/host/sys", Etc: "/host/etc"}
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"/proc/meminfo", "/host/proc/meminfo"},
+		{"/proc/net/dev", "/host/proc/net/dev"},
+		{"/proc", "/host/proc"},
+		{"/sys/devices/system", "/host/sys/devices/system"},
+		{"/etc/os-release", "/host/etc/os-release"},
+		{"/procfoo/meminfo", "/procfoo/meminfo"},
+		{"/var/run", "/var/run"},
+	}
+	for _, test := range tests {
+		p := o.Path(test.path)
+		if p != test.want {
+			t.Errorf("%s: got %q; want %q", test.path, p, test.want)
+		}
+	}
+	p := NewOptions(WithProcFS("/proc")).Path("/proc/meminfo")
+	if p != "/proc/meminfo" {
+		t.Errorf("default: got %q; want %q", p, "/proc/meminfo")
+	}
+	o = NewOptions(WithRoot("/host"))
+	for _, test := range []struct{ path, want string }{
+		{"/proc/meminfo", "/host/proc/meminfo"},
+		{"/", "/host"},
+		{"/mnt/data", "/host/mnt/data"},
+		{"meminfo", "meminfo"},
+	} {
+		p = o.Path(test.path)
+		if p != test.want {
+			t.Errorf("root: %s: got %q; want %q", test.path, p, test.want)
+		}
+	}
+}
+
+func TestOptionsFS(t *testing.T) {
+	fsys := fstest.MapFS{
+		"host/proc/meminfo":       &fstest.MapFile{Data: []byte("MemTotal: 1 kB\n")},
+		"host/sys/block/sda/size": &fstest.MapFile{Data: []byte("100\n")},
+		"host/sys/block/sda/sda1": &fstest.MapFile{Mode: fs.ModeDir},
+	}
+	o := NewOptions(WithFS(fsys), WithRoot("/host"))
+
+	b, err := o.ReadFile(o.Path("/proc/meminfo"))
+	if err != nil {
+		t.Errorf("ReadFile: %s", err)
+	} else if string(b) != "MemTotal: 1 kB\n" {
+		t.Errorf("ReadFile: got %q; want %q", b, "MemTotal: 1 kB\n")
+	}
+	p, err := o.NewProc(o.Path("/proc/meminfo"))
+	if err != nil {
+		t.Errorf("NewProc: %s", err)
+	} else {
+		line, _ := p.ReadSlice('\n')
+		if string(line) != "MemTotal: 1 kB\n" {
+			t.Errorf("NewProc: got %q; want %q", line, "MemTotal: 1 kB\n")
+		}
+	}
+	fi, err := o.Stat(o.Path("/sys/block/sda"))
+	if err != nil {
+		t.Errorf("Stat: %s", err)
+	} else if !fi.IsDir() {
+		t.Error("Stat: expected /host/sys/block/sda to be a directory")
+	}
+	entries, err := o.ReadDir(o.Path("/sys/block/sda"))
+	if err != nil {
+		t.Errorf("ReadDir: %s", err)
+	} else if len(entries) != 2 || entries[0].Name() != "sda1" || entries[1].Name() != "size" {
+		t.Errorf("ReadDir: got %v; want sda1 and size", entries)
+	}
+	matches, err := o.Glob(o.Path("/sys/block/*/sda1"))
+	if err != nil {
+		t.Errorf("Glob: %s", err)
+	} else if !reflect.DeepEqual(matches, []string{"/host/sys/block/sda/sda1"}) {
+		t.Errorf("Glob: got %q; want %q", matches, []string{"/host/sys/block/sda/sda1"})
+	}
+	f, err := o.Open(o.Path("/proc/meminfo"))
+	if err != nil {
+		t.Errorf("Open: %s", err)
+	} else {
+		f.Close()
+	}
+	_, err = o.Open("/proc/meminfo")
+	if !os.IsNotExist(err) {
+		t.Errorf("Open: got %v; want ErrNotExist", err)
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procstats handles Flatbuffer based processing of per-process
+// information: /proc/[pid]/stat, /proc/[pid]/statm, /proc/[pid]/status, and
+// /proc/[pid]/cmdline. Instead of returning a Go struct, it returns
+// Flatbuffer serialized bytes. A function to deserialize the Flatbuffer
+// serialized bytes into a procstats.Processes struct is provided.
+//
+// Note: the package name is procstats and not the final element of the import
+// path (flat).
+package procstats
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/process/procstats"
+	"github.com/hmmftg/joefriday/process/procstats/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the /proc/[pid] files as Flatbuffer serialized
+// bytes.
+type Profiler struct {
+	*stats.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized profiler that uses Flatbuffers.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the information about all of the processes on the system as
+// Flatbuffer serialized bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	procs, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(procs), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the information about all of the processes on the system as
+// Flatbuffer serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		std.Builder.Reset()
+	}
+
+	return std.Get()
+}
+
+// Serialize procstats.Processes using Flatbuffers.
+func (prof *Profiler) Serialize(procs *stats.Processes) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	procsF := make([]fb.UOffsetT, len(procs.Process))
+	names := make([]fb.UOffsetT, len(procs.Process))
+	states := make([]fb.UOffsetT, len(procs.Process))
+	cmdlines := make([]fb.UOffsetT, len(procs.Process))
+	for i := 0; i < len(names); i++ {
+		names[i] = prof.Builder.CreateString(procs.Process[i].Name)
+		states[i] = prof.Builder.CreateString(procs.Process[i].State)
+		cmdlines[i] = prof.Builder.CreateString(procs.Process[i].Cmdline)
+	}
+	for i := 0; i < len(procsF); i++ {
+		structs.ProcessStart(prof.Builder)
+		structs.ProcessAddPID(prof.Builder, procs.Process[i].PID)
+		structs.ProcessAddPPID(prof.Builder, procs.Process[i].PPID)
+		structs.ProcessAddPGRP(prof.Builder, procs.Process[i].PGRP)
+		structs.ProcessAddSession(prof.Builder, procs.Process[i].Session)
+		structs.ProcessAddName(prof.Builder, names[i])
+		structs.ProcessAddState(prof.Builder, states[i])
+		structs.ProcessAddUID(prof.Builder, procs.Process[i].UID)
+		structs.ProcessAddEUID(prof.Builder, procs.Process[i].EUID)
+		structs.ProcessAddGID(prof.Builder, procs.Process[i].GID)
+		structs.ProcessAddEGID(prof.Builder, procs.Process[i].EGID)
+		structs.ProcessAddMinFlt(prof.Builder, procs.Process[i].MinFlt)
+		structs.ProcessAddMajFlt(prof.Builder, procs.Process[i].MajFlt)
+		structs.ProcessAddUTime(prof.Builder, procs.Process[i].UTime)
+		structs.ProcessAddSTime(prof.Builder, procs.Process[i].STime)
+		structs.ProcessAddCUTime(prof.Builder, procs.Process[i].CUTime)
+		structs.ProcessAddCSTime(prof.Builder, procs.Process[i].CSTime)
+		structs.ProcessAddPriority(prof.Builder, procs.Process[i].Priority)
+		structs.ProcessAddNice(prof.Builder, procs.Process[i].Nice)
+		structs.ProcessAddThreads(prof.Builder, procs.Process[i].Threads)
+		structs.ProcessAddStartTime(prof.Builder, procs.Process[i].StartTime)
+		structs.ProcessAddVSize(prof.Builder, procs.Process[i].VSize)
+		structs.ProcessAddRSS(prof.Builder, procs.Process[i].RSS)
+		structs.ProcessAddSize(prof.Builder, procs.Process[i].Size)
+		structs.ProcessAddResident(prof.Builder, procs.Process[i].Resident)
+		structs.ProcessAddShared(prof.Builder, procs.Process[i].Shared)
+		structs.ProcessAddText(prof.Builder, procs.Process[i].Text)
+		structs.ProcessAddData(prof.Builder, procs.Process[i].Data)
+		structs.ProcessAddCmdline(prof.Builder, cmdlines[i])
+		structs.ProcessAddRChar(prof.Builder, procs.Process[i].RChar)
+		structs.ProcessAddWChar(prof.Builder, procs.Process[i].WChar)
+		structs.ProcessAddReadBytes(prof.Builder, procs.Process[i].ReadBytes)
+		structs.ProcessAddWriteBytes(prof.Builder, procs.Process[i].WriteBytes)
+		procsF[i] = structs.ProcessEnd(prof.Builder)
+	}
+	structs.ProcessesStartProcessVector(prof.Builder, len(procsF))
+	for i := len(procsF) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(procsF[i])
+	}
+	procsV := prof.Builder.EndVector(len(procsF))
+	structs.ProcessesStart(prof.Builder)
+	structs.ProcessesAddTimestamp(prof.Builder, procs.Timestamp)
+	structs.ProcessesAddClkTck(prof.Builder, procs.ClkTck)
+	structs.ProcessesAddPageSize(prof.Builder, procs.PageSize)
+	structs.ProcessesAddProcess(prof.Builder, procsV)
+	prof.Builder.Finish(structs.ProcessesEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize procstats.Processes with Flatbuffers using the package's global
+// Profiler.
+func Serialize(procs *stats.Processes) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(procs), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserialize's them
+// as procstats.Processes.
+func Deserialize(p []byte) *stats.Processes {
+	procsF := structs.GetRootAsProcesses(p, 0)
+	procs := &stats.Processes{}
+	procF := &structs.Process{}
+	procs.Timestamp = procsF.Timestamp()
+	procs.ClkTck = procsF.ClkTck()
+	procs.PageSize = procsF.PageSize()
+	len := procsF.ProcessLength()
+	procs.Process = make([]stats.Process, len)
+	for i := 0; i < len; i++ {
+		var proc stats.Process
+		if procsF.Process(procF, i) {
+			proc.PID = procF.PID()
+			proc.PPID = procF.PPID()
+			proc.PGRP = procF.PGRP()
+			proc.Session = procF.Session()
+			proc.Name = string(procF.Name())
+			proc.State = string(procF.State())
+			proc.UID = procF.UID()
+			proc.EUID = procF.EUID()
+			proc.GID = procF.GID()
+			proc.EGID = procF.EGID()
+			proc.MinFlt = procF.MinFlt()
+			proc.MajFlt = procF.MajFlt()
+			proc.UTime = procF.UTime()
+			proc.STime = procF.STime()
+			proc.CUTime = procF.CUTime()
+			proc.CSTime = procF.CSTime()
+			proc.Priority = procF.Priority()
+			proc.Nice = procF.Nice()
+			proc.Threads = procF.Threads()
+			proc.StartTime = procF.StartTime()
+			proc.VSize = procF.VSize()
+			proc.RSS = procF.RSS()
+			proc.Size = procF.Size()
+			proc.Resident = procF.Resident()
+			proc.Shared = procF.Shared()
+			proc.Text = procF.Text()
+			proc.Data = procF.Data()
+			proc.Cmdline = string(procF.Cmdline())
+			proc.RChar = procF.RChar()
+			proc.WChar = procF.WChar()
+			proc.ReadBytes = procF.ReadBytes()
+			proc.WriteBytes = procF.WriteBytes()
+		}
+		procs.Process[i] = proc
+	}
+	return procs
+}
+
+// Ticker delivers the information about all of the processes on the system
+// at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,149 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procstats handles JSON based processing of per-process information:
+// /proc/[pid]/stat, /proc/[pid]/statm, /proc/[pid]/status, and
+// /proc/[pid]/cmdline. Instead of returning a Go struct, it returns JSON
+// serialized bytes. A function to deserialize the JSON serialized bytes into
+// a procstats.Processes struct is provided.
+//
+// Note: the package name is procstats and not the final element of the import
+// path (json).
+package procstats
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/process/procstats"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the /proc/[pid] files as JSON serialized bytes.
+type Profiler struct {
+	*stats.Profiler
+}
+
+// Returns an initialized profiler that uses JSON.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the information about all of the processes on the system as
+// JSON serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	procs, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(procs)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the information about all of the processes on the system as
+// JSON serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize procstats.Processes as JSON.
+func (prof *Profiler) Serialize(procs *stats.Processes) ([]byte, error) {
+	return json.Marshal(procs)
+}
+
+// Serialize procstats.Processes as JSON using package globals.
+func Serialize(procs *stats.Processes) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(procs)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(procs *stats.Processes) ([]byte, error) {
+	return prof.Serialize(procs)
+}
+
+// Marshal is an alias for Serialize using package globals.
+func Marshal(procs *stats.Processes) ([]byte, error) {
+	return Serialize(procs)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// procstats.Processes.
+func Deserialize(p []byte) (*stats.Processes, error) {
+	procs := &stats.Processes{}
+	err := json.Unmarshal(p, procs)
+	if err != nil {
+		return nil, err
+	}
+	return procs, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*stats.Processes, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the information about all of the processes on the system
+// at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
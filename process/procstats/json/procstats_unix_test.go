@@ -0,0 +1,91 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procstats
+
+import (
+	"testing"
+	"time"
+
+	stats "github.com/hmmftg/joefriday/process/procstats"
+)
+
+func TestGet(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	procs, err := Unmarshal(p)
+	if err != nil {
+		t.Errorf("got %s, want nil", err)
+		return
+	}
+	checkProcesses("get", procs, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			procs, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkProcesses("ticker", procs, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkProcesses(n string, procs *stats.Processes, t *testing.T) {
+	if procs.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if len(procs.Process) == 0 {
+		t.Errorf("%s: expected processes; got none", n)
+	}
+	for i, p := range procs.Process {
+		if p.PID == 0 {
+			t.Errorf("%s: %d: PID: wanted non-zero value; got 0", n, i)
+		}
+		if p.Name == "" {
+			t.Errorf("%s: %d: Name: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
@@ -0,0 +1,618 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procstats handles the processing of per-process information:
+// /proc/[pid]/stat, /proc/[pid]/statm, /proc/[pid]/status, /proc/[pid]/io,
+// and /proc/[pid]/cmdline. Processes that exit while the information is being
+// gathered are skipped. The io file is only readable for processes that the
+// caller is permitted to trace; if it can't be read, the io fields will be 0.
+package procstats
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/cpu/cpustats"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const (
+	// ProcPath is the default path of the proc filesystem.
+	ProcPath    = "/proc"
+	statFile    = "stat"
+	statmFile   = "statm"
+	statusFile  = "status"
+	cmdlineFile = "cmdline"
+	ioFile      = "io"
+)
+
+var errMalformed = errors.New("malformed line")
+
+// Processes holds the information about all of the processes on the system.
+type Processes struct {
+	Timestamp int64 `json:"timestamp"`
+	// ClkTck is the number of clock ticks per second; the time fields are in
+	// clock ticks.
+	ClkTck int16 `json:"clk_tck"`
+	// PageSize is the system's page size in bytes; the RSS and statm fields
+	// are in pages.
+	PageSize int32     `json:"page_size"`
+	Process  []Process `json:"process"`
+}
+
+// Process holds the information about a single process. The time values are
+// in clock ticks and the memory values, except for VSize, are in pages.
+type Process struct {
+	PID       int32  `json:"pid"`
+	PPID      int32  `json:"ppid"`
+	PGRP      int32  `json:"pgrp"`
+	Session   int32  `json:"session"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	UID       uint32 `json:"uid"`
+	EUID      uint32 `json:"euid"`
+	GID       uint32 `json:"gid"`
+	EGID      uint32 `json:"egid"`
+	MinFlt    uint64 `json:"min_flt"`
+	MajFlt    uint64 `json:"maj_flt"`
+	UTime     int64  `json:"utime"`
+	STime     int64  `json:"stime"`
+	CUTime    int64  `json:"cutime"`
+	CSTime    int64  `json:"cstime"`
+	Priority  int32  `json:"priority"`
+	Nice      int32  `json:"nice"`
+	Threads   int32  `json:"threads"`
+	StartTime uint64 `json:"start_time"`
+	// VSize is the virtual memory size in bytes.
+	VSize    uint64 `json:"vsize"`
+	RSS      int64  `json:"rss"`
+	Size     uint64 `json:"size"`
+	Resident uint64 `json:"resident"`
+	Shared   uint64 `json:"shared"`
+	Text     uint64 `json:"text"`
+	Data     uint64 `json:"data"`
+	Cmdline  string `json:"cmdline"`
+	// The io values are in bytes.
+	RChar      uint64 `json:"rchar"`
+	WChar      uint64 `json:"wchar"`
+	ReadBytes  uint64 `json:"read_bytes"`
+	WriteBytes uint64 `json:"write_bytes"`
+}
+
+// Profiler is used to process the /proc/[pid] files. A single Proc and its
+// buffer are reused for every file that is read.
+type Profiler struct {
+	*joe.Buffer
+	ClkTck   int16
+	PageSize int32
+	proc     *joe.FSProc
+	procPath string
+	opts     joe.Options
+	// cmd holds the command line while it's read; prof.Val may still refer
+	// to the buffer of the file that was read before it.
+	cmd []byte
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	// if it hasn't been set, set it.
+	if atomic.LoadInt32(&cpustats.CLK_TCK) == 0 {
+		err = cpustats.ClkTck()
+		if err != nil {
+			return nil, err
+		}
+	}
+	o := joe.NewOptions(opts...)
+	return &Profiler{
+		Buffer:   joe.NewBuffer(),
+		ClkTck:   int16(atomic.LoadInt32(&cpustats.CLK_TCK)),
+		PageSize: int32(os.Getpagesize()),
+		proc:     &joe.FSProc{Buf: bufio.NewReader(nil)},
+		procPath: o.Path(ProcPath),
+		opts:     o,
+	}, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	return nil
+}
+
+// ProcPath enables overriding the default value. This is for testing and
+// should not be used outside of tests.
+func (prof *Profiler) ProcPath(s string) {
+	prof.procPath = s
+}
+
+// Get returns the information about all of the processes on the system.
+func (prof *Profiler) Get() (procs *Processes, err error) {
+	pids, err := prof.PIDs()
+	if err != nil {
+		return nil, err
+	}
+	procs = &Processes{
+		Timestamp: time.Now().UTC().UnixNano(),
+		ClkTck:    prof.ClkTck,
+		PageSize:  prof.PageSize,
+		Process:   make([]Process, 0, len(pids)),
+	}
+	for _, pid := range pids {
+		var p Process
+		err = prof.process(pid, &p)
+		if err != nil {
+			// the process exited after the pids were read.
+			if Exited(err) {
+				continue
+			}
+			return nil, err
+		}
+		procs.Process = append(procs.Process, p)
+	}
+	return procs, nil
+}
+
+// GetPID returns the information about the process with the provided pid.
+func (prof *Profiler) GetPID(pid int32) (p *Process, err error) {
+	p = &Process{}
+	err = prof.process(pid, p)
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// PIDs returns the pids of all of the processes on the system, sorted in
+// ascending order.
+func (prof *Profiler) PIDs() ([]int32, error) {
+	entries, err := prof.opts.ReadDir(prof.procPath)
+	if err != nil {
+		return nil, err
+	}
+	pids := make([]int32, 0, len(entries))
+	for _, entry := range entries {
+		name := entry.Name()
+		if name[0] < '0' || name[0] > '9' {
+			continue
+		}
+		n, err := tools.ParseUint([]byte(name))
+		if err != nil {
+			continue
+		}
+		pids = append(pids, int32(n))
+	}
+	sort.Slice(pids, func(i, j int) bool { return pids[i] < pids[j] })
+	return pids, nil
+}
+
+// Exited returns a boolean indicating whether the error is the result of
+// the process no longer existing.
+func Exited(err error) bool {
+	switch e := err.(type) {
+	case *joe.ReadError:
+		err = e.Err
+	case *os.PathError:
+		err = e.Err
+	}
+	return err == syscall.ENOENT || err == syscall.ESRCH || os.IsNotExist(err)
+}
+
+func (prof *Profiler) process(pid int32, p *Process) (err error) {
+	dir := filepath.Join(prof.procPath, strconv.Itoa(int(pid)))
+	err = prof.stat(dir, p)
+	if err != nil {
+		return err
+	}
+	err = prof.statm(dir, p)
+	if err != nil {
+		return err
+	}
+	err = prof.status(dir, p)
+	if err != nil {
+		return err
+	}
+	err = prof.io(dir, p)
+	if err != nil {
+		return err
+	}
+	return prof.cmdline(dir, p)
+}
+
+// open opens the named file using the Profiler's Proc; the Proc's buffer is
+// reused.
+func (prof *Profiler) open(name string) error {
+	f, err := prof.opts.Open(name)
+	if err != nil {
+		return err
+	}
+	prof.proc.File = f
+	prof.proc.Buf.Reset(f)
+	prof.Buffer.Reset()
+	return nil
+}
+
+// close closes the Proc's current file.
+func (prof *Profiler) close() {
+	prof.proc.File.Close()
+	prof.proc.File = nil
+}
+
+// stat processes /proc/[pid]/stat.
+func (prof *Profiler) stat(dir string, p *Process) (err error) {
+	fname := filepath.Join(dir, statFile)
+	err = prof.open(fname)
+	if err != nil {
+		return err
+	}
+	defer prof.close()
+	prof.Line, err = prof.proc.ReadSlice('\n')
+	if err != nil && err != io.EOF {
+		return &joe.ReadError{Info: fname, Err: err}
+	}
+	var (
+		i, l, r, pos, fieldNum int
+		v                      byte
+		n                      uint64
+		sn                     int64
+	)
+	// the comm is enclosed in parens and may itself contain spaces and
+	// parens: it's everything between the first '(' and the last ')'.
+	l, r = -1, -1
+	for i, v = range prof.Line {
+		if v == '(' && l < 0 {
+			l = i
+			continue
+		}
+		if v == ')' {
+			r = i
+		}
+	}
+	if l < 1 || r < l || r+2 >= len(prof.Line) {
+		return &joe.ParseError{Info: fname, Err: errMalformed}
+	}
+	n, err = tools.ParseUint(prof.Line[:l-1])
+	if err != nil {
+		return &joe.ParseError{Info: fname + ": pid", Err: err}
+	}
+	p.PID = int32(n)
+	p.Name = string(prof.Line[l+1 : r])
+	fieldNum = 2
+	pos = r + 2
+	for pos < len(prof.Line) {
+		fieldNum++
+		for i, v = range prof.Line[pos:] {
+			if v == 0x20 || v == '\n' {
+				break
+			}
+		}
+		prof.Val = prof.Line[pos : pos+i]
+		pos += i + 1
+		switch fieldNum {
+		case 3:
+			p.State = string(prof.Val)
+			continue
+		case 4, 5, 6, 16, 17, 18, 19, 20, 24:
+			sn, err = tools.ParseInt(prof.Val)
+		case 10, 12, 14, 15, 22, 23:
+			n, err = tools.ParseUint(prof.Val)
+		default:
+			continue
+		}
+		if err != nil {
+			return &joe.ParseError{Info: fname + ": field " + strconv.Itoa(fieldNum), Err: err}
+		}
+		switch fieldNum {
+		case 4:
+			p.PPID = int32(sn)
+		case 5:
+			p.PGRP = int32(sn)
+		case 6:
+			p.Session = int32(sn)
+		case 10:
+			p.MinFlt = n
+		case 12:
+			p.MajFlt = n
+		case 14:
+			p.UTime = int64(n)
+		case 15:
+			p.STime = int64(n)
+		case 16:
+			p.CUTime = sn
+		case 17:
+			p.CSTime = sn
+		case 18:
+			p.Priority = int32(sn)
+		case 19:
+			p.Nice = int32(sn)
+		case 20:
+			p.Threads = int32(sn)
+		case 22:
+			p.StartTime = n
+		case 23:
+			p.VSize = n
+		case 24:
+			p.RSS = sn
+			// nothing else is processed
+			return nil
+		}
+	}
+	return nil
+}
+
+// statm processes /proc/[pid]/statm.
+func (prof *Profiler) statm(dir string, p *Process) (err error) {
+	fname := filepath.Join(dir, statmFile)
+	err = prof.open(fname)
+	if err != nil {
+		return err
+	}
+	defer prof.close()
+	prof.Line, err = prof.proc.ReadSlice('\n')
+	if err != nil && err != io.EOF {
+		return &joe.ReadError{Info: fname, Err: err}
+	}
+	var (
+		i, pos, fieldNum int
+		v                byte
+		n                uint64
+	)
+	for pos < len(prof.Line) && fieldNum < 6 {
+		fieldNum++
+		for i, v = range prof.Line[pos:] {
+			if v == 0x20 || v == '\n' {
+				break
+			}
+		}
+		n, err = tools.ParseUint(prof.Line[pos : pos+i])
+		if err != nil {
+			return &joe.ParseError{Info: fname + ": field " + strconv.Itoa(fieldNum), Err: err}
+		}
+		pos += i + 1
+		switch fieldNum {
+		case 1:
+			p.Size = n
+		case 2:
+			p.Resident = n
+		case 3:
+			p.Shared = n
+		case 4:
+			p.Text = n
+		case 6: // 5 is lib, which is always 0
+			p.Data = n
+		}
+	}
+	return nil
+}
+
+// status processes /proc/[pid]/status. Only the Uid and Gid lines are used.
+func (prof *Profiler) status(dir string, p *Process) (err error) {
+	fname := filepath.Join(dir, statusFile)
+	err = prof.open(fname)
+	if err != nil {
+		return err
+	}
+	defer prof.close()
+	var (
+		i, pos, found int
+		v             byte
+		real, eff     uint64
+	)
+	for found < 2 {
+		prof.Line, err = prof.proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return &joe.ReadError{Info: fname, Err: err}
+		}
+		if len(prof.Line) < 5 || prof.Line[1] != 'i' || prof.Line[2] != 'd' || prof.Line[3] != ':' {
+			continue
+		}
+		if prof.Line[0] != 'U' && prof.Line[0] != 'G' {
+			continue
+		}
+		// the first two values are the real and effective ids; they are
+		// tab separated.
+		pos = 5
+		for i, v = range prof.Line[pos:] {
+			if v == '\t' {
+				break
+			}
+		}
+		real, err = tools.ParseUint(prof.Line[pos : pos+i])
+		if err != nil {
+			return &joe.ParseError{Info: fname + ": " + string(prof.Line[:3]), Err: err}
+		}
+		pos += i + 1
+		for i, v = range prof.Line[pos:] {
+			if v == '\t' || v == '\n' {
+				break
+			}
+		}
+		eff, err = tools.ParseUint(prof.Line[pos : pos+i])
+		if err != nil {
+			return &joe.ParseError{Info: fname + ": " + string(prof.Line[:3]), Err: err}
+		}
+		if prof.Line[0] == 'U' {
+			p.UID, p.EUID = uint32(real), uint32(eff)
+		} else {
+			p.GID, p.EGID = uint32(real), uint32(eff)
+		}
+		found++
+	}
+	return nil
+}
+
+// io processes /proc/[pid]/io. If the caller doesn't have permission to read
+// the file, the io fields are left as is.
+func (prof *Profiler) io(dir string, p *Process) (err error) {
+	fname := filepath.Join(dir, ioFile)
+	err = prof.open(fname)
+	if err != nil {
+		if os.IsPermission(err) {
+			return nil
+		}
+		return err
+	}
+	defer prof.close()
+	var (
+		i, pos int
+		v      byte
+		n      uint64
+	)
+	for {
+		prof.Line, err = prof.proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			if os.IsPermission(err) {
+				return nil
+			}
+			return &joe.ReadError{Info: fname, Err: err}
+		}
+		// first grab the key name (everything up to the ':')
+		pos = 0
+		for i, v = range prof.Line {
+			if v == ':' {
+				pos = i + 2
+				break
+			}
+		}
+		prof.Val = prof.Line[:i]
+		if pos == 0 || pos >= len(prof.Line) {
+			continue
+		}
+		// only process the keys of interest: rchar, wchar, read_bytes,
+		// write_bytes.
+		if len(prof.Val) != 5 && len(prof.Val) != 10 && len(prof.Val) != 11 {
+			continue
+		}
+		n, err = tools.ParseUint(prof.Line[pos : len(prof.Line)-1])
+		if err != nil {
+			return &joe.ParseError{Info: fname + ": " + string(prof.Val), Err: err}
+		}
+		switch string(prof.Val) {
+		case "rchar":
+			p.RChar = n
+		case "wchar":
+			p.WChar = n
+		case "read_bytes":
+			p.ReadBytes = n
+		case "write_bytes":
+			p.WriteBytes = n
+		}
+	}
+	return nil
+}
+
+// cmdline processes /proc/[pid]/cmdline. The arguments are NUL separated;
+// they are joined using spaces. Kernel threads do not have a command line.
+func (prof *Profiler) cmdline(dir string, p *Process) (err error) {
+	fname := filepath.Join(dir, cmdlineFile)
+	err = prof.open(fname)
+	if err != nil {
+		return err
+	}
+	defer prof.close()
+	prof.cmd = prof.cmd[:0]
+	for {
+		prof.Line, err = prof.proc.ReadSlice(0x00)
+		if err != nil && err != bufio.ErrBufferFull && err != io.EOF {
+			return &joe.ReadError{Info: fname, Err: err}
+		}
+		if len(prof.Line) > 0 && prof.Line[len(prof.Line)-1] == 0x00 {
+			prof.Line[len(prof.Line)-1] = 0x20
+		}
+		prof.cmd = append(prof.cmd, prof.Line...)
+		if err == io.EOF {
+			break
+		}
+	}
+	p.Cmdline = string(joe.TrimTrailingSpaces(prof.cmd))
+	return nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the information about all of the processes on the system using
+// the package's global Profiler.
+func Get() (procs *Processes, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetPID returns the information about the process with the provided pid
+// using the package's global Profiler.
+func GetPID(pid int32) (p *Process, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetPID(pid)
+}
+
+// Ticker delivers the information about all of the processes on the system
+// at intervals.
+type Ticker struct {
+	*ticker.Ticker[*Processes]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*Processes, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procstats
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+const (
+	testStat    = "4242 (a (weird) name) S 1 4242 4242 0 -1 4194560 1234 0 5 0 150 25 0 0 20 -5 3 0 98765 123456789 2048 18446744073709551615 1 1 0 0 0 0 0 4096 0 0 0 0 17 1 0 0 0 0 0 0 0 0 0 0 0 0 0\n"
+	testStatm   = "30140 2048 1024 100 0 5000 0\n"
+	testStatus  = "Name:\ta (weird) name\nUmask:\t0022\nState:\tS (sleeping)\nTgid:\t4242\nNgid:\t0\nPid:\t4242\nPPid:\t1\nTracerPid:\t0\nUid:\t1000\t1001\t1000\t1000\nGid:\t100\t101\t100\t100\nFDSize:\t64\n"
+	testCmdline = "/usr/bin/weird\x00--flag\x00value\x00"
+	testIO      = "rchar: 4096\nwchar: 2048\nsyscr: 10\nsyscw: 5\nread_bytes: 8192\nwrite_bytes: 1024\ncancelled_write_bytes: 0\n"
+)
+
+// newTestProc creates a proc tree with a single process, pid 4242, in a temp
+// dir.
+func newTestProc(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "procstats")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pid := filepath.Join(dir, "4242")
+	err = os.Mkdir(pid, 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a non-pid entry that should be skipped
+	err = os.Mkdir(filepath.Join(dir, "self"), 0777)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{statFile: testStat, statmFile: testStatm, statusFile: testStatus, cmdlineFile: testCmdline, ioFile: testIO}
+	for k, v := range files {
+		err = ioutil.WriteFile(filepath.Join(pid, k), []byte(v), 0777)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestParse(t *testing.T) {
+	dir := newTestProc(t)
+	defer os.RemoveAll(dir)
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Fatal(err)
+	}
+	prof.ProcPath(dir)
+	procs, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(procs.Process) != 1 {
+		t.Fatalf("got %d processes; want 1", len(procs.Process))
+	}
+	want := Process{
+		PID: 4242, PPID: 1, PGRP: 4242, Session: 4242, Name: "a (weird) name", State: "S",
+		UID: 1000, EUID: 1001, GID: 100, EGID: 101, MinFlt: 1234, MajFlt: 5,
+		UTime: 150, STime: 25, Priority: 20, Nice: -5, Threads: 3, StartTime: 98765,
+		VSize: 123456789, RSS: 2048, Size: 30140, Resident: 2048, Shared: 1024,
+		Text: 100, Data: 5000, Cmdline: "/usr/bin/weird --flag value",
+		RChar: 4096, WChar: 2048, ReadBytes: 8192, WriteBytes: 1024,
+	}
+	if procs.Process[0] != want {
+		t.Errorf("got %#v; want %#v", procs.Process[0], want)
+	}
+	_, err = prof.GetPID(1)
+	if !Exited(err) {
+		t.Errorf("GetPID of a non-existent process: got %v; want a not exist error", err)
+	}
+}
+
+// The io file is read before the cmdline; the cmdline must not be built in
+// the buffer that the io file was read into.
+func TestIOCmdline(t *testing.T) {
+	cmdline := "/usr/bin/someprogram --flag=value --another-flag=another-value positional --yet-another-flag=yet-another-value"
+	fsys := fstest.MapFS{
+		"proc/4242/stat":    &fstest.MapFile{Data: []byte(testStat)},
+		"proc/4242/statm":   &fstest.MapFile{Data: []byte(testStatm)},
+		"proc/4242/status":  &fstest.MapFile{Data: []byte(testStatus)},
+		"proc/4242/io":      &fstest.MapFile{Data: []byte(testIO)},
+		"proc/4242/cmdline": &fstest.MapFile{Data: []byte("/usr/bin/someprogram\x00--flag=value\x00--another-flag=another-value\x00positional\x00--yet-another-flag=yet-another-value\x00")},
+	}
+	prof, err := NewProfiler(joe.WithFS(fsys))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, err := prof.GetPID(4242)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if p.Cmdline != cmdline {
+		t.Errorf("Cmdline: got %q; want %q", p.Cmdline, cmdline)
+	}
+	if p.RChar != 4096 || p.WriteBytes != 1024 {
+		t.Errorf("got rchar %d, write_bytes %d; want 4096, 1024", p.RChar, p.WriteBytes)
+	}
+}
+
+func TestGetPID(t *testing.T) {
+	p, err := GetPID(int32(os.Getpid()))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if p.PID != int32(os.Getpid()) {
+		t.Errorf("PID: got %d; want %d", p.PID, os.Getpid())
+	}
+	if p.PPID != int32(os.Getppid()) {
+		t.Errorf("PPID: got %d; want %d", p.PPID, os.Getppid())
+	}
+	if p.UID != uint32(os.Getuid()) {
+		t.Errorf("UID: got %d; want %d", p.UID, os.Getuid())
+	}
+	if p.Threads == 0 {
+		t.Error("Threads: wanted a non-zero value; got 0")
+	}
+	if p.Resident == 0 {
+		t.Error("Resident: wanted a non-zero value; got 0")
+	}
+	if p.Cmdline == "" {
+		t.Error("Cmdline: wanted a non-empty value; was empty")
+	}
+}
+
+func TestGet(t *testing.T) {
+	procs, err := Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkProcesses("get", procs, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkProcesses("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkProcesses(n string, procs *Processes, t *testing.T) {
+	if procs.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if procs.ClkTck == 0 {
+		t.Errorf("%s: ClkTck: wanted non-zero value; got 0", n)
+	}
+	if procs.PageSize == 0 {
+		t.Errorf("%s: PageSize: wanted non-zero value; got 0", n)
+	}
+	if len(procs.Process) == 0 {
+		t.Errorf("%s: expected processes; got none", n)
+	}
+	for i, p := range procs.Process {
+		if p.PID == 0 {
+			t.Errorf("%s: %d: PID: wanted non-zero value; got 0", n, i)
+		}
+		if p.Name == "" {
+			t.Errorf("%s: %d: Name: wanted a non-empty value; was empty", n, i)
+		}
+		if p.State == "" {
+			t.Errorf("%s: %d: State: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var procs *Processes
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		procs, _ = p.Get()
+	}
+	_ = procs
+}
@@ -0,0 +1,217 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procutil handles Flatbuffer based processing of per-process
+// utilization information. This information is calculated using the
+// difference between two snapshots of the system's processes, /proc/[pid].
+// The time elapsed between the two snapshots is stored in the TimeDelta
+// field. Instead of returning a Go struct, it returns the data as Flatbuffer
+// serialized bytes. For convenience, a function to deserialize the Flatbuffer
+// serialized bytes into a procutil.ProcUtil struct is provided.
+//
+// Note: the package name is procutil and not the final element of the import
+// path (flat).
+package procutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	util "github.com/hmmftg/joefriday/process/procutil"
+	"github.com/hmmftg/joefriday/process/procutil/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the /proc/[pid] files and calculate utilization
+// information, returning the data as Flatbuffer serialized bytes.
+type Profiler struct {
+	*util.Profiler
+	*fb.Builder
+}
+
+// Initializes and returns a process utilization profiler that uses
+// Flatbuffers.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the utilization of the system's processes as Flatbuffer
+// serialized bytes. Utilization calculations requires two snapshots. This func
+// gets the current snapshot of the processes and calculates the utilization
+// using the difference between the current snapshot and the prior one. The
+// current snapshot is stored for use as the prior snapshot on the next Get
+// call. If ongoing utilization information is desired, the Ticker should be
+// used; it's better suited for ongoing utilization information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current utilization of the system's processes as Flatbuffer
+// serialized bytes using the package's global Profiler. The Profiler is
+// instantiated lazily. If the profiler doesn't already exist, the first
+// utilization information will not be useful due to minimal time elapsing
+// between the initial and second snapshots used for utilization calculations;
+// the results of the first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize process utilization using Flatbuffers.
+func (prof *Profiler) Serialize(u *util.ProcUtil) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	utils := make([]fb.UOffsetT, len(u.Process))
+	names := make([]fb.UOffsetT, len(u.Process))
+	for i := 0; i < len(names); i++ {
+		names[i] = prof.Builder.CreateString(u.Process[i].Name)
+	}
+	for i := 0; i < len(utils); i++ {
+		structs.UtilizationStart(prof.Builder)
+		structs.UtilizationAddPID(prof.Builder, u.Process[i].PID)
+		structs.UtilizationAddPPID(prof.Builder, u.Process[i].PPID)
+		structs.UtilizationAddName(prof.Builder, names[i])
+		structs.UtilizationAddStartTime(prof.Builder, u.Process[i].StartTime)
+		structs.UtilizationAddNew(prof.Builder, u.Process[i].New)
+		structs.UtilizationAddCPU(prof.Builder, u.Process[i].CPU)
+		structs.UtilizationAddUser(prof.Builder, u.Process[i].User)
+		structs.UtilizationAddSystem(prof.Builder, u.Process[i].System)
+		structs.UtilizationAddRSS(prof.Builder, u.Process[i].RSS)
+		structs.UtilizationAddRSSDelta(prof.Builder, u.Process[i].RSSDelta)
+		structs.UtilizationAddReadBytesPerSec(prof.Builder, u.Process[i].ReadBytesPerSec)
+		structs.UtilizationAddWriteBytesPerSec(prof.Builder, u.Process[i].WriteBytesPerSec)
+		utils[i] = structs.UtilizationEnd(prof.Builder)
+	}
+	structs.ProcUtilStartProcessVector(prof.Builder, len(utils))
+	for i := len(utils) - 1; i >= 0; i-- {
+		prof.Builder.PrependUOffsetT(utils[i])
+	}
+	utilsV := prof.Builder.EndVector(len(utils))
+	structs.ProcUtilStartExitedVector(prof.Builder, len(u.Exited))
+	for i := len(u.Exited) - 1; i >= 0; i-- {
+		prof.Builder.PrependInt32(u.Exited[i])
+	}
+	exitedV := prof.Builder.EndVector(len(u.Exited))
+	structs.ProcUtilStart(prof.Builder)
+	structs.ProcUtilAddTimestamp(prof.Builder, u.Timestamp)
+	structs.ProcUtilAddTimeDelta(prof.Builder, u.TimeDelta)
+	structs.ProcUtilAddExited(prof.Builder, exitedV)
+	structs.ProcUtilAddProcess(prof.Builder, utilsV)
+	prof.Builder.Finish(structs.ProcUtilEnd(prof.Builder))
+	p := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(p))
+	copy(tmp, p)
+	return tmp
+}
+
+// Serialize the process utilization using the package global Profiler.
+func Serialize(u *util.ProcUtil) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them as
+// procutil.ProcUtil.
+func Deserialize(p []byte) *util.ProcUtil {
+	u := &util.ProcUtil{}
+	uF := &structs.Utilization{}
+	flatUtil := structs.GetRootAsProcUtil(p, 0)
+	u.Timestamp = flatUtil.Timestamp()
+	u.TimeDelta = flatUtil.TimeDelta()
+	if n := flatUtil.ExitedLength(); n > 0 {
+		u.Exited = make([]int32, n)
+		for i := 0; i < n; i++ {
+			u.Exited[i] = flatUtil.Exited(i)
+		}
+	}
+	len := flatUtil.ProcessLength()
+	u.Process = make([]util.Utilization, len)
+	for i := 0; i < len; i++ {
+		var v util.Utilization
+		if flatUtil.Process(uF, i) {
+			v.PID = uF.PID()
+			v.PPID = uF.PPID()
+			v.Name = string(uF.Name())
+			v.StartTime = uF.StartTime()
+			v.New = uF.New()
+			v.CPU = uF.CPU()
+			v.User = uF.User()
+			v.System = uF.System()
+			v.RSS = uF.RSS()
+			v.RSSDelta = uF.RSSDelta()
+			v.ReadBytesPerSec = uF.ReadBytesPerSec()
+			v.WriteBytesPerSec = uF.WriteBytesPerSec()
+		}
+		u.Process[i] = v
+	}
+	return u
+}
+
+// Ticker delivers the utilization of the system's processes at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers
+// the data at intervals and an error channel that delivers any errors
+// encountered. Stop the ticker to signal the ticker to stop running. Stopping
+// the ticker does not close the Data channel; call Close to close both the
+// ticker and the data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
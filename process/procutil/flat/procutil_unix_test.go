@@ -0,0 +1,92 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	util "github.com/hmmftg/joefriday/process/procutil"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	u := &util.ProcUtil{
+		Timestamp: 1000, TimeDelta: 500, Exited: []int32{7, 9},
+		Process: []util.Utilization{
+			{PID: 1, PPID: 0, Name: "init", StartTime: 1, CPU: 1.5, User: 1, System: 0.5, RSS: 4096, RSSDelta: -4096, ReadBytesPerSec: 10, WriteBytesPerSec: 20},
+			{PID: 42, PPID: 1, Name: "new", StartTime: 100, New: true, CPU: 3, User: 3, RSS: 8192, RSSDelta: 8192},
+		},
+	}
+	p, err := Serialize(u)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	uD := Deserialize(p)
+	if !reflect.DeepEqual(u, uD) {
+		t.Errorf("got %#v; want %#v", uD, u)
+	}
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkProcUtil("ticker", Deserialize(v), t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkProcUtil(name string, u *util.ProcUtil, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: timestamp: expected non-zero", name)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: expected non-zero value, got 0", name)
+	}
+	if len(u.Process) == 0 {
+		t.Errorf("%s: expected processes; got none", name)
+	}
+	for i, v := range u.Process {
+		if v.PID == 0 {
+			t.Errorf("%s: %d: expected PID to have a value, was 0", name, i)
+		}
+	}
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var u *util.ProcUtil
+	p, _ := NewProfiler()
+	tmp, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u = Deserialize(tmp)
+	}
+	_ = u
+}
@@ -0,0 +1,161 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procutil handles JSON based processing of per-process utilization
+// information. This information is calculated using the difference between
+// two snapshots of the system's processes, /proc/[pid]. The time elapsed
+// between the two snapshots is stored in the TimeDelta field. Instead of
+// returning a Go struct, it returns JSON serialized bytes. For convenience, a
+// function to deserialize the JSON serialized bytes into a procutil.ProcUtil
+// struct is provided.
+//
+// Note: the package name is procutil and not the final element of the import
+// path (json).
+package procutil
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	util "github.com/hmmftg/joefriday/process/procutil"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the /proc/[pid] files and calculate utilization
+// information, returning the data as JSON serialized bytes.
+type Profiler struct {
+	*util.Profiler
+}
+
+// Initializes and returns a process utilization profiler.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := util.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the utilization of the system's processes as JSON serialized
+// bytes. Utilization calculations requires two snapshots. This func gets the
+// current snapshot of the processes and calculates the utilization using the
+// difference between the current snapshot and the prior one. The current
+// snapshot is stored for use as the prior snapshot on the next Get call. If
+// ongoing utilization information is desired, the Ticker should be used; it's
+// better suited for ongoing utilization information.
+func (prof *Profiler) Get() (p []byte, err error) {
+	u, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(u)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get returns the current utilization of the system's processes as JSON
+// serialized bytes using the package's global Profiler. The Profiler is
+// instantiated lazily. If the profiler doesn't already exist, the first
+// utilization information will not be useful due to minimal time elapsing
+// between the initial and second snapshots used for utilization calculations;
+// the results of the first call should be discarded.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize process utilization using JSON.
+func (prof *Profiler) Serialize(u *util.ProcUtil) ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// Serialize the process utilization as JSON using the package global
+// Profiler.
+func Serialize(u *util.ProcUtil) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(u)
+}
+
+// Marshal is an alias for Serialize
+func (prof *Profiler) Marshal(u *util.ProcUtil) ([]byte, error) {
+	return prof.Serialize(u)
+}
+
+// Marshal is an alias for Serialize using the package global Profiler.
+func Marshal(u *util.ProcUtil) ([]byte, error) {
+	return Serialize(u)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// procutil.ProcUtil.
+func Deserialize(p []byte) (*util.ProcUtil, error) {
+	u := &util.ProcUtil{}
+	err := json.Unmarshal(p, u)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*util.ProcUtil, error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the utilization of the system's processes at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers
+// the data at intervals and an error channel that delivers any errors
+// encountered. Stop the ticker to signal the ticker to stop running. Stopping
+// the ticker does not close the Data channel; call Close to close both the
+// ticker and the data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,87 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procutil
+
+import (
+	"testing"
+	"time"
+
+	util "github.com/hmmftg/joefriday/process/procutil"
+)
+
+func TestGet(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	time.Sleep(time.Duration(200) * time.Millisecond)
+	b, err := p.Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	u, err := Deserialize(b)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkProcUtil("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := Deserialize(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			checkProcUtil("ticker", u, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkProcUtil(name string, u *util.ProcUtil, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: timestamp: expected non-zero", name)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: expected non-zero value, got 0", name)
+	}
+	if len(u.Process) == 0 {
+		t.Errorf("%s: expected processes; got none", name)
+	}
+	for i, v := range u.Process {
+		if v.PID == 0 {
+			t.Errorf("%s: %d: expected PID to have a value, was 0", name, i)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package procutil handles processing of per-process utilization information.
+// This information is calculated using the difference between two snapshots
+// of the system's processes, /proc/[pid]; CPU usage is represented as a
+// percentage and IO as bytes per second. The time elapsed between the two
+// snapshots is stored in the TimeDelta field.
+//
+// Processes are matched between snapshots using their pid and start time: a
+// process that wasn't in the prior snapshot, including a new process that
+// reused a pid, is flagged as New and its utilization is calculated from its
+// start. The pids of processes that were in the prior snapshot but no longer
+// exist are in the Exited field.
+package procutil
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	stats "github.com/hmmftg/joefriday/process/procstats"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+// ProcUtil holds the utilization information for all of the processes on the
+// system.
+type ProcUtil struct {
+	Timestamp int64 `json:"timestamp"`
+	// the time since the prior snapshot; the window that the utilization covers.
+	TimeDelta int64 `json:"time_delta"`
+	// pids of the processes that exited since the prior snapshot.
+	Exited  []int32       `json:"exited"`
+	Process []Utilization `json:"process"`
+}
+
+// Utilization holds the utilization information for a process. The CPU values
+// are percentages of a single CPU; a multi-threaded process may exceed 100.
+type Utilization struct {
+	PID       int32  `json:"pid"`
+	PPID      int32  `json:"ppid"`
+	Name      string `json:"name"`
+	StartTime uint64 `json:"start_time"`
+	// New is true if the process wasn't in the prior snapshot.
+	New    bool    `json:"new"`
+	CPU    float32 `json:"cpu"`
+	User   float32 `json:"user"`
+	System float32 `json:"system"`
+	// resident set size, in bytes.
+	RSS int64 `json:"rss"`
+	// change in the resident set size since the prior snapshot, in bytes.
+	RSSDelta         int64   `json:"rss_delta"`
+	ReadBytesPerSec  float64 `json:"read_bytes_per_sec"`
+	WriteBytesPerSec float64 `json:"write_bytes_per_sec"`
+}
+
+// Profiler is used to process the /proc/[pid] files and calculate
+// Utilization information.
+type Profiler struct {
+	*stats.Profiler
+	prior stats.Processes
+	// the index of each pid in the prior snapshot.
+	index   map[int32]int
+	matched []bool
+}
+
+// Returns an initialized Profiler; ready to use. Upon creation, a snapshot of
+// the processes is taken so that any Get() will return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := stats.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	s, err := p.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p, prior: *s, index: make(map[int32]int, len(s.Process))}, nil
+}
+
+// Get returns the utilization of the system's processes. Utilization
+// calculations requires two snapshots. This func gets the current snapshot of
+// the processes and calculates the utilization using the difference between
+// the current snapshot and the prior one. The current snapshot is stored for
+// use as the prior snapshot on the next Get call. If ongoing utilization
+// information is desired, the Ticker should be used; it's better suited for
+// ongoing utilization information.
+func (prof *Profiler) Get() (u *ProcUtil, err error) {
+	procs, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	u = prof.calculateUtilization(procs)
+	prof.prior = *procs
+	return u, nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get returns the current utilization of the system's processes using the
+// package's global Profiler. The Profiler is instantiated lazily. If the
+// profiler doesn't already exist, the first utilization information will not
+// be useful due to minimal time elapsing between the initial and second
+// snapshots used for utilization calculations; the results of the first call
+// should be discarded.
+func Get() (*ProcUtil, error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		var err error
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// cpu utilization = Δ(utime + stime) / (TimeDelta * CLK_TCK) * 100
+func (prof *Profiler) calculateUtilization(cur *stats.Processes) *ProcUtil {
+	u := &ProcUtil{
+		Timestamp: cur.Timestamp,
+		TimeDelta: cur.Timestamp - prof.prior.Timestamp,
+		Process:   make([]Utilization, len(cur.Process)),
+	}
+	var (
+		ok          bool
+		j           int
+		prior       stats.Process
+		dUser, dSys float64
+		secs, ticks float64
+		pageSize    = int64(cur.PageSize)
+		priorPgSize = int64(prof.prior.PageSize)
+		zero        stats.Process
+	)
+	secs = float64(u.TimeDelta) / float64(time.Second)
+	ticks = secs * float64(cur.ClkTck)
+	// index the prior snapshot by pid
+	for k := range prof.index {
+		delete(prof.index, k)
+	}
+	for i := range prof.prior.Process {
+		prof.index[prof.prior.Process[i].PID] = i
+	}
+	if cap(prof.matched) < len(prof.prior.Process) {
+		prof.matched = make([]bool, len(prof.prior.Process))
+	}
+	prof.matched = prof.matched[:len(prof.prior.Process)]
+	for i := range prof.matched {
+		prof.matched[i] = false
+	}
+	for i := range cur.Process {
+		v := Utilization{
+			PID:       cur.Process[i].PID,
+			PPID:      cur.Process[i].PPID,
+			Name:      cur.Process[i].Name,
+			StartTime: cur.Process[i].StartTime,
+			RSS:       int64(cur.Process[i].Resident) * pageSize,
+		}
+		j, ok = prof.index[v.PID]
+		if ok && prof.prior.Process[j].StartTime == v.StartTime {
+			prior = prof.prior.Process[j]
+			prof.matched[j] = true
+			v.RSSDelta = v.RSS - int64(prior.Resident)*priorPgSize
+		} else {
+			// a new process: everything it has done happened since the prior
+			// snapshot.
+			prior = zero
+			v.New = true
+			v.RSSDelta = v.RSS
+		}
+		dUser = float64(tools.CounterAdvance(cur.Process[i].UTime, prior.UTime))
+		dSys = float64(tools.CounterAdvance(cur.Process[i].STime, prior.STime))
+		if ticks > 0 {
+			v.User = float32(dUser / ticks * 100)
+			v.System = float32(dSys / ticks * 100)
+			v.CPU = float32((dUser + dSys) / ticks * 100)
+		}
+		if secs > 0 {
+			v.ReadBytesPerSec = float64(tools.CounterAdvance(cur.Process[i].ReadBytes, prior.ReadBytes)) / secs
+			v.WriteBytesPerSec = float64(tools.CounterAdvance(cur.Process[i].WriteBytes, prior.WriteBytes)) / secs
+		}
+		u.Process[i] = v
+	}
+	for i := range prof.matched {
+		if !prof.matched[i] {
+			u.Exited = append(u.Exited, prof.prior.Process[i].PID)
+		}
+	}
+	return u
+}
+
+// Ticker delivers the utilization of the system's processes at intervals.
+type Ticker struct {
+	*ticker.Ticker[*ProcUtil]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (*ProcUtil, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
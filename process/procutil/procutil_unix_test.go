@@ -0,0 +1,146 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package procutil
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	stats "github.com/hmmftg/joefriday/process/procstats"
+)
+
+func TestCalculateUtilization(t *testing.T) {
+	prior := stats.Processes{
+		Timestamp: 0, ClkTck: 100, PageSize: 4096,
+		Process: []stats.Process{
+			{PID: 1, Name: "init", StartTime: 1, UTime: 100, STime: 50, Resident: 10, ReadBytes: 1000, WriteBytes: 500},
+			{PID: 20, Name: "gone", StartTime: 5, UTime: 10, STime: 10, Resident: 5},
+			{PID: 30, Name: "old", StartTime: 7, UTime: 500, STime: 500, Resident: 100},
+		},
+	}
+	cur := stats.Processes{
+		Timestamp: int64(2 * time.Second), ClkTck: 100, PageSize: 4096,
+		Process: []stats.Process{
+			// ran for 1 second of user time and 0.5 of system time
+			{PID: 1, Name: "init", StartTime: 1, UTime: 200, STime: 100, Resident: 12, ReadBytes: 3000, WriteBytes: 4500},
+			// pid reused by a new process
+			{PID: 30, Name: "new", StartTime: 190, UTime: 20, STime: 0, Resident: 2},
+			// a new pid
+			{PID: 40, Name: "newer", StartTime: 195, UTime: 0, STime: 40, Resident: 3, ReadBytes: 2000},
+		},
+	}
+	prof := &Profiler{prior: prior, index: map[int32]int{}}
+	u := prof.calculateUtilization(&cur)
+	if u.TimeDelta != int64(2*time.Second) {
+		t.Errorf("TimeDelta: got %d; want %d", u.TimeDelta, 2*time.Second)
+	}
+	if !reflect.DeepEqual(u.Exited, []int32{20, 30}) {
+		t.Errorf("Exited: got %v; want [20 30]", u.Exited)
+	}
+	want := []Utilization{
+		{PID: 1, Name: "init", StartTime: 1, CPU: 75, User: 50, System: 25, RSS: 12 * 4096, RSSDelta: 2 * 4096, ReadBytesPerSec: 1000, WriteBytesPerSec: 2000},
+		{PID: 30, Name: "new", StartTime: 190, New: true, CPU: 10, User: 10, RSS: 2 * 4096, RSSDelta: 2 * 4096},
+		{PID: 40, Name: "newer", StartTime: 195, New: true, CPU: 20, System: 20, RSS: 3 * 4096, RSSDelta: 3 * 4096, ReadBytesPerSec: 1000},
+	}
+	if len(u.Process) != len(want) {
+		t.Fatalf("got %d processes; want %d", len(u.Process), len(want))
+	}
+	for i := range want {
+		if u.Process[i] != want[i] {
+			t.Errorf("%d: got %#v; want %#v", i, u.Process[i], want[i])
+		}
+	}
+
+	// a zero time delta must not result in NaN or Inf
+	prof.prior = cur
+	u = prof.calculateUtilization(&cur)
+	for i, v := range u.Process {
+		if v.CPU != 0 || v.ReadBytesPerSec != 0 {
+			t.Errorf("zero delta: %d: got CPU %f, ReadBytesPerSec %f; want 0", i, v.CPU, v.ReadBytesPerSec)
+		}
+	}
+	if len(u.Exited) != 0 {
+		t.Errorf("zero delta: Exited: got %v; want none", u.Exited)
+	}
+}
+
+func TestGet(t *testing.T) {
+	p, err := NewProfiler()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	time.Sleep(time.Duration(200) * time.Millisecond)
+	u, err := p.Get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	checkProcUtil("get", u, t)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkProcUtil("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func checkProcUtil(name string, u *ProcUtil, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: timestamp: expected non-zero", name)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: expected non-zero value, got 0", name)
+	}
+	if len(u.Process) == 0 {
+		t.Errorf("%s: expected processes; got none", name)
+	}
+	for i, v := range u.Process {
+		if v.PID == 0 {
+			t.Errorf("%s: %d: expected PID to have a value, was 0", name, i)
+		}
+		if v.CPU < 0 {
+			t.Errorf("%s: %d: CPU: got %f; want a value >= 0", name, i, v.CPU)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var u *ProcUtil
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		u, _ = p.Get()
+	}
+	_ = u
+}
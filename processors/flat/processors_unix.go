@@ -35,6 +35,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	"github.com/hmmftg/joefriday/node"
 	"github.com/hmmftg/joefriday/processors"
 	"github.com/hmmftg/joefriday/processors/flat/structs"
@@ -48,8 +49,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (p *Profiler, err error) {
-	prof, err := processors.NewProfiler()
+func NewProfiler(opts ...joe.Option) (p *Profiler, err error) {
+	prof, err := processors.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -87,6 +88,7 @@ func (p *Profiler) Serialize(procs *processors.Processors) []byte {
 	// ensure the Builder is in a usable state.
 	p.Builder.Reset()
 	architecture := p.Builder.CreateString(procs.Architecture)
+	byteOrder := p.Builder.CreateString(procs.ByteOrder)
 	vendorID := p.Builder.CreateString(procs.VendorID)
 	cpuFamily := p.Builder.CreateString(procs.CPUFamily)
 	model := p.Builder.CreateString(procs.Model)
@@ -155,6 +157,7 @@ func (p *Profiler) Serialize(procs *processors.Processors) []byte {
 	structs.ProcessorsStart(p.Builder)
 	structs.ProcessorsAddTimestamp(p.Builder, procs.Timestamp)
 	structs.ProcessorsAddArchitecture(p.Builder, architecture)
+	structs.ProcessorsAddByteOrder(p.Builder, byteOrder)
 	structs.ProcessorsAddCPUs(p.Builder, int32(procs.CPUs))
 	structs.ProcessorsAddPossible(p.Builder, possible)
 	structs.ProcessorsAddPresent(p.Builder, present)
@@ -229,6 +232,7 @@ func Deserialize(p []byte) *processors.Processors {
 	flatCache := &structs.CacheInf{}
 	procs.Timestamp = flatP.Timestamp()
 	procs.Architecture = string(flatP.Architecture())
+	procs.ByteOrder = string(flatP.ByteOrder())
 	procs.CPUs = flatP.CPUs()
 	procs.Possible = string(flatP.Possible())
 	procs.Present = string(flatP.Present())
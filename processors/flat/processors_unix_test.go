@@ -14,13 +14,31 @@
 package processors
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/hmmftg/joefriday"
 	ps "github.com/hmmftg/joefriday/processors"
 	"github.com/hmmftg/joefriday/testinfo"
+	"github.com/hmmftg/joefriday/testinfo/codectest"
 )
 
+func TestSerializeDeserialize(t *testing.T) {
+	want := codectest.Processors()
+	p, err := Serialize(want)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	got := Deserialize(p)
+	if got.ByteOrder != want.ByteOrder {
+		t.Errorf("ByteOrder: got %q; want %q", got.ByteOrder, want.ByteOrder)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+}
+
 func Testi75600u(t *testing.T) {
 	// set up the cpuinfo
 	tProc, err := joefriday.NewTempFileProc("intel", "i75600", testinfo.I75600uCPUInfo)
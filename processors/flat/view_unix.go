@@ -0,0 +1,277 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processors
+
+import (
+	fb "github.com/google/flatbuffers/go"
+	"github.com/hmmftg/joefriday/processors/flat/structs"
+)
+
+// View provides read-only access to Flatbuffer serialized
+// processors.Processors without deserializing it. Values are read from the
+// serialized bytes as they are requested, so reading a few fields, or
+// iterating the cache and NUMA node entries, does not allocate.
+type View struct {
+	t structs.Processors
+}
+
+// NewView returns a View of the Flatbuffer serialized processors.Processors in
+// p. p is not copied; it must not be modified while the View, or anything
+// returned by it, is in use.
+func NewView(p []byte) View {
+	var v View
+	v.t.Init(p, fb.GetUOffsetT(p))
+	return v
+}
+
+// Timestamp returns the Timestamp field.
+func (v View) Timestamp() int64 {
+	return v.t.Timestamp()
+}
+
+// Architecture returns the Architecture field. The returned slice refers to the
+// serialized bytes.
+func (v View) Architecture() []byte {
+	return v.t.Architecture()
+}
+
+// ByteOrder returns the ByteOrder field. The returned slice refers to the
+// serialized bytes.
+func (v View) ByteOrder() []byte {
+	return v.t.ByteOrder()
+}
+
+// Sockets returns the Sockets field.
+func (v View) Sockets() int32 {
+	return v.t.Sockets()
+}
+
+// CPUs returns the CPUs field.
+func (v View) CPUs() int32 {
+	return v.t.CPUs()
+}
+
+// Possible returns the Possible field. The returned slice refers to the
+// serialized bytes.
+func (v View) Possible() []byte {
+	return v.t.Possible()
+}
+
+// Present returns the Present field. The returned slice refers to the
+// serialized bytes.
+func (v View) Present() []byte {
+	return v.t.Present()
+}
+
+// Offline returns the Offline field. The returned slice refers to the
+// serialized bytes.
+func (v View) Offline() []byte {
+	return v.t.Offline()
+}
+
+// Online returns the Online field. The returned slice refers to the
+// serialized bytes.
+func (v View) Online() []byte {
+	return v.t.Online()
+}
+
+// CoresPerSocket returns the CoresPerSocket field.
+func (v View) CoresPerSocket() int16 {
+	return v.t.CoresPerSocket()
+}
+
+// ThreadsPerCore returns the ThreadsPerCore field.
+func (v View) ThreadsPerCore() int8 {
+	return v.t.ThreadsPerCore()
+}
+
+// VendorID returns the VendorID field. The returned slice refers to the
+// serialized bytes.
+func (v View) VendorID() []byte {
+	return v.t.VendorID()
+}
+
+// CPUFamily returns the CPUFamily field. The returned slice refers to the
+// serialized bytes.
+func (v View) CPUFamily() []byte {
+	return v.t.CPUFamily()
+}
+
+// Model returns the Model field. The returned slice refers to the
+// serialized bytes.
+func (v View) Model() []byte {
+	return v.t.Model()
+}
+
+// ModelName returns the ModelName field. The returned slice refers to the
+// serialized bytes.
+func (v View) ModelName() []byte {
+	return v.t.ModelName()
+}
+
+// Stepping returns the Stepping field. The returned slice refers to the
+// serialized bytes.
+func (v View) Stepping() []byte {
+	return v.t.Stepping()
+}
+
+// Microcode returns the Microcode field. The returned slice refers to the
+// serialized bytes.
+func (v View) Microcode() []byte {
+	return v.t.Microcode()
+}
+
+// CPUMHz returns the CPUMHz field.
+func (v View) CPUMHz() float32 {
+	return v.t.CPUMHz()
+}
+
+// MHzMin returns the MHzMin field.
+func (v View) MHzMin() float32 {
+	return v.t.MHzMin()
+}
+
+// MHzMax returns the MHzMax field.
+func (v View) MHzMax() float32 {
+	return v.t.MHzMax()
+}
+
+// CacheSize returns the CacheSize field. The returned slice refers to the
+// serialized bytes.
+func (v View) CacheSize() []byte {
+	return v.t.CacheSize()
+}
+
+// CacheLen returns the number of Cache entries.
+func (v View) CacheLen() int {
+	return v.t.CacheLength()
+}
+
+// Cache returns a view of the i-th Cache entry. It panics if i is out of
+// range.
+func (v View) Cache(i int) CacheInfView {
+	if i < 0 || i >= v.t.CacheLength() {
+		panic("processors: Cache index out of range")
+	}
+	var e CacheInfView
+	v.t.Cache(&e.t, i)
+	return e
+}
+
+// BogoMIPS returns the BogoMIPS field.
+func (v View) BogoMIPS() float32 {
+	return v.t.BogoMIPS()
+}
+
+// FlagsLen returns the number of Flags entries.
+func (v View) FlagsLen() int {
+	return v.t.FlagsLength()
+}
+
+// Flags returns the i-th Flags entry. The returned slice refers to the
+// serialized bytes. It panics if i is out of range.
+func (v View) Flags(i int) []byte {
+	if i < 0 || i >= v.t.FlagsLength() {
+		panic("processors: Flags index out of range")
+	}
+	return v.t.Flags(i)
+}
+
+// BugsLen returns the number of Bugs entries.
+func (v View) BugsLen() int {
+	return v.t.BugsLength()
+}
+
+// Bugs returns the i-th Bugs entry. The returned slice refers to the
+// serialized bytes. It panics if i is out of range.
+func (v View) Bugs(i int) []byte {
+	if i < 0 || i >= v.t.BugsLength() {
+		panic("processors: Bugs index out of range")
+	}
+	return v.t.Bugs(i)
+}
+
+// OpModesLen returns the number of OpModes entries.
+func (v View) OpModesLen() int {
+	return v.t.OpModesLength()
+}
+
+// OpModes returns the i-th OpModes entry. The returned slice refers to the
+// serialized bytes. It panics if i is out of range.
+func (v View) OpModes(i int) []byte {
+	if i < 0 || i >= v.t.OpModesLength() {
+		panic("processors: OpModes index out of range")
+	}
+	return v.t.OpModes(i)
+}
+
+// Virtualization returns the Virtualization field. The returned slice refers to
+// the serialized bytes.
+func (v View) Virtualization() []byte {
+	return v.t.Virtualization()
+}
+
+// NumaNodes returns the NumaNodes field.
+func (v View) NumaNodes() int32 {
+	return v.t.NumaNodes()
+}
+
+// NumaNodeCPUsLen returns the number of NumaNodeCPUs entries.
+func (v View) NumaNodeCPUsLen() int {
+	return v.t.NumaNodeCPUsLength()
+}
+
+// NumaNodeCPUs returns a view of the i-th NumaNodeCPUs entry. It panics if i is
+// out of range.
+func (v View) NumaNodeCPUs(i int) NodeView {
+	if i < 0 || i >= v.t.NumaNodeCPUsLength() {
+		panic("processors: NumaNodeCPUs index out of range")
+	}
+	var e NodeView
+	v.t.NumaNodeCPUs(&e.t, i)
+	return e
+}
+
+// CacheInfView is a read-only view of a single Cache entry of a View.
+type CacheInfView struct {
+	t structs.CacheInf
+}
+
+// ID returns the ID field. The returned slice refers to the
+// serialized bytes.
+func (v CacheInfView) ID() []byte {
+	return v.t.ID()
+}
+
+// Size returns the Size field. The returned slice refers to the
+// serialized bytes.
+func (v CacheInfView) Size() []byte {
+	return v.t.Size()
+}
+
+// NodeView is a read-only view of a single NumaNodeCPUs entry of a View.
+type NodeView struct {
+	t structs.Node
+}
+
+// ID returns the ID field.
+func (v NodeView) ID() int32 {
+	return v.t.ID()
+}
+
+// CPUList returns the CPUList field. The returned slice refers to the
+// serialized bytes.
+func (v NodeView) CPUList() []byte {
+	return v.t.CPUList()
+}
@@ -0,0 +1,224 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processors
+
+import (
+	"testing"
+
+	"github.com/hmmftg/joefriday/node"
+	procs "github.com/hmmftg/joefriday/processors"
+)
+
+func viewFixture() *procs.Processors {
+	return &procs.Processors{
+		Timestamp:      1,
+		Architecture:   "architecture-2",
+		ByteOrder:      "byteorder-3",
+		Sockets:        4,
+		CPUs:           5,
+		Possible:       "possible-6",
+		Present:        "present-7",
+		Offline:        "offline-8",
+		Online:         "online-9",
+		CoresPerSocket: 10,
+		ThreadsPerCore: 11,
+		VendorID:       "vendorid-12",
+		CPUFamily:      "cpufamily-13",
+		Model:          "model-14",
+		ModelName:      "modelname-15",
+		Stepping:       "stepping-16",
+		Microcode:      "microcode-17",
+		CPUMHz:         18.5,
+		MHzMin:         19.5,
+		MHzMax:         20.5,
+		CacheSize:      "cachesize-21",
+		Cache:          map[string]string{"cacheids0": "32K", "cacheids1": "256K"},
+		CacheIDs:       []string{"cacheids0", "cacheids1"},
+		BogoMIPS:       24.5,
+		Flags:          []string{"flags0", "flags1"},
+		Bugs:           []string{"bugs0", "bugs1"},
+		OpModes:        []string{"opmodes0", "opmodes1"},
+		Virtualization: "virtualization-28",
+		NumaNodes:      29,
+		NumaNodeCPUs: []node.Node{
+			{
+				ID:      31,
+				CPUList: "cpulist-32",
+			},
+			{
+				ID:      33,
+				CPUList: "cpulist-34",
+			},
+		},
+	}
+}
+
+func TestView(t *testing.T) {
+	s := viewFixture()
+	p, err := Serialize(s)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	v := NewView(p)
+	if v.Timestamp() != s.Timestamp {
+		t.Errorf("s.Timestamp: got %v; want %v", v.Timestamp(), s.Timestamp)
+	}
+	if string(v.Architecture()) != s.Architecture {
+		t.Errorf("s.Architecture: got %s; want %s", v.Architecture(), s.Architecture)
+	}
+	if string(v.ByteOrder()) != s.ByteOrder {
+		t.Errorf("s.ByteOrder: got %s; want %s", v.ByteOrder(), s.ByteOrder)
+	}
+	if v.Sockets() != s.Sockets {
+		t.Errorf("s.Sockets: got %v; want %v", v.Sockets(), s.Sockets)
+	}
+	if v.CPUs() != s.CPUs {
+		t.Errorf("s.CPUs: got %v; want %v", v.CPUs(), s.CPUs)
+	}
+	if string(v.Possible()) != s.Possible {
+		t.Errorf("s.Possible: got %s; want %s", v.Possible(), s.Possible)
+	}
+	if string(v.Present()) != s.Present {
+		t.Errorf("s.Present: got %s; want %s", v.Present(), s.Present)
+	}
+	if string(v.Offline()) != s.Offline {
+		t.Errorf("s.Offline: got %s; want %s", v.Offline(), s.Offline)
+	}
+	if string(v.Online()) != s.Online {
+		t.Errorf("s.Online: got %s; want %s", v.Online(), s.Online)
+	}
+	if v.CoresPerSocket() != s.CoresPerSocket {
+		t.Errorf("s.CoresPerSocket: got %v; want %v", v.CoresPerSocket(), s.CoresPerSocket)
+	}
+	if v.ThreadsPerCore() != s.ThreadsPerCore {
+		t.Errorf("s.ThreadsPerCore: got %v; want %v", v.ThreadsPerCore(), s.ThreadsPerCore)
+	}
+	if string(v.VendorID()) != s.VendorID {
+		t.Errorf("s.VendorID: got %s; want %s", v.VendorID(), s.VendorID)
+	}
+	if string(v.CPUFamily()) != s.CPUFamily {
+		t.Errorf("s.CPUFamily: got %s; want %s", v.CPUFamily(), s.CPUFamily)
+	}
+	if string(v.Model()) != s.Model {
+		t.Errorf("s.Model: got %s; want %s", v.Model(), s.Model)
+	}
+	if string(v.ModelName()) != s.ModelName {
+		t.Errorf("s.ModelName: got %s; want %s", v.ModelName(), s.ModelName)
+	}
+	if string(v.Stepping()) != s.Stepping {
+		t.Errorf("s.Stepping: got %s; want %s", v.Stepping(), s.Stepping)
+	}
+	if string(v.Microcode()) != s.Microcode {
+		t.Errorf("s.Microcode: got %s; want %s", v.Microcode(), s.Microcode)
+	}
+	if v.CPUMHz() != s.CPUMHz {
+		t.Errorf("s.CPUMHz: got %v; want %v", v.CPUMHz(), s.CPUMHz)
+	}
+	if v.MHzMin() != s.MHzMin {
+		t.Errorf("s.MHzMin: got %v; want %v", v.MHzMin(), s.MHzMin)
+	}
+	if v.MHzMax() != s.MHzMax {
+		t.Errorf("s.MHzMax: got %v; want %v", v.MHzMax(), s.MHzMax)
+	}
+	if string(v.CacheSize()) != s.CacheSize {
+		t.Errorf("s.CacheSize: got %s; want %s", v.CacheSize(), s.CacheSize)
+	}
+	if v.BogoMIPS() != s.BogoMIPS {
+		t.Errorf("s.BogoMIPS: got %v; want %v", v.BogoMIPS(), s.BogoMIPS)
+	}
+	if v.FlagsLen() != len(s.Flags) {
+		t.Fatalf("FlagsLen: got %d; want %d", v.FlagsLen(), len(s.Flags))
+	}
+	for i := 0; i < v.FlagsLen(); i++ {
+		if string(v.Flags(i)) != s.Flags[i] {
+			t.Errorf("s.Flags[i]: got %s; want %s", v.Flags(i), s.Flags[i])
+		}
+	}
+	if v.BugsLen() != len(s.Bugs) {
+		t.Fatalf("BugsLen: got %d; want %d", v.BugsLen(), len(s.Bugs))
+	}
+	for i := 0; i < v.BugsLen(); i++ {
+		if string(v.Bugs(i)) != s.Bugs[i] {
+			t.Errorf("s.Bugs[i]: got %s; want %s", v.Bugs(i), s.Bugs[i])
+		}
+	}
+	if v.OpModesLen() != len(s.OpModes) {
+		t.Fatalf("OpModesLen: got %d; want %d", v.OpModesLen(), len(s.OpModes))
+	}
+	for i := 0; i < v.OpModesLen(); i++ {
+		if string(v.OpModes(i)) != s.OpModes[i] {
+			t.Errorf("s.OpModes[i]: got %s; want %s", v.OpModes(i), s.OpModes[i])
+		}
+	}
+	if string(v.Virtualization()) != s.Virtualization {
+		t.Errorf("s.Virtualization: got %s; want %s", v.Virtualization(), s.Virtualization)
+	}
+	if v.NumaNodes() != s.NumaNodes {
+		t.Errorf("s.NumaNodes: got %v; want %v", v.NumaNodes(), s.NumaNodes)
+	}
+	if v.NumaNodeCPUsLen() != len(s.NumaNodeCPUs) {
+		t.Fatalf("NumaNodeCPUsLen: got %d; want %d", v.NumaNodeCPUsLen(), len(s.NumaNodeCPUs))
+	}
+	for i := 0; i < v.NumaNodeCPUsLen(); i++ {
+		e, w := v.NumaNodeCPUs(i), s.NumaNodeCPUs[i]
+		if e.ID() != w.ID {
+			t.Errorf("w.ID: got %v; want %v", e.ID(), w.ID)
+		}
+		if string(e.CPUList()) != w.CPUList {
+			t.Errorf("w.CPUList: got %s; want %s", e.CPUList(), w.CPUList)
+		}
+	}
+	if v.CacheLen() != len(s.CacheIDs) {
+		t.Fatalf("CacheLen: got %d; want %d", v.CacheLen(), len(s.CacheIDs))
+	}
+	for i := 0; i < v.CacheLen(); i++ {
+		c := v.Cache(i)
+		id := s.CacheIDs[i]
+		if string(c.ID()) != id || string(c.Size()) != s.Cache[id] {
+			t.Errorf("Cache %d: got %s %s; want %s %s", i, c.ID(), c.Size(), id, s.Cache[id])
+		}
+	}
+}
+
+func BenchmarkView(b *testing.B) {
+	var n int
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v := NewView(p)
+		n = 0
+		for j := 0; j < v.NumaNodeCPUsLen(); j++ {
+			n += len(v.NumaNodeCPUs(j).CPUList())
+		}
+	}
+	_ = n
+}
+
+// BenchmarkViewDeserialize reads the same values as BenchmarkView using
+// Deserialize.
+func BenchmarkViewDeserialize(b *testing.B) {
+	var n int
+	p, _ := Serialize(viewFixture())
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s := Deserialize(p)
+		n = 0
+		for _, nd := range s.NumaNodeCPUs {
+			n += len(nd.CPUList)
+		}
+	}
+	_ = n
+}
@@ -35,6 +35,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	procs "github.com/hmmftg/joefriday/processors"
 )
 
@@ -45,8 +46,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (p *Profiler, err error) {
-	prof, err := procs.NewProfiler()
+func NewProfiler(opts ...joe.Option) (p *Profiler, err error) {
+	prof, err := procs.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
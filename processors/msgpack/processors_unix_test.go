@@ -0,0 +1,106 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package processors
+
+import (
+	"testing"
+
+	ps "github.com/hmmftg/joefriday/processors"
+	"github.com/hmmftg/joefriday/testinfo/codectest"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	codectest.RoundTrip(t, codectest.Processors(), Serialize, Deserialize)
+}
+
+func TestI75600u(t *testing.T) {
+	testProcessors(t, codectest.I75600u)
+}
+
+func TestXeonE52690(t *testing.T) {
+	testProcessors(t, codectest.XeonE52690)
+}
+
+func TestR71800x(t *testing.T) {
+	testProcessors(t, codectest.R71800x)
+}
+
+func testProcessors(t *testing.T, cpu codectest.CPU) {
+	prof, err := NewProfiler()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	codectest.CheckProcessors(t, cpu, prof.Profiler, func() (*ps.Processors, error) {
+		p, err := prof.Get()
+		if err != nil {
+			return nil, err
+		}
+		return Unmarshal(p)
+	})
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Get()
+	}
+	_ = tmp
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	v, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Serialize(v)
+	}
+	_ = tmp
+}
+
+func BenchmarkMarshal(b *testing.B) {
+	var tmp []byte
+	p, _ := NewProfiler()
+	v, _ := p.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = p.Marshal(v)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var proc *ps.Processors
+	p, _ := NewProfiler()
+	pB, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		proc, _ = Deserialize(pB)
+	}
+	_ = proc
+}
+
+func BenchmarkUnmarshal(b *testing.B) {
+	var proc *ps.Processors
+	p, _ := NewProfiler()
+	procB, _ := p.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		proc, _ = Unmarshal(procB)
+	}
+	_ = proc
+}
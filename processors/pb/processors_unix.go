@@ -0,0 +1,214 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package processors gathers information about the physical processors on a
+// system by parsing the information from /procs/cpuinfo and the sysfs. This
+// package gathers basic information about sockets, physical processors, etc. on
+// the system. For multi-socket systems, it is assumed that all of the
+// processors are the same. Instead of returning a Go struct, protobuf
+// serialized bytes are returned. A function to deserialize the protobuf
+// serialized bytes into a processors.Processors struct is provided.
+//
+// CPUMHz currently provides the current speed of the first core encountered for
+// each physical processor. Modern x86/x86-64 cores have the ability to shift
+// their speed so this is just a point in time data point for that core; there
+// may be other cores on the processor that are at higher and lower speeds at
+// the time the data is read. This field is more useful for other architectures.
+// For x86/x86-64 cores, the MHzMin and MHzMax fields provide information about
+// the range of speeds that are possible for the cores.
+//
+// Note: the package name is processors and not the final element of the import
+// path (pb).
+package processors
+
+import (
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/node"
+	procs "github.com/hmmftg/joefriday/processors"
+	"github.com/hmmftg/joefriday/processors/pb/structs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to get the processor information, as protobuf serialized
+// bytes, by processing the /proc/cpuinfo file.
+type Profiler struct {
+	*procs.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (p *Profiler, err error) {
+	prof, err := procs.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: prof}, nil
+}
+
+// Get returns the processor information as protobuf serialized bytes.
+func (p *Profiler) Get() (b []byte, err error) {
+	proc, err := p.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return p.Serialize(proc)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the processor information as protobuf serialized bytes using the
+// package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize processor information.
+func (p *Profiler) Serialize(proc *procs.Processors) ([]byte, error) {
+	return proto.Marshal(toProto(proc))
+}
+
+// Serialize processor information.
+func Serialize(proc *procs.Processors) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(proc)
+}
+
+// Marshal is an alias for serialize.
+func (p *Profiler) Marshal(proc *procs.Processors) ([]byte, error) {
+	return p.Serialize(proc)
+}
+
+// Marshal is an alias for Serialize.
+func Marshal(proc *procs.Processors) ([]byte, error) {
+	return std.Serialize(proc)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// processors.Processors
+func Deserialize(p []byte) (*procs.Processors, error) {
+	m := &structs.Processors{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize
+func Unmarshal(p []byte) (*procs.Processors, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a processors.Processors into a new structs.Processors message.
+func toProto(proc *procs.Processors) *structs.Processors {
+	m := &structs.Processors{
+		Timestamp:      proc.Timestamp,
+		Architecture:   proc.Architecture,
+		ByteOrder:      proc.ByteOrder,
+		Sockets:        proc.Sockets,
+		Cpus:           proc.CPUs,
+		Possible:       proc.Possible,
+		Present:        proc.Present,
+		Offline:        proc.Offline,
+		Online:         proc.Online,
+		CoresPerSocket: int32(proc.CoresPerSocket),
+		ThreadsPerCore: int32(proc.ThreadsPerCore),
+		VendorId:       proc.VendorID,
+		CpuFamily:      proc.CPUFamily,
+		Model:          proc.Model,
+		ModelName:      proc.ModelName,
+		Stepping:       proc.Stepping,
+		Microcode:      proc.Microcode,
+		CpuMhz:         proc.CPUMHz,
+		MhzMin:         proc.MHzMin,
+		MhzMax:         proc.MHzMax,
+		CacheSize:      proc.CacheSize,
+		Cache:          make([]*structs.CacheInf, len(proc.CacheIDs)),
+		Bogomips:       proc.BogoMIPS,
+		Flags:          proc.Flags,
+		Bugs:           proc.Bugs,
+		OpModes:        proc.OpModes,
+		Virtualization: proc.Virtualization,
+		NumaNodes:      proc.NumaNodes,
+		NumaNodeCpus:   make([]*structs.Node, len(proc.NumaNodeCPUs)),
+	}
+	// The cache entries are kept in CacheIDs order.
+	for i, id := range proc.CacheIDs {
+		m.Cache[i] = &structs.CacheInf{Id: id, Size: proc.Cache[id]}
+	}
+	for i, n := range proc.NumaNodeCPUs {
+		m.NumaNodeCpus[i] = &structs.Node{Id: n.ID, CpuList: n.CPUList}
+	}
+	return m
+}
+
+// fromProto copies a structs.Processors message into a processors.Processors.
+func fromProto(m *structs.Processors) *procs.Processors {
+	proc := &procs.Processors{
+		Timestamp:      m.Timestamp,
+		Architecture:   m.Architecture,
+		ByteOrder:      m.ByteOrder,
+		Sockets:        m.Sockets,
+		CPUs:           m.Cpus,
+		Possible:       m.Possible,
+		Present:        m.Present,
+		Offline:        m.Offline,
+		Online:         m.Online,
+		CoresPerSocket: int16(m.CoresPerSocket),
+		ThreadsPerCore: int8(m.ThreadsPerCore),
+		VendorID:       m.VendorId,
+		CPUFamily:      m.CpuFamily,
+		Model:          m.Model,
+		ModelName:      m.ModelName,
+		Stepping:       m.Stepping,
+		Microcode:      m.Microcode,
+		CPUMHz:         m.CpuMhz,
+		MHzMin:         m.MhzMin,
+		MHzMax:         m.MhzMax,
+		CacheSize:      m.CacheSize,
+		Cache:          make(map[string]string, len(m.Cache)),
+		CacheIDs:       make([]string, len(m.Cache)),
+		BogoMIPS:       m.Bogomips,
+		Flags:          m.Flags,
+		Bugs:           m.Bugs,
+		OpModes:        m.OpModes,
+		Virtualization: m.Virtualization,
+		NumaNodes:      m.NumaNodes,
+		NumaNodeCPUs:   make([]node.Node, len(m.NumaNodeCpus)),
+	}
+	for i, c := range m.Cache {
+		proc.CacheIDs[i] = c.Id
+		proc.Cache[c.Id] = c.Size
+	}
+	for i, n := range m.NumaNodeCpus {
+		proc.NumaNodeCPUs[i] = node.Node{ID: n.Id, CPUList: n.CpuList}
+	}
+	return proc
+}
@@ -0,0 +1,524 @@
+// processors.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: processors/pb/processors.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Processors struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp      int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Architecture   string                 `protobuf:"bytes,2,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	ByteOrder      string                 `protobuf:"bytes,3,opt,name=byte_order,json=byteOrder,proto3" json:"byte_order,omitempty"`
+	Sockets        int32                  `protobuf:"varint,4,opt,name=sockets,proto3" json:"sockets,omitempty"`
+	Cpus           int32                  `protobuf:"varint,5,opt,name=cpus,proto3" json:"cpus,omitempty"`
+	Possible       string                 `protobuf:"bytes,6,opt,name=possible,proto3" json:"possible,omitempty"`
+	Present        string                 `protobuf:"bytes,7,opt,name=present,proto3" json:"present,omitempty"`
+	Offline        string                 `protobuf:"bytes,8,opt,name=offline,proto3" json:"offline,omitempty"`
+	Online         string                 `protobuf:"bytes,9,opt,name=online,proto3" json:"online,omitempty"`
+	CoresPerSocket int32                  `protobuf:"varint,10,opt,name=cores_per_socket,json=coresPerSocket,proto3" json:"cores_per_socket,omitempty"`
+	ThreadsPerCore int32                  `protobuf:"varint,11,opt,name=threads_per_core,json=threadsPerCore,proto3" json:"threads_per_core,omitempty"`
+	VendorId       string                 `protobuf:"bytes,12,opt,name=vendor_id,json=vendorId,proto3" json:"vendor_id,omitempty"`
+	CpuFamily      string                 `protobuf:"bytes,13,opt,name=cpu_family,json=cpuFamily,proto3" json:"cpu_family,omitempty"`
+	Model          string                 `protobuf:"bytes,14,opt,name=model,proto3" json:"model,omitempty"`
+	ModelName      string                 `protobuf:"bytes,15,opt,name=model_name,json=modelName,proto3" json:"model_name,omitempty"`
+	Stepping       string                 `protobuf:"bytes,16,opt,name=stepping,proto3" json:"stepping,omitempty"`
+	Microcode      string                 `protobuf:"bytes,17,opt,name=microcode,proto3" json:"microcode,omitempty"`
+	CpuMhz         float32                `protobuf:"fixed32,18,opt,name=cpu_mhz,json=cpuMhz,proto3" json:"cpu_mhz,omitempty"`
+	MhzMin         float32                `protobuf:"fixed32,19,opt,name=mhz_min,json=mhzMin,proto3" json:"mhz_min,omitempty"`
+	MhzMax         float32                `protobuf:"fixed32,20,opt,name=mhz_max,json=mhzMax,proto3" json:"mhz_max,omitempty"`
+	CacheSize      string                 `protobuf:"bytes,21,opt,name=cache_size,json=cacheSize,proto3" json:"cache_size,omitempty"`
+	Cache          []*CacheInf            `protobuf:"bytes,22,rep,name=cache,proto3" json:"cache,omitempty"`
+	Bogomips       float32                `protobuf:"fixed32,23,opt,name=bogomips,proto3" json:"bogomips,omitempty"`
+	Flags          []string               `protobuf:"bytes,24,rep,name=flags,proto3" json:"flags,omitempty"`
+	Bugs           []string               `protobuf:"bytes,25,rep,name=bugs,proto3" json:"bugs,omitempty"`
+	OpModes        []string               `protobuf:"bytes,26,rep,name=op_modes,json=opModes,proto3" json:"op_modes,omitempty"`
+	Virtualization string                 `protobuf:"bytes,27,opt,name=virtualization,proto3" json:"virtualization,omitempty"`
+	NumaNodes      int32                  `protobuf:"varint,28,opt,name=numa_nodes,json=numaNodes,proto3" json:"numa_nodes,omitempty"`
+	NumaNodeCpus   []*Node                `protobuf:"bytes,29,rep,name=numa_node_cpus,json=numaNodeCpus,proto3" json:"numa_node_cpus,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Processors) Reset() {
+	*x = Processors{}
+	mi := &file_processors_pb_processors_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Processors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Processors) ProtoMessage() {}
+
+func (x *Processors) ProtoReflect() protoreflect.Message {
+	mi := &file_processors_pb_processors_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Processors.ProtoReflect.Descriptor instead.
+func (*Processors) Descriptor() ([]byte, []int) {
+	return file_processors_pb_processors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Processors) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *Processors) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *Processors) GetByteOrder() string {
+	if x != nil {
+		return x.ByteOrder
+	}
+	return ""
+}
+
+func (x *Processors) GetSockets() int32 {
+	if x != nil {
+		return x.Sockets
+	}
+	return 0
+}
+
+func (x *Processors) GetCpus() int32 {
+	if x != nil {
+		return x.Cpus
+	}
+	return 0
+}
+
+func (x *Processors) GetPossible() string {
+	if x != nil {
+		return x.Possible
+	}
+	return ""
+}
+
+func (x *Processors) GetPresent() string {
+	if x != nil {
+		return x.Present
+	}
+	return ""
+}
+
+func (x *Processors) GetOffline() string {
+	if x != nil {
+		return x.Offline
+	}
+	return ""
+}
+
+func (x *Processors) GetOnline() string {
+	if x != nil {
+		return x.Online
+	}
+	return ""
+}
+
+func (x *Processors) GetCoresPerSocket() int32 {
+	if x != nil {
+		return x.CoresPerSocket
+	}
+	return 0
+}
+
+func (x *Processors) GetThreadsPerCore() int32 {
+	if x != nil {
+		return x.ThreadsPerCore
+	}
+	return 0
+}
+
+func (x *Processors) GetVendorId() string {
+	if x != nil {
+		return x.VendorId
+	}
+	return ""
+}
+
+func (x *Processors) GetCpuFamily() string {
+	if x != nil {
+		return x.CpuFamily
+	}
+	return ""
+}
+
+func (x *Processors) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Processors) GetModelName() string {
+	if x != nil {
+		return x.ModelName
+	}
+	return ""
+}
+
+func (x *Processors) GetStepping() string {
+	if x != nil {
+		return x.Stepping
+	}
+	return ""
+}
+
+func (x *Processors) GetMicrocode() string {
+	if x != nil {
+		return x.Microcode
+	}
+	return ""
+}
+
+func (x *Processors) GetCpuMhz() float32 {
+	if x != nil {
+		return x.CpuMhz
+	}
+	return 0
+}
+
+func (x *Processors) GetMhzMin() float32 {
+	if x != nil {
+		return x.MhzMin
+	}
+	return 0
+}
+
+func (x *Processors) GetMhzMax() float32 {
+	if x != nil {
+		return x.MhzMax
+	}
+	return 0
+}
+
+func (x *Processors) GetCacheSize() string {
+	if x != nil {
+		return x.CacheSize
+	}
+	return ""
+}
+
+func (x *Processors) GetCache() []*CacheInf {
+	if x != nil {
+		return x.Cache
+	}
+	return nil
+}
+
+func (x *Processors) GetBogomips() float32 {
+	if x != nil {
+		return x.Bogomips
+	}
+	return 0
+}
+
+func (x *Processors) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *Processors) GetBugs() []string {
+	if x != nil {
+		return x.Bugs
+	}
+	return nil
+}
+
+func (x *Processors) GetOpModes() []string {
+	if x != nil {
+		return x.OpModes
+	}
+	return nil
+}
+
+func (x *Processors) GetVirtualization() string {
+	if x != nil {
+		return x.Virtualization
+	}
+	return ""
+}
+
+func (x *Processors) GetNumaNodes() int32 {
+	if x != nil {
+		return x.NumaNodes
+	}
+	return 0
+}
+
+func (x *Processors) GetNumaNodeCpus() []*Node {
+	if x != nil {
+		return x.NumaNodeCpus
+	}
+	return nil
+}
+
+type CacheInf struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Size          string                 `protobuf:"bytes,2,opt,name=size,proto3" json:"size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CacheInf) Reset() {
+	*x = CacheInf{}
+	mi := &file_processors_pb_processors_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CacheInf) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheInf) ProtoMessage() {}
+
+func (x *CacheInf) ProtoReflect() protoreflect.Message {
+	mi := &file_processors_pb_processors_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheInf.ProtoReflect.Descriptor instead.
+func (*CacheInf) Descriptor() ([]byte, []int) {
+	return file_processors_pb_processors_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CacheInf) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CacheInf) GetSize() string {
+	if x != nil {
+		return x.Size
+	}
+	return ""
+}
+
+type Node struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int32                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CpuList       string                 `protobuf:"bytes,2,opt,name=cpu_list,json=cpuList,proto3" json:"cpu_list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Node) Reset() {
+	*x = Node{}
+	mi := &file_processors_pb_processors_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Node) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Node) ProtoMessage() {}
+
+func (x *Node) ProtoReflect() protoreflect.Message {
+	mi := &file_processors_pb_processors_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Node.ProtoReflect.Descriptor instead.
+func (*Node) Descriptor() ([]byte, []int) {
+	return file_processors_pb_processors_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Node) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Node) GetCpuList() string {
+	if x != nil {
+		return x.CpuList
+	}
+	return ""
+}
+
+var File_processors_pb_processors_proto protoreflect.FileDescriptor
+
+var file_processors_pb_processors_proto_rawDesc = string([]byte{
+	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2f, 0x70, 0x62, 0x2f,
+	0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x14, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x22, 0x8c, 0x07, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69, 0x74, 0x65, 0x63, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x74, 0x65, 0x63, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x79, 0x74, 0x65, 0x5f,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x63, 0x70, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x63, 0x70, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x66, 0x66,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x28, 0x0a, 0x10,
+	0x63, 0x6f, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x50, 0x65, 0x72,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0e, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x73, 0x50, 0x65, 0x72, 0x43, 0x6f, 0x72, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x70, 0x75, 0x5f, 0x66, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x63, 0x70, 0x75, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x65, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x6d, 0x69, 0x63, 0x72, 0x6f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x63,
+	0x70, 0x75, 0x5f, 0x6d, 0x68, 0x7a, 0x18, 0x12, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x63, 0x70,
+	0x75, 0x4d, 0x68, 0x7a, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x68, 0x7a, 0x5f, 0x6d, 0x69, 0x6e, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x6d, 0x68, 0x7a, 0x4d, 0x69, 0x6e, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x68, 0x7a, 0x5f, 0x6d, 0x61, 0x78, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
+	0x6d, 0x68, 0x7a, 0x4d, 0x61, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x61, 0x63, 0x68, 0x65, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x16,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x73, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x49, 0x6e, 0x66, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x6f, 0x67, 0x6f, 0x6d, 0x69, 0x70, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x62,
+	0x6f, 0x67, 0x6f, 0x6d, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73,
+	0x18, 0x18, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x62, 0x75, 0x67, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x62, 0x75, 0x67,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x70, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x1a, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x4d, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x76, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x6e, 0x75, 0x6d, 0x61, 0x5f, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x63, 0x70, 0x75, 0x73, 0x18, 0x1d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6a, 0x6f,
+	0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x6e, 0x75, 0x6d, 0x61, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x70, 0x75, 0x73, 0x22, 0x2e, 0x0a, 0x08, 0x43, 0x61, 0x63, 0x68, 0x65, 0x49, 0x6e,
+	0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x31, 0x0a, 0x04, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x70, 0x75, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x70, 0x75, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x3b, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f,
+	0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f,
+	0x72, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_processors_pb_processors_proto_rawDescOnce sync.Once
+	file_processors_pb_processors_proto_rawDescData []byte
+)
+
+func file_processors_pb_processors_proto_rawDescGZIP() []byte {
+	file_processors_pb_processors_proto_rawDescOnce.Do(func() {
+		file_processors_pb_processors_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_processors_pb_processors_proto_rawDesc), len(file_processors_pb_processors_proto_rawDesc)))
+	})
+	return file_processors_pb_processors_proto_rawDescData
+}
+
+var file_processors_pb_processors_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_processors_pb_processors_proto_goTypes = []any{
+	(*Processors)(nil), // 0: joefriday.processors.Processors
+	(*CacheInf)(nil),   // 1: joefriday.processors.CacheInf
+	(*Node)(nil),       // 2: joefriday.processors.Node
+}
+var file_processors_pb_processors_proto_depIdxs = []int32{
+	1, // 0: joefriday.processors.Processors.cache:type_name -> joefriday.processors.CacheInf
+	2, // 1: joefriday.processors.Processors.numa_node_cpus:type_name -> joefriday.processors.Node
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_processors_pb_processors_proto_init() }
+func file_processors_pb_processors_proto_init() {
+	if File_processors_pb_processors_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_processors_pb_processors_proto_rawDesc), len(file_processors_pb_processors_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_processors_pb_processors_proto_goTypes,
+		DependencyIndexes: file_processors_pb_processors_proto_depIdxs,
+		MessageInfos:      file_processors_pb_processors_proto_msgTypes,
+	}.Build()
+	File_processors_pb_processors_proto = out.File
+	file_processors_pb_processors_proto_goTypes = nil
+	file_processors_pb_processors_proto_depIdxs = nil
+}
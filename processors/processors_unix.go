@@ -99,13 +99,14 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
-	cpuProf := cpux.NewProfiler()
-	nodeProf := node.NewProfiler()
+	cpuProf := cpux.NewProfiler(opts...)
+	nodeProf := node.NewProfiler(opts...)
 	return &Profiler{Procer: proc, Buffer: joe.NewBuffer(), CPUProf: cpuProf, NodeProf: nodeProf}, nil
 }
 
@@ -21,6 +21,7 @@
 package mem
 
 import (
+	"context"
 	"sync"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	joe "github.com/hmmftg/joefriday"
 	m "github.com/hmmftg/joefriday/sysinfo/mem"
 	"github.com/hmmftg/joefriday/sysinfo/mem/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 var builder = fb.NewBuilder(0)
@@ -82,8 +84,7 @@ func Deserialize(p []byte) *m.MemInfo {
 
 // Ticker gets mem.MemInfo as Flatbuffers serialized bytes at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan []byte
+	*ticker.Ticker[[]byte]
 }
 
 // NewTicker returns a new Ticker containing a Data channel that delivers the
@@ -92,31 +93,12 @@ type Ticker struct {
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel..
 func NewTicker(d time.Duration) (joe.Tocker, error) {
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan []byte)}
-	go t.Run()
-	return &t, nil
+	return NewTickerContext(context.Background(), d, ticker.Config{})
 }
 
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// read until done signal is received
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.Ticker.C:
-			p, err := Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- p
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config) (joe.Tocker, error) {
+	return &Ticker{Ticker: ticker.Start(ctx, d, cfg, Get)}, nil
 }
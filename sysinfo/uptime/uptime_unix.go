@@ -15,10 +15,12 @@
 package uptime
 
 import (
+	"context"
 	"syscall"
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
 )
 
 // Uptime holds the current uptime and timestamp.
@@ -47,8 +49,7 @@ func Get() (u Uptime, err error) {
 
 // Ticker deliivers the uptime at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan Uptime
+	*ticker.Ticker[Uptime]
 }
 
 // NewTicker returns a new Ticker containing a Data channel that delivers the
@@ -57,31 +58,12 @@ type Ticker struct {
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
 func NewTicker(d time.Duration) (joe.Tocker, error) {
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan Uptime)}
-	go t.Run()
-	return &t, nil
+	return NewTickerContext(context.Background(), d, ticker.Config{})
 }
 
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	// read until done signal is received
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.Ticker.C:
-			u, err := Get()
-			if err != nil {
-				t.Errs <- err
-				continue
-			}
-			t.Data <- u
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config) (joe.Tocker, error) {
+	return &Ticker{Ticker: ticker.Start(ctx, d, cfg, Get)}, nil
 }
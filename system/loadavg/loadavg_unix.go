@@ -15,6 +15,7 @@
 package loadavg
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"strconv"
@@ -22,6 +23,7 @@ import (
 	"time"
 
 	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
 	"github.com/hmmftg/joefriday/tools"
 )
 
@@ -45,8 +47,9 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	proc, err := joe.NewProc(procFile)
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	proc, err := o.NewProc(o.Path(procFile))
 	if err != nil {
 		return nil, err
 	}
@@ -154,8 +157,7 @@ func Get() (la LoadAvg, err error) {
 
 // Ticker delivers the system's loadavg information at intervals.
 type Ticker struct {
-	*joe.Ticker
-	Data chan LoadAvg
+	*ticker.Ticker[LoadAvg]
 	*Profiler
 }
 
@@ -164,109 +166,21 @@ type Ticker struct {
 // Stop the ticker to signal the ticker to stop running. Stopping the ticker
 // does not close the Data channel; call Close to close both the ticker and the
 // data channel.
-func NewTicker(d time.Duration) (joe.Tocker, error) {
-	p, err := NewProfiler()
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
-	t := Ticker{Ticker: joe.NewTicker(d), Data: make(chan LoadAvg), Profiler: p}
-	go t.Run()
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (LoadAvg, error) {
+		return t.Get()
+	})
 	return &t, nil
 }
-
-// Run runs the ticker.
-func (t *Ticker) Run() {
-	var (
-		i, priorPos, pos, line, fieldNum int
-		n                                uint64
-		f                                float64
-		v                                byte
-		la                               LoadAvg
-		err                              error
-	)
-	// ticker
-	for {
-		select {
-		case <-t.Done:
-			return
-		case <-t.C:
-			la.Timestamp = time.Now().UTC().UnixNano()
-			err = t.Reset()
-			line = 0
-		tick:
-			for {
-				t.Line, err = t.ReadSlice('\n')
-				if err != nil {
-					if err == io.EOF {
-						break
-					}
-					t.Errs <- &joe.ReadError{Err: err}
-					break
-				}
-				line++
-				for {
-					// space delimits the values
-					for i, v = range t.Line[pos:] {
-						if v == 0x20 {
-							priorPos, pos = pos, pos+i+1
-							break
-						}
-					}
-					fieldNum++
-					if fieldNum <= 3 {
-						f, err = strconv.ParseFloat(string(t.Line[priorPos:pos-1]), 64)
-						if err != nil {
-							t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-							break tick
-						}
-						if fieldNum == 1 {
-							la.Minute = float32(f)
-							continue
-						}
-						if fieldNum == 2 {
-							la.Five = float32(f)
-							continue
-						}
-						la.Fifteen = float32(f)
-						continue
-					}
-					if fieldNum == 4 {
-						// get the process information: separated by /
-						for i, v = range t.Line[priorPos:pos] {
-							if v == '/' {
-								break
-							}
-						}
-						n, err = tools.ParseUint(t.Line[priorPos : priorPos+i])
-						if err != nil {
-							t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-							break tick
-						}
-						la.Running = int32(n)
-						n, err = tools.ParseUint(t.Line[priorPos+i+1 : pos-1])
-						if err != nil {
-							t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-							break tick
-						}
-						la.Total = int32(n)
-						continue
-					}
-					n, err = tools.ParseUint(t.Line[pos : len(t.Line)-1])
-					if err != nil {
-						t.Errs <- &joe.ParseError{Info: fmt.Sprintf("line %d: field %d", line, fieldNum), Err: err}
-						break tick
-					}
-					la.PID = int32(n)
-					break
-				}
-			}
-			t.Data <- la
-		}
-	}
-}
-
-// Close closes the ticker resources.
-func (t *Ticker) Close() {
-	t.Ticker.Close()
-	close(t.Data)
-}
@@ -0,0 +1,174 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loadAvg gets loadavg information from the /proc/loadavg file.
+// Instead of returning a Go struct, it returns protobuf serialized bytes. A
+// function to deserialize the protobuf serialized bytes into an loadavg.LoadAvg
+// struct is provided.
+//
+// Note: the package name is loadavg and not the final element of the import
+// path (pb).
+package loadavg
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	l "github.com/hmmftg/joefriday/system/loadavg"
+	"github.com/hmmftg/joefriday/system/loadavg/pb/structs"
+	"github.com/hmmftg/joefriday/ticker"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler is used to process the loadavg information, /proc/loadavg, using
+// protobuf.
+type Profiler struct {
+	*l.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := l.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get returns the current loadavg information as protobuf serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	k, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(k)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current loadavg information as protobuf serialized bytes
+// using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize loadavg.LoadAvg using protobuf.
+func (prof *Profiler) Serialize(la l.LoadAvg) ([]byte, error) {
+	return proto.Marshal(toProto(la))
+}
+
+// Serialize loadavg.LoadAvg using protobuf with the package's global Profiler.
+func Serialize(la l.LoadAvg) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(la)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(la l.LoadAvg) ([]byte, error) {
+	return prof.Serialize(la)
+}
+
+// Marshal is an alias for Serialize using the package's global profiler.
+func Marshal(la l.LoadAvg) ([]byte, error) {
+	return Serialize(la)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// loadavg.LoadAvg.
+func Deserialize(p []byte) (la l.LoadAvg, err error) {
+	m := &structs.LoadAvg{}
+	err = proto.Unmarshal(p, m)
+	if err != nil {
+		return la, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (l.LoadAvg, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a loadavg.LoadAvg into a new structs.LoadAvg message.
+func toProto(la l.LoadAvg) *structs.LoadAvg {
+	return &structs.LoadAvg{
+		Timestamp: la.Timestamp,
+		Minute:    la.Minute,
+		Five:      la.Five,
+		Fifteen:   la.Fifteen,
+		Running:   la.Running,
+		Total:     la.Total,
+		Pid:       la.PID,
+	}
+}
+
+// fromProto copies a structs.LoadAvg message into a loadavg.LoadAvg.
+func fromProto(m *structs.LoadAvg) l.LoadAvg {
+	return l.LoadAvg{
+		Timestamp: m.Timestamp,
+		Minute:    m.Minute,
+		Five:      m.Five,
+		Fifteen:   m.Fifteen,
+		Running:   m.Running,
+		Total:     m.Total,
+		PID:       m.Pid,
+	}
+}
+
+// Ticker delivers the system's loadavg information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -0,0 +1,188 @@
+// loadavg.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: system/loadavg/pb/loadavg.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type LoadAvg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Timestamp     int64                  `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Minute        float32                `protobuf:"fixed32,2,opt,name=minute,proto3" json:"minute,omitempty"`
+	Five          float32                `protobuf:"fixed32,3,opt,name=five,proto3" json:"five,omitempty"`
+	Fifteen       float32                `protobuf:"fixed32,4,opt,name=fifteen,proto3" json:"fifteen,omitempty"`
+	Running       int32                  `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+	Total         int32                  `protobuf:"varint,6,opt,name=total,proto3" json:"total,omitempty"`
+	Pid           int32                  `protobuf:"varint,7,opt,name=pid,proto3" json:"pid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadAvg) Reset() {
+	*x = LoadAvg{}
+	mi := &file_system_loadavg_pb_loadavg_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadAvg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadAvg) ProtoMessage() {}
+
+func (x *LoadAvg) ProtoReflect() protoreflect.Message {
+	mi := &file_system_loadavg_pb_loadavg_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadAvg.ProtoReflect.Descriptor instead.
+func (*LoadAvg) Descriptor() ([]byte, []int) {
+	return file_system_loadavg_pb_loadavg_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoadAvg) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetMinute() float32 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetFive() float32 {
+	if x != nil {
+		return x.Five
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetFifteen() float32 {
+	if x != nil {
+		return x.Fifteen
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+var File_system_loadavg_pb_loadavg_proto protoreflect.FileDescriptor
+
+var file_system_loadavg_pb_loadavg_proto_rawDesc = string([]byte{
+	0x0a, 0x1f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x67,
+	0x2f, 0x70, 0x62, 0x2f, 0x6c, 0x6f, 0x61, 0x64, 0x61, 0x76, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x11, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64, 0x61, 0x79, 0x2e, 0x6c, 0x6f, 0x61,
+	0x64, 0x61, 0x76, 0x67, 0x22, 0xaf, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06,
+	0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x76, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x66, 0x69, 0x76, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69,
+	0x66, 0x74, 0x65, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x66, 0x69, 0x66,
+	0x74, 0x65, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x70, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x70, 0x69, 0x64, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66,
+	0x72, 0x69, 0x64, 0x61, 0x79, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6c, 0x6f, 0x61,
+	0x64, 0x61, 0x76, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_system_loadavg_pb_loadavg_proto_rawDescOnce sync.Once
+	file_system_loadavg_pb_loadavg_proto_rawDescData []byte
+)
+
+func file_system_loadavg_pb_loadavg_proto_rawDescGZIP() []byte {
+	file_system_loadavg_pb_loadavg_proto_rawDescOnce.Do(func() {
+		file_system_loadavg_pb_loadavg_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_system_loadavg_pb_loadavg_proto_rawDesc), len(file_system_loadavg_pb_loadavg_proto_rawDesc)))
+	})
+	return file_system_loadavg_pb_loadavg_proto_rawDescData
+}
+
+var file_system_loadavg_pb_loadavg_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_system_loadavg_pb_loadavg_proto_goTypes = []any{
+	(*LoadAvg)(nil), // 0: joefriday.loadavg.LoadAvg
+}
+var file_system_loadavg_pb_loadavg_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_system_loadavg_pb_loadavg_proto_init() }
+func file_system_loadavg_pb_loadavg_proto_init() {
+	if File_system_loadavg_pb_loadavg_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_system_loadavg_pb_loadavg_proto_rawDesc), len(file_system_loadavg_pb_loadavg_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_loadavg_pb_loadavg_proto_goTypes,
+		DependencyIndexes: file_system_loadavg_pb_loadavg_proto_depIdxs,
+		MessageInfos:      file_system_loadavg_pb_loadavg_proto_msgTypes,
+	}.Build()
+	File_system_loadavg_pb_loadavg_proto = out.File
+	file_system_loadavg_pb_loadavg_proto_goTypes = nil
+	file_system_loadavg_pb_loadavg_proto_depIdxs = nil
+}
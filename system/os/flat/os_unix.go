@@ -23,6 +23,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	o "github.com/hmmftg/joefriday/system/os"
 	"github.com/hmmftg/joefriday/system/os/flat/structs"
 )
@@ -35,8 +36,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := o.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := o.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
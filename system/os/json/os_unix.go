@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	o "github.com/hmmftg/joefriday/system/os"
 )
 
@@ -33,8 +34,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := o.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := o.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
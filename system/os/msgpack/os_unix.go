@@ -0,0 +1,130 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package os provides OS Release information, /etc/os-release. Instead of
+// returning a Go struct, it returns MessagePack serialized bytes. A function to
+// deserialize the MessagePack serialized bytes into am os.OS struct is
+// provided.
+//
+// Struct fields are keyed by their json tag names so that the MessagePack and
+// JSON encodings of a struct use the same keys; integers are written in their
+// most compact form.
+//
+// Note: the package name is os and not the final element of the import path
+// (msgpack).
+package os
+
+import (
+	"bytes"
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	o "github.com/hmmftg/joefriday/system/os"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Profiler processes the OS release information, /etc/os-release,
+// using MessagePack.
+type Profiler struct {
+	*o.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := o.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get gets the OS release information, /etc/os-release, as MessagePack
+// serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	k, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(k)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get gets the OS release information, /etc/os-release, as MessagePack
+// serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize os.OS as MessagePack
+func (prof *Profiler) Serialize(os *o.OS) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	err := enc.Encode(os)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Serialize os.OS as MessagePack using the package's global Profiler.
+func Serialize(os *o.OS) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(os)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(os *o.OS) ([]byte, error) {
+	return prof.Serialize(os)
+}
+
+// Marshal is an alias for Serialize using the package's global profiler.
+func Marshal(os *o.OS) ([]byte, error) {
+	return Serialize(os)
+}
+
+// Deserialize takes some MessagePack serialized bytes and unmarshals them as
+// os.OS.
+func Deserialize(p []byte) (*o.OS, error) {
+	os := &o.OS{}
+	dec := msgpack.NewDecoder(bytes.NewReader(p))
+	dec.SetCustomStructTag("json")
+	err := dec.Decode(os)
+	if err != nil {
+		return nil, err
+	}
+	return os, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*o.OS, error) {
+	return Deserialize(p)
+}
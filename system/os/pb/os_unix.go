@@ -0,0 +1,143 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package os provides OS Release information, /etc/os-release. Instead of
+// returning a Go struct, it returns protobuf serialized bytes. A function to
+// deserialize the protobuf serialized bytes into am os.OS struct is provided.
+//
+// Note: the package name is os and not the final element of the import
+// path (pb).
+package os
+
+import (
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	o "github.com/hmmftg/joefriday/system/os"
+	"github.com/hmmftg/joefriday/system/os/pb/structs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler processes the OS release information, /etc/os-release,
+// using protobuf.
+type Profiler struct {
+	*o.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := o.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get gets the OS release information, /etc/os-release, as protobuf serialized
+// bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	k, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(k)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get gets the OS release information, /etc/os-release, as protobuf serialized
+// bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize os.OS as protobuf
+func (prof *Profiler) Serialize(os *o.OS) ([]byte, error) {
+	return proto.Marshal(toProto(os))
+}
+
+// Serialize os.OS as protobuf using the package's global Profiler.
+func Serialize(os *o.OS) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(os)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(os *o.OS) ([]byte, error) {
+	return prof.Serialize(os)
+}
+
+// Marshal is an alias for Serialize using the package's global profiler.
+func Marshal(os *o.OS) ([]byte, error) {
+	return Serialize(os)
+}
+
+// Deserialize takes some protobuf serialized bytes and unmarshals them as
+// os.OS.
+func Deserialize(p []byte) (*o.OS, error) {
+	m := &structs.OS{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*o.OS, error) {
+	return Deserialize(p)
+}
+
+// toProto copies an os.OS into a new structs.OS message.
+func toProto(os *o.OS) *structs.OS {
+	return &structs.OS{
+		Name:         os.Name,
+		Id:           os.ID,
+		IdLike:       os.IDLike,
+		PrettyName:   os.PrettyName,
+		Version:      os.Version,
+		VersionId:    os.VersionID,
+		HomeUrl:      os.HomeURL,
+		BugReportUrl: os.BugReportURL,
+	}
+}
+
+// fromProto copies a structs.OS message into an os.OS.
+func fromProto(m *structs.OS) *o.OS {
+	return &o.OS{
+		Name:         m.Name,
+		ID:           m.Id,
+		IDLike:       m.IdLike,
+		PrettyName:   m.PrettyName,
+		Version:      m.Version,
+		VersionID:    m.VersionId,
+		HomeURL:      m.HomeUrl,
+		BugReportURL: m.BugReportUrl,
+	}
+}
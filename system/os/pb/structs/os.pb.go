@@ -0,0 +1,198 @@
+// os.proto
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.5
+// 	protoc        (unknown)
+// source: system/os/pb/os.proto
+
+package structs
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type OS struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Id            string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	IdLike        string                 `protobuf:"bytes,3,opt,name=id_like,json=idLike,proto3" json:"id_like,omitempty"`
+	PrettyName    string                 `protobuf:"bytes,4,opt,name=pretty_name,json=prettyName,proto3" json:"pretty_name,omitempty"`
+	Version       string                 `protobuf:"bytes,5,opt,name=version,proto3" json:"version,omitempty"`
+	VersionId     string                 `protobuf:"bytes,6,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	HomeUrl       string                 `protobuf:"bytes,7,opt,name=home_url,json=homeUrl,proto3" json:"home_url,omitempty"`
+	BugReportUrl  string                 `protobuf:"bytes,8,opt,name=bug_report_url,json=bugReportUrl,proto3" json:"bug_report_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OS) Reset() {
+	*x = OS{}
+	mi := &file_system_os_pb_os_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OS) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OS) ProtoMessage() {}
+
+func (x *OS) ProtoReflect() protoreflect.Message {
+	mi := &file_system_os_pb_os_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OS.ProtoReflect.Descriptor instead.
+func (*OS) Descriptor() ([]byte, []int) {
+	return file_system_os_pb_os_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *OS) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OS) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OS) GetIdLike() string {
+	if x != nil {
+		return x.IdLike
+	}
+	return ""
+}
+
+func (x *OS) GetPrettyName() string {
+	if x != nil {
+		return x.PrettyName
+	}
+	return ""
+}
+
+func (x *OS) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *OS) GetVersionId() string {
+	if x != nil {
+		return x.VersionId
+	}
+	return ""
+}
+
+func (x *OS) GetHomeUrl() string {
+	if x != nil {
+		return x.HomeUrl
+	}
+	return ""
+}
+
+func (x *OS) GetBugReportUrl() string {
+	if x != nil {
+		return x.BugReportUrl
+	}
+	return ""
+}
+
+var File_system_os_pb_os_proto protoreflect.FileDescriptor
+
+var file_system_os_pb_os_proto_rawDesc = string([]byte{
+	0x0a, 0x15, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6f, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69, 0x64,
+	0x61, 0x79, 0x2e, 0x6f, 0x73, 0x22, 0xdc, 0x01, 0x0a, 0x02, 0x4f, 0x53, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x69, 0x64, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x69, 0x64, 0x4c, 0x69, 0x6b, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x65,
+	0x74, 0x74, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x72, 0x65, 0x74, 0x74, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x68, 0x6f, 0x6d, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x24,
+	0x0a, 0x0e, 0x62, 0x75, 0x67, 0x5f, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x75, 0x72, 0x6c,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x75, 0x67, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x55, 0x72, 0x6c, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x68, 0x6d, 0x6d, 0x66, 0x74, 0x67, 0x2f, 0x6a, 0x6f, 0x65, 0x66, 0x72, 0x69,
+	0x64, 0x61, 0x79, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x2f, 0x6f, 0x73, 0x2f, 0x70, 0x62,
+	0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x3b, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+})
+
+var (
+	file_system_os_pb_os_proto_rawDescOnce sync.Once
+	file_system_os_pb_os_proto_rawDescData []byte
+)
+
+func file_system_os_pb_os_proto_rawDescGZIP() []byte {
+	file_system_os_pb_os_proto_rawDescOnce.Do(func() {
+		file_system_os_pb_os_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_system_os_pb_os_proto_rawDesc), len(file_system_os_pb_os_proto_rawDesc)))
+	})
+	return file_system_os_pb_os_proto_rawDescData
+}
+
+var file_system_os_pb_os_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_system_os_pb_os_proto_goTypes = []any{
+	(*OS)(nil), // 0: joefriday.os.OS
+}
+var file_system_os_pb_os_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_system_os_pb_os_proto_init() }
+func file_system_os_pb_os_proto_init() {
+	if File_system_os_pb_os_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_system_os_pb_os_proto_rawDesc), len(file_system_os_pb_os_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_system_os_pb_os_proto_goTypes,
+		DependencyIndexes: file_system_os_pb_os_proto_depIdxs,
+		MessageInfos:      file_system_os_pb_os_proto_msgTypes,
+	}.Build()
+	File_system_os_pb_os_proto = out.File
+	file_system_os_pb_os_proto_goTypes = nil
+	file_system_os_pb_os_proto_depIdxs = nil
+}
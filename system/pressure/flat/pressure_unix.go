@@ -0,0 +1,314 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pressure gets the pressure stall information (PSI) of the cpu,
+// memory, and io resources from /proc/pressure/cpu, /proc/pressure/memory,
+// and /proc/pressure/io. Instead of returning a Go struct, it returns
+// Flatbuffer serialized bytes. Functions to deserialize the Flatbuffer
+// serialized bytes into a pressure.Pressure or pressure.Usage struct are
+// provided.
+//
+// Note: the package name is pressure and not the final element of the import
+// path (flat).
+package pressure
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
+	p "github.com/hmmftg/joefriday/system/pressure"
+	"github.com/hmmftg/joefriday/system/pressure/flat/structs"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the pressure information using Flatbuffers.
+type Profiler struct {
+	*p.Profiler
+	*fb.Builder
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	pr, err := p.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: pr, Builder: fb.NewBuilder(0)}, nil
+}
+
+// Get returns the current pressure information as Flatbuffer serialized
+// bytes.
+func (prof *Profiler) Get() ([]byte, error) {
+	pr, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(pr), nil
+}
+
+// GetUsage returns the stall percentages since the prior snapshot as
+// Flatbuffer serialized bytes.
+func (prof *Profiler) GetUsage() ([]byte, error) {
+	u, err := prof.Profiler.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeUsage(u), nil
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current pressure information as Flatbuffer serialized
+// bytes using the package's global Profiler.
+func Get() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage returns the stall percentages as Flatbuffer serialized bytes using
+// the package's global Profiler. The profiler is lazily instantiated. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func GetUsage() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Serialize serializes pressure information using Flatbuffers.
+func (prof *Profiler) Serialize(pr p.Pressure) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	cpu := prof.serializeResource(&pr.CPU)
+	mem := prof.serializeResource(&pr.Memory)
+	io := prof.serializeResource(&pr.IO)
+	structs.PressureStart(prof.Builder)
+	structs.PressureAddTimestamp(prof.Builder, pr.Timestamp)
+	structs.PressureAddCPU(prof.Builder, cpu)
+	structs.PressureAddMemory(prof.Builder, mem)
+	structs.PressureAddIO(prof.Builder, io)
+	prof.Builder.Finish(structs.PressureEnd(prof.Builder))
+	b := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(b))
+	copy(tmp, b)
+	return tmp
+}
+
+func (prof *Profiler) serializeResource(r *p.Resource) fb.UOffsetT {
+	some := prof.serializeStall(&r.Some)
+	full := prof.serializeStall(&r.Full)
+	structs.ResourceStart(prof.Builder)
+	structs.ResourceAddSome(prof.Builder, some)
+	structs.ResourceAddFull(prof.Builder, full)
+	return structs.ResourceEnd(prof.Builder)
+}
+
+func (prof *Profiler) serializeStall(s *p.Stall) fb.UOffsetT {
+	structs.StallStart(prof.Builder)
+	structs.StallAddAvg10(prof.Builder, s.Avg10)
+	structs.StallAddAvg60(prof.Builder, s.Avg60)
+	structs.StallAddAvg300(prof.Builder, s.Avg300)
+	structs.StallAddTotal(prof.Builder, s.Total)
+	return structs.StallEnd(prof.Builder)
+}
+
+// Serialize serializes pressure information using Flatbuffers with the
+// package's global Profiler.
+func Serialize(pr p.Pressure) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(pr), nil
+}
+
+// SerializeUsage serializes the stall percentages using Flatbuffers.
+func (prof *Profiler) SerializeUsage(u p.Usage) []byte {
+	// ensure the Builder is in a usable state.
+	prof.Builder.Reset()
+	cpu := prof.serializeResourceUsage(&u.CPU)
+	mem := prof.serializeResourceUsage(&u.Memory)
+	io := prof.serializeResourceUsage(&u.IO)
+	structs.UsageStart(prof.Builder)
+	structs.UsageAddTimestamp(prof.Builder, u.Timestamp)
+	structs.UsageAddTimeDelta(prof.Builder, u.TimeDelta)
+	structs.UsageAddCPU(prof.Builder, cpu)
+	structs.UsageAddMemory(prof.Builder, mem)
+	structs.UsageAddIO(prof.Builder, io)
+	prof.Builder.Finish(structs.UsageEnd(prof.Builder))
+	b := prof.Builder.Bytes[prof.Builder.Head():]
+	// copy them (otherwise gets lost in reset)
+	tmp := make([]byte, len(b))
+	copy(tmp, b)
+	return tmp
+}
+
+func (prof *Profiler) serializeResourceUsage(r *p.ResourceUsage) fb.UOffsetT {
+	structs.ResourceUsageStart(prof.Builder)
+	structs.ResourceUsageAddSome(prof.Builder, r.Some)
+	structs.ResourceUsageAddFull(prof.Builder, r.Full)
+	return structs.ResourceUsageEnd(prof.Builder)
+}
+
+// SerializeUsage serializes the stall percentages using Flatbuffers with the
+// package's global Profiler.
+func SerializeUsage(u p.Usage) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeUsage(u), nil
+}
+
+// Deserialize takes some Flatbuffer serialized bytes and deserializes them as
+// pressure.Pressure.
+func Deserialize(b []byte) p.Pressure {
+	flatP := structs.GetRootAsPressure(b, 0)
+	var pr p.Pressure
+	pr.Timestamp = flatP.Timestamp()
+	pr.CPU = deserializeResource(flatP.CPU(nil))
+	pr.Memory = deserializeResource(flatP.Memory(nil))
+	pr.IO = deserializeResource(flatP.IO(nil))
+	return pr
+}
+
+func deserializeResource(flatR *structs.Resource) (r p.Resource) {
+	if flatR == nil {
+		return r
+	}
+	r.Some = deserializeStall(flatR.Some(nil))
+	r.Full = deserializeStall(flatR.Full(nil))
+	return r
+}
+
+func deserializeStall(flatS *structs.Stall) (s p.Stall) {
+	if flatS == nil {
+		return s
+	}
+	s.Avg10 = flatS.Avg10()
+	s.Avg60 = flatS.Avg60()
+	s.Avg300 = flatS.Avg300()
+	s.Total = flatS.Total()
+	return s
+}
+
+// DeserializeUsage takes some Flatbuffer serialized bytes and deserializes
+// them as pressure.Usage.
+func DeserializeUsage(b []byte) p.Usage {
+	flatU := structs.GetRootAsUsage(b, 0)
+	var u p.Usage
+	u.Timestamp = flatU.Timestamp()
+	u.TimeDelta = flatU.TimeDelta()
+	u.CPU = deserializeResourceUsage(flatU.CPU(nil))
+	u.Memory = deserializeResourceUsage(flatU.Memory(nil))
+	u.IO = deserializeResourceUsage(flatU.IO(nil))
+	return u
+}
+
+func deserializeResourceUsage(flatR *structs.ResourceUsage) (r p.ResourceUsage) {
+	if flatR == nil {
+		return r
+	}
+	r.Some = flatR.Some()
+	r.Full = flatR.Full()
+	return r
+}
+
+// Ticker delivers the system's pressure information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	pr, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: pr}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the system's stall percentages, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	pr, err := NewProfiler()
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: pr}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
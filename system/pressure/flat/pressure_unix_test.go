@@ -0,0 +1,117 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressure
+
+import (
+	"testing"
+	"time"
+
+	fb "github.com/google/flatbuffers/go"
+	p "github.com/hmmftg/joefriday/system/pressure"
+)
+
+func TestSerializeDeserialize(t *testing.T) {
+	prof := &Profiler{Builder: fb.NewBuilder(0)}
+	pr := p.Pressure{
+		Timestamp: 1000,
+		CPU:       p.Resource{Some: p.Stall{Avg10: 4.34, Avg60: 1.39, Avg300: 0.97, Total: 34598895}},
+		Memory: p.Resource{
+			Some: p.Stall{Avg10: 0.1, Avg60: 0.2, Avg300: 0.3, Total: 2435462},
+			Full: p.Stall{Avg10: 0.01, Avg60: 0.02, Avg300: 0.03, Total: 1993870},
+		},
+		IO: p.Resource{Full: p.Stall{Total: 42}},
+	}
+	prD := Deserialize(prof.Serialize(pr))
+	if prD != pr {
+		t.Errorf("got %#v; want %#v", prD, pr)
+	}
+
+	u := p.Usage{
+		Timestamp: 2000, TimeDelta: 1000,
+		CPU:    p.ResourceUsage{Some: 25.5},
+		Memory: p.ResourceUsage{Some: 10, Full: 5},
+		IO:     p.ResourceUsage{Some: 100, Full: 99.9},
+	}
+	uD := DeserializeUsage(prof.SerializeUsage(u))
+	if uD != u {
+		t.Errorf("usage: got %#v; want %#v", uD, u)
+	}
+}
+
+func TestGet(t *testing.T) {
+	b, err := Get()
+	if err != nil {
+		if err == p.ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	pr := Deserialize(b)
+	if pr.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100 * time.Millisecond)
+	if err != nil {
+		if err == p.ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u := DeserializeUsage(v)
+			if u.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func BenchmarkSerialize(b *testing.B) {
+	var tmp []byte
+	prof, _ := NewProfiler()
+	pr, _ := prof.Profiler.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp = prof.Serialize(pr)
+	}
+	_ = tmp
+}
+
+func BenchmarkDeserialize(b *testing.B) {
+	var pr p.Pressure
+	prof, _ := NewProfiler()
+	tmp, _ := prof.Get()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		pr = Deserialize(tmp)
+	}
+	_ = pr
+}
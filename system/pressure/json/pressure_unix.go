@@ -0,0 +1,234 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pressure gets the pressure stall information (PSI) of the cpu,
+// memory, and io resources from /proc/pressure/cpu, /proc/pressure/memory,
+// and /proc/pressure/io. Instead of returning a Go struct, it returns JSON
+// serialized bytes. Functions to deserialize the JSON serialized bytes into a
+// pressure.Pressure or pressure.Usage struct are provided.
+//
+// Note: the package name is pressure and not the final element of the import
+// path (json).
+package pressure
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	p "github.com/hmmftg/joefriday/system/pressure"
+	"github.com/hmmftg/joefriday/ticker"
+)
+
+// Profiler is used to process the pressure information using JSON.
+type Profiler struct {
+	*p.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	pr, err := p.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: pr}, nil
+}
+
+// Get returns the current pressure information as JSON serialized bytes.
+func (prof *Profiler) Get() (b []byte, err error) {
+	pr, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(pr)
+}
+
+// GetUsage returns the stall percentages since the prior snapshot as JSON
+// serialized bytes.
+func (prof *Profiler) GetUsage() (b []byte, err error) {
+	u, err := prof.Profiler.GetUsage()
+	if err != nil {
+		return nil, err
+	}
+	return prof.SerializeUsage(u)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get returns the current pressure information as JSON serialized bytes using
+// the package's global Profiler.
+func Get() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage returns the stall percentages as JSON serialized bytes using the
+// package's global Profiler. The profiler is lazily instantiated. If the
+// profiler doesn't already exist, the first usage information will not be
+// useful due to minimal time elapsing between the initial and second
+// snapshots used for usage calculations; the results of the first call should
+// be discarded.
+func GetUsage() (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Serialize pressure.Pressure using JSON.
+func (prof *Profiler) Serialize(pr p.Pressure) ([]byte, error) {
+	return json.Marshal(pr)
+}
+
+// Serialize pressure.Pressure using JSON with the package's global Profiler.
+func Serialize(pr p.Pressure) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(pr)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(pr p.Pressure) ([]byte, error) {
+	return prof.Serialize(pr)
+}
+
+// Marshal is an alias for Serialize using the package's global profiler.
+func Marshal(pr p.Pressure) ([]byte, error) {
+	return Serialize(pr)
+}
+
+// SerializeUsage serializes pressure.Usage using JSON.
+func (prof *Profiler) SerializeUsage(u p.Usage) ([]byte, error) {
+	return json.Marshal(u)
+}
+
+// SerializeUsage serializes pressure.Usage using JSON with the package's
+// global Profiler.
+func SerializeUsage(u p.Usage) (b []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.SerializeUsage(u)
+}
+
+// Deserialize takes some JSON serialized bytes and unmarshals them as
+// pressure.Pressure.
+func Deserialize(b []byte) (pr p.Pressure, err error) {
+	err = json.Unmarshal(b, &pr)
+	if err != nil {
+		return pr, err
+	}
+	return pr, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(b []byte) (p.Pressure, error) {
+	return Deserialize(b)
+}
+
+// DeserializeUsage takes some JSON serialized bytes and unmarshals them as
+// pressure.Usage.
+func DeserializeUsage(b []byte) (u p.Usage, err error) {
+	err = json.Unmarshal(b, &u)
+	if err != nil {
+		return u, err
+	}
+	return u, nil
+}
+
+// Ticker delivers the system's pressure information at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	pr, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: pr}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the system's stall percentages, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	pr, err := NewProfiler()
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: pr}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
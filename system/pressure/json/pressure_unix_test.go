@@ -0,0 +1,84 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressure
+
+import (
+	"testing"
+	"time"
+
+	p "github.com/hmmftg/joefriday/system/pressure"
+)
+
+func TestGet(t *testing.T) {
+	b, err := Get()
+	if err != nil {
+		if err == p.ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	pr, err := Deserialize(b)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if pr.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100 * time.Millisecond)
+	if err != nil {
+		if err == p.ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			u, err := DeserializeUsage(v)
+			if err != nil {
+				t.Error(err)
+				continue
+			}
+			if u.TimeDelta == 0 {
+				t.Error("ticker: TimeDelta: wanted non-zero value; got 0")
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func BenchmarkGet(b *testing.B) {
+	var tmp []byte
+	prof, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tmp, _ = prof.Get()
+	}
+	_ = tmp
+}
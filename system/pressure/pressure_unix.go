@@ -0,0 +1,402 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pressure gets the pressure stall information (PSI) of the cpu,
+// memory, and io resources from /proc/pressure/cpu, /proc/pressure/memory,
+// and /proc/pressure/io. PSI requires Linux 4.20 or later with PSI enabled.
+//
+// The pressure, Get, holds the kernel's running averages of the percentage of
+// time tasks were stalled on each resource and the total stall time. The
+// usage, GetUsage, is the percentage of time tasks were stalled on each
+// resource during the interval between two snapshots; it is calculated from
+// the change in the total stall time.
+package pressure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/hmmftg/joefriday/tools"
+)
+
+const (
+	CPUFile    = "/proc/pressure/cpu"
+	MemoryFile = "/proc/pressure/memory"
+	IOFile     = "/proc/pressure/io"
+)
+
+// ErrNotSupported is returned when none of the pressure files exist: the
+// kernel doesn't support PSI.
+var ErrNotSupported = errors.New("pressure stall information is not supported")
+
+// Stall holds a line of a pressure file. Avg10, Avg60, and Avg300 are the
+// percentage of time that tasks were stalled over the last 10, 60, and 300
+// seconds. Total is the total stall time in microseconds.
+type Stall struct {
+	Avg10  float32
+	Avg60  float32
+	Avg300 float32
+	Total  int64
+}
+
+// Resource holds the pressure information of a resource. Some is the time in
+// which at least some tasks were stalled on the resource. Full is the time in
+// which all non-idle tasks were stalled on the resource simultaneously. Full
+// is zero for CPU prior to Linux 5.13, which doesn't report it.
+type Resource struct {
+	Some Stall
+	Full Stall
+}
+
+// Pressure holds the pressure information of the cpu, memory, and io
+// resources. The information of a resource whose file doesn't exist is zero.
+type Pressure struct {
+	Timestamp int64
+	CPU       Resource
+	Memory    Resource
+	IO        Resource
+}
+
+// ResourceUsage holds the percentage of time, during the interval between
+// two snapshots, that some or all non-idle tasks were stalled on a resource.
+type ResourceUsage struct {
+	Some float32
+	Full float32
+}
+
+// Usage holds the stall percentages of the cpu, memory, and io resources
+// during the interval between two snapshots; the TimeDelta field holds the
+// time elapsed between the two snapshots.
+type Usage struct {
+	Timestamp int64
+	TimeDelta int64
+	CPU       ResourceUsage
+	Memory    ResourceUsage
+	IO        ResourceUsage
+}
+
+// Profiler processes the pressure information.
+type Profiler struct {
+	*joe.Buffer
+	// The pressure files. A nil file is skipped.
+	CPU    joe.Procer
+	Memory joe.Procer
+	IO     joe.Procer
+	prior  Pressure
+}
+
+// Returns an initialized Profiler; ready to use. Pressure files that don't
+// exist are skipped; if none of them exist, ErrNotSupported is returned. Upon
+// creation, a snapshot of the pressure information is taken so that any
+// GetUsage() will return valid information.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	o := joe.NewOptions(opts...)
+	prof = &Profiler{Buffer: joe.NewBuffer()}
+	prof.CPU, err = newProc(o, CPUFile)
+	if err != nil {
+		return nil, err
+	}
+	prof.Memory, err = newProc(o, MemoryFile)
+	if err != nil {
+		return nil, err
+	}
+	prof.IO, err = newProc(o, IOFile)
+	if err != nil {
+		return nil, err
+	}
+	if prof.CPU == nil && prof.Memory == nil && prof.IO == nil {
+		return nil, ErrNotSupported
+	}
+	prof.prior, err = prof.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// newProc returns a Procer for the file, using the Options; if the file
+// doesn't exist, nil is returned.
+func newProc(o joe.Options, fname string) (joe.Procer, error) {
+	proc, err := o.NewProc(o.Path(fname))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return proc, nil
+}
+
+// Reset resources: after reset, the profiler is ready to be used again.
+func (prof *Profiler) Reset() error {
+	prof.Buffer.Reset()
+	for _, p := range []joe.Procer{prof.CPU, prof.Memory, prof.IO} {
+		if p == nil {
+			continue
+		}
+		err := p.Reset()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Get returns the current pressure information.
+func (prof *Profiler) Get() (p Pressure, err error) {
+	err = prof.Reset()
+	if err != nil {
+		return p, err
+	}
+	p.Timestamp = time.Now().UTC().UnixNano()
+	if prof.CPU != nil {
+		p.CPU, err = prof.parse("cpu", prof.CPU)
+		if err != nil {
+			return p, err
+		}
+	}
+	if prof.Memory != nil {
+		p.Memory, err = prof.parse("memory", prof.Memory)
+		if err != nil {
+			return p, err
+		}
+	}
+	if prof.IO != nil {
+		p.IO, err = prof.parse("io", prof.IO)
+		if err != nil {
+			return p, err
+		}
+	}
+	return p, nil
+}
+
+// GetUsage returns the percentage of time that tasks were stalled on each
+// resource since the prior snapshot. The current snapshot is stored for use
+// as the prior snapshot on the next GetUsage call. If ongoing usage
+// information is desired, the UsageTicker should be used; it's better suited
+// for ongoing usage information.
+func (prof *Profiler) GetUsage() (u Usage, err error) {
+	cur, err := prof.Get()
+	if err != nil {
+		return u, err
+	}
+	u = CalculateUsage(&cur, &prof.prior)
+	prof.prior = cur
+	return u, nil
+}
+
+// parse processes a pressure file, e.g.:
+//
+//	some avg10=0.00 avg60=0.00 avg300=0.00 total=0
+//	full avg10=0.00 avg60=0.00 avg300=0.00 total=0
+func (prof *Profiler) parse(name string, proc joe.Procer) (r Resource, err error) {
+	var (
+		i, pos, eq, line int
+		n                uint64
+		f                float64
+		s                *Stall
+	)
+	for {
+		prof.Line, err = proc.ReadSlice('\n')
+		if err != nil {
+			if err == io.EOF {
+				return r, nil
+			}
+			return r, &joe.ReadError{Info: name, Err: err}
+		}
+		line++
+		for i = 0; i < len(prof.Line); i++ {
+			if prof.Line[i] == 0x20 {
+				break
+			}
+		}
+		switch string(prof.Line[:i]) {
+		case "some":
+			s = &r.Some
+		case "full":
+			s = &r.Full
+		default:
+			return r, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", name, line), Err: fmt.Errorf("unknown stall type %q", prof.Line[:i])}
+		}
+		// the key=value pairs
+		for pos = i + 1; pos < len(prof.Line); pos = i + 1 {
+			eq = 0
+			for i = pos; i < len(prof.Line); i++ {
+				if prof.Line[i] == '=' {
+					eq = i
+				}
+				if prof.Line[i] == 0x20 || prof.Line[i] == '\n' {
+					break
+				}
+			}
+			if eq == 0 {
+				return r, &joe.ParseError{Info: fmt.Sprintf("%s: line %d", name, line), Err: fmt.Errorf("%q: not a key=value pair", prof.Line[pos:i])}
+			}
+			prof.Val = prof.Line[eq+1 : i]
+			switch string(prof.Line[pos:eq]) {
+			case "avg10":
+				f, err = strconv.ParseFloat(string(prof.Val), 32)
+				s.Avg10 = float32(f)
+			case "avg60":
+				f, err = strconv.ParseFloat(string(prof.Val), 32)
+				s.Avg60 = float32(f)
+			case "avg300":
+				f, err = strconv.ParseFloat(string(prof.Val), 32)
+				s.Avg300 = float32(f)
+			case "total":
+				n, err = tools.ParseUint(prof.Val)
+				s.Total = int64(n)
+			}
+			if err != nil {
+				return r, &joe.ParseError{Info: fmt.Sprintf("%s: line %d: %s", name, line, prof.Line[pos:eq]), Err: err}
+			}
+		}
+	}
+}
+
+// CalculateUsage returns the percentage of time that tasks were stalled on
+// each resource between the prior and current snapshots. The percentages are
+// calculated from the change in the total stall time, which is in
+// microseconds, relative to the time elapsed between the snapshots.
+func CalculateUsage(cur, prior *Pressure) Usage {
+	u := Usage{Timestamp: cur.Timestamp, TimeDelta: cur.Timestamp - prior.Timestamp}
+	u.CPU = resourceUsage(&cur.CPU, &prior.CPU, u.TimeDelta)
+	u.Memory = resourceUsage(&cur.Memory, &prior.Memory, u.TimeDelta)
+	u.IO = resourceUsage(&cur.IO, &prior.IO, u.TimeDelta)
+	return u
+}
+
+func resourceUsage(cur, prior *Resource, timeDelta int64) ResourceUsage {
+	return ResourceUsage{
+		Some: stallPercent(cur.Some.Total, prior.Some.Total, timeDelta),
+		Full: stallPercent(cur.Full.Total, prior.Full.Total, timeDelta),
+	}
+}
+
+// stallPercent returns the change in the total stall time, in microseconds,
+// as a percentage of the time delta, in nanoseconds. If the time delta isn't
+// positive or the total decreased, 0 is returned. Since the snapshots aren't
+// taken at exactly the same time as the kernel updates the totals, the
+// result is capped at 100.
+func stallPercent(cur, prior, timeDelta int64) float32 {
+	if timeDelta <= 0 {
+		return 0
+	}
+	pct := float64(tools.CounterAdvance(cur, prior)) * float64(time.Microsecond) / float64(timeDelta) * 100
+	if pct > 100 {
+		return 100
+	}
+	return float32(pct)
+}
+
+var std *Profiler
+var stdMu sync.Mutex
+
+// Get gets the pressure information using the package's global Profiler.
+func Get() (p Pressure, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return p, err
+		}
+	}
+	return std.Get()
+}
+
+// GetUsage gets the stall percentages using the package's global Profiler.
+// The profiler is lazily instantiated. If the profiler doesn't already exist,
+// the first usage information will not be useful due to minimal time elapsing
+// between the initial and second snapshots used for usage calculations; the
+// results of the first call should be discarded.
+func GetUsage() (u Usage, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return u, err
+		}
+	}
+	return std.GetUsage()
+}
+
+// Ticker delivers the system's pressure information at intervals.
+type Ticker struct {
+	*ticker.Ticker[Pressure]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (Pressure, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
+
+// UsageTicker delivers the system's stall percentages, over each tick's
+// interval, at intervals.
+type UsageTicker struct {
+	*ticker.Ticker[Usage]
+	*Profiler
+}
+
+// NewUsageTicker returns a new UsageTicker containing a Data channel that
+// delivers the data at intervals and an error channel that delivers any
+// errors encountered. Stop the ticker to signal the ticker to stop running.
+// Stopping the ticker does not close the Data channel; call Close to close
+// both the ticker and the data channel.
+func NewUsageTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewUsageTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewUsageTickerContext returns a new UsageTicker that stops when ctx is
+// done. The size of the UsageTicker's Data and Errs channel buffers, and
+// what happens when they are full, is determined by cfg.
+func NewUsageTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := UsageTicker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() (Usage, error) {
+		return t.GetUsage()
+	})
+	return &t, nil
+}
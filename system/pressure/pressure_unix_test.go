@@ -0,0 +1,243 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pressure
+
+import (
+	"testing"
+	"testing/fstest"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+)
+
+func TestGet(t *testing.T) {
+	// cpu doesn't have a full line, as on kernels prior to 5.13.
+	cpu, err := joe.NewTempFileProc("pressure", "cpu", []byte(
+		"some avg10=4.34 avg60=1.39 avg300=0.97 total=34598895\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cpu.Remove()
+	mem, err := joe.NewTempFileProc("pressure", "memory", []byte(
+		"some avg10=0.10 avg60=0.20 avg300=0.30 total=2435462\n"+
+			"full avg10=0.01 avg60=0.02 avg300=0.03 total=1993870\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer mem.Remove()
+
+	prof := &Profiler{Buffer: joe.NewBuffer(), CPU: cpu, Memory: mem}
+	p, err := prof.Get()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if p.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	expected := Pressure{
+		Timestamp: p.Timestamp,
+		CPU: Resource{
+			Some: Stall{Avg10: 4.34, Avg60: 1.39, Avg300: 0.97, Total: 34598895},
+		},
+		Memory: Resource{
+			Some: Stall{Avg10: 0.10, Avg60: 0.20, Avg300: 0.30, Total: 2435462},
+			Full: Stall{Avg10: 0.01, Avg60: 0.02, Avg300: 0.03, Total: 1993870},
+		},
+	}
+	if p != expected {
+		t.Errorf("got %#v; want %#v", p, expected)
+	}
+}
+
+func TestGetErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"unknown stall type", "most avg10=0.00 avg60=0.00 avg300=0.00 total=0\n"},
+		{"not a pair", "some avg10 avg60=0.00 avg300=0.00 total=0\n"},
+		{"bad avg", "some avg10=x avg60=0.00 avg300=0.00 total=0\n"},
+		{"bad total", "some avg10=0.00 avg60=0.00 avg300=0.00 total=-1\n"},
+	}
+	prof := &Profiler{Buffer: joe.NewBuffer()}
+	for _, test := range tests {
+		proc, err := joe.NewTempFileProc("pressure", "cpu", []byte(test.data))
+		if err != nil {
+			t.Fatal(err)
+		}
+		prof.CPU = proc
+		_, err = prof.Get()
+		if err == nil {
+			t.Errorf("%s: expected an error; got none", test.name)
+		} else if _, ok := err.(*joe.ParseError); !ok {
+			t.Errorf("%s: expected a ParseError; got %#v", test.name, err)
+		}
+		proc.Remove()
+	}
+}
+
+func TestCalculateUsage(t *testing.T) {
+	sec := int64(time.Second)
+	tests := []struct {
+		name     string
+		prior    Resource
+		cur      Resource
+		delta    int64
+		expected ResourceUsage
+	}{
+		{"none", Resource{}, Resource{}, sec, ResourceUsage{}},
+		{
+			"some and full", Resource{Some: Stall{Total: 1000}, Full: Stall{Total: 500}},
+			Resource{Some: Stall{Total: 251000}, Full: Stall{Total: 100500}}, sec,
+			ResourceUsage{Some: 25, Full: 10},
+		},
+		{"capped", Resource{}, Resource{Some: Stall{Total: 1000100}}, sec, ResourceUsage{Some: 100}},
+		{"reset", Resource{Some: Stall{Total: 5000}}, Resource{Some: Stall{Total: 10}}, sec, ResourceUsage{}},
+		{"no time delta", Resource{}, Resource{Some: Stall{Total: 5000}}, 0, ResourceUsage{}},
+	}
+	for _, test := range tests {
+		prior := Pressure{Timestamp: 1000, IO: test.prior}
+		cur := Pressure{Timestamp: 1000 + test.delta, IO: test.cur}
+		u := CalculateUsage(&cur, &prior)
+		if u.TimeDelta != test.delta {
+			t.Errorf("%s: TimeDelta: got %d; want %d", test.name, u.TimeDelta, test.delta)
+		}
+		if u.IO != test.expected {
+			t.Errorf("%s: got %#v; want %#v", test.name, u.IO, test.expected)
+		}
+		if u.CPU != (ResourceUsage{}) || u.Memory != (ResourceUsage{}) {
+			t.Errorf("%s: expected the CPU and Memory usage to be zero; got %#v and %#v", test.name, u.CPU, u.Memory)
+		}
+	}
+}
+
+func TestGetLive(t *testing.T) {
+	p, err := Get()
+	if err != nil {
+		if err == ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if p.Timestamp == 0 {
+		t.Error("Timestamp: wanted non-zero value; got 0")
+	}
+	t.Logf("%#v\n", p)
+}
+
+func TestTicker(t *testing.T) {
+	tkr, err := NewTicker(100 * time.Millisecond)
+	if err != nil {
+		if err == ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*Ticker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			if v.Timestamp == 0 {
+				t.Error("ticker: Timestamp: wanted non-zero value; got 0")
+			}
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func TestUsageTicker(t *testing.T) {
+	tkr, err := NewUsageTicker(100 * time.Millisecond)
+	if err != nil {
+		if err == ErrNotSupported {
+			t.Skip(err)
+		}
+		t.Error(err)
+		return
+	}
+	tk := tkr.(*UsageTicker)
+	for i := 0; i < 5; i++ {
+		select {
+		case <-tk.Done:
+			break
+		case v, ok := <-tk.Data:
+			if !ok {
+				break
+			}
+			checkUsage("ticker", v, t)
+		case err := <-tk.Errs:
+			t.Errorf("unexpected error: %s", err)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+// The UsageTicker uses the Options, like the Ticker.
+func TestUsageTickerOptions(t *testing.T) {
+	_, err := NewUsageTicker(time.Millisecond, joe.WithFS(fstest.MapFS{}))
+	if err != ErrNotSupported {
+		t.Errorf("no pressure files: got %v; want %v", err, ErrNotSupported)
+	}
+	fsys := fstest.MapFS{
+		"proc/pressure/cpu": &fstest.MapFile{Data: []byte("some avg10=4.34 avg60=1.39 avg300=0.97 total=34598895\n")},
+	}
+	tkr, err := NewUsageTicker(time.Millisecond, joe.WithFS(fsys))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	tk := tkr.(*UsageTicker)
+	defer tk.Close()
+	if tk.Profiler.Memory != nil || tk.Profiler.IO != nil {
+		t.Errorf("got memory %v and io %v; want only the fixture's cpu file", tk.Profiler.Memory, tk.Profiler.IO)
+	}
+	select {
+	case <-tk.Data:
+	case err := <-tk.Errs:
+		t.Errorf("unexpected error: %s", err)
+	}
+}
+
+func checkUsage(n string, u Usage, t *testing.T) {
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	for _, v := range []ResourceUsage{u.CPU, u.Memory, u.IO} {
+		if v.Some < 0 || v.Some > 100 || v.Full < 0 || v.Full > 100 {
+			t.Errorf("%s: expected stall percentages between 0 and 100; got %#v", n, v)
+		}
+	}
+}
+
+func BenchmarkGet(b *testing.B) {
+	var p Pressure
+	prof, _ := NewProfiler()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		p, _ = prof.Get()
+	}
+	_ = p
+}
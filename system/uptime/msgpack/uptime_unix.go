@@ -0,0 +1,160 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package uptime gets the current uptime from the /proc/uptime file. Instead of
+// returning a Go struct, it returns MessagePack serialized bytes. A function to
+// deserialize the MessagePack serialized bytes into an uptime.Uptime struct is
+// provided.
+//
+// Struct fields are keyed by their json tag names so that the MessagePack and
+// JSON encodings of a struct use the same keys; integers are written in their
+// most compact form.
+//
+// Note: the package name is uptime and not the final element of the import path
+// (msgpack).
+package uptime
+
+import (
+	"bytes"
+	"context"
+	"sync"
+	"time"
+
+	joe "github.com/hmmftg/joefriday"
+	u "github.com/hmmftg/joefriday/system/uptime"
+	"github.com/hmmftg/joefriday/ticker"
+	"github.com/vmihailenco/msgpack/v5"
+)
+
+// Profiler processes uptime information, /proc/uptime, using MessagePack.
+type Profiler struct {
+	*u.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := u.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get gets the current uptime, /proc/uptime, as MessagePack serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	k, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(k)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to prevent a data race on checking/instantiation
+
+// Get gets the current uptime, /proc/uptime, as MessagePack serialized bytes
+// using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize uptime.Uptime as MessagePack.
+func (prof *Profiler) Serialize(up u.Uptime) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := msgpack.NewEncoder(&buf)
+	enc.SetCustomStructTag("json")
+	enc.UseCompactInts(true)
+	err := enc.Encode(up)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Serialize uptime.Uptime as MessagePack using the package's global Profiler.
+func Serialize(up u.Uptime) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(up)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(up u.Uptime) ([]byte, error) {
+	return prof.Serialize(up)
+}
+
+// Marshal is an alias for Serialize that uses the package's global profiler.
+func Marshal(up u.Uptime) ([]byte, error) {
+	return Serialize(up)
+}
+
+// Deserialize takes some MessagePack serialized bytes and unmarshals them as
+// uptime.Uptime.
+func Deserialize(p []byte) (up u.Uptime, err error) {
+	dec := msgpack.NewDecoder(bytes.NewReader(p))
+	dec.SetCustomStructTag("json")
+	err = dec.Decode(&up)
+	if err != nil {
+		return up, err
+	}
+	return up, nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (up u.Uptime, err error) {
+	return Deserialize(p)
+}
+
+// Ticker delivers the system's uptime at intervals.
+type Ticker struct {
+	*ticker.Ticker[[]byte]
+	*Profiler
+}
+
+// NewTicker returns a new Ticker containing a Data channel that delivers the
+// data at intervals and an error channel that delivers any errors encountered.
+// Stop the ticker to signal the ticker to stop running. Stopping the ticker
+// does not close the Data channel; call Close to close both the ticker and the
+// data channel.
+func NewTicker(d time.Duration, opts ...joe.Option) (joe.Tocker, error) {
+	return NewTickerContext(context.Background(), d, ticker.Config{}, opts...)
+}
+
+// NewTickerContext returns a new Ticker that stops when ctx is done. The size
+// of the Ticker's Data and Errs channel buffers, and what happens when they
+// are full, is determined by cfg.
+func NewTickerContext(ctx context.Context, d time.Duration, cfg ticker.Config, opts ...joe.Option) (joe.Tocker, error) {
+	p, err := NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	t := Ticker{Profiler: p}
+	t.Ticker = ticker.Start(ctx, d, cfg, func() ([]byte, error) {
+		return t.Get()
+	})
+	return &t, nil
+}
@@ -24,6 +24,7 @@ import (
 	"sync"
 
 	fb "github.com/google/flatbuffers/go"
+	joe "github.com/hmmftg/joefriday"
 	v "github.com/hmmftg/joefriday/system/version"
 	"github.com/hmmftg/joefriday/system/version/flat/structs"
 )
@@ -36,8 +37,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := v.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := v.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
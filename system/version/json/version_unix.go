@@ -24,6 +24,7 @@ import (
 	"encoding/json"
 	"sync"
 
+	joe "github.com/hmmftg/joefriday"
 	v "github.com/hmmftg/joefriday/system/version"
 )
 
@@ -34,8 +35,8 @@ type Profiler struct {
 }
 
 // Returns an initialized Profiler; ready to use.
-func NewProfiler() (prof *Profiler, err error) {
-	p, err := v.NewProfiler()
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := v.NewProfiler(opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,144 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package version gets the kernel and version information from the
+// /proc/version file. Instead of returning a Go struct, it returns protobuf
+// serialized bytes. A function to deserialize the protobuf serialized bytes
+// into a version.Kernel struct is provided.
+//
+// Note: the package name is version and not the final element of the import
+// path (pb).
+package version
+
+import (
+	"sync"
+
+	joe "github.com/hmmftg/joefriday"
+	v "github.com/hmmftg/joefriday/system/version"
+	"github.com/hmmftg/joefriday/system/version/pb/structs"
+	"google.golang.org/protobuf/proto"
+)
+
+// Profiler processes the version information, /proc/version, using
+// protobuf.
+type Profiler struct {
+	*v.Profiler
+}
+
+// Returns an initialized Profiler; ready to use.
+func NewProfiler(opts ...joe.Option) (prof *Profiler, err error) {
+	p, err := v.NewProfiler(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Profiler{Profiler: p}, nil
+}
+
+// Get gets the kernel information from the /proc/version file as protobuf
+// serialized bytes.
+func (prof *Profiler) Get() (p []byte, err error) {
+	inf, err := prof.Profiler.Get()
+	if err != nil {
+		return nil, err
+	}
+	return prof.Serialize(inf)
+}
+
+var std *Profiler
+var stdMu sync.Mutex //protects standard to preven data race on checking/instantiation
+
+// Get gets the kernel information from the /proc/version file as protobuf
+// serialized bytes using the package's global Profiler.
+func Get() (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Get()
+}
+
+// Serialize version.Kernel as protobuf.
+func (prof *Profiler) Serialize(k *v.Kernel) ([]byte, error) {
+	return proto.Marshal(toProto(k))
+}
+
+// Serialize version.Kernel as protobuf using the package's global Profiler.
+func Serialize(k *v.Kernel) (p []byte, err error) {
+	stdMu.Lock()
+	defer stdMu.Unlock()
+	if std == nil {
+		std, err = NewProfiler()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return std.Serialize(k)
+}
+
+// Marshal is an alias for Serialize.
+func (prof *Profiler) Marshal(k *v.Kernel) ([]byte, error) {
+	return prof.Serialize(k)
+}
+
+// Marshal is an alias for Serialize that uses the package's global profiler.
+func Marshal(k *v.Kernel) ([]byte, error) {
+	return Serialize(k)
+}
+
+// Deserialize takes some protobuf serialized bytes and deserializes them as
+// version.Kernel.
+func Deserialize(p []byte) (*v.Kernel, error) {
+	m := &structs.Kernel{}
+	err := proto.Unmarshal(p, m)
+	if err != nil {
+		return nil, err
+	}
+	return fromProto(m), nil
+}
+
+// Unmarshal is an alias for Deserialize.
+func Unmarshal(p []byte) (*v.Kernel, error) {
+	return Deserialize(p)
+}
+
+// toProto copies a version.Kernel into a new structs.Kernel message.
+func toProto(k *v.Kernel) *structs.Kernel {
+	return &structs.Kernel{
+		Os:          k.OS,
+		Version:     k.Version,
+		CompileUser: k.CompileUser,
+		Gcc:         k.GCC,
+		OsGcc:       k.OSGCC,
+		Type:        k.Type,
+		CompileDate: k.CompileDate,
+		Arch:        k.Arch,
+	}
+}
+
+// fromProto copies a structs.Kernel message into a version.Kernel.
+func fromProto(m *structs.Kernel) *v.Kernel {
+	return &v.Kernel{
+		OS:          m.Os,
+		Version:     m.Version,
+		CompileUser: m.CompileUser,
+		GCC:         m.Gcc,
+		OSGCC:       m.OsGcc,
+		Type:        m.Type,
+		CompileDate: m.CompileDate,
+		Arch:        m.Arch,
+	}
+}
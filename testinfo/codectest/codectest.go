@@ -0,0 +1,28 @@
+// Package codectest holds the fixtures and checks shared by the tests of the
+// pb and msgpack packages so that each encoding runs the same tests against
+// the same data.
+package codectest
+
+import (
+	"reflect"
+	"testing"
+)
+
+// RoundTrip serializes want and checks that deserializing the result returns
+// an equal value.
+func RoundTrip[T any](t *testing.T, want T, serialize func(T) ([]byte, error), deserialize func([]byte) (T, error)) {
+	t.Helper()
+	p, err := serialize(want)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	got, err := deserialize(p)
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+}
@@ -0,0 +1,257 @@
+package codectest
+
+import (
+	"testing"
+
+	freq "github.com/hmmftg/joefriday/cpu/cpufreq"
+	"github.com/hmmftg/joefriday/cpu/cpuinfo"
+	stats "github.com/hmmftg/joefriday/cpu/cpustats"
+	util "github.com/hmmftg/joefriday/cpu/cpuutil"
+)
+
+// Frequency returns a cpufreq.Frequency with every field set.
+func Frequency() *freq.Frequency {
+	return &freq.Frequency{
+		Timestamp: 1,
+		Sockets:   2,
+		CPU: []freq.CPU{
+			{
+				Processor:  4,
+				CPUMHz:     5.5,
+				PhysicalID: 6,
+				CoreID:     7,
+				APICID:     8,
+			},
+			{
+				Processor:  9,
+				CPUMHz:     10.5,
+				PhysicalID: 11,
+				CoreID:     12,
+				APICID:     13,
+			},
+		},
+	}
+}
+
+// CPUInfo returns a cpuinfo.CPUInfo with every field set.
+func CPUInfo() *cpuinfo.CPUInfo {
+	return &cpuinfo.CPUInfo{
+		Timestamp: 1,
+		Sockets:   2,
+		CPU: []cpuinfo.CPU{
+			{
+				Processor:       4,
+				VendorID:        "vendorid-5",
+				CPUFamily:       "cpufamily-6",
+				Model:           "model-7",
+				ModelName:       "modelname-8",
+				Stepping:        "stepping-9",
+				Microcode:       "microcode-10",
+				CPUMHz:          11.5,
+				CacheSize:       "cachesize-12",
+				PhysicalID:      13,
+				Siblings:        14,
+				CoreID:          15,
+				CPUCores:        16,
+				APICID:          17,
+				InitialAPICID:   18,
+				FPU:             "fpu-19",
+				FPUException:    "fpuexception-20",
+				CPUIDLevel:      "cpuidlevel-21",
+				WP:              "wp-22",
+				Flags:           []string{"flags0", "flags1"},
+				BogoMIPS:        24.5,
+				Bugs:            []string{"bugs0", "bugs1"},
+				CLFlushSize:     26,
+				CacheAlignment:  27,
+				AddressSizes:    []string{"addresssizes0", "addresssizes1"},
+				PowerManagement: []string{"powermanagement0", "powermanagement1"},
+				TLBSize:         "tlbsize-30",
+			},
+			{
+				Processor:       31,
+				VendorID:        "vendorid-32",
+				CPUFamily:       "cpufamily-33",
+				Model:           "model-34",
+				ModelName:       "modelname-35",
+				Stepping:        "stepping-36",
+				Microcode:       "microcode-37",
+				CPUMHz:          38.5,
+				CacheSize:       "cachesize-39",
+				PhysicalID:      40,
+				Siblings:        41,
+				CoreID:          42,
+				CPUCores:        43,
+				APICID:          44,
+				InitialAPICID:   45,
+				FPU:             "fpu-46",
+				FPUException:    "fpuexception-47",
+				CPUIDLevel:      "cpuidlevel-48",
+				WP:              "wp-49",
+				Flags:           []string{"flags0", "flags1"},
+				BogoMIPS:        51.5,
+				Bugs:            []string{"bugs0", "bugs1"},
+				CLFlushSize:     53,
+				CacheAlignment:  54,
+				AddressSizes:    []string{"addresssizes0", "addresssizes1"},
+				PowerManagement: []string{"powermanagement0", "powermanagement1"},
+				TLBSize:         "tlbsize-57",
+			},
+		},
+	}
+}
+
+// CPUStats returns a cpustats.CPUStats with every field set.
+func CPUStats() *stats.CPUStats {
+	return &stats.CPUStats{
+		ClkTck:    1,
+		Timestamp: 2,
+		Ctxt:      3,
+		BTime:     4,
+		Processes: 5,
+		CPU: []stats.CPU{
+			{
+				ID:        "id-7",
+				User:      8,
+				Nice:      9,
+				System:    10,
+				Idle:      11,
+				IOWait:    12,
+				IRQ:       13,
+				SoftIRQ:   14,
+				Steal:     15,
+				Quest:     16,
+				QuestNice: 17,
+			},
+			{
+				ID:        "id-18",
+				User:      19,
+				Nice:      20,
+				System:    21,
+				Idle:      22,
+				IOWait:    23,
+				IRQ:       24,
+				SoftIRQ:   25,
+				Steal:     26,
+				Quest:     27,
+				QuestNice: 28,
+			},
+		},
+		Intr:         29,
+		SoftIRQ:      stats.SoftIRQ{Total: 30, Hi: 31, Timer: 32, NetTx: 33, NetRx: 34, Block: 35, IRQPoll: 36, Tasklet: 37, Sched: 38, HRTimer: 39, RCU: 40},
+		ProcsRunning: 41,
+		ProcsBlocked: 42,
+	}
+}
+
+// CheckCPUStats checks the cpustats.CPUStats of the running system.
+func CheckCPUStats(t *testing.T, n string, s *stats.CPUStats) {
+	t.Helper()
+	if int16(stats.CLK_TCK) != s.ClkTck {
+		t.Errorf("%s: ClkTck: got %d; want %d", n, s.ClkTck, stats.CLK_TCK)
+	}
+	if s.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if s.Ctxt == 0 {
+		t.Errorf("%s: Ctxt: wanted non-zero value; got 0", n)
+	}
+	if s.BTime == 0 {
+		t.Errorf("%s: BTime: wanted non-zero value; got 0", n)
+	}
+	if s.Processes == 0 {
+		t.Errorf("%s: Processes: wanted non-zero value; got 0", n)
+	}
+	if len(s.CPU) < 2 {
+		t.Errorf("%s: expected stats for at least 2 CPU entries, got %d", n, len(s.CPU))
+	}
+	for i := 0; i < len(s.CPU); i++ {
+		if s.CPU[i].ID == "" {
+			t.Errorf("%s: CPU %d: ID: wanted a non-empty value; was empty", n, i)
+		}
+		if s.CPU[i].User == 0 {
+			t.Errorf("%s: CPU %d: User: wanted a non-zero value, was 0", n, i)
+		}
+		if s.CPU[i].System == 0 {
+			t.Errorf("%s: CPU %d: System: wanted a non-xero value, was 0", n, i)
+		}
+	}
+}
+
+// CPUUtil returns a cpuutil.CPUUtil with every field set.
+func CPUUtil() *util.CPUUtil {
+	return &util.CPUUtil{
+		Timestamp:  1,
+		TimeDelta:  2,
+		BTimeDelta: 3,
+		CtxtDelta:  4,
+		Processes:  5,
+		CPU: []util.Utilization{
+			{
+				ID:        "id-7",
+				Usage:     8.5,
+				User:      9.5,
+				Nice:      10.5,
+				System:    11.5,
+				Idle:      12.5,
+				IOWait:    13.5,
+				IRQ:       14.5,
+				SoftIRQ:   15.5,
+				Steal:     16.5,
+				Guest:     17.5,
+				GuestNice: 18.5,
+			},
+			{
+				ID:        "id-19",
+				Usage:     20.5,
+				User:      21.5,
+				Nice:      22.5,
+				System:    23.5,
+				Idle:      24.5,
+				IOWait:    25.5,
+				IRQ:       26.5,
+				SoftIRQ:   27.5,
+				Steal:     28.5,
+				Guest:     29.5,
+				GuestNice: 30.5,
+			},
+		},
+		Container: &util.Container{
+			CPUs:          21.5,
+			Usage:         22.5,
+			User:          23.5,
+			System:        24.5,
+			NrPeriods:     25,
+			NrThrottled:   26,
+			ThrottledUsec: 27,
+		},
+	}
+}
+
+// CheckCPUUtil checks the cpuutil.CPUUtil of the running system.
+func CheckCPUUtil(t *testing.T, n string, u *util.CPUUtil) {
+	t.Helper()
+	if u.Timestamp == 0 {
+		t.Errorf("%s: timestamp: expected on-zero", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: expected non-zero value, got 0", n)
+	}
+	if u.CtxtDelta == 0 {
+		t.Errorf("%s: CtxtDelta: expected non-zero value, got 0", n)
+	}
+	if u.BTimeDelta == 0 {
+		t.Errorf("%s: BTimeDelta: expected non-zero value, got 0", n)
+	}
+	if u.Processes == 0 {
+		t.Errorf("%s: Processes: expected non-zero value, got 0", n)
+	}
+	if len(u.CPU) < 2 {
+		t.Errorf("%s: cpu: got %d, want at least 2", n, len(u.CPU))
+	}
+	for i, v := range u.CPU {
+		if v.ID == "" {
+			t.Errorf("%d: %s: expected ID to have a value, was empty", i, n)
+		}
+	}
+}
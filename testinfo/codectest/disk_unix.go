@@ -0,0 +1,172 @@
+package codectest
+
+import (
+	"testing"
+
+	"github.com/hmmftg/joefriday/disk/structs"
+)
+
+// DiskStats returns a structs.DiskStats with every field set.
+func DiskStats() *structs.DiskStats {
+	return &structs.DiskStats{
+		Timestamp: 1,
+		Device: []structs.Device{
+			{
+				Major:           3,
+				Minor:           4,
+				Name:            "name-5",
+				ReadsCompleted:  6,
+				ReadsMerged:     7,
+				ReadSectors:     8,
+				ReadingTime:     9,
+				WritesCompleted: 10,
+				WritesMerged:    11,
+				WrittenSectors:  12,
+				WritingTime:     13,
+				IOInProgress:    14,
+				IOTime:          15,
+				WeightedIOTime:  16,
+			},
+			{
+				Major:           17,
+				Minor:           18,
+				Name:            "name-19",
+				ReadsCompleted:  20,
+				ReadsMerged:     21,
+				ReadSectors:     22,
+				ReadingTime:     23,
+				WritesCompleted: 24,
+				WritesMerged:    25,
+				WrittenSectors:  26,
+				WritingTime:     27,
+				IOInProgress:    28,
+				IOTime:          29,
+				WeightedIOTime:  30,
+			},
+		},
+	}
+}
+
+// CheckDiskStats checks the structs.DiskStats of the running system.
+func CheckDiskStats(t *testing.T, n string, s *structs.DiskStats) {
+	t.Helper()
+	if s.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if len(s.Device) == 0 {
+		t.Errorf("%s: expected there to be devices; didn't get any", n)
+	}
+	for i := 0; i < len(s.Device); i++ {
+		if s.Device[i].Major == 0 {
+			t.Errorf("%s: Device %d: Major: wanted a non-zero value, was 0", n, i)
+		}
+		if s.Device[i].Name == "" {
+			t.Errorf("%s: Device %d: Name: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
+
+// DiskUsage returns a structs.DiskUsage with every field set.
+func DiskUsage() *structs.DiskUsage {
+	return &structs.DiskUsage{
+		Timestamp: 1,
+		TimeDelta: 2,
+		Device: []structs.Device{
+			{
+				Major:           4,
+				Minor:           5,
+				Name:            "name-6",
+				ReadsCompleted:  7,
+				ReadsMerged:     8,
+				ReadSectors:     9,
+				ReadingTime:     10,
+				WritesCompleted: 11,
+				WritesMerged:    12,
+				WrittenSectors:  13,
+				WritingTime:     14,
+				IOInProgress:    15,
+				IOTime:          16,
+				WeightedIOTime:  17,
+			},
+			{
+				Major:           18,
+				Minor:           19,
+				Name:            "name-20",
+				ReadsCompleted:  21,
+				ReadsMerged:     22,
+				ReadSectors:     23,
+				ReadingTime:     24,
+				WritesCompleted: 25,
+				WritesMerged:    26,
+				WrittenSectors:  27,
+				WritingTime:     28,
+				IOInProgress:    29,
+				IOTime:          30,
+				WeightedIOTime:  31,
+			},
+		},
+		Rates: []structs.DeviceRates{
+			{
+				Major:              33,
+				Minor:              34,
+				Name:               "name-35",
+				SectorSize:         36,
+				ReadsPerSec:        37.5,
+				WritesPerSec:       38.5,
+				ReadsMergedPerSec:  39.5,
+				WritesMergedPerSec: 40.5,
+				ReadBytesPerSec:    41.5,
+				WriteBytesPerSec:   42.5,
+				ReadAwait:          43.5,
+				WriteAwait:         44.5,
+				Await:              45.5,
+				AvgQueueSize:       46.5,
+				Util:               47.5,
+			},
+			{
+				Major:              48,
+				Minor:              49,
+				Name:               "name-50",
+				SectorSize:         51,
+				ReadsPerSec:        52.5,
+				WritesPerSec:       53.5,
+				ReadsMergedPerSec:  54.5,
+				WritesMergedPerSec: 55.5,
+				ReadBytesPerSec:    56.5,
+				WriteBytesPerSec:   57.5,
+				ReadAwait:          58.5,
+				WriteAwait:         59.5,
+				Await:              60.5,
+				AvgQueueSize:       61.5,
+				Util:               62.5,
+			},
+		},
+		Added:   []string{"added0", "added1"},
+		Removed: []string{"removed0", "removed1"},
+	}
+}
+
+// CheckDiskUsage checks the structs.DiskUsage of the running system.
+func CheckDiskUsage(t *testing.T, n string, u *structs.DiskUsage) {
+	t.Helper()
+	if u.Timestamp == 0 {
+		t.Errorf("%s: Timestamp: wanted non-zero value; got 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: TimeDelta: wanted non-zero value; got 0", n)
+	}
+	if len(u.Device) == 0 {
+		t.Errorf("%s: expected there to be devices; didn't get any", n)
+	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
+	for i := 0; i < len(u.Device); i++ {
+		if u.Device[i].Major == 0 {
+			t.Errorf("%s: Device %d: Major: wanted a non-zero value, was 0", n, i)
+		}
+		if u.Device[i].Name == "" {
+			t.Errorf("%s: Device %d: Name: wanted a non-empty value; was empty", n, i)
+		}
+	}
+}
@@ -0,0 +1,194 @@
+package codectest
+
+import (
+	"testing"
+
+	basic "github.com/hmmftg/joefriday/mem/membasic"
+	mem "github.com/hmmftg/joefriday/mem/meminfo"
+)
+
+// MemBasic returns a membasic.Info with every field set.
+func MemBasic() *basic.Info {
+	return &basic.Info{
+		Timestamp:    1,
+		Active:       2,
+		Inactive:     3,
+		Mapped:       4,
+		MemAvailable: 5,
+		MemFree:      6,
+		MemTotal:     7,
+		SwapCached:   8,
+		SwapFree:     9,
+		SwapTotal:    10,
+	}
+}
+
+// CheckMemBasic checks the membasic.Info of the running system.
+func CheckMemBasic(t *testing.T, n string, i *basic.Info) {
+	t.Helper()
+	if i.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value, got 0", n)
+	}
+	if i.Active == 0 {
+		t.Errorf("%s: expected Active to be a non-zero value, got 0", n)
+	}
+	if i.Inactive == 0 {
+		t.Errorf("%s: expected Inactive to be a non-zero value, got 0", n)
+	}
+	if i.Mapped == 0 {
+		t.Errorf("%s: expected Mapped to be a non-zero value, got 0", n)
+	}
+	if i.MemAvailable == 0 {
+		t.Errorf("%s: expected MemAvailable to be a non-zero value, got 0", n)
+	}
+	if i.MemFree == 0 {
+		t.Errorf("%s: expected MemFree to be a non-zero value, got 0", n)
+	}
+	if i.MemTotal == 0 {
+		t.Errorf("%s: expected MemTotal to be a non-zero value, got 0", n)
+	}
+	if i.SwapFree == 0 {
+		t.Errorf("%s: expected SwapFree to be a non-zero value, got 0", n)
+	}
+	if i.SwapTotal == 0 {
+		t.Errorf("%s: expected SwapTotal to be a non-zero value, got 0", n)
+	}
+	t.Logf("%#v\n", i)
+}
+
+// MemInfo returns a meminfo.Info with every field set.
+func MemInfo() *mem.Info {
+	return &mem.Info{
+		Timestamp:         1,
+		Active:            2,
+		ActiveAnon:        3,
+		ActiveFile:        4,
+		AnonHugePages:     5,
+		AnonPages:         6,
+		Bounce:            7,
+		Buffers:           8,
+		Cached:            9,
+		CommitLimit:       10,
+		CommittedAS:       11,
+		DirectMap4K:       12,
+		DirectMap2M:       13,
+		Dirty:             14,
+		HardwareCorrupted: 15,
+		HugePagesFree:     16,
+		HugePagesRsvd:     17,
+		HugePagesSize:     18,
+		HugePagesSurp:     19,
+		HugePagesTotal:    20,
+		Inactive:          21,
+		InactiveAnon:      22,
+		InactiveFile:      23,
+		KernelStack:       24,
+		Mapped:            25,
+		MemAvailable:      26,
+		MemFree:           27,
+		MemTotal:          28,
+		Mlocked:           29,
+		NFSUnstable:       30,
+		PageTables:        31,
+		Shmem:             32,
+		Slab:              33,
+		SReclaimable:      34,
+		SUnreclaim:        35,
+		SwapCached:        36,
+		SwapFree:          37,
+		SwapTotal:         38,
+		Unevictable:       39,
+		VmallocChunk:      40,
+		VmallocTotal:      41,
+		VmallocUsed:       42,
+		Writeback:         43,
+		WritebackTmp:      44,
+	}
+}
+
+// CheckMemInfo checks the meminfo.Info of the running system.
+func CheckMemInfo(t *testing.T, n string, i *mem.Info) {
+	t.Helper()
+	if i.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value, got 0", n)
+	}
+	if i.Active == 0 {
+		t.Errorf("%s: expected Active to be a non-zero value, got 0", n)
+	}
+	if i.ActiveAnon == 0 {
+		t.Errorf("%s: expected ActiveAnon to be a non-zero value, got 0", n)
+	}
+	if i.ActiveFile == 0 {
+		t.Errorf("%s: expected ActiveFile to be a non-zero value, got 0", n)
+	}
+	if i.AnonPages == 0 {
+		t.Errorf("%s: expected AnonPages to be a non-zero value, got 0", n)
+	}
+	if i.Buffers == 0 {
+		t.Errorf("%s: expected Buffers to be a non-zero value, got 0", n)
+	}
+	if i.Cached == 0 {
+		t.Errorf("%s: expected Cached to be a non-zero value, got 0", n)
+	}
+	if i.CommitLimit == 0 {
+		t.Errorf("%s: expected CommitLimit to be a non-zero value, got 0", n)
+	}
+	if i.CommittedAS == 0 {
+		t.Errorf("%s: expected CommittedAS to be a non-zero value, got 0", n)
+	}
+	if i.DirectMap4K == 0 {
+		t.Errorf("%s: expected DirectMap4K to be a non-zero value, got 0", n)
+	}
+	if i.DirectMap2M == 0 {
+		t.Errorf("%s: expected DirectMap2M to be a non-zero value, got 0", n)
+	}
+	if i.HugePagesSize == 0 {
+		t.Errorf("%s: expected HugePagesSize to be a non-zero value, got 0", n)
+	}
+	if i.Inactive == 0 {
+		t.Errorf("%s: expected Inactive to be a non-zero value, got 0", n)
+	}
+	if i.InactiveAnon == 0 {
+		t.Errorf("%s: expected InactiveAnon to be a non-zero value, got 0", n)
+	}
+	if i.InactiveFile == 0 {
+		t.Errorf("%s: expected InactiveFile to be a non-zero value, got 0", n)
+	}
+	if i.KernelStack == 0 {
+		t.Errorf("%s: expected KernelStack to be a non-zero value, got 0", n)
+	}
+	if i.Mapped == 0 {
+		t.Errorf("%s: expected Mapped to be a non-zero value, got 0", n)
+	}
+	if i.MemAvailable == 0 {
+		t.Errorf("%s: expected MemAvailable to be a non-zero value, got 0", n)
+	}
+	if i.MemFree == 0 {
+		t.Errorf("%s: expected MemFree to be a non-zero value, got 0", n)
+	}
+	if i.MemTotal == 0 {
+		t.Errorf("%s: expected MemTotal to be a non-zero value, got 0", n)
+	}
+	if i.PageTables == 0 {
+		t.Errorf("%s: expected PageTables to be a non-zero value, got 0", n)
+	}
+	if i.Shmem == 0 {
+		t.Errorf("%s: expected Shmem to be a non-zero value, got 0", n)
+	}
+	if i.Slab == 0 {
+		t.Errorf("%s: expected Slab to be a non-zero value, got 0", n)
+	}
+	if i.SReclaimable == 0 {
+		t.Errorf("%s: expected SReclaimable to be a non-zero value, got 0", n)
+	}
+	if i.SUnreclaim == 0 {
+		t.Errorf("%s: expected SReclaimable to be a non-zero value, got 0", n)
+	}
+	if i.SwapFree == 0 {
+		t.Errorf("%s: expected SwapFree to be a non-zero value, got 0", n)
+	}
+	if i.SwapTotal == 0 {
+		t.Errorf("%s: expected SwapTotal to be a non-zero value, got 0", n)
+	}
+	t.Logf("%#v\n", i)
+}
@@ -0,0 +1,176 @@
+package codectest
+
+import (
+	"testing"
+
+	"github.com/hmmftg/joefriday/net/structs"
+)
+
+// DevInfo returns a structs.DevInfo with every field set.
+func DevInfo() *structs.DevInfo {
+	return &structs.DevInfo{
+		Timestamp: 1,
+		Device: []structs.Device{
+			{
+				Name:        "name-3",
+				RBytes:      4,
+				RPackets:    5,
+				RErrs:       6,
+				RDrop:       7,
+				RFIFO:       8,
+				RFrame:      9,
+				RCompressed: 10,
+				RMulticast:  11,
+				TBytes:      12,
+				TPackets:    13,
+				TErrs:       14,
+				TDrop:       15,
+				TFIFO:       16,
+				TColls:      17,
+				TCarrier:    18,
+				TCompressed: 19,
+			},
+			{
+				Name:        "name-20",
+				RBytes:      21,
+				RPackets:    22,
+				RErrs:       23,
+				RDrop:       24,
+				RFIFO:       25,
+				RFrame:      26,
+				RCompressed: 27,
+				RMulticast:  28,
+				TBytes:      29,
+				TPackets:    30,
+				TErrs:       31,
+				TDrop:       32,
+				TFIFO:       33,
+				TColls:      34,
+				TCarrier:    35,
+				TCompressed: 36,
+			},
+		},
+	}
+}
+
+// CheckDevInfo checks the structs.DevInfo of the running system.
+func CheckDevInfo(t *testing.T, n string, inf *structs.DevInfo) {
+	t.Helper()
+	if inf.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value; was 0", n)
+	}
+	if len(inf.Device) == 0 {
+		t.Errorf("%s: expected devices; got none", n)
+		return
+	}
+	// check name
+	for i, v := range inf.Device {
+		if v.Name == "" {
+			t.Errorf("%s: %d: expected device to have a name; was empty", n, i)
+		}
+	}
+}
+
+// DevUsage returns a structs.DevUsage with every field set.
+func DevUsage() *structs.DevUsage {
+	return &structs.DevUsage{
+		Timestamp: 1,
+		TimeDelta: 2,
+		Device: []structs.Device{
+			{
+				Name:        "name-4",
+				RBytes:      5,
+				RPackets:    6,
+				RErrs:       7,
+				RDrop:       8,
+				RFIFO:       9,
+				RFrame:      10,
+				RCompressed: 11,
+				RMulticast:  12,
+				TBytes:      13,
+				TPackets:    14,
+				TErrs:       15,
+				TDrop:       16,
+				TFIFO:       17,
+				TColls:      18,
+				TCarrier:    19,
+				TCompressed: 20,
+			},
+			{
+				Name:        "name-21",
+				RBytes:      22,
+				RPackets:    23,
+				RErrs:       24,
+				RDrop:       25,
+				RFIFO:       26,
+				RFrame:      27,
+				RCompressed: 28,
+				RMulticast:  29,
+				TBytes:      30,
+				TPackets:    31,
+				TErrs:       32,
+				TDrop:       33,
+				TFIFO:       34,
+				TColls:      35,
+				TCarrier:    36,
+				TCompressed: 37,
+			},
+		},
+		Rates: []structs.DeviceRates{
+			{
+				Name:           "name-39",
+				Speed:          40,
+				RBytesPerSec:   41.5,
+				RPacketsPerSec: 42.5,
+				RDropRatio:     43.5,
+				RErrRatio:      44.5,
+				RUtil:          45.5,
+				TBytesPerSec:   46.5,
+				TPacketsPerSec: 47.5,
+				TDropRatio:     48.5,
+				TErrRatio:      49.5,
+				TUtil:          50.5,
+			},
+			{
+				Name:           "name-51",
+				Speed:          52,
+				RBytesPerSec:   53.5,
+				RPacketsPerSec: 54.5,
+				RDropRatio:     55.5,
+				RErrRatio:      56.5,
+				RUtil:          57.5,
+				TBytesPerSec:   58.5,
+				TPacketsPerSec: 59.5,
+				TDropRatio:     60.5,
+				TErrRatio:      61.5,
+				TUtil:          62.5,
+			},
+		},
+		Added:   []string{"added0", "added1"},
+		Removed: []string{"removed0", "removed1"},
+	}
+}
+
+// CheckDevUsage checks the structs.DevUsage of the running system.
+func CheckDevUsage(t *testing.T, n string, u *structs.DevUsage) {
+	t.Helper()
+	if u.Timestamp == 0 {
+		t.Errorf("%s: expected timestamp to be a non-zero value; was 0", n)
+	}
+	if u.TimeDelta == 0 {
+		t.Errorf("%s: expected TimeDelta to be a non-zero value; was 0", n)
+	}
+	if len(u.Device) == 0 {
+		t.Errorf("%s: expected devices; got none", n)
+		return
+	}
+	if len(u.Rates) != len(u.Device) {
+		t.Errorf("%s: Rates: got %d; want %d", n, len(u.Rates), len(u.Device))
+	}
+	// check name
+	for i, v := range u.Device {
+		if v.Name == "" {
+			t.Errorf("%s: %d: expected device to have a name; was empty", n, i)
+		}
+	}
+}
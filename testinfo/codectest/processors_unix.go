@@ -0,0 +1,172 @@
+package codectest
+
+import (
+	"testing"
+
+	"github.com/hmmftg/joefriday"
+	"github.com/hmmftg/joefriday/node"
+	"github.com/hmmftg/joefriday/processors"
+	"github.com/hmmftg/joefriday/testinfo"
+)
+
+// Processors returns a processors.Processors with every field set.
+func Processors() *processors.Processors {
+	return &processors.Processors{
+		Timestamp:      1,
+		Architecture:   "architecture-2",
+		ByteOrder:      "byteorder-3",
+		Sockets:        4,
+		CPUs:           5,
+		Possible:       "possible-6",
+		Present:        "present-7",
+		Offline:        "offline-8",
+		Online:         "online-9",
+		CoresPerSocket: 10,
+		ThreadsPerCore: 11,
+		VendorID:       "vendorid-12",
+		CPUFamily:      "cpufamily-13",
+		Model:          "model-14",
+		ModelName:      "modelname-15",
+		Stepping:       "stepping-16",
+		Microcode:      "microcode-17",
+		CPUMHz:         18.5,
+		MHzMin:         19.5,
+		MHzMax:         20.5,
+		CacheSize:      "cachesize-21",
+		Cache:          map[string]string{"cacheids0": "32K", "cacheids1": "256K"},
+		CacheIDs:       []string{"cacheids0", "cacheids1"},
+		BogoMIPS:       24.5,
+		Flags:          []string{"flags0", "flags1"},
+		Bugs:           []string{"bugs0", "bugs1"},
+		OpModes:        []string{"opmodes0", "opmodes1"},
+		Virtualization: "virtualization-28",
+		NumaNodes:      29,
+		NumaNodeCPUs: []node.Node{
+			{
+				ID:      31,
+				CPUList: "cpulist-32",
+			},
+			{
+				ID:      33,
+				CPUList: "cpulist-34",
+			},
+		},
+	}
+}
+
+// A CPU is one of the processors in testinfo along with the layout of the
+// sysfs tree that goes with it.
+type CPU struct {
+	Vendor                  string
+	Name                    string
+	CPUInfo                 []byte
+	PhysicalPackageCount    int32
+	CoresPerPhysicalPackage int32
+	ThreadsPerCore          int32
+	Validate                func(*processors.Processors, bool) error
+}
+
+var (
+	I75600u    = CPU{"intel", "i75600", testinfo.I75600uCPUInfo, 1, 2, 2, testinfo.ValidateI75600uProc}
+	XeonE52690 = CPU{"intel", "e52690", testinfo.XeonE52690CPUInfo, 2, 8, 2, testinfo.ValidateXeonE52690Proc}
+	R71800x    = CPU{"intel", "r71800x", testinfo.R71800xCPUInfo, 1, 8, 2, testinfo.ValidateR71800xProc}
+)
+
+// CheckProcessors sets up the cpuinfo and sysfs trees for cpu, configures
+// prof to use them, and validates what get returns, first with cpufreq
+// information in the sysfs tree and then without it.
+func CheckProcessors(t *testing.T, cpu CPU, prof *processors.Profiler, get func() (*processors.Processors, error)) {
+	t.Helper()
+	// set up the cpuinfo
+	tProc, err := joefriday.NewTempFileProc(cpu.Vendor, cpu.Name, cpu.CPUInfo)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tProc.Remove()
+
+	// get a new struct for the sysfs tree
+	tSysFS := testinfo.NewTempSysFS()
+	err = tSysFS.SetSysFS("")
+	if err != nil {
+		t.Fatalf("setting up sysfs tree: %s", err)
+	}
+	defer tSysFS.Clean()
+
+	tSysFS.Freq = true
+	tSysFS.PhysicalPackageCount = cpu.PhysicalPackageCount
+	tSysFS.CoresPerPhysicalPackage = cpu.CoresPerPhysicalPackage
+	tSysFS.ThreadsPerCore = cpu.ThreadsPerCore
+	// create the sysfs cpu tree
+	err = tSysFS.CreateCPU()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// create the sysfs node tree
+	err = tSysFS.CreateNode()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// configure the profiler
+	prof.Procer = tProc
+	prof.CPUProf.NumCPU = int(tSysFS.CPUs())
+	prof.CPUProf.SysFSSystemPath(tSysFS.Path())
+	prof.NodeProf.SysFSSystemPath(tSysFS.Path())
+
+	// get the processor info.
+	procs, err := get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	// Verify results
+	t.Logf("%#v", procs)
+	err = cpu.Validate(procs, tSysFS.Freq)
+	if err != nil {
+		t.Error(err)
+	}
+
+	// cleanup for next
+	err = tSysFS.CleanCPU()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// clean up for next
+	err = tSysFS.CleanNode()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// create the sysfs node tree
+	err = tSysFS.CreateNode()
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	// set up test stuff w/o freq
+	tSysFS.Freq = false
+	err = tSysFS.CreateCPU()
+	if err != nil {
+		t.Error(err)
+	}
+
+	// get the processor info.
+	procs, err = get()
+	if err != nil {
+		t.Errorf("unexpected error: %s", err)
+		return
+	}
+
+	// Verify results
+	t.Logf("%#v", procs)
+	err = cpu.Validate(procs, tSysFS.Freq)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,168 @@
+package codectest
+
+import (
+	"testing"
+
+	l "github.com/hmmftg/joefriday/system/loadavg"
+	o "github.com/hmmftg/joefriday/system/os"
+	u "github.com/hmmftg/joefriday/system/uptime"
+	v "github.com/hmmftg/joefriday/system/version"
+)
+
+// LoadAvg returns a loadavg.LoadAvg with every field set.
+func LoadAvg() l.LoadAvg {
+	return l.LoadAvg{
+		Timestamp: 1,
+		Minute:    2.5,
+		Five:      3.5,
+		Fifteen:   4.5,
+		Running:   5,
+		Total:     6,
+		PID:       7,
+	}
+}
+
+// CheckLoadAvg checks the loadavg.LoadAvg of the running system.
+func CheckLoadAvg(t *testing.T, n string, la l.LoadAvg) {
+	t.Helper()
+	if la.Timestamp == 0 {
+		t.Errorf("%s: expected Timestamp to be a non-zero value; got 0", n)
+	}
+	if la.Minute == 0 {
+		t.Errorf("%s: expected Minute to be a non-zero value; got 0", n)
+	}
+	if la.Five == 0 {
+		t.Errorf("%s: expected Five to be a non-zero value; got 0", n)
+	}
+	if la.Fifteen == 0 {
+		t.Errorf("%s: expected Fifteen to be a non-zero value; got 0", n)
+	}
+	if la.Running == 0 {
+		t.Errorf("%s: expected Running to be a non-zero value; got 0", n)
+	}
+	if la.Total == 0 {
+		t.Errorf("%s: expected Total to be a non-zero value; got 0", n)
+	}
+	if la.PID == 0 {
+		t.Errorf("%s: expected PID to be a non-zero value; got 0", n)
+	}
+}
+
+// OS returns an os.OS with every field set.
+func OS() *o.OS {
+	return &o.OS{
+		Name:         "name-1",
+		ID:           "id-2",
+		IDLike:       "idlike-3",
+		PrettyName:   "prettyname-4",
+		Version:      "version-5",
+		VersionID:    "versionid-6",
+		HomeURL:      "homeurl-7",
+		BugReportURL: "bugreporturl-8",
+	}
+}
+
+// CheckOS checks osD against the os.OS of the running system.
+func CheckOS(t *testing.T, osD *o.OS) {
+	t.Helper()
+	os, err := o.Get()
+	if err != nil {
+		t.Errorf("release.Get(): got %s, want nil", err)
+		return
+	}
+	if os.Name != osD.Name {
+		t.Errorf("Name: got %s; want %s", osD.Name, os.Name)
+	}
+	if os.ID != osD.ID {
+		t.Errorf("ID: got %s; want %s", osD.ID, os.ID)
+	}
+	if os.IDLike != osD.IDLike {
+		t.Errorf("IDLike: got %s; want %s", osD.IDLike, os.IDLike)
+	}
+	if os.PrettyName != osD.PrettyName {
+		t.Errorf("PrettyName: got %s; want %s", osD.PrettyName, os.PrettyName)
+	}
+	if os.Version != osD.Version {
+		t.Errorf("Version: got %s; want %s", osD.Version, os.Version)
+	}
+	if os.VersionID != osD.VersionID {
+		t.Errorf("VersionID: got %s; want %s", osD.VersionID, os.VersionID)
+	}
+	if os.HomeURL != osD.HomeURL {
+		t.Errorf("HomeURL: got %s; want %s", osD.HomeURL, os.HomeURL)
+	}
+	if os.BugReportURL != osD.BugReportURL {
+		t.Errorf("BugReportURL: got %s; want %s", osD.BugReportURL, os.BugReportURL)
+	}
+}
+
+// Uptime returns an uptime.Uptime with every field set.
+func Uptime() u.Uptime {
+	return u.Uptime{
+		Timestamp: 1,
+		Total:     2.5,
+		Idle:      3.5,
+	}
+}
+
+// CheckUptime checks the uptime.Uptime of the running system.
+func CheckUptime(t *testing.T, n string, up u.Uptime) {
+	t.Helper()
+	if up.Timestamp == 0 {
+		t.Errorf("%s: expected Timestamp to be a non-zero value; got 0", n)
+	}
+	if up.Total == 0 {
+		t.Errorf("%s: expected total to be a non-zero value; got 0", n)
+	}
+	if up.Idle == 0 {
+		t.Errorf("%s: expected idle to be a non-zero value; got 0", n)
+	}
+}
+
+// Kernel returns a version.Kernel with every field set.
+func Kernel() *v.Kernel {
+	return &v.Kernel{
+		OS:          "os-1",
+		Version:     "version-2",
+		CompileUser: "compileuser-3",
+		GCC:         "gcc-4",
+		OSGCC:       "osgcc-5",
+		Type:        "type-6",
+		CompileDate: "compiledate-7",
+		Arch:        "arch-8",
+	}
+}
+
+// CheckKernel checks kD against the version.Kernel of the running system.
+func CheckKernel(t *testing.T, kD *v.Kernel) {
+	t.Helper()
+	k, err := v.Get()
+	if err != nil {
+		t.Errorf("version.Get(): got %s, want nil", err)
+		return
+	}
+	if k.OS != kD.OS {
+		t.Errorf("OS: got %s; want %s", kD.OS, k.OS)
+	}
+	if k.Version != kD.Version {
+		t.Errorf("Version: got %s; want %s", kD.Version, k.Version)
+	}
+	if k.CompileUser != kD.CompileUser {
+		t.Errorf("CompileUser: got %s; want %s", kD.CompileUser, k.CompileUser)
+	}
+	if k.GCC != kD.GCC {
+		t.Errorf("GCC: got %s; want %s", kD.GCC, k.GCC)
+	}
+	if k.OSGCC != kD.OSGCC {
+		t.Errorf("OSGCC: got %s; want %s", kD.OSGCC, k.OSGCC)
+	}
+	if k.Type != kD.Type {
+		t.Errorf("Type: got %s; want %s", kD.Type, k.Type)
+	}
+	if k.CompileDate != kD.CompileDate {
+		t.Errorf("CompileDate: got %s; want %s", kD.CompileDate, k.CompileDate)
+	}
+	if k.Arch != kD.Arch {
+		t.Errorf("Arch: got %s; want %s", kD.Arch, k.Arch)
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ticker
+
+import "sync"
+
+// Broadcaster sends the values and errors delivered by a single Ticker to
+// any number of Subscriptions. Every subscriber gets the same values, e.g.
+// the same usage deltas, from one sampling loop.
+//
+// A slow subscriber never blocks the Broadcaster or the other subscribers:
+// when a Subscription's buffer is full, values are dropped according to its
+// Policy.
+type Broadcaster[T any] struct {
+	t *Ticker[T]
+
+	mu     sync.Mutex
+	subs   map[*Subscription[T]]struct{}
+	closed bool
+	done   chan struct{}
+}
+
+// NewBroadcaster returns a Broadcaster that fans out t's values and errors.
+// The Broadcaster takes over t: it runs t, if it isn't already running, and
+// t's channels must not be read by anything else.
+func NewBroadcaster[T any](t *Ticker[T]) *Broadcaster[T] {
+	b := &Broadcaster[T]{
+		t:    t,
+		subs: make(map[*Subscription[T]]struct{}),
+		done: make(chan struct{}),
+	}
+	go t.Run()
+	go b.run()
+	return b
+}
+
+// run receives from the ticker until it is stopped, sending everything it
+// receives to the subscriptions.
+func (b *Broadcaster[T]) run() {
+	defer close(b.done)
+	defer b.closeSubs()
+	for {
+		select {
+		case <-b.t.Done:
+			return
+		case v, ok := <-b.t.Data:
+			if !ok {
+				return
+			}
+			b.mu.Lock()
+			for s := range b.subs {
+				send(s.data, v, s.policy, nil)
+			}
+			b.mu.Unlock()
+		case err, ok := <-b.t.Errs:
+			if !ok {
+				return
+			}
+			b.mu.Lock()
+			for s := range b.subs {
+				send(s.errs, err, s.policy, nil)
+			}
+			b.mu.Unlock()
+		}
+	}
+}
+
+// closeSubs closes all of the subscriptions; nothing can subscribe once it
+// has been called.
+func (b *Broadcaster[T]) closeSubs() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.closed = true
+	for s := range b.subs {
+		delete(b.subs, s)
+		close(s.data)
+		close(s.errs)
+	}
+}
+
+// Subscribe returns a new Subscription whose channels are configured by cfg.
+// A Subscription can't block the Broadcaster, so a Block Policy is treated as
+// DropOldest, and a Buffer of less than 1 is treated as 1. If the Broadcaster
+// has been stopped, the Subscription's channels are already closed.
+func (b *Broadcaster[T]) Subscribe(cfg Config) *Subscription[T] {
+	if cfg.Buffer < 1 {
+		cfg.Buffer = 1
+	}
+	if cfg.Policy == Block {
+		cfg.Policy = DropOldest
+	}
+	s := &Subscription[T]{
+		data:   make(chan T, cfg.Buffer),
+		errs:   make(chan error, cfg.Buffer),
+		policy: cfg.Policy,
+		b:      b,
+	}
+	s.Data, s.Errs = s.data, s.errs
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		close(s.data)
+		close(s.errs)
+		return s
+	}
+	b.subs[s] = struct{}{}
+	return s
+}
+
+// Len returns the number of subscriptions.
+func (b *Broadcaster[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.subs)
+}
+
+// Close closes the Broadcaster's Ticker and all of its Subscriptions.
+func (b *Broadcaster[T]) Close() {
+	b.t.Close()
+	<-b.done
+}
+
+// A Subscription receives a Broadcaster's values on its Data channel and
+// its errors on its Errs channel. Both channels are closed when the
+// Subscription is unsubscribed or the Broadcaster is stopped.
+type Subscription[T any] struct {
+	Data <-chan T
+	Errs <-chan error
+
+	data   chan T
+	errs   chan error
+	policy Policy
+	b      *Broadcaster[T]
+}
+
+// Unsubscribe removes the Subscription from its Broadcaster and closes its
+// channels. Unsubscribe is safe to call more than once.
+func (s *Subscription[T]) Unsubscribe() {
+	s.b.mu.Lock()
+	defer s.b.mu.Unlock()
+	if _, ok := s.b.subs[s]; !ok {
+		return
+	}
+	delete(s.b.subs, s)
+	close(s.data)
+	close(s.errs)
+}
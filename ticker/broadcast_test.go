@@ -0,0 +1,151 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ticker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// gated returns a get func that returns the number of times it has been
+// called; the first call waits until gate is closed.
+func gated(n *int64, gate chan struct{}) func() (int64, error) {
+	return func() (int64, error) {
+		<-gate
+		return atomic.AddInt64(n, 1), nil
+	}
+}
+
+func TestBroadcaster(t *testing.T) {
+	var n int64
+	gate := make(chan struct{})
+	b := NewBroadcaster(New(context.Background(), time.Millisecond, Config{}, gated(&n, gate)))
+	subs := []*Subscription[int64]{
+		b.Subscribe(Config{Buffer: 5}),
+		b.Subscribe(Config{Buffer: 5}),
+	}
+	if b.Len() != 2 {
+		t.Errorf("Len: got %d; want 2", b.Len())
+	}
+	close(gate)
+	for i, s := range subs {
+		for j := int64(1); j <= 5; j++ {
+			v := <-s.Data
+			if v != j {
+				t.Errorf("%d: got %d; want %d", i, v, j)
+			}
+		}
+	}
+	b.Close()
+	for i, s := range subs {
+		if _, ok := <-s.Data; ok {
+			t.Errorf("%d: expected Data to be closed", i)
+		}
+		if _, ok := <-s.Errs; ok {
+			t.Errorf("%d: expected Errs to be closed", i)
+		}
+	}
+}
+
+func TestBroadcasterSlowSubscriber(t *testing.T) {
+	var n int64
+	gate := make(chan struct{})
+	b := NewBroadcaster(New(context.Background(), time.Millisecond, Config{}, gated(&n, gate)))
+	// slow is never read from; fast must still get every value.
+	slow := b.Subscribe(Config{Buffer: 1, Policy: DropOldest})
+	fast := b.Subscribe(Config{Buffer: 1})
+	close(gate)
+	for j := int64(1); j <= 5; j++ {
+		select {
+		case v := <-fast.Data:
+			if v < j {
+				t.Errorf("got %d; want at least %d", v, j)
+			}
+		case <-time.After(time.Second):
+			t.Fatal("timed out; the slow subscriber blocked the broadcaster")
+		}
+	}
+	b.Close()
+	// only the newest value is kept; it's at least the last one fast got.
+	var vals []int64
+	for v := range slow.Data {
+		vals = append(vals, v)
+	}
+	if len(vals) != 1 || vals[0] < 5 {
+		t.Errorf("slow: got %v; want a single value of at least 5", vals)
+	}
+}
+
+func TestBroadcasterErrs(t *testing.T) {
+	errTest := errors.New("test error")
+	gate := make(chan struct{})
+	b := NewBroadcaster(New(context.Background(), time.Millisecond, Config{}, func() (int, error) {
+		<-gate
+		return 0, errTest
+	}))
+	defer b.Close()
+	subs := []*Subscription[int]{b.Subscribe(Config{}), b.Subscribe(Config{})}
+	close(gate)
+	for i, s := range subs {
+		if err := <-s.Errs; err != errTest {
+			t.Errorf("%d: got %v; want %v", i, err, errTest)
+		}
+	}
+}
+
+func TestBroadcasterUnsubscribe(t *testing.T) {
+	var n int64
+	b := NewBroadcaster(New(context.Background(), time.Millisecond, Config{}, counter(&n)))
+	s1 := b.Subscribe(Config{})
+	s2 := b.Subscribe(Config{})
+	s1.Unsubscribe()
+	s1.Unsubscribe()
+	if b.Len() != 1 {
+		t.Errorf("Len: got %d; want 1", b.Len())
+	}
+	for range s1.Data {
+	}
+	if _, ok := <-s1.Errs; ok {
+		t.Error("expected Errs to be closed")
+	}
+	// the remaining subscription is unaffected.
+	if _, ok := <-s2.Data; !ok {
+		t.Error("expected s2 to receive a value")
+	}
+	b.Close()
+	s2.Unsubscribe()
+	s3 := b.Subscribe(Config{})
+	if _, ok := <-s3.Data; ok {
+		t.Error("expected a subscription to a closed broadcaster to be closed")
+	}
+}
+
+func TestBroadcasterStop(t *testing.T) {
+	var n int64
+	ctx, cancel := context.WithCancel(context.Background())
+	b := NewBroadcaster(New(ctx, time.Millisecond, Config{}, counter(&n)))
+	s := b.Subscribe(Config{})
+	cancel()
+	select {
+	case <-b.done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for the broadcaster to stop")
+	}
+	for range s.Data {
+	}
+	b.Close()
+}
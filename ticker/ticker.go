@@ -0,0 +1,204 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package ticker provides a generic Ticker that calls a func at intervals
+// and delivers its results, and any errors, on channels. A Ticker is driven
+// by a context.Context: it stops when the context is done or when Stop is
+// called; neither requires a consumer to be reading from the Ticker's
+// channels.
+//
+// The Data and Errs channels can be buffered. What happens when a channel's
+// buffer is full is determined by the Ticker's Policy: Block waits for the
+// consumer, DropOldest discards the oldest buffered value to make room for
+// the new one, and DropNewest discards the new value.
+package ticker
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Policy determines what a Ticker does with a value when the channel it is
+// being sent on is full.
+type Policy int
+
+const (
+	// Block waits until the value is received or the Ticker is stopped.
+	Block Policy = iota
+	// DropOldest discards the oldest value in the channel's buffer to make
+	// room for the value. An unbuffered channel has nothing to discard, so
+	// the value is dropped instead.
+	DropOldest
+	// DropNewest discards the value.
+	DropNewest
+)
+
+func (p Policy) String() string {
+	switch p {
+	case Block:
+		return "block"
+	case DropOldest:
+		return "drop-oldest"
+	case DropNewest:
+		return "drop-newest"
+	}
+	return fmt.Sprintf("Policy(%d)", int(p))
+}
+
+// Config holds a Ticker's channel configuration. The zero value is an
+// unbuffered Ticker that blocks until its values are received.
+type Config struct {
+	// Buffer is the capacity of the Data and Errs channels.
+	Buffer int
+	// Policy determines what happens when a channel is full.
+	Policy Policy
+}
+
+// Ticker calls a func at intervals and delivers its results on the Data
+// channel and its errors on the Errs channel. Done is closed when the Ticker
+// has been stopped, either by Stop, Close, or the cancellation of the
+// Ticker's context.
+type Ticker[T any] struct {
+	*time.Ticker
+	Data chan T
+	Errs chan error
+	Done <-chan struct{}
+
+	get    func() (T, error)
+	policy Policy
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	running bool
+	closed  bool
+	wg      sync.WaitGroup
+}
+
+// New returns a Ticker that calls get every d until ctx is done or the Ticker
+// is stopped. The Ticker doesn't start running until Run is called.
+func New[T any](ctx context.Context, d time.Duration, cfg Config, get func() (T, error)) *Ticker[T] {
+	if cfg.Buffer < 0 {
+		cfg.Buffer = 0
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Ticker[T]{
+		Ticker: time.NewTicker(d),
+		Data:   make(chan T, cfg.Buffer),
+		Errs:   make(chan error, cfg.Buffer),
+		Done:   ctx.Done(),
+		get:    get,
+		policy: cfg.Policy,
+		cancel: cancel,
+	}
+}
+
+// Start returns a Ticker, created by New, that is already running.
+func Start[T any](ctx context.Context, d time.Duration, cfg Config, get func() (T, error)) *Ticker[T] {
+	t := New(ctx, d, cfg, get)
+	go t.Run()
+	return t
+}
+
+// Run runs the ticker until it is stopped. Run returns immediately if the
+// ticker is already running or has been closed; a stopped Ticker can't be
+// restarted.
+func (t *Ticker[T]) Run() {
+	t.mu.Lock()
+	if t.running || t.closed {
+		t.mu.Unlock()
+		return
+	}
+	t.running = true
+	t.wg.Add(1)
+	t.mu.Unlock()
+	defer t.wg.Done()
+	for {
+		select {
+		case <-t.Done:
+			return
+		case <-t.C:
+			// a tick and a stop can be ready at the same time; the stop wins.
+			select {
+			case <-t.Done:
+				return
+			default:
+			}
+			v, err := t.get()
+			if err != nil {
+				send(t.Errs, err, t.policy, t.Done)
+				continue
+			}
+			send(t.Data, v, t.policy, t.Done)
+		}
+	}
+}
+
+// Stop stops the ticker. Stop doesn't block and is safe to call more than
+// once, whether or not the ticker is running. Stopping the ticker doesn't
+// close the channels; call Close for that.
+func (t *Ticker[T]) Stop() {
+	t.cancel()
+}
+
+// Close stops the ticker, waits for Run to return, and closes the Data and
+// Errs channels.
+func (t *Ticker[T]) Close() {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return
+	}
+	t.closed = true
+	t.mu.Unlock()
+	t.cancel()
+	t.wg.Wait()
+	t.Ticker.Stop()
+	close(t.Data)
+	close(t.Errs)
+}
+
+// send sends v on ch according to policy. A Block send gives up when done is
+// closed.
+func send[V any](ch chan V, v V, policy Policy, done <-chan struct{}) {
+	switch policy {
+	case DropOldest:
+		if cap(ch) > 0 {
+			for {
+				select {
+				case ch <- v:
+					return
+				default:
+				}
+				// the buffer is full; discard the oldest value, unless the
+				// consumer already has.
+				select {
+				case <-ch:
+				default:
+				}
+			}
+		}
+		fallthrough
+	case DropNewest:
+		select {
+		case ch <- v:
+		default:
+		}
+	default:
+		select {
+		case ch <- v:
+		case <-done:
+		}
+	}
+}
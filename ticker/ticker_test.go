@@ -0,0 +1,180 @@
+// Copyright 2016 Joel Scoble and The JoeFriday authors.
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ticker
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// counter returns a get func that returns the number of times it has been
+// called.
+func counter(n *int64) func() (int64, error) {
+	return func() (int64, error) {
+		return atomic.AddInt64(n, 1), nil
+	}
+}
+
+// waitFor waits until n is at least v.
+func waitFor(t *testing.T, n *int64, v int64) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		if atomic.LoadInt64(n) >= v {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d calls; got %d", v, atomic.LoadInt64(n))
+}
+
+func drain(ch chan int64) []int64 {
+	var vals []int64
+	for v := range ch {
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+func TestTickerBlock(t *testing.T) {
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{}, counter(&n))
+	go tk.Run()
+	for i := int64(1); i <= 5; i++ {
+		v := <-tk.Data
+		if v != i {
+			t.Errorf("got %d; want %d", v, i)
+		}
+	}
+	tk.Stop()
+	tk.Close()
+}
+
+func TestTickerDropNewest(t *testing.T) {
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{Buffer: 2, Policy: DropNewest}, counter(&n))
+	go tk.Run()
+	waitFor(t, &n, 5)
+	tk.Close()
+	vals := drain(tk.Data)
+	if len(vals) != 2 || vals[0] != 1 || vals[1] != 2 {
+		t.Errorf("got %v; want [1 2]", vals)
+	}
+}
+
+func TestTickerDropOldest(t *testing.T) {
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{Buffer: 2, Policy: DropOldest}, counter(&n))
+	go tk.Run()
+	waitFor(t, &n, 5)
+	tk.Close()
+	last := atomic.LoadInt64(&n)
+	vals := drain(tk.Data)
+	if len(vals) != 2 || vals[0] != last-1 || vals[1] != last {
+		t.Errorf("got %v; want [%d %d]", vals, last-1, last)
+	}
+}
+
+func TestTickerDropUnbuffered(t *testing.T) {
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{Policy: DropOldest}, counter(&n))
+	go tk.Run()
+	waitFor(t, &n, 3)
+	tk.Close()
+	if vals := drain(tk.Data); len(vals) != 0 {
+		t.Errorf("got %v; want no values", vals)
+	}
+}
+
+func TestTickerErrs(t *testing.T) {
+	errTest := errors.New("test error")
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{}, func() (int64, error) {
+		atomic.AddInt64(&n, 1)
+		return 0, errTest
+	})
+	go tk.Run()
+	if err := <-tk.Errs; err != errTest {
+		t.Errorf("got %v; want %v", err, errTest)
+	}
+	// nothing is reading Errs; stopping the ticker must not block.
+	waitFor(t, &n, 2)
+	tk.Stop()
+	tk.Close()
+}
+
+func TestTickerStop(t *testing.T) {
+	var n int64
+	tk := New(context.Background(), time.Millisecond, Config{}, counter(&n))
+	// the ticker isn't running; neither call may block.
+	tk.Stop()
+	tk.Stop()
+	select {
+	case <-tk.Done:
+	default:
+		t.Error("expected Done to be closed")
+	}
+	go tk.Run()
+	tk.Close()
+	tk.Close()
+	if v := atomic.LoadInt64(&n); v != 0 {
+		t.Errorf("got %d calls; want 0", v)
+	}
+}
+
+func TestTickerContext(t *testing.T) {
+	var n int64
+	ctx, cancel := context.WithCancel(context.Background())
+	tk := New(ctx, time.Millisecond, Config{}, counter(&n))
+	done := make(chan struct{})
+	go func() {
+		tk.Run()
+		close(done)
+	}()
+	<-tk.Data
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for Run to return after the context was canceled")
+	}
+	tk.Close()
+}
+
+func TestStart(t *testing.T) {
+	var n int64
+	tk := Start(context.Background(), time.Millisecond, Config{}, counter(&n))
+	<-tk.Data
+	<-tk.Data
+	tk.Close()
+}
+
+func TestPolicyString(t *testing.T) {
+	tests := []struct {
+		p    Policy
+		want string
+	}{
+		{Block, "block"},
+		{DropOldest, "drop-oldest"},
+		{DropNewest, "drop-newest"},
+		{Policy(42), "Policy(42)"},
+	}
+	for _, test := range tests {
+		if s := test.p.String(); s != test.want {
+			t.Errorf("%d: got %q; want %q", int(test.p), s, test.want)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package tools
+
+import "math"
+
+// Counter is a counter's value. The kernel's counters are unsigned, but some
+// of them are stored as int64.
+type Counter interface {
+	~int64 | ~uint64
+}
+
+// CounterDelta returns the amount a monotonically increasing counter has
+// increased by between the prior and current values. If the current value is
+// less than the prior value, the counter either wrapped or was reset, e.g. the
+// device it belongs to was removed and re-added between the two readings.
+// Counters exposed by the kernel are unsigned longs, which are only 32 bits on
+// 32-bit systems. If the prior value fits in 32 bits and treating the decrease
+// as a 32-bit wrap results in a plausible delta, less than half the 32-bit
+// range, it is treated as a wrap. Otherwise, the counter is treated as having
+// been reset to 0 and the current value is the delta.
+//
+// CounterDelta is for counters that can be reset; for counters that can't,
+// but that can go backwards, use CounterAdvance.
+func CounterDelta[T Counter](cur, prior T) T {
+	c, p := uint64(cur), uint64(prior)
+	if c >= p {
+		return T(c - p)
+	}
+	if p <= math.MaxUint32 {
+		d := math.MaxUint32 - p + c + 1
+		if d < math.MaxUint32/2 {
+			return T(d)
+		}
+	}
+	return cur
+}
+
+// CounterAdvance returns the amount a counter has advanced by between the
+// prior and current values. A counter that went backwards didn't advance and
+// 0 is returned, e.g. the cpu times, whose iowait can go backwards, see
+// proc(5), and the counters of a process, which aren't reset or wrapped.
+func CounterAdvance[T Counter](cur, prior T) T {
+	if cur < prior {
+		return 0
+	}
+	return cur - prior
+}
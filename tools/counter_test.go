@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCounterDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		cur      uint64
+		prior    uint64
+		expected uint64
+	}{
+		{"unchanged", 42, 42, 0},
+		{"increased", 1500, 1000, 500},
+		{"increased past 32 bits", 5000000000, 4294967000, 705033000},
+		{"wrapped at 32 bits", 100, 4294967000, 396},
+		{"wrapped to 0", 0, math.MaxUint32, 1},
+		{"reset", 300, 5000000000, 300},
+		{"reset within 32 bits", 10, 1000, 10},
+		{"reset to 0", 0, 1000, 0},
+	}
+	for _, test := range tests {
+		d := CounterDelta(test.cur, test.prior)
+		if d != test.expected {
+			t.Errorf("%s: got %d; want %d", test.name, d, test.expected)
+		}
+	}
+}
+
+func TestCounterAdvance(t *testing.T) {
+	tests := []struct {
+		name     string
+		cur      int64
+		prior    int64
+		expected int64
+	}{
+		{"unchanged", 42, 42, 0},
+		{"increased", 1500, 1000, 500},
+		{"went backwards", 100, 4294967000, 0},
+		{"went backwards within 32 bits", 999, 1000, 0},
+	}
+	for _, test := range tests {
+		d := CounterAdvance(test.cur, test.prior)
+		if d != test.expected {
+			t.Errorf("%s: got %d; want %d", test.name, d, test.expected)
+		}
+	}
+}
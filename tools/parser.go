@@ -55,3 +55,55 @@ func ParseUint(s []byte) (n uint64, err error) {
 Error:
 	return n, &strconv.NumError{Func: "ParseUint", Num: string(s), Err: err}
 }
+
+// ParseInt is like ParseUint but accepts a leading '-' for negative numbers.
+func ParseInt(s []byte) (n int64, err error) {
+	var neg bool
+	if len(s) > 0 && s[0] == '-' {
+		neg = true
+		s = s[1:]
+	}
+	u, err := ParseUint(s)
+	if err != nil {
+		if ne, ok := err.(*strconv.NumError); ok {
+			ne.Func = "ParseInt"
+		}
+		return 0, err
+	}
+	if neg {
+		if u > 1<<63 {
+			return -1 << 63, &strconv.NumError{Func: "ParseInt", Num: "-" + string(s), Err: strconv.ErrRange}
+		}
+		return -int64(u), nil
+	}
+	if u > 1<<63-1 {
+		return 1<<63 - 1, &strconv.NumError{Func: "ParseInt", Num: string(s), Err: strconv.ErrRange}
+	}
+	return int64(u), nil
+}
+
+// ParseHex is like ParseUint but for hexadecimal numbers without a prefix.
+func ParseHex(s []byte) (n uint64, err error) {
+	if len(s) == 0 {
+		return 0, &strconv.NumError{Func: "ParseHex", Num: string(s), Err: strconv.ErrSyntax}
+	}
+	if len(s) > 16 {
+		return 0, &strconv.NumError{Func: "ParseHex", Num: string(s), Err: strconv.ErrRange}
+	}
+	for i := 0; i < len(s); i++ {
+		var v byte
+		d := s[i]
+		switch {
+		case '0' <= d && d <= '9':
+			v = d - '0'
+		case 'a' <= d && d <= 'f':
+			v = d - 'a' + 10
+		case 'A' <= d && d <= 'F':
+			v = d - 'A' + 10
+		default:
+			return 0, &strconv.NumError{Func: "ParseHex", Num: string(s), Err: strconv.ErrSyntax}
+		}
+		n = n<<4 | uint64(v)
+	}
+	return n, nil
+}